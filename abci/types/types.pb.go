@@ -2126,6 +2126,11 @@ type ResponseCheckTx struct {
 	// mempool_error is set by CometBFT.
 	// ABCI applications creating a ResponseCheckTX should not set mempool_error.
 	MempoolError string `protobuf:"bytes,11,opt,name=mempool_error,json=mempoolError,proto3" json:"mempool_error,omitempty"`
+	// sequence is the position, starting at 1, of the transaction in the
+	// sequence of transactions of its sender. Mempools supporting replacement use
+	// it along with sender to order and replace transactions. Zero means the
+	// transaction has no sequence.
+	Sequence uint64 `protobuf:"varint,12,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
 func (m *ResponseCheckTx) Reset()         { *m = ResponseCheckTx{} }
@@ -2238,6 +2243,13 @@ func (m *ResponseCheckTx) GetMempoolError() string {
 	return ""
 }
 
+func (m *ResponseCheckTx) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
 type ResponseDeliverTx struct {
 	Code      uint32  `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	Data      []byte  `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -3463,196 +3475,196 @@ func init() {
 func init() { proto.RegisterFile("tendermint/abci/types.proto", fileDescriptor_252557cfdd89a31a) }
 
 var fileDescriptor_252557cfdd89a31a = []byte{
-	// 3010 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0x3b, 0x73, 0x23, 0xc7,
-	0xf1, 0xc7, 0xfb, 0xd1, 0x78, 0x72, 0x8e, 0xa2, 0x70, 0xd0, 0x89, 0xa4, 0xf6, 0x4a, 0xd2, 0xdd,
-	0x49, 0x22, 0xf5, 0xa7, 0xfe, 0xa7, 0x47, 0xc9, 0xb2, 0x05, 0xe0, 0x70, 0x06, 0x45, 0x8a, 0xa4,
-	0x97, 0xe0, 0xa9, 0xe4, 0xc7, 0xad, 0x16, 0xd8, 0x21, 0xb1, 0x3a, 0x60, 0x77, 0xb5, 0x3b, 0xa0,
-	0x40, 0x85, 0x56, 0xb9, 0xca, 0xa5, 0x72, 0xa0, 0x50, 0x89, 0x02, 0x07, 0xfe, 0x0e, 0x8e, 0x1c,
-	0x39, 0x50, 0xe0, 0x40, 0x81, 0x03, 0x07, 0x2e, 0xd9, 0x25, 0x65, 0xfe, 0x02, 0x0e, 0x1c, 0xd8,
-	0x35, 0x8f, 0x7d, 0x01, 0x58, 0x02, 0x94, 0x5c, 0xae, 0x72, 0x39, 0x9b, 0xe9, 0xed, 0xee, 0x99,
-	0xe9, 0x99, 0xe9, 0xee, 0x5f, 0xef, 0xc0, 0x13, 0x04, 0x1b, 0x1a, 0xb6, 0x47, 0xba, 0x41, 0xb6,
-	0xd5, 0x5e, 0x5f, 0xdf, 0x26, 0x17, 0x16, 0x76, 0xb6, 0x2c, 0xdb, 0x24, 0x26, 0xaa, 0xf8, 0x1f,
-	0xb7, 0xe8, 0xc7, 0xfa, 0x93, 0x01, 0xee, 0xbe, 0x7d, 0x61, 0x11, 0x73, 0xdb, 0xb2, 0x4d, 0xf3,
-	0x94, 0xf3, 0xd7, 0x6f, 0x04, 0x3e, 0x33, 0x3d, 0x41, 0x6d, 0xa1, 0xaf, 0x42, 0xf8, 0x11, 0xbe,
-	0x70, 0xbf, 0x3e, 0x39, 0x23, 0x6b, 0xa9, 0xb6, 0x3a, 0x72, 0x3f, 0x6f, 0x9c, 0x99, 0xe6, 0xd9,
-	0x10, 0x6f, 0xb3, 0x5e, 0x6f, 0x7c, 0xba, 0x4d, 0xf4, 0x11, 0x76, 0x88, 0x3a, 0xb2, 0x04, 0xc3,
-	0xea, 0x99, 0x79, 0x66, 0xb2, 0xe6, 0x36, 0x6d, 0x71, 0xaa, 0xf4, 0xcf, 0x1c, 0x64, 0x65, 0xfc,
-	0xc1, 0x18, 0x3b, 0x04, 0xed, 0x40, 0x0a, 0xf7, 0x07, 0x66, 0x2d, 0xbe, 0x19, 0xbf, 0x55, 0xd8,
-	0xb9, 0xb1, 0x35, 0xb5, 0xb8, 0x2d, 0xc1, 0xd7, 0xee, 0x0f, 0xcc, 0x4e, 0x4c, 0x66, 0xbc, 0xe8,
-	0x2e, 0xa4, 0x4f, 0x87, 0x63, 0x67, 0x50, 0x4b, 0x30, 0xa1, 0x27, 0xa3, 0x84, 0xee, 0x53, 0xa6,
-	0x4e, 0x4c, 0xe6, 0xdc, 0x74, 0x28, 0xdd, 0x38, 0x35, 0x6b, 0xc9, 0xcb, 0x87, 0xda, 0x35, 0x4e,
-	0xd9, 0x50, 0x94, 0x17, 0x35, 0x01, 0x74, 0x43, 0x27, 0x4a, 0x7f, 0xa0, 0xea, 0x46, 0x2d, 0xcd,
-	0x24, 0x9f, 0x8a, 0x96, 0xd4, 0x49, 0x8b, 0x32, 0x76, 0x62, 0x72, 0x5e, 0x77, 0x3b, 0x74, 0xba,
-	0x1f, 0x8c, 0xb1, 0x7d, 0x51, 0xcb, 0x5c, 0x3e, 0xdd, 0x1f, 0x51, 0x26, 0x3a, 0x5d, 0xc6, 0x8d,
-	0xda, 0x50, 0xe8, 0xe1, 0x33, 0xdd, 0x50, 0x7a, 0x43, 0xb3, 0xff, 0xa8, 0x96, 0x65, 0xc2, 0x52,
-	0x94, 0x70, 0x93, 0xb2, 0x36, 0x29, 0x67, 0x27, 0x26, 0x43, 0xcf, 0xeb, 0xa1, 0xef, 0x41, 0xae,
-	0x3f, 0xc0, 0xfd, 0x47, 0x0a, 0x99, 0xd4, 0x72, 0x4c, 0xc7, 0x46, 0x94, 0x8e, 0x16, 0xe5, 0xeb,
-	0x4e, 0x3a, 0x31, 0x39, 0xdb, 0xe7, 0x4d, 0xba, 0x7e, 0x0d, 0x0f, 0xf5, 0x73, 0x6c, 0x53, 0xf9,
-	0xfc, 0xe5, 0xeb, 0xbf, 0xc7, 0x39, 0x99, 0x86, 0xbc, 0xe6, 0x76, 0xd0, 0x0f, 0x20, 0x8f, 0x0d,
-	0x4d, 0x2c, 0x03, 0x98, 0x8a, 0xcd, 0xc8, 0x7d, 0x36, 0x34, 0x77, 0x11, 0x39, 0x2c, 0xda, 0xe8,
-	0x55, 0xc8, 0xf4, 0xcd, 0xd1, 0x48, 0x27, 0xb5, 0x02, 0x93, 0x5e, 0x8f, 0x5c, 0x00, 0xe3, 0xea,
-	0xc4, 0x64, 0xc1, 0x8f, 0x0e, 0xa0, 0x3c, 0xd4, 0x1d, 0xa2, 0x38, 0x86, 0x6a, 0x39, 0x03, 0x93,
-	0x38, 0xb5, 0x22, 0xd3, 0xf0, 0x74, 0x94, 0x86, 0x7d, 0xdd, 0x21, 0xc7, 0x2e, 0x73, 0x27, 0x26,
-	0x97, 0x86, 0x41, 0x02, 0xd5, 0x67, 0x9e, 0x9e, 0x62, 0xdb, 0x53, 0x58, 0x2b, 0x5d, 0xae, 0xef,
-	0x90, 0x72, 0xbb, 0xf2, 0x54, 0x9f, 0x19, 0x24, 0xa0, 0x9f, 0xc0, 0xb5, 0xa1, 0xa9, 0x6a, 0x9e,
-	0x3a, 0xa5, 0x3f, 0x18, 0x1b, 0x8f, 0x6a, 0x65, 0xa6, 0xf4, 0x76, 0xe4, 0x24, 0x4d, 0x55, 0x73,
-	0x55, 0xb4, 0xa8, 0x40, 0x27, 0x26, 0xaf, 0x0c, 0xa7, 0x89, 0xe8, 0x21, 0xac, 0xaa, 0x96, 0x35,
-	0xbc, 0x98, 0xd6, 0x5e, 0x61, 0xda, 0xef, 0x44, 0x69, 0x6f, 0x50, 0x99, 0x69, 0xf5, 0x48, 0x9d,
-	0xa1, 0xa2, 0x2e, 0x54, 0x2d, 0x1b, 0x5b, 0xaa, 0x8d, 0x15, 0xcb, 0x36, 0x2d, 0xd3, 0x51, 0x87,
-	0xb5, 0x2a, 0xd3, 0xfd, 0x6c, 0x94, 0xee, 0x23, 0xce, 0x7f, 0x24, 0xd8, 0x3b, 0x31, 0xb9, 0x62,
-	0x85, 0x49, 0x5c, 0xab, 0xd9, 0xc7, 0x8e, 0xe3, 0x6b, 0x5d, 0x59, 0xa4, 0x95, 0xf1, 0x87, 0xb5,
-	0x86, 0x48, 0xcd, 0x2c, 0xa4, 0xcf, 0xd5, 0xe1, 0x18, 0xbf, 0x95, 0xca, 0xa5, 0xaa, 0x69, 0xe9,
-	0x59, 0x28, 0x04, 0x1c, 0x0b, 0xaa, 0x41, 0x76, 0x84, 0x1d, 0x47, 0x3d, 0xc3, 0xcc, 0x0f, 0xe5,
-	0x65, 0xb7, 0x2b, 0x95, 0xa1, 0x18, 0x74, 0x26, 0xd2, 0xa7, 0x71, 0x4f, 0x92, 0xfa, 0x09, 0x2a,
-	0x79, 0x8e, 0x6d, 0x47, 0x37, 0x0d, 0x57, 0x52, 0x74, 0xd1, 0x4d, 0x28, 0xb1, 0x13, 0xaf, 0xb8,
-	0xdf, 0xa9, 0xb3, 0x4a, 0xc9, 0x45, 0x46, 0x7c, 0x20, 0x98, 0x36, 0xa0, 0x60, 0xed, 0x58, 0x1e,
-	0x4b, 0x92, 0xb1, 0x80, 0xb5, 0x63, 0xb9, 0x0c, 0x4f, 0x41, 0x91, 0xae, 0xd4, 0xe3, 0x48, 0xb1,
-	0x41, 0x0a, 0x94, 0x26, 0x58, 0xa4, 0x3f, 0x24, 0xa0, 0x3a, 0xed, 0x80, 0xd0, 0xab, 0x90, 0xa2,
-	0xbe, 0x58, 0xb8, 0xd5, 0xfa, 0x16, 0x77, 0xd4, 0x5b, 0xae, 0xa3, 0xde, 0xea, 0xba, 0x8e, 0xba,
-	0x99, 0xfb, 0xe2, 0xab, 0x8d, 0xd8, 0xa7, 0x7f, 0xd9, 0x88, 0xcb, 0x4c, 0x02, 0x5d, 0xa7, 0xfe,
-	0x42, 0xd5, 0x0d, 0x45, 0xd7, 0xd8, 0x94, 0xf3, 0xd4, 0x19, 0xa8, 0xba, 0xb1, 0xab, 0xa1, 0x7d,
-	0xa8, 0xf6, 0x4d, 0xc3, 0xc1, 0x86, 0x33, 0x76, 0x14, 0x1e, 0x08, 0x84, 0x33, 0x0d, 0xb9, 0x04,
-	0x1e, 0x5e, 0x5a, 0x2e, 0xe7, 0x11, 0x63, 0x94, 0x2b, 0xfd, 0x30, 0x01, 0xdd, 0x07, 0x38, 0x57,
-	0x87, 0xba, 0xa6, 0x12, 0xd3, 0x76, 0x6a, 0xa9, 0xcd, 0xe4, 0x5c, 0xbf, 0xf0, 0xc0, 0x65, 0x39,
-	0xb1, 0x34, 0x95, 0xe0, 0x66, 0x8a, 0x4e, 0x57, 0x0e, 0x48, 0xa2, 0x67, 0xa0, 0xa2, 0x5a, 0x96,
-	0xe2, 0x10, 0x95, 0x60, 0xa5, 0x77, 0x41, 0xb0, 0xc3, 0xfc, 0x74, 0x51, 0x2e, 0xa9, 0x96, 0x75,
-	0x4c, 0xa9, 0x4d, 0x4a, 0x44, 0x4f, 0x43, 0x99, 0xfa, 0x64, 0x5d, 0x1d, 0x2a, 0x03, 0xac, 0x9f,
-	0x0d, 0x08, 0xf3, 0xc7, 0x49, 0xb9, 0x24, 0xa8, 0x1d, 0x46, 0x94, 0x34, 0x6f, 0xc7, 0x99, 0x3f,
-	0x46, 0x08, 0x52, 0x9a, 0x4a, 0x54, 0x66, 0xc9, 0xa2, 0xcc, 0xda, 0x94, 0x66, 0xa9, 0x64, 0x20,
-	0xec, 0xc3, 0xda, 0x68, 0x0d, 0x32, 0x42, 0x6d, 0x92, 0xa9, 0x15, 0x3d, 0xb4, 0x0a, 0x69, 0xcb,
-	0x36, 0xcf, 0x31, 0xdb, 0xba, 0x9c, 0xcc, 0x3b, 0xd2, 0xc7, 0x09, 0x58, 0x99, 0xf1, 0xdc, 0x54,
-	0xef, 0x40, 0x75, 0x06, 0xee, 0x58, 0xb4, 0x8d, 0x5e, 0xa6, 0x7a, 0x55, 0x0d, 0xdb, 0x22, 0xda,
-	0xd5, 0x66, 0x4d, 0xdd, 0x61, 0xdf, 0x85, 0x69, 0x04, 0x37, 0xda, 0x83, 0xea, 0x50, 0x75, 0x88,
-	0xc2, 0x3d, 0xa1, 0x12, 0x88, 0x7c, 0x4f, 0xcc, 0x18, 0x99, 0xfb, 0x4d, 0x7a, 0xa0, 0x85, 0x92,
-	0x32, 0x15, 0xf5, 0xa9, 0xe8, 0x04, 0x56, 0x7b, 0x17, 0x1f, 0xa9, 0x06, 0xd1, 0x0d, 0xac, 0xcc,
-	0xec, 0xda, 0x6c, 0x28, 0x7d, 0x5b, 0x77, 0x7a, 0x78, 0xa0, 0x9e, 0xeb, 0xa6, 0x3b, 0xad, 0x6b,
-	0x9e, 0xbc, 0xb7, 0xa3, 0x8e, 0x24, 0x43, 0x39, 0x1c, 0x7a, 0x50, 0x19, 0x12, 0x64, 0x22, 0xd6,
-	0x9f, 0x20, 0x13, 0xf4, 0x22, 0xa4, 0xe8, 0x1a, 0xd9, 0xda, 0xcb, 0x73, 0x06, 0x12, 0x72, 0xdd,
-	0x0b, 0x0b, 0xcb, 0x8c, 0x53, 0x92, 0xbc, 0xdb, 0xe0, 0x85, 0xa3, 0x69, 0xad, 0xd2, 0x6d, 0xa8,
-	0x4c, 0xc5, 0x9b, 0xc0, 0xf6, 0xc5, 0x83, 0xdb, 0x27, 0x55, 0xa0, 0x14, 0x0a, 0x2e, 0xd2, 0x1a,
-	0xac, 0xce, 0x8b, 0x15, 0xd2, 0xc0, 0xa3, 0x87, 0x7c, 0x3e, 0xba, 0x0b, 0x39, 0x2f, 0x58, 0xf0,
-	0xdb, 0x78, 0x7d, 0x66, 0x15, 0x2e, 0xb3, 0xec, 0xb1, 0xd2, 0x6b, 0x48, 0x4f, 0x35, 0x3b, 0x0e,
-	0x09, 0x36, 0xf1, 0xac, 0x6a, 0x59, 0x1d, 0xd5, 0x19, 0x48, 0xef, 0x41, 0x2d, 0x2a, 0x10, 0x4c,
-	0x2d, 0x23, 0xe5, 0x9d, 0xc2, 0x35, 0xc8, 0x9c, 0x9a, 0xf6, 0x48, 0x25, 0x4c, 0x59, 0x49, 0x16,
-	0x3d, 0x7a, 0x3a, 0x79, 0x50, 0x48, 0x32, 0x32, 0xef, 0x48, 0x0a, 0x5c, 0x8f, 0x0c, 0x06, 0x54,
-	0x44, 0x37, 0x34, 0xcc, 0xed, 0x59, 0x92, 0x79, 0xc7, 0x57, 0xc4, 0x27, 0xcb, 0x3b, 0x74, 0x58,
-	0x87, 0xad, 0x95, 0xe9, 0xcf, 0xcb, 0xa2, 0x27, 0x7d, 0x96, 0x84, 0xb5, 0xf9, 0x21, 0x01, 0x6d,
-	0x42, 0x71, 0xa4, 0x4e, 0x14, 0x32, 0x11, 0x77, 0x99, 0x6f, 0x07, 0x8c, 0xd4, 0x49, 0x77, 0xc2,
-	0x2f, 0x72, 0x15, 0x92, 0x64, 0xe2, 0xd4, 0x12, 0x9b, 0xc9, 0x5b, 0x45, 0x99, 0x36, 0xd1, 0x09,
-	0xac, 0x0c, 0xcd, 0xbe, 0x3a, 0x54, 0x02, 0x27, 0x5e, 0x1c, 0xf6, 0x9b, 0x33, 0xc6, 0x6e, 0x4f,
-	0x18, 0x45, 0x9b, 0x39, 0xf4, 0x15, 0xa6, 0x63, 0xdf, 0x3b, 0xf9, 0xe8, 0x1e, 0x14, 0x46, 0xfe,
-	0x41, 0xbe, 0xc2, 0x61, 0x0f, 0x8a, 0x05, 0xb6, 0x24, 0x1d, 0x72, 0x0c, 0xae, 0x8b, 0xce, 0x5c,
-	0xd9, 0x45, 0xbf, 0x08, 0xab, 0x06, 0x9e, 0x90, 0xc0, 0x45, 0xe4, 0xe7, 0x24, 0xcb, 0x4c, 0x8f,
-	0xe8, 0x37, 0xff, 0x92, 0xd1, 0x23, 0x83, 0x6e, 0xb3, 0xa0, 0x6a, 0x99, 0x0e, 0xb6, 0x15, 0x55,
-	0xd3, 0x6c, 0xec, 0x38, 0x2c, 0x19, 0x2c, 0xb2, 0x48, 0xc9, 0xe8, 0x0d, 0x4e, 0x96, 0x7e, 0x19,
-	0xdc, 0x9a, 0x50, 0x10, 0x75, 0x0d, 0x1f, 0xf7, 0x0d, 0x7f, 0x0c, 0xab, 0x42, 0x5e, 0x0b, 0xd9,
-	0x3e, 0xb1, 0xac, 0xa3, 0x41, 0xae, 0x78, 0xb4, 0xd9, 0x93, 0xdf, 0xce, 0xec, 0xae, 0x2f, 0x4d,
-	0x05, 0x7c, 0xe9, 0x7f, 0xd9, 0x56, 0xfc, 0x31, 0x0f, 0x39, 0x19, 0x3b, 0x16, 0x0d, 0x9c, 0xa8,
-	0x09, 0x79, 0x3c, 0xe9, 0x63, 0x8b, 0xb8, 0xb9, 0xc6, 0x7c, 0x30, 0xc0, 0xb9, 0xdb, 0x2e, 0x27,
-	0xcd, 0xc4, 0x3d, 0x31, 0xf4, 0x92, 0x00, 0x5b, 0xd1, 0xb8, 0x49, 0x88, 0x07, 0xd1, 0xd6, 0xcb,
-	0x2e, 0xda, 0x4a, 0x46, 0x26, 0xdf, 0x5c, 0x6a, 0x0a, 0x6e, 0xbd, 0x24, 0xe0, 0x56, 0x6a, 0xc1,
-	0x60, 0x21, 0xbc, 0xd5, 0x0a, 0xe1, 0xad, 0xcc, 0x82, 0x65, 0x46, 0x00, 0xae, 0x97, 0x5d, 0xc0,
-	0x95, 0x5d, 0x30, 0xe3, 0x29, 0xc4, 0x75, 0x3f, 0x8c, 0xb8, 0x72, 0x11, 0x0e, 0xc4, 0x95, 0x8e,
-	0x84, 0x5c, 0x6f, 0x04, 0x20, 0x57, 0x3e, 0x12, 0xef, 0x70, 0x25, 0x73, 0x30, 0x57, 0x2b, 0x84,
-	0xb9, 0x60, 0x81, 0x0d, 0x22, 0x40, 0xd7, 0x9b, 0x41, 0xd0, 0x55, 0x88, 0xc4, 0x6d, 0x62, 0xbf,
-	0xe7, 0xa1, 0xae, 0xd7, 0x3c, 0xd4, 0x55, 0x8c, 0x84, 0x8d, 0x62, 0x0d, 0xd3, 0xb0, 0xeb, 0x70,
-	0x06, 0x76, 0x71, 0x98, 0xf4, 0x4c, 0xa4, 0x8a, 0x05, 0xb8, 0xeb, 0x70, 0x06, 0x77, 0x95, 0x17,
-	0x28, 0x5c, 0x00, 0xbc, 0x7e, 0x3a, 0x1f, 0x78, 0x45, 0x43, 0x23, 0x31, 0xcd, 0xe5, 0x90, 0x97,
-	0x12, 0x81, 0xbc, 0x38, 0x3a, 0x7a, 0x2e, 0x52, 0xfd, 0xd2, 0xd0, 0xeb, 0x64, 0x0e, 0xf4, 0xe2,
-	0x20, 0xe9, 0x56, 0xa4, 0xf2, 0x25, 0xb0, 0xd7, 0xc9, 0x1c, 0xec, 0x85, 0x16, 0xaa, 0xbd, 0x0a,
-	0xf8, 0x4a, 0x57, 0x33, 0xd2, 0x6d, 0x9a, 0xfa, 0x4e, 0xf9, 0x29, 0x9a, 0x3f, 0x60, 0xdb, 0x36,
-	0x6d, 0x01, 0xa3, 0x78, 0x47, 0xba, 0x45, 0x93, 0x71, 0xdf, 0x27, 0x5d, 0x02, 0xd4, 0x58, 0x9e,
-	0x16, 0xf0, 0x43, 0xd2, 0x6f, 0xe3, 0xbe, 0x2c, 0xcb, 0x61, 0x83, 0x89, 0x7c, 0x5e, 0x24, 0xf2,
-	0x01, 0xf8, 0x96, 0x08, 0xc3, 0xb7, 0x0d, 0x28, 0xd0, 0xfc, 0x6b, 0x0a, 0x99, 0xa9, 0x96, 0x87,
-	0xcc, 0xee, 0xc0, 0x0a, 0x8b, 0x78, 0x1c, 0xe4, 0x89, 0xb0, 0x92, 0x62, 0x61, 0xa5, 0x42, 0x3f,
-	0xf0, 0x0b, 0xc5, 0xe3, 0xcb, 0x0b, 0x70, 0x2d, 0xc0, 0xeb, 0xe5, 0x75, 0x1c, 0xa6, 0x54, 0x3d,
-	0xee, 0x86, 0x48, 0xf0, 0x7e, 0x1f, 0xf7, 0x2d, 0xe4, 0x43, 0xba, 0x79, 0xe8, 0x2b, 0xfe, 0x6f,
-	0x42, 0x5f, 0x89, 0x6f, 0x8d, 0xbe, 0x82, 0x79, 0x6a, 0x32, 0x9c, 0xa7, 0xfe, 0x3d, 0xee, 0xef,
-	0x89, 0x87, 0xa5, 0xfa, 0xa6, 0x86, 0x45, 0xe6, 0xc8, 0xda, 0x34, 0xa9, 0x18, 0x9a, 0x67, 0x22,
-	0x3f, 0xa4, 0x4d, 0xca, 0xe5, 0x05, 0x8e, 0xbc, 0x88, 0x0b, 0x5e, 0xd2, 0xc9, 0x03, 0xb7, 0x48,
-	0x3a, 0xab, 0x90, 0x7c, 0x84, 0x79, 0x5d, 0xad, 0x28, 0xd3, 0x26, 0xe5, 0x63, 0x47, 0x4d, 0x04,
-	0x60, 0xde, 0x41, 0xaf, 0x42, 0x9e, 0x55, 0x44, 0x15, 0xd3, 0x72, 0x84, 0x5b, 0x0f, 0xe5, 0x26,
-	0xbc, 0xf0, 0xb9, 0x75, 0x44, 0x79, 0x0e, 0x2d, 0x47, 0xce, 0x59, 0xa2, 0x15, 0xc8, 0x18, 0xf2,
-	0xa1, 0x8c, 0xe1, 0x06, 0xe4, 0xe9, 0xec, 0x1d, 0x4b, 0xed, 0x63, 0xe6, 0xa2, 0xf3, 0xb2, 0x4f,
-	0x90, 0x1e, 0x02, 0x9a, 0x0d, 0x12, 0xa8, 0x03, 0x19, 0x7c, 0x8e, 0x0d, 0xc2, 0x33, 0xa8, 0xc2,
-	0xce, 0xda, 0x6c, 0x6a, 0x4a, 0x3f, 0x37, 0x6b, 0xd4, 0xc8, 0x7f, 0xfb, 0x6a, 0xa3, 0xca, 0xb9,
-	0x9f, 0x37, 0x47, 0x3a, 0xc1, 0x23, 0x8b, 0x5c, 0xc8, 0x42, 0x5e, 0xfa, 0x73, 0x82, 0x02, 0x98,
-	0x50, 0x00, 0x99, 0x6b, 0x5b, 0xf7, 0xc8, 0x27, 0x02, 0xd8, 0x75, 0x39, 0x7b, 0xaf, 0x03, 0x9c,
-	0xa9, 0x8e, 0xf2, 0xa1, 0x6a, 0x10, 0xac, 0x09, 0xa3, 0x07, 0x28, 0xa8, 0x0e, 0x39, 0xda, 0x1b,
-	0x3b, 0x58, 0x13, 0x30, 0xda, 0xeb, 0x07, 0xd6, 0x99, 0xfd, 0x6e, 0xeb, 0x0c, 0x5b, 0x39, 0x37,
-	0x65, 0xe5, 0x00, 0xb8, 0xc8, 0x07, 0xc1, 0x05, 0x9d, 0x9b, 0x65, 0xeb, 0xa6, 0xad, 0x93, 0x0b,
-	0xb6, 0x35, 0x49, 0xd9, 0xeb, 0xa3, 0x9b, 0x50, 0x1a, 0xe1, 0x91, 0x65, 0x9a, 0x43, 0x85, 0xbb,
-	0x9b, 0x02, 0x13, 0x2d, 0x0a, 0x62, 0x9b, 0x79, 0x9d, 0x5f, 0x24, 0xfc, 0xfb, 0xe7, 0x83, 0xc8,
-	0xff, 0x39, 0x03, 0x4b, 0xbf, 0x62, 0x95, 0xa5, 0x70, 0x8a, 0x80, 0x8e, 0x61, 0xc5, 0xbb, 0xfe,
-	0xca, 0x98, 0xb9, 0x05, 0xf7, 0x40, 0x2f, 0xeb, 0x3f, 0xaa, 0xe7, 0x61, 0xb2, 0x83, 0xde, 0x85,
-	0xc7, 0xa7, 0x7c, 0x9b, 0xa7, 0x3a, 0xb1, 0xac, 0x8b, 0x7b, 0x2c, 0xec, 0xe2, 0x5c, 0xd5, 0xbe,
-	0xb1, 0x92, 0xdf, 0xf1, 0xd6, 0xed, 0x42, 0x39, 0x9c, 0xf1, 0xcc, 0xdd, 0xfe, 0x9b, 0x50, 0xb2,
-	0x31, 0x51, 0x75, 0x43, 0x09, 0x95, 0x83, 0x8a, 0x9c, 0x28, 0x8a, 0x4c, 0x47, 0xf0, 0xd8, 0xdc,
-	0xcc, 0x07, 0xbd, 0x02, 0x79, 0x3f, 0x69, 0xe2, 0x56, 0xbd, 0xa4, 0x5c, 0xe0, 0xf3, 0x4a, 0xbf,
-	0x8b, 0xfb, 0x2a, 0xc3, 0x05, 0x88, 0x36, 0x64, 0x6c, 0xec, 0x8c, 0x87, 0xbc, 0x24, 0x50, 0xde,
-	0x79, 0x61, 0xb9, 0x9c, 0x89, 0x52, 0xc7, 0x43, 0x22, 0x0b, 0x61, 0xe9, 0x21, 0x64, 0x38, 0x05,
-	0x15, 0x20, 0x7b, 0x72, 0xb0, 0x77, 0x70, 0xf8, 0xce, 0x41, 0x35, 0x86, 0x00, 0x32, 0x8d, 0x56,
-	0xab, 0x7d, 0xd4, 0xad, 0xc6, 0x51, 0x1e, 0xd2, 0x8d, 0xe6, 0xa1, 0xdc, 0xad, 0x26, 0x28, 0x59,
-	0x6e, 0xbf, 0xd5, 0x6e, 0x75, 0xab, 0x49, 0xb4, 0x02, 0x25, 0xde, 0x56, 0xee, 0x1f, 0xca, 0x6f,
-	0x37, 0xba, 0xd5, 0x54, 0x80, 0x74, 0xdc, 0x3e, 0xb8, 0xd7, 0x96, 0xab, 0x69, 0xe9, 0xff, 0xe0,
-	0x7a, 0x64, 0x96, 0xe5, 0x57, 0x17, 0xe2, 0x81, 0xea, 0x82, 0xf4, 0x59, 0x02, 0xea, 0xd1, 0xa9,
-	0x13, 0x7a, 0x6b, 0x6a, 0xe1, 0x3b, 0x57, 0xc8, 0xbb, 0xa6, 0x56, 0x8f, 0x9e, 0x86, 0xb2, 0x8d,
-	0x4f, 0x31, 0xe9, 0x0f, 0x78, 0x2a, 0xc7, 0x43, 0x66, 0x49, 0x2e, 0x09, 0x2a, 0x13, 0x72, 0x38,
-	0xdb, 0xfb, 0xb8, 0x4f, 0x14, 0xee, 0x8b, 0xf8, 0xa1, 0xcb, 0x53, 0x36, 0x4a, 0x3d, 0xe6, 0x44,
-	0xe9, 0xbd, 0x2b, 0xd9, 0x32, 0x0f, 0x69, 0xb9, 0xdd, 0x95, 0xdf, 0xad, 0x26, 0x11, 0x82, 0x32,
-	0x6b, 0x2a, 0xc7, 0x07, 0x8d, 0xa3, 0xe3, 0xce, 0x21, 0xb5, 0xe5, 0x35, 0xa8, 0xb8, 0xb6, 0x74,
-	0x89, 0x69, 0xe9, 0x39, 0x78, 0x3c, 0x22, 0xef, 0x9b, 0x45, 0xf1, 0xd2, 0xaf, 0xe3, 0x41, 0xee,
-	0x30, 0xe6, 0x3f, 0x84, 0x8c, 0x43, 0x54, 0x32, 0x76, 0x84, 0x11, 0x5f, 0x59, 0x36, 0x11, 0xdc,
-	0x72, 0x1b, 0xc7, 0x4c, 0x5c, 0x16, 0x6a, 0xa4, 0xbb, 0x50, 0x0e, 0x7f, 0x89, 0xb6, 0x81, 0x7f,
-	0x88, 0x12, 0xd2, 0xbb, 0x00, 0x81, 0x7a, 0xe4, 0x2a, 0xa4, 0x6d, 0x73, 0x6c, 0x68, 0x6c, 0x52,
-	0x69, 0x99, 0x77, 0xd0, 0x5d, 0x48, 0x9f, 0x9b, 0xdc, 0x67, 0xcc, 0xbf, 0x38, 0x0f, 0x4c, 0x82,
-	0x03, 0xc5, 0x07, 0xce, 0x2d, 0xe9, 0x80, 0x66, 0x6b, 0x42, 0x11, 0x43, 0xbc, 0x11, 0x1e, 0xe2,
-	0xa9, 0xc8, 0xea, 0xd2, 0xfc, 0xa1, 0x3e, 0x82, 0x34, 0xf3, 0x36, 0xd4, 0x73, 0xb0, 0xba, 0xa6,
-	0x48, 0x46, 0x69, 0x1b, 0xfd, 0x0c, 0x40, 0x25, 0xc4, 0xd6, 0x7b, 0x63, 0x7f, 0x80, 0x8d, 0xf9,
-	0xde, 0xaa, 0xe1, 0xf2, 0x35, 0x6f, 0x08, 0xb7, 0xb5, 0xea, 0x8b, 0x06, 0x5c, 0x57, 0x40, 0xa1,
-	0x74, 0x00, 0xe5, 0xb0, 0xac, 0x9b, 0x3e, 0xf1, 0x39, 0x84, 0xd3, 0x27, 0x9e, 0x0d, 0x8b, 0xf4,
-	0xc9, 0x4b, 0xbe, 0x92, 0xbc, 0x84, 0xcd, 0x3a, 0xd2, 0x27, 0x71, 0xc8, 0x75, 0x27, 0xe2, 0x1c,
-	0x47, 0x94, 0x4f, 0x7d, 0xd1, 0x44, 0xb0, 0x58, 0xc8, 0xeb, 0xb1, 0x49, 0xaf, 0xca, 0xfb, 0xa6,
-	0x77, 0x53, 0x53, 0xcb, 0xa2, 0x5d, 0xb7, 0xda, 0x2d, 0xbc, 0xd3, 0xeb, 0x90, 0xf7, 0x62, 0x0d,
-	0xcd, 0xea, 0xdd, 0xca, 0x4a, 0x5c, 0xa4, 0xa4, 0xbc, 0xcb, 0x8a, 0xf1, 0xe6, 0x87, 0xa2, 0x1c,
-	0x99, 0x94, 0x79, 0x47, 0xd2, 0xa0, 0x32, 0x15, 0xa8, 0xd0, 0xeb, 0x90, 0xb5, 0xc6, 0x3d, 0xc5,
-	0x35, 0xcf, 0x54, 0xfd, 0xc9, 0xcd, 0x17, 0xc7, 0xbd, 0xa1, 0xde, 0xdf, 0xc3, 0x17, 0xee, 0x64,
-	0xac, 0x71, 0x6f, 0x8f, 0x5b, 0x91, 0x8f, 0x92, 0x08, 0x8e, 0x72, 0x0e, 0x39, 0xf7, 0x50, 0xa0,
-	0xef, 0x43, 0xde, 0x8b, 0x81, 0xde, 0x3f, 0x9a, 0xc8, 0xe0, 0x29, 0xd4, 0xfb, 0x22, 0x14, 0x7c,
-	0x38, 0xfa, 0x99, 0xe1, 0x56, 0xdd, 0x38, 0xca, 0x4f, 0xb0, 0xdd, 0xa9, 0xf0, 0x0f, 0xfb, 0x2e,
-	0xa8, 0x90, 0x7e, 0x13, 0x87, 0xea, 0xf4, 0xa9, 0xfc, 0x4f, 0x4e, 0x80, 0x3a, 0x45, 0x7a, 0xfa,
-	0x15, 0x4c, 0x27, 0xe1, 0xa1, 0xa9, 0xa2, 0x5c, 0xa2, 0xd4, 0xb6, 0x4b, 0x94, 0x3e, 0x4e, 0x40,
-	0x21, 0x50, 0xd3, 0x43, 0xff, 0x1f, 0xb8, 0x22, 0xe5, 0x39, 0xb9, 0x45, 0x80, 0xd7, 0x2f, 0xff,
-	0x87, 0x17, 0x96, 0xb8, 0xfa, 0xc2, 0xa2, 0x7e, 0xe3, 0xb8, 0x25, 0xc2, 0xd4, 0x95, 0x4b, 0x84,
-	0xcf, 0x03, 0x22, 0x26, 0x51, 0x87, 0xca, 0xb9, 0x49, 0x74, 0xe3, 0x4c, 0xe1, 0x47, 0x83, 0x67,
-	0x7c, 0x55, 0xf6, 0xe5, 0x01, 0xfb, 0x70, 0xc4, 0x4e, 0xc9, 0xcf, 0xe3, 0x90, 0xf3, 0x42, 0xf7,
-	0x55, 0xab, 0xf9, 0x6b, 0x90, 0x11, 0xd1, 0x89, 0x97, 0xf3, 0x45, 0x6f, 0x6e, 0x2d, 0xb4, 0x0e,
-	0xb9, 0x11, 0x26, 0x2a, 0xcb, 0x5f, 0x38, 0x10, 0xf5, 0xfa, 0x77, 0x5e, 0x83, 0x42, 0xe0, 0xc7,
-	0x0a, 0xf5, 0x13, 0x07, 0xed, 0x77, 0xaa, 0xb1, 0x7a, 0xf6, 0x93, 0xcf, 0x37, 0x93, 0x07, 0xf8,
-	0x43, 0x7a, 0xc3, 0xe4, 0x76, 0xab, 0xd3, 0x6e, 0xed, 0x55, 0xe3, 0xf5, 0xc2, 0x27, 0x9f, 0x6f,
-	0x66, 0x65, 0xcc, 0xca, 0x57, 0x77, 0xf6, 0xa0, 0x32, 0xb5, 0x31, 0x61, 0xff, 0x8e, 0xa0, 0x7c,
-	0xef, 0xe4, 0x68, 0x7f, 0xb7, 0xd5, 0xe8, 0xb6, 0x95, 0x07, 0x87, 0xdd, 0x76, 0x35, 0x8e, 0x1e,
-	0x87, 0x6b, 0xfb, 0xbb, 0x3f, 0xec, 0x74, 0x95, 0xd6, 0xfe, 0x6e, 0xfb, 0xa0, 0xab, 0x34, 0xba,
-	0xdd, 0x46, 0x6b, 0xaf, 0x9a, 0xd8, 0xf9, 0x07, 0x40, 0xa5, 0xd1, 0x6c, 0xed, 0xd2, 0xf8, 0xac,
-	0xf7, 0x55, 0x56, 0x28, 0x68, 0x41, 0x8a, 0x95, 0x02, 0x2e, 0x7d, 0x2a, 0x52, 0xbf, 0xbc, 0xb6,
-	0x89, 0xee, 0x43, 0x9a, 0x55, 0x09, 0xd0, 0xe5, 0x6f, 0x47, 0xea, 0x0b, 0x8a, 0x9d, 0x74, 0x32,
-	0xec, 0x3a, 0x5d, 0xfa, 0x98, 0xa4, 0x7e, 0x79, 0xed, 0x13, 0xc9, 0x90, 0xf7, 0x51, 0xc6, 0xe2,
-	0xc7, 0x15, 0xf5, 0x25, 0xbc, 0x23, 0xda, 0x87, 0xac, 0x0b, 0x0c, 0x17, 0x3d, 0xf7, 0xa8, 0x2f,
-	0x2c, 0x4e, 0x52, 0x73, 0x71, 0x00, 0x7f, 0xf9, 0xdb, 0x95, 0xfa, 0x82, 0x4a, 0x2b, 0xda, 0x85,
-	0x8c, 0xc8, 0x9c, 0x17, 0x3c, 0xe1, 0xa8, 0x2f, 0x2a, 0x36, 0x52, 0xa3, 0xf9, 0xa5, 0x91, 0xc5,
-	0x2f, 0x72, 0xea, 0x4b, 0x14, 0x91, 0xd1, 0x09, 0x40, 0x00, 0xae, 0x2f, 0xf1, 0xd4, 0xa6, 0xbe,
-	0x4c, 0x71, 0x18, 0x1d, 0x42, 0xce, 0x43, 0x4f, 0x0b, 0x1f, 0xbe, 0xd4, 0x17, 0x57, 0x69, 0xd1,
-	0x43, 0x28, 0x85, 0x51, 0xc3, 0x72, 0xcf, 0x59, 0xea, 0x4b, 0x96, 0x5f, 0xa9, 0xfe, 0x30, 0x84,
-	0x58, 0xee, 0x79, 0x4b, 0x7d, 0xc9, 0x6a, 0x2c, 0x7a, 0x1f, 0x56, 0x66, 0x53, 0xfc, 0xe5, 0x5f,
-	0xbb, 0xd4, 0xaf, 0x50, 0x9f, 0x45, 0x23, 0x40, 0x73, 0xa0, 0xc1, 0x15, 0x1e, 0xbf, 0xd4, 0xaf,
-	0x52, 0xae, 0x45, 0x1a, 0x54, 0xa6, 0xf3, 0xed, 0x65, 0x1f, 0xc3, 0xd4, 0x97, 0x2e, 0xdd, 0xf2,
-	0x51, 0xc2, 0x79, 0xfa, 0xb2, 0x8f, 0x63, 0xea, 0x4b, 0x57, 0x72, 0x9b, 0x8d, 0x2f, 0xbe, 0x5e,
-	0x8f, 0x7f, 0xf9, 0xf5, 0x7a, 0xfc, 0xaf, 0x5f, 0xaf, 0xc7, 0x3f, 0xfd, 0x66, 0x3d, 0xf6, 0xe5,
-	0x37, 0xeb, 0xb1, 0x3f, 0x7d, 0xb3, 0x1e, 0xfb, 0xf1, 0xb3, 0x67, 0x3a, 0x19, 0x8c, 0x7b, 0x5b,
-	0x7d, 0x73, 0xb4, 0xdd, 0x37, 0x47, 0x98, 0xf4, 0x4e, 0x89, 0xdf, 0xf0, 0x5f, 0x2c, 0xf6, 0x32,
-	0x2c, 0x3e, 0xbe, 0xf4, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x89, 0x8f, 0xc4, 0x2a, 0xd1, 0x28,
-	0x00, 0x00,
+	// 3015 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x5a, 0xcd, 0x73, 0x23, 0xc5,
+	0x15, 0xd7, 0xf7, 0xc7, 0xd3, 0xa7, 0x7b, 0xcd, 0xa2, 0x15, 0x8b, 0x6d, 0x86, 0x02, 0x76, 0x17,
+	0xb0, 0x89, 0x09, 0x5f, 0x45, 0x48, 0xb0, 0xb4, 0xda, 0xc8, 0xd8, 0xd8, 0xce, 0x58, 0x5e, 0x8a,
+	0x7c, 0xec, 0x30, 0xd2, 0xb4, 0xad, 0x61, 0xa5, 0x99, 0x61, 0xa6, 0x65, 0x64, 0x8e, 0x49, 0xa5,
+	0x2a, 0x45, 0xe5, 0xc0, 0x91, 0x0b, 0x87, 0x1c, 0xf2, 0x3f, 0xe4, 0x94, 0x53, 0x0e, 0x1c, 0x72,
+	0xe0, 0x90, 0x43, 0x4e, 0x24, 0x05, 0xb7, 0xfc, 0x03, 0x49, 0x55, 0x0e, 0x49, 0xf5, 0xd7, 0x68,
+	0x46, 0xd2, 0x58, 0x32, 0xa4, 0x52, 0x95, 0xca, 0xad, 0xfb, 0xf5, 0x7b, 0xaf, 0xbb, 0x5f, 0xf7,
+	0xbc, 0xf7, 0x7e, 0x6f, 0x1a, 0x1e, 0x23, 0xd8, 0x32, 0xb0, 0x3b, 0x34, 0x2d, 0xb2, 0xa5, 0x77,
+	0x7b, 0xe6, 0x16, 0xb9, 0x70, 0xb0, 0xb7, 0xe9, 0xb8, 0x36, 0xb1, 0x51, 0x65, 0x32, 0xb8, 0x49,
+	0x07, 0xeb, 0x8f, 0x07, 0xb8, 0x7b, 0xee, 0x85, 0x43, 0xec, 0x2d, 0xc7, 0xb5, 0xed, 0x53, 0xce,
+	0x5f, 0xbf, 0x19, 0x18, 0x66, 0x7a, 0x82, 0xda, 0xea, 0x37, 0x67, 0x85, 0x1f, 0xe2, 0x0b, 0x39,
+	0xfa, 0xf8, 0x8c, 0xac, 0xa3, 0xbb, 0xfa, 0x50, 0x0e, 0xaf, 0x9f, 0xd9, 0xf6, 0xd9, 0x00, 0x6f,
+	0xb1, 0x5e, 0x77, 0x74, 0xba, 0x45, 0xcc, 0x21, 0xf6, 0x88, 0x3e, 0x74, 0x04, 0xc3, 0xea, 0x99,
+	0x7d, 0x66, 0xb3, 0xe6, 0x16, 0x6d, 0x71, 0xaa, 0xf2, 0xaf, 0x1c, 0x64, 0x55, 0xfc, 0xc1, 0x08,
+	0x7b, 0x04, 0x6d, 0x43, 0x0a, 0xf7, 0xfa, 0x76, 0x2d, 0xbe, 0x11, 0xbf, 0x55, 0xd8, 0xbe, 0xb9,
+	0x39, 0xb5, 0xb9, 0x4d, 0xc1, 0xd7, 0xea, 0xf5, 0xed, 0x76, 0x4c, 0x65, 0xbc, 0xe8, 0x25, 0x48,
+	0x9f, 0x0e, 0x46, 0x5e, 0xbf, 0x96, 0x60, 0x42, 0x8f, 0x47, 0x09, 0xdd, 0xa3, 0x4c, 0xed, 0x98,
+	0xca, 0xb9, 0xe9, 0x54, 0xa6, 0x75, 0x6a, 0xd7, 0x92, 0x97, 0x4f, 0xb5, 0x6b, 0x9d, 0xb2, 0xa9,
+	0x28, 0x2f, 0x6a, 0x00, 0x98, 0x96, 0x49, 0xb4, 0x5e, 0x5f, 0x37, 0xad, 0x5a, 0x9a, 0x49, 0x3e,
+	0x11, 0x2d, 0x69, 0x92, 0x26, 0x65, 0x6c, 0xc7, 0xd4, 0xbc, 0x29, 0x3b, 0x74, 0xb9, 0x1f, 0x8c,
+	0xb0, 0x7b, 0x51, 0xcb, 0x5c, 0xbe, 0xdc, 0x1f, 0x51, 0x26, 0xba, 0x5c, 0xc6, 0x8d, 0x5a, 0x50,
+	0xe8, 0xe2, 0x33, 0xd3, 0xd2, 0xba, 0x03, 0xbb, 0xf7, 0xb0, 0x96, 0x65, 0xc2, 0x4a, 0x94, 0x70,
+	0x83, 0xb2, 0x36, 0x28, 0x67, 0x3b, 0xa6, 0x42, 0xd7, 0xef, 0xa1, 0xef, 0x41, 0xae, 0xd7, 0xc7,
+	0xbd, 0x87, 0x1a, 0x19, 0xd7, 0x72, 0x4c, 0xc7, 0x7a, 0x94, 0x8e, 0x26, 0xe5, 0xeb, 0x8c, 0xdb,
+	0x31, 0x35, 0xdb, 0xe3, 0x4d, 0xba, 0x7f, 0x03, 0x0f, 0xcc, 0x73, 0xec, 0x52, 0xf9, 0xfc, 0xe5,
+	0xfb, 0xbf, 0xcb, 0x39, 0x99, 0x86, 0xbc, 0x21, 0x3b, 0xe8, 0x07, 0x90, 0xc7, 0x96, 0x21, 0xb6,
+	0x01, 0x4c, 0xc5, 0x46, 0xe4, 0x39, 0x5b, 0x86, 0xdc, 0x44, 0x0e, 0x8b, 0x36, 0x7a, 0x15, 0x32,
+	0x3d, 0x7b, 0x38, 0x34, 0x49, 0xad, 0xc0, 0xa4, 0xd7, 0x22, 0x37, 0xc0, 0xb8, 0xda, 0x31, 0x55,
+	0xf0, 0xa3, 0x03, 0x28, 0x0f, 0x4c, 0x8f, 0x68, 0x9e, 0xa5, 0x3b, 0x5e, 0xdf, 0x26, 0x5e, 0xad,
+	0xc8, 0x34, 0x3c, 0x15, 0xa5, 0x61, 0xdf, 0xf4, 0xc8, 0xb1, 0x64, 0x6e, 0xc7, 0xd4, 0xd2, 0x20,
+	0x48, 0xa0, 0xfa, 0xec, 0xd3, 0x53, 0xec, 0xfa, 0x0a, 0x6b, 0xa5, 0xcb, 0xf5, 0x1d, 0x52, 0x6e,
+	0x29, 0x4f, 0xf5, 0xd9, 0x41, 0x02, 0xfa, 0x09, 0x5c, 0x1b, 0xd8, 0xba, 0xe1, 0xab, 0xd3, 0x7a,
+	0xfd, 0x91, 0xf5, 0xb0, 0x56, 0x66, 0x4a, 0x6f, 0x47, 0x2e, 0xd2, 0xd6, 0x0d, 0xa9, 0xa2, 0x49,
+	0x05, 0xda, 0x31, 0x75, 0x65, 0x30, 0x4d, 0x44, 0x0f, 0x60, 0x55, 0x77, 0x9c, 0xc1, 0xc5, 0xb4,
+	0xf6, 0x0a, 0xd3, 0x7e, 0x27, 0x4a, 0xfb, 0x0e, 0x95, 0x99, 0x56, 0x8f, 0xf4, 0x19, 0x2a, 0xea,
+	0x40, 0xd5, 0x71, 0xb1, 0xa3, 0xbb, 0x58, 0x73, 0x5c, 0xdb, 0xb1, 0x3d, 0x7d, 0x50, 0xab, 0x32,
+	0xdd, 0xcf, 0x44, 0xe9, 0x3e, 0xe2, 0xfc, 0x47, 0x82, 0xbd, 0x1d, 0x53, 0x2b, 0x4e, 0x98, 0xc4,
+	0xb5, 0xda, 0x3d, 0xec, 0x79, 0x13, 0xad, 0x2b, 0x8b, 0xb4, 0x32, 0xfe, 0xb0, 0xd6, 0x10, 0xa9,
+	0x91, 0x85, 0xf4, 0xb9, 0x3e, 0x18, 0xe1, 0xb7, 0x52, 0xb9, 0x54, 0x35, 0xad, 0x3c, 0x03, 0x85,
+	0x80, 0x63, 0x41, 0x35, 0xc8, 0x0e, 0xb1, 0xe7, 0xe9, 0x67, 0x98, 0xf9, 0xa1, 0xbc, 0x2a, 0xbb,
+	0x4a, 0x19, 0x8a, 0x41, 0x67, 0xa2, 0x7c, 0x12, 0x87, 0x42, 0xc0, 0x4f, 0x50, 0xc9, 0x73, 0xec,
+	0x7a, 0xa6, 0x6d, 0x49, 0x49, 0xd1, 0x45, 0x4f, 0x42, 0x89, 0xdd, 0x78, 0x4d, 0x8e, 0x53, 0x67,
+	0x95, 0x52, 0x8b, 0x8c, 0x78, 0x5f, 0x30, 0xad, 0x43, 0xc1, 0xd9, 0x76, 0x7c, 0x96, 0x24, 0x63,
+	0x01, 0x67, 0xdb, 0x91, 0x0c, 0x4f, 0x40, 0x91, 0xee, 0xd4, 0xe7, 0x48, 0xb1, 0x49, 0x0a, 0x94,
+	0x26, 0x58, 0x94, 0x3f, 0x26, 0xa0, 0x3a, 0xed, 0x80, 0xd0, 0xab, 0x90, 0xa2, 0xbe, 0x58, 0xb8,
+	0xd5, 0xfa, 0x26, 0x77, 0xd4, 0x9b, 0xd2, 0x51, 0x6f, 0x76, 0xa4, 0xa3, 0x6e, 0xe4, 0x3e, 0xff,
+	0x72, 0x3d, 0xf6, 0xc9, 0x5f, 0xd6, 0xe3, 0x2a, 0x93, 0x40, 0x37, 0xa8, 0xbf, 0xd0, 0x4d, 0x4b,
+	0x33, 0x0d, 0xb6, 0xe4, 0x3c, 0x75, 0x06, 0xba, 0x69, 0xed, 0x1a, 0x68, 0x1f, 0xaa, 0x3d, 0xdb,
+	0xf2, 0xb0, 0xe5, 0x8d, 0x3c, 0x8d, 0x07, 0x82, 0x5a, 0x72, 0xd6, 0x25, 0xf0, 0xf0, 0xd2, 0x94,
+	0x9c, 0x47, 0x8c, 0x51, 0xad, 0xf4, 0xc2, 0x04, 0x74, 0x0f, 0xe0, 0x5c, 0x1f, 0x98, 0x86, 0x4e,
+	0x6c, 0xd7, 0xab, 0xa5, 0x36, 0x92, 0x73, 0xfd, 0xc2, 0x7d, 0xc9, 0x72, 0xe2, 0x18, 0x3a, 0xc1,
+	0x8d, 0x14, 0x5d, 0xae, 0x1a, 0x90, 0x44, 0x4f, 0x43, 0x45, 0x77, 0x1c, 0xcd, 0x23, 0x3a, 0xc1,
+	0x5a, 0xf7, 0x82, 0x60, 0x8f, 0xf9, 0xe9, 0xa2, 0x5a, 0xd2, 0x1d, 0xe7, 0x98, 0x52, 0x1b, 0x94,
+	0x88, 0x9e, 0x82, 0x32, 0xf5, 0xc9, 0xa6, 0x3e, 0xd0, 0xfa, 0xd8, 0x3c, 0xeb, 0x13, 0xe6, 0x8f,
+	0x93, 0x6a, 0x49, 0x50, 0xdb, 0x8c, 0xa8, 0x18, 0x50, 0x0c, 0xfa, 0x63, 0x84, 0x20, 0x65, 0xe8,
+	0x44, 0x67, 0x96, 0x2c, 0xaa, 0xac, 0x4d, 0x69, 0x8e, 0x4e, 0xfa, 0xc2, 0x3e, 0xac, 0x8d, 0xae,
+	0x43, 0x46, 0xa8, 0x4d, 0x32, 0xb5, 0xa2, 0x87, 0x56, 0x21, 0xed, 0xb8, 0xf6, 0x39, 0x66, 0x47,
+	0x97, 0x53, 0x79, 0x47, 0xf9, 0x45, 0x02, 0x56, 0x66, 0x3c, 0x37, 0xd5, 0xdb, 0xd7, 0xbd, 0xbe,
+	0x9c, 0x8b, 0xb6, 0xd1, 0xcb, 0x54, 0xaf, 0x6e, 0x60, 0x57, 0x44, 0xbb, 0xda, 0xac, 0xa9, 0xdb,
+	0x6c, 0x5c, 0x98, 0x46, 0x70, 0xa3, 0x3d, 0xa8, 0x0e, 0x74, 0x8f, 0x68, 0xdc, 0x13, 0x6a, 0x81,
+	0xc8, 0xf7, 0xd8, 0x8c, 0x91, 0xb9, 0xdf, 0xa4, 0x17, 0x5a, 0x28, 0x29, 0x53, 0xd1, 0x09, 0x15,
+	0x9d, 0xc0, 0x6a, 0xf7, 0xe2, 0x23, 0xdd, 0x22, 0xa6, 0x85, 0xb5, 0x99, 0x53, 0x9b, 0x0d, 0xa5,
+	0x6f, 0x9b, 0x5e, 0x17, 0xf7, 0xf5, 0x73, 0xd3, 0x96, 0xcb, 0xba, 0xe6, 0xcb, 0xfb, 0x27, 0xea,
+	0x29, 0x2a, 0x94, 0xc3, 0xa1, 0x07, 0x95, 0x21, 0x41, 0xc6, 0x62, 0xff, 0x09, 0x32, 0x46, 0x2f,
+	0x40, 0x8a, 0xee, 0x91, 0xed, 0xbd, 0x3c, 0x67, 0x22, 0x21, 0xd7, 0xb9, 0x70, 0xb0, 0xca, 0x38,
+	0x15, 0x05, 0xaa, 0xd3, 0xe1, 0x68, 0x5a, 0xab, 0x72, 0x1b, 0x2a, 0x53, 0xf1, 0x26, 0x70, 0x7c,
+	0xf1, 0xe0, 0xf1, 0x29, 0x15, 0x28, 0x85, 0x82, 0x8b, 0x72, 0x1d, 0x56, 0xe7, 0xc5, 0x0a, 0xa5,
+	0x0f, 0xab, 0xf3, 0x7c, 0x3e, 0x7a, 0x09, 0x72, 0x7e, 0xb0, 0xe0, 0x5f, 0xe3, 0x8d, 0x99, 0x5d,
+	0x48, 0x66, 0xd5, 0x67, 0xa5, 0x9f, 0x21, 0xbd, 0xd5, 0xec, 0x3a, 0x24, 0xd8, 0xc2, 0xb3, 0xba,
+	0xe3, 0xb4, 0x75, 0xaf, 0xaf, 0xbc, 0x07, 0xb5, 0xa8, 0x40, 0x30, 0xb5, 0x8d, 0x94, 0x7f, 0x0b,
+	0xaf, 0x43, 0xe6, 0xd4, 0x76, 0x87, 0x3a, 0x61, 0xca, 0x4a, 0xaa, 0xe8, 0xd1, 0xdb, 0xc9, 0x83,
+	0x42, 0x92, 0x91, 0x79, 0x47, 0xd1, 0xe0, 0x46, 0x64, 0x30, 0xa0, 0x22, 0xa6, 0x65, 0x60, 0x6e,
+	0xcf, 0x92, 0xca, 0x3b, 0x13, 0x45, 0x7c, 0xb1, 0xbc, 0x43, 0xa7, 0xf5, 0xd8, 0x5e, 0x99, 0xfe,
+	0xbc, 0x2a, 0x7a, 0xca, 0xa7, 0x49, 0xb8, 0x3e, 0x3f, 0x24, 0xa0, 0x0d, 0x28, 0x0e, 0xf5, 0xb1,
+	0x46, 0xc6, 0xe2, 0x5b, 0xe6, 0xc7, 0x01, 0x43, 0x7d, 0xdc, 0x19, 0xf3, 0x0f, 0xb9, 0x0a, 0x49,
+	0x32, 0xf6, 0x6a, 0x89, 0x8d, 0xe4, 0xad, 0xa2, 0x4a, 0x9b, 0xe8, 0x04, 0x56, 0x06, 0x76, 0x4f,
+	0x1f, 0x68, 0x81, 0x1b, 0x2f, 0x2e, 0xfb, 0x93, 0x33, 0xc6, 0x6e, 0x8d, 0x19, 0xc5, 0x98, 0xb9,
+	0xf4, 0x15, 0xa6, 0x63, 0xdf, 0xbf, 0xf9, 0xe8, 0x2e, 0x14, 0x86, 0x93, 0x8b, 0x7c, 0x85, 0xcb,
+	0x1e, 0x14, 0x0b, 0x1c, 0x49, 0x3a, 0xe4, 0x18, 0xa4, 0x8b, 0xce, 0x5c, 0xd9, 0x45, 0xbf, 0x00,
+	0xab, 0x16, 0x1e, 0x93, 0xc0, 0x87, 0xc8, 0xef, 0x49, 0x96, 0x99, 0x1e, 0xd1, 0xb1, 0xc9, 0x47,
+	0x46, 0xaf, 0x0c, 0xba, 0xcd, 0x82, 0xaa, 0x63, 0x7b, 0xd8, 0xd5, 0x74, 0xc3, 0x70, 0xb1, 0xe7,
+	0xb1, 0x64, 0xb0, 0xa8, 0x56, 0x24, 0x7d, 0x87, 0x93, 0x95, 0x5f, 0x05, 0x8f, 0x26, 0x14, 0x44,
+	0xa5, 0xe1, 0xe3, 0x13, 0xc3, 0x1f, 0xc3, 0xaa, 0x90, 0x37, 0x42, 0xb6, 0x4f, 0x2c, 0xeb, 0x68,
+	0x90, 0x14, 0x8f, 0x36, 0x7b, 0xf2, 0x9b, 0x99, 0x5d, 0xfa, 0xd2, 0x54, 0xc0, 0x97, 0xfe, 0x8f,
+	0x1d, 0xc5, 0x9f, 0xf2, 0x90, 0x53, 0xb1, 0xe7, 0xd8, 0x96, 0x87, 0x51, 0x03, 0xf2, 0x78, 0xdc,
+	0xc3, 0x0e, 0x91, 0xb9, 0xc6, 0x7c, 0x30, 0xc0, 0xb9, 0x5b, 0x92, 0x93, 0x66, 0xe2, 0xbe, 0x18,
+	0x7a, 0x51, 0x80, 0xad, 0x68, 0xdc, 0x24, 0xc4, 0x83, 0x68, 0xeb, 0x65, 0x89, 0xb6, 0x92, 0x91,
+	0xc9, 0x37, 0x97, 0x9a, 0x82, 0x5b, 0x2f, 0x0a, 0xb8, 0x95, 0x5a, 0x30, 0x59, 0x08, 0x6f, 0x35,
+	0x43, 0x78, 0x2b, 0xb3, 0x60, 0x9b, 0x11, 0x80, 0xeb, 0x65, 0x09, 0xb8, 0xb2, 0x0b, 0x56, 0x3c,
+	0x85, 0xb8, 0xee, 0x85, 0x11, 0x57, 0x2e, 0xc2, 0x81, 0x48, 0xe9, 0x48, 0xc8, 0xf5, 0x46, 0x00,
+	0x72, 0xe5, 0x23, 0xf1, 0x0e, 0x57, 0x32, 0x07, 0x73, 0x35, 0x43, 0x98, 0x0b, 0x16, 0xd8, 0x20,
+	0x02, 0x74, 0xbd, 0x19, 0x04, 0x5d, 0x85, 0x48, 0xdc, 0x26, 0xce, 0x7b, 0x1e, 0xea, 0x7a, 0xcd,
+	0x47, 0x5d, 0xc5, 0x48, 0xd8, 0x28, 0xf6, 0x30, 0x0d, 0xbb, 0x0e, 0x67, 0x60, 0x17, 0x87, 0x49,
+	0x4f, 0x47, 0xaa, 0x58, 0x80, 0xbb, 0x0e, 0x67, 0x70, 0x57, 0x79, 0x81, 0xc2, 0x05, 0xc0, 0xeb,
+	0xa7, 0xf3, 0x81, 0x57, 0x34, 0x34, 0x12, 0xcb, 0x5c, 0x0e, 0x79, 0x69, 0x11, 0xc8, 0x8b, 0xa3,
+	0xa3, 0x67, 0x23, 0xd5, 0x2f, 0x0d, 0xbd, 0x4e, 0xe6, 0x40, 0x2f, 0x0e, 0x92, 0x6e, 0x45, 0x2a,
+	0x5f, 0x02, 0x7b, 0x9d, 0xcc, 0xc1, 0x5e, 0x68, 0xa1, 0xda, 0xab, 0x80, 0xaf, 0x74, 0x35, 0xa3,
+	0xdc, 0x86, 0x15, 0x29, 0xec, 0xfb, 0x29, 0x9a, 0x3f, 0x60, 0xd7, 0xb5, 0x5d, 0x01, 0xa3, 0x78,
+	0x47, 0xb9, 0x05, 0x45, 0x9f, 0xf5, 0x72, 0xa0, 0xc6, 0xf2, 0xb4, 0x80, 0x1f, 0x52, 0x7e, 0x17,
+	0x87, 0x62, 0xd0, 0xc5, 0x84, 0x12, 0xf9, 0xbc, 0x48, 0xe4, 0x03, 0xf0, 0x2d, 0x11, 0x86, 0x6f,
+	0xeb, 0x50, 0xa0, 0xf9, 0xd7, 0x14, 0x32, 0xd3, 0x1d, 0x1f, 0x99, 0xdd, 0x81, 0x15, 0x16, 0xf1,
+	0x38, 0xc8, 0x13, 0x61, 0x25, 0xc5, 0xc2, 0x4a, 0x85, 0x0e, 0xf0, 0x0f, 0x8a, 0x91, 0xd1, 0xf3,
+	0x70, 0x2d, 0xc0, 0xeb, 0xe7, 0x75, 0x1c, 0xa6, 0x54, 0x7d, 0xee, 0x1d, 0x91, 0xe0, 0xfd, 0x21,
+	0x0e, 0x2b, 0x33, 0x2e, 0x6e, 0x2e, 0xfa, 0x8a, 0xff, 0x87, 0xd0, 0x57, 0xe2, 0x1b, 0xa3, 0xaf,
+	0x60, 0x9e, 0x9a, 0x0c, 0xe7, 0xa9, 0x7f, 0x8f, 0x43, 0x29, 0xe4, 0x69, 0xe9, 0x11, 0xf4, 0x6c,
+	0x03, 0x8b, 0xcc, 0x91, 0xb5, 0x69, 0x52, 0x31, 0xb0, 0xcf, 0x44, 0x7e, 0x48, 0x9b, 0x94, 0xcb,
+	0x0f, 0x1c, 0x79, 0x11, 0x17, 0xfc, 0xa4, 0x93, 0x07, 0x6e, 0xde, 0xa1, 0xb2, 0x0f, 0x31, 0xaf,
+	0xab, 0x15, 0x55, 0xda, 0x44, 0xab, 0xe2, 0xaa, 0x89, 0x00, 0xcc, 0x3b, 0xe8, 0x55, 0xc8, 0xb3,
+	0x8a, 0xa8, 0x66, 0x3b, 0x5e, 0x2d, 0x37, 0x9b, 0x9b, 0xf0, 0xc2, 0xe7, 0xe6, 0x11, 0xe5, 0x39,
+	0x74, 0x3c, 0x35, 0xe7, 0x88, 0x56, 0x20, 0x63, 0xc8, 0x87, 0x32, 0x86, 0x9b, 0x90, 0xa7, 0xab,
+	0xf7, 0x1c, 0xbd, 0x87, 0x99, 0x8b, 0xce, 0xab, 0x13, 0x82, 0xf2, 0x00, 0xd0, 0x6c, 0x90, 0x40,
+	0x6d, 0xc8, 0xe0, 0x73, 0x6c, 0x11, 0x9e, 0x41, 0x15, 0xb6, 0xaf, 0xcf, 0xa6, 0xa6, 0x74, 0xb8,
+	0x51, 0xa3, 0x46, 0xfe, 0xdb, 0x97, 0xeb, 0x55, 0xce, 0xfd, 0x9c, 0x3d, 0x34, 0x09, 0x1e, 0x3a,
+	0xe4, 0x42, 0x15, 0xf2, 0xca, 0x3f, 0x12, 0x50, 0x91, 0x13, 0x48, 0xe4, 0x34, 0xcf, 0xb6, 0xf2,
+	0xca, 0x27, 0x02, 0xd8, 0x75, 0x39, 0x7b, 0xaf, 0x01, 0x9c, 0xe9, 0x9e, 0xf6, 0xa1, 0x6e, 0x11,
+	0x6c, 0x08, 0xa3, 0x07, 0x28, 0xa8, 0x0e, 0x39, 0xda, 0x1b, 0x79, 0xd8, 0x10, 0x30, 0xda, 0xef,
+	0x07, 0xf6, 0x99, 0xfd, 0x76, 0xfb, 0x0c, 0x5b, 0x39, 0x37, 0x65, 0xe5, 0x00, 0xb8, 0xc8, 0x07,
+	0xc1, 0x05, 0x5d, 0x9b, 0xe3, 0x9a, 0xb6, 0x6b, 0x92, 0x0b, 0x76, 0x34, 0x49, 0xd5, 0xef, 0xd3,
+	0xaa, 0xcc, 0x10, 0x0f, 0x1d, 0xdb, 0x1e, 0x68, 0xdc, 0xdd, 0x14, 0x98, 0x68, 0x51, 0x10, 0x5b,
+	0x94, 0x46, 0x15, 0x78, 0x34, 0x03, 0xb6, 0x7a, 0x98, 0xc5, 0xbe, 0x94, 0xea, 0xf7, 0x95, 0x5f,
+	0x26, 0x60, 0x65, 0x26, 0xf4, 0xfe, 0xff, 0x19, 0x5f, 0xf9, 0x35, 0xab, 0x3a, 0x85, 0xd3, 0x07,
+	0x74, 0x0c, 0x2b, 0xbe, 0x6b, 0xd0, 0x46, 0xcc, 0x65, 0xc8, 0xcb, 0xbe, 0xac, 0x6f, 0xa9, 0x9e,
+	0x87, 0xc9, 0x1e, 0x7a, 0x17, 0x1e, 0x9d, 0xf2, 0x7b, 0xbe, 0xea, 0xc4, 0xb2, 0xee, 0xef, 0x91,
+	0xb0, 0xfb, 0x93, 0xaa, 0x27, 0xc6, 0x4a, 0x7e, 0xcb, 0x2f, 0x72, 0x17, 0xca, 0xd2, 0x1a, 0x02,
+	0xc5, 0xcc, 0x3b, 0xfe, 0x27, 0xa1, 0xe4, 0x62, 0x42, 0x8b, 0x6b, 0xa1, 0x52, 0x51, 0x91, 0x13,
+	0x45, 0x01, 0xea, 0x08, 0x1e, 0x99, 0x9b, 0x15, 0xa1, 0x57, 0x20, 0x3f, 0x49, 0xa8, 0xb8, 0x55,
+	0x2f, 0x29, 0x25, 0x4c, 0x78, 0x95, 0xdf, 0xc7, 0xe1, 0x91, 0xb9, 0x79, 0x11, 0x6a, 0x41, 0xc6,
+	0xc5, 0xde, 0x68, 0xc0, 0xcb, 0x05, 0xe5, 0xed, 0xe7, 0x97, 0xcb, 0xa7, 0x28, 0x75, 0x34, 0x20,
+	0xaa, 0x10, 0x56, 0x1e, 0x40, 0x86, 0x53, 0x50, 0x01, 0xb2, 0x27, 0x07, 0x7b, 0x07, 0x87, 0xef,
+	0x1c, 0x54, 0x63, 0x08, 0x20, 0xb3, 0xd3, 0x6c, 0xb6, 0x8e, 0x3a, 0xd5, 0x38, 0xca, 0x43, 0x7a,
+	0xa7, 0x71, 0xa8, 0x76, 0xaa, 0x09, 0x4a, 0x56, 0x5b, 0x6f, 0xb5, 0x9a, 0x9d, 0x6a, 0x12, 0xad,
+	0x40, 0x89, 0xb7, 0xb5, 0x7b, 0x87, 0xea, 0xdb, 0x3b, 0x9d, 0x6a, 0x2a, 0x40, 0x3a, 0x6e, 0x1d,
+	0xdc, 0x6d, 0xa9, 0xd5, 0xb4, 0xf2, 0x1d, 0xb8, 0x21, 0xd7, 0x31, 0x5b, 0xf2, 0xf0, 0x2b, 0x0f,
+	0xf1, 0x40, 0xe5, 0x41, 0xf9, 0x34, 0x01, 0xf5, 0xe8, 0xb4, 0x0a, 0xbd, 0x35, 0xb5, 0xf1, 0xed,
+	0x2b, 0xe4, 0x64, 0x53, 0xbb, 0xa7, 0x85, 0x45, 0x17, 0x9f, 0x62, 0xd2, 0xeb, 0xf3, 0x34, 0x8f,
+	0x87, 0xd3, 0x92, 0x5a, 0x12, 0x54, 0x26, 0xe4, 0x71, 0xb6, 0xf7, 0x71, 0x8f, 0x68, 0xdc, 0x4f,
+	0xf1, 0x4b, 0x97, 0x57, 0x4b, 0x9c, 0x7a, 0xcc, 0x89, 0xca, 0x7b, 0x57, 0xb2, 0x65, 0x1e, 0xd2,
+	0x6a, 0xab, 0xa3, 0xbe, 0x5b, 0x4d, 0x22, 0x04, 0x65, 0xd6, 0xd4, 0x8e, 0x0f, 0x76, 0x8e, 0x8e,
+	0xdb, 0x87, 0xd4, 0x96, 0xd7, 0xa0, 0x22, 0x6d, 0x29, 0x89, 0x69, 0xe5, 0x59, 0x78, 0x34, 0x22,
+	0x27, 0x9c, 0x45, 0xf8, 0xca, 0x6f, 0xe2, 0x41, 0xee, 0x70, 0x3d, 0xe0, 0x10, 0x32, 0x1e, 0xd1,
+	0xc9, 0xc8, 0x13, 0x46, 0x7c, 0x65, 0xd9, 0x24, 0x71, 0x53, 0x36, 0x8e, 0x99, 0xb8, 0x2a, 0xd4,
+	0x28, 0x2f, 0x41, 0x39, 0x3c, 0x12, 0x6d, 0x83, 0xc9, 0x25, 0x4a, 0x28, 0xef, 0x02, 0x04, 0x6a,
+	0x95, 0xab, 0x90, 0x76, 0xed, 0x91, 0x65, 0xb0, 0x45, 0xa5, 0x55, 0xde, 0xa1, 0x3f, 0xe1, 0xce,
+	0x6d, 0xee, 0x33, 0xe6, 0x7f, 0x38, 0xf7, 0x6d, 0x82, 0x03, 0x85, 0x09, 0xce, 0xad, 0x98, 0x80,
+	0x66, 0xeb, 0x45, 0x11, 0x53, 0xbc, 0x11, 0x9e, 0xe2, 0x89, 0xc8, 0xca, 0xd3, 0xfc, 0xa9, 0x3e,
+	0x82, 0x34, 0xf3, 0x36, 0xd4, 0x73, 0xb0, 0x9a, 0xa7, 0x48, 0x54, 0x69, 0x1b, 0xfd, 0x0c, 0x40,
+	0x27, 0xc4, 0x35, 0xbb, 0xa3, 0xc9, 0x04, 0xeb, 0xf3, 0xbd, 0xd5, 0x8e, 0xe4, 0x6b, 0xdc, 0x14,
+	0x6e, 0x6b, 0x75, 0x22, 0x1a, 0x70, 0x5d, 0x01, 0x85, 0xca, 0x01, 0x94, 0xc3, 0xb2, 0x32, 0xb5,
+	0xe2, 0x6b, 0x08, 0xa7, 0x56, 0x3c, 0x53, 0xe6, 0x9d, 0x49, 0x62, 0x96, 0xe4, 0xe5, 0x6d, 0xd6,
+	0x51, 0x3e, 0x8e, 0x43, 0xae, 0x33, 0x16, 0xf7, 0x38, 0xa2, 0xb4, 0x3a, 0x11, 0x4d, 0x04, 0x0b,
+	0x89, 0xbc, 0x56, 0x9b, 0xf4, 0x2b, 0xc0, 0x6f, 0xfa, 0x5f, 0x6a, 0x6a, 0x59, 0x24, 0x2c, 0x2b,
+	0xe1, 0xc2, 0x3b, 0xbd, 0x0e, 0x79, 0x3f, 0xd6, 0xd0, 0x8c, 0x5f, 0x56, 0x5d, 0xe2, 0x22, 0x5d,
+	0xe5, 0x5d, 0xba, 0x1c, 0xc7, 0xfe, 0x50, 0x94, 0x2a, 0x93, 0x2a, 0xef, 0x28, 0x06, 0x54, 0xa6,
+	0x02, 0x15, 0x7a, 0x1d, 0xb2, 0xce, 0xa8, 0xab, 0x49, 0xf3, 0x4c, 0xd5, 0xa6, 0x64, 0x2e, 0x39,
+	0xea, 0x0e, 0xcc, 0xde, 0x1e, 0xbe, 0x90, 0x8b, 0x71, 0x46, 0xdd, 0x3d, 0x6e, 0x45, 0x3e, 0x4b,
+	0x22, 0x38, 0xcb, 0x39, 0xe4, 0xe4, 0xa5, 0x40, 0xdf, 0x87, 0xbc, 0x1f, 0x03, 0xfd, 0xff, 0x37,
+	0x91, 0xc1, 0x53, 0xa8, 0x9f, 0x88, 0x50, 0x60, 0xe2, 0x99, 0x67, 0x96, 0xac, 0xc8, 0xf1, 0x0a,
+	0x40, 0x82, 0x9d, 0x4e, 0x85, 0x0f, 0xec, 0x4b, 0xc0, 0xa1, 0xfc, 0x36, 0x0e, 0xd5, 0xe9, 0x5b,
+	0xf9, 0xdf, 0x5c, 0x00, 0x75, 0x8a, 0xf4, 0xf6, 0x6b, 0x98, 0x2e, 0xc2, 0x47, 0x5a, 0x45, 0xb5,
+	0x44, 0xa9, 0x2d, 0x49, 0xa4, 0xbf, 0x4b, 0x0a, 0x81, 0x7a, 0x1f, 0xfa, 0x6e, 0xe0, 0x13, 0x29,
+	0xcf, 0xc9, 0x2d, 0x02, 0xbc, 0x93, 0x5f, 0x03, 0xe1, 0x8d, 0x25, 0xae, 0xbe, 0xb1, 0xa8, 0x5f,
+	0x3c, 0xb2, 0x7c, 0x98, 0xba, 0x72, 0xf9, 0xf0, 0x39, 0x40, 0xc4, 0x26, 0xfa, 0x40, 0x3b, 0xb7,
+	0x89, 0x69, 0x9d, 0x69, 0xfc, 0x6a, 0xf0, 0x8c, 0xaf, 0xca, 0x46, 0xee, 0xb3, 0x81, 0x23, 0x76,
+	0x4b, 0x7e, 0x1e, 0x87, 0x9c, 0x1f, 0xba, 0xaf, 0x5a, 0xe9, 0xbf, 0x0e, 0x19, 0x11, 0x9d, 0x78,
+	0xa9, 0x5f, 0xf4, 0xe6, 0xd6, 0x49, 0xeb, 0x90, 0x1b, 0x62, 0xa2, 0xb3, 0xfc, 0x85, 0x83, 0x54,
+	0xbf, 0x7f, 0xe7, 0x35, 0x28, 0x04, 0x7e, 0xba, 0x50, 0x3f, 0x71, 0xd0, 0x7a, 0xa7, 0x1a, 0xab,
+	0x67, 0x3f, 0xfe, 0x6c, 0x23, 0x79, 0x80, 0x3f, 0xa4, 0x5f, 0x98, 0xda, 0x6a, 0xb6, 0x5b, 0xcd,
+	0xbd, 0x6a, 0xbc, 0x5e, 0xf8, 0xf8, 0xb3, 0x8d, 0xac, 0x8a, 0x59, 0x69, 0xeb, 0xce, 0x1e, 0x54,
+	0xa6, 0x0e, 0x26, 0xec, 0xdf, 0x11, 0x94, 0xef, 0x9e, 0x1c, 0xed, 0xef, 0x36, 0x77, 0x3a, 0x2d,
+	0xed, 0xfe, 0x61, 0xa7, 0x55, 0x8d, 0xa3, 0x47, 0xe1, 0xda, 0xfe, 0xee, 0x0f, 0xdb, 0x1d, 0xad,
+	0xb9, 0xbf, 0xdb, 0x3a, 0xe8, 0x68, 0x3b, 0x9d, 0xce, 0x4e, 0x73, 0xaf, 0x9a, 0xd8, 0xfe, 0x27,
+	0x40, 0x65, 0xa7, 0xd1, 0xdc, 0xa5, 0xf1, 0xd9, 0xec, 0xe9, 0xac, 0x88, 0xd0, 0x84, 0x14, 0x2b,
+	0x13, 0x5c, 0xfa, 0x8c, 0xa4, 0x7e, 0x79, 0xdd, 0x13, 0xdd, 0x83, 0x34, 0xab, 0x20, 0xa0, 0xcb,
+	0xdf, 0x95, 0xd4, 0x17, 0x14, 0x42, 0xe9, 0x62, 0xd8, 0xe7, 0x74, 0xe9, 0x43, 0x93, 0xfa, 0xe5,
+	0x75, 0x51, 0xa4, 0x42, 0x7e, 0x82, 0x32, 0x16, 0x3f, 0xbc, 0xa8, 0x2f, 0xe1, 0x1d, 0xd1, 0x3e,
+	0x64, 0x25, 0x68, 0x5c, 0xf4, 0x14, 0xa4, 0xbe, 0xb0, 0x70, 0x49, 0xcd, 0xc5, 0xc1, 0xfd, 0xe5,
+	0xef, 0x5a, 0xea, 0x0b, 0xaa, 0xb0, 0x68, 0x17, 0x32, 0x22, 0x73, 0x5e, 0xf0, 0xbc, 0xa3, 0xbe,
+	0xa8, 0x10, 0x49, 0x8d, 0x36, 0x29, 0x9b, 0x2c, 0x7e, 0xad, 0x53, 0x5f, 0xa2, 0xc0, 0x8c, 0x4e,
+	0x00, 0x02, 0x50, 0x7e, 0x89, 0x67, 0x38, 0xf5, 0x65, 0x0a, 0xc7, 0xe8, 0x10, 0x72, 0x3e, 0x7a,
+	0x5a, 0xf8, 0x28, 0xa6, 0xbe, 0xb8, 0x82, 0x8b, 0x1e, 0x40, 0x29, 0x8c, 0x1a, 0x96, 0x7b, 0xea,
+	0x52, 0x5f, 0xb2, 0x34, 0x4b, 0xf5, 0x87, 0x21, 0xc4, 0x72, 0x4f, 0x5f, 0xea, 0x4b, 0x56, 0x6a,
+	0xd1, 0xfb, 0xb0, 0x32, 0x9b, 0xe2, 0x2f, 0xff, 0x12, 0xa6, 0x7e, 0x85, 0xda, 0x2d, 0x1a, 0x02,
+	0x9a, 0x03, 0x0d, 0xae, 0xf0, 0x30, 0xa6, 0x7e, 0x95, 0x52, 0x2e, 0x32, 0xa0, 0x32, 0x9d, 0x6f,
+	0x2f, 0xfb, 0x50, 0xa6, 0xbe, 0x74, 0x59, 0x97, 0xcf, 0x12, 0xce, 0xd3, 0x97, 0x7d, 0x38, 0x53,
+	0x5f, 0xba, 0xca, 0xdb, 0xd8, 0xf9, 0xfc, 0xab, 0xb5, 0xf8, 0x17, 0x5f, 0xad, 0xc5, 0xff, 0xfa,
+	0xd5, 0x5a, 0xfc, 0x93, 0xaf, 0xd7, 0x62, 0x5f, 0x7c, 0xbd, 0x16, 0xfb, 0xf3, 0xd7, 0x6b, 0xb1,
+	0x1f, 0x3f, 0x73, 0x66, 0x92, 0xfe, 0xa8, 0xbb, 0xd9, 0xb3, 0x87, 0x5b, 0x3d, 0x7b, 0x88, 0x49,
+	0xf7, 0x94, 0x4c, 0x1a, 0x93, 0xd7, 0x8c, 0xdd, 0x0c, 0x8b, 0x8f, 0x2f, 0xfe, 0x7b, 0x00, 0x29,
+	0x97, 0x2c, 0xdc, 0xed, 0x28, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -6108,6 +6120,11 @@ func (m *ResponseCheckTx) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.MempoolError) > 0 {
 		i -= len(m.MempoolError)
 		copy(dAtA[i:], m.MempoolError)
@@ -8011,6 +8028,9 @@ func (m *ResponseCheckTx) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.Sequence != 0 {
+		n += 1 + sovTypes(uint64(m.Sequence))
+	}
 	return n
 }
 
@@ -13102,6 +13122,25 @@ func (m *ResponseCheckTx) Unmarshal(dAtA []byte) error {
 			}
 			m.MempoolError = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
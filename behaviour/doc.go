@@ -4,7 +4,7 @@ Package Behaviour provides a mechanism for reactors to report behaviour of peers
 Instead of a reactor calling the switch directly it will call the behaviour module which will
 handle the stopping and marking peer as good on behalf of the reactor.
 
-There are four different behaviours a reactor can report.
+There are five different behaviours a reactor can report.
 
 1. bad message
 
@@ -12,7 +12,7 @@ There are four different behaviours a reactor can report.
 		explanation string
 	}
 
-# This message will request the peer be stopped for an error
+# This message will lower the trust score of the peer and request the peer be stopped for an error
 
 2. message out of order
 
@@ -20,7 +20,7 @@ There are four different behaviours a reactor can report.
 		explanation string
 	}
 
-# This message will request the peer be stopped for an error
+# This message will lower the trust score of the peer and request the peer be stopped for an error
 
 3. consesnsus Vote
 
@@ -36,6 +36,17 @@ There are four different behaviours a reactor can report.
 		explanation string
 	}
 
-This message will request the peer be marked as good
+# This message will request the peer be marked as good
+
+5. good message
+
+	type goodMessage struct {
+		explanation string
+	}
+
+This message will request the peer be marked as good.
+
+Peers marked as good see their trust score raised. Peers whose trust score falls
+below the configured minimum are banned by the switch.
 */
 package behaviour
@@ -47,3 +47,13 @@ type blockPart struct {
 func BlockPart(peerID p2p.ID, explanation string) PeerBehaviour {
 	return PeerBehaviour{peerID: peerID, reason: blockPart{explanation}}
 }
+
+type goodMessage struct {
+	explanation string
+}
+
+// GoodMessage returns a goodMessage PeerBehaviour, reported when a peer sent a
+// useful message, like a requested transaction or a valid piece of evidence.
+func GoodMessage(peerID p2p.ID, explanation string) PeerBehaviour {
+	return PeerBehaviour{peerID: peerID, reason: goodMessage{explanation}}
+}
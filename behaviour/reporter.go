@@ -25,20 +25,20 @@ func NewSwitchReporter(sw *p2p.Switch) *SwitchReporter {
 	}
 }
 
-// Report reports the behaviour of a peer to the Switch.
+// Report reports the behaviour of a peer to the Switch. Good behaviour raises
+// the trust score of the peer, while bad behaviour lowers it and disconnects
+// the peer, which is also banned if its score becomes too low. The score of a
+// peer that is already disconnected is still updated.
 func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
-	peer := spbr.sw.Peers().Get(behaviour.peerID)
-	if peer == nil {
-		return errors.New("peer not found")
-	}
-
 	switch reason := behaviour.reason.(type) {
-	case consensusVote, blockPart:
-		spbr.sw.MarkPeerAsGood(peer)
+	case consensusVote, blockPart, goodMessage:
+		spbr.sw.MarkPeerIDAsGood(behaviour.peerID)
 	case badMessage:
-		spbr.sw.StopPeerForError(peer, reason.explanation)
+		spbr.sw.MarkPeerAsBad(behaviour.peerID, reason.explanation)
+		spbr.stopPeer(behaviour.peerID, reason.explanation)
 	case messageOutOfOrder:
-		spbr.sw.StopPeerForError(peer, reason.explanation)
+		spbr.sw.MarkPeerAsBad(behaviour.peerID, reason.explanation)
+		spbr.stopPeer(behaviour.peerID, reason.explanation)
 	default:
 		return errors.New("unknown reason reported")
 	}
@@ -46,6 +46,13 @@ func (spbr *SwitchReporter) Report(behaviour PeerBehaviour) error {
 	return nil
 }
 
+// stopPeer disconnects the peer if it is still connected.
+func (spbr *SwitchReporter) stopPeer(id p2p.ID, reason interface{}) {
+	if peer := spbr.sw.Peers().Get(id); peer != nil {
+		spbr.sw.StopPeerForError(peer, reason)
+	}
+}
+
 // MockReporter is a concrete implementation of the Reporter
 // interface used in reactor tests to ensure reactors report the correct
 // behaviour in manufactured scenarios.
@@ -38,6 +38,12 @@ type consensusReactor interface {
 	SwitchToConsensus(state sm.State, skipWAL bool)
 }
 
+// haltingReactor is a consensus reactor of a node halting after committing
+// a given height or time, at which fast sync stops too.
+type haltingReactor interface {
+	ShouldHalt(height int64, blockTime time.Time) bool
+}
+
 type peerError struct {
 	err    error
 	peerID p2p.ID
@@ -316,17 +322,7 @@ FOR_LOOP:
 				"outbound", outbound, "inbound", inbound)
 			if bcR.pool.IsCaughtUp() {
 				bcR.Logger.Info("Time to switch to consensus reactor!", "height", height)
-				if err := bcR.pool.Stop(); err != nil {
-					bcR.Logger.Error("Error stopping pool", "err", err)
-				}
-				conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor)
-				if ok {
-					conR.SwitchToConsensus(state, blocksSynced > 0 || stateSynced)
-				}
-				// else {
-				// should only happen during testing
-				// }
-
+				bcR.switchToConsensus(state, blocksSynced > 0 || stateSynced)
 				break FOR_LOOP
 			}
 
@@ -337,6 +333,15 @@ FOR_LOOP:
 			}
 
 		case <-didProcessCh:
+			// Don't sync the blocks after the halt height or time: consensus
+			// halts the node once started.
+			if bcR.reachedHalt(state) {
+				bcR.Logger.Info("Reached the halt height or time, switching to consensus reactor",
+					"height", state.LastBlockHeight)
+				bcR.switchToConsensus(state, blocksSynced > 0 || stateSynced)
+				break FOR_LOOP
+			}
+
 			// NOTE: It is a subtle mistake to process more than a single block
 			// at a time (e.g. 10) here, because we only TrySend 1 request per
 			// loop.  The ratio mismatch can result in starving of blocks, a
@@ -434,6 +439,28 @@ FOR_LOOP:
 	}
 }
 
+// switchToConsensus stops the pool and starts the consensus reactor from the
+// synced state.
+func (bcR *BlockchainReactor) switchToConsensus(state sm.State, skipWAL bool) {
+	if err := bcR.pool.Stop(); err != nil {
+		bcR.Logger.Error("Error stopping pool", "err", err)
+	}
+	conR, ok := bcR.Switch.Reactor("CONSENSUS").(consensusReactor)
+	if ok {
+		conR.SwitchToConsensus(state, skipWAL)
+	}
+	// else {
+	// should only happen during testing
+	// }
+}
+
+// reachedHalt returns true if the node halts after committing the last block
+// of the synced state.
+func (bcR *BlockchainReactor) reachedHalt(state sm.State) bool {
+	conR, ok := bcR.Switch.Reactor("CONSENSUS").(haltingReactor)
+	return ok && state.LastBlockHeight > 0 && conR.ShouldHalt(state.LastBlockHeight, state.LastBlockTime)
+}
+
 // BroadcastStatusRequest broadcasts `BlockStore` base and height.
 func (bcR *BlockchainReactor) BroadcastStatusRequest() error {
 	bcR.Switch.BroadcastEnvelope(p2p.Envelope{
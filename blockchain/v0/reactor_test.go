@@ -194,6 +194,66 @@ func TestNoBlockResponse(t *testing.T) {
 	}
 }
 
+// haltingConsensusReactor is a consensus reactor halting the node after
+// committing haltHeight.
+type haltingConsensusReactor struct {
+	p2p.BaseReactor
+
+	haltHeight int64
+	switched   chan sm.State
+}
+
+func (conR *haltingConsensusReactor) SwitchToConsensus(state sm.State, skipWAL bool) {
+	conR.switched <- state
+}
+
+func (conR *haltingConsensusReactor) ShouldHalt(height int64, blockTime time.Time) bool {
+	return height >= conR.haltHeight
+}
+
+func TestHaltHeightStopsFastSync(t *testing.T) {
+	config = cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+	genDoc, privVals := randGenesisDoc(1, false, 30)
+
+	maxBlockHeight := int64(65)
+	haltHeight := int64(20)
+
+	reactorPairs := make([]BlockchainReactorPair, 2)
+
+	reactorPairs[0] = newBlockchainReactor(log.TestingLogger(), genDoc, privVals, maxBlockHeight)
+	reactorPairs[1] = newBlockchainReactor(log.TestingLogger(), genDoc, privVals, 0)
+
+	conR := &haltingConsensusReactor{haltHeight: haltHeight, switched: make(chan sm.State, 1)}
+	conR.BaseReactor = *p2p.NewBaseReactor("Consensus", conR)
+
+	p2p.MakeConnectedSwitches(config.P2P, 2, func(i int, s *p2p.Switch) *p2p.Switch {
+		s.AddReactor("BLOCKCHAIN", reactorPairs[i].reactor)
+		if i == 1 {
+			s.AddReactor("CONSENSUS", conR)
+		}
+		return s
+
+	}, p2p.Connect2Switches)
+
+	defer func() {
+		for _, r := range reactorPairs {
+			err := r.reactor.Stop()
+			require.NoError(t, err)
+			err = r.app.Stop()
+			require.NoError(t, err)
+		}
+	}()
+
+	select {
+	case state := <-conR.switched:
+		assert.Equal(t, haltHeight, state.LastBlockHeight)
+	case <-time.After(10 * time.Second):
+		t.Fatal("fast sync didn't switch to consensus")
+	}
+	assert.Equal(t, haltHeight, reactorPairs[1].reactor.store.Height())
+}
+
 func TestLegacyReactorReceiveBasic(t *testing.T) {
 	config = cfg.ResetTestRoot("blockchain_reactor_test")
 	defer os.RemoveAll(config.RootDir)
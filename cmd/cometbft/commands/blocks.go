@@ -0,0 +1,165 @@
+package commands
+
+import (
+	"encoding/hex"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	"github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/store/archive"
+	"github.com/cometbft/cometbft/types"
+)
+
+var (
+	exportFrom        int64
+	exportTo          int64
+	importTrustedHash string
+)
+
+// BlocksCmd groups the commands exporting and importing blocks.
+var BlocksCmd = &cobra.Command{
+	Use:   "blocks",
+	Short: "Export and import blocks to and from portable archives",
+}
+
+var blocksExportCmd = &cobra.Command{
+	Use:   "export [file]",
+	Short: "Export blocks, commits and ABCI responses to an archive",
+	Long: `
+export writes the blocks of the node, with their commits, validator sets and
+ABCI responses, to a versioned and checksummed archive, which can be imported
+by another node with "blocks import". The node must be stopped.
+
+The default --from is the lowest height of the block store, and the default
+--to its latest height.
+`,
+	Example: `
+	cometbft blocks export blocks.archive
+	cometbft blocks export --from 100 --to 200 blocks.archive
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBlocksExport,
+}
+
+var blocksImportCmd = &cobra.Command{
+	Use:   "import [file]",
+	Short: "Import blocks, commits and ABCI responses from an archive",
+	Long: `
+import verifies the blocks of an archive written by "blocks export" against the
+commits and validator sets of the archive, and saves them with their validator
+sets, consensus params and ABCI responses in the block store and the state
+store of the node.
+
+The first block of the archive must be trusted: its hash must be passed with
+--trusted-hash, unless its validator set is the one of its height in the state
+store, or the one of the genesis for an archive starting at the initial height.
+
+The state is then bootstrapped at the height before the last block, which the
+node replays on start. The application must hold the state of a height of the
+archive, or be empty for an archive starting at the initial height, in which
+case the node replays all the blocks.
+
+If the block store isn't empty, for example after an import failed, the
+import resumes after its latest block, which the archive must hold. A failed
+import must be resumed before starting the node.
+`,
+	Example: `
+	cometbft blocks import blocks.archive
+	cometbft blocks import --trusted-hash 6F4D...E1A2 blocks.archive
+	`,
+	Args: cobra.ExactArgs(1),
+	RunE: runBlocksImport,
+}
+
+func init() {
+	blocksExportCmd.Flags().Int64Var(&exportFrom, "from", 0, "the first height to export")
+	blocksExportCmd.Flags().Int64Var(&exportTo, "to", 0, "the last height to export")
+	blocksImportCmd.Flags().StringVar(&importTrustedHash, "trusted-hash", "",
+		"the hex encoded hash of the first block of the archive")
+
+	BlocksCmd.AddCommand(blocksExportCmd)
+	BlocksCmd.AddCommand(blocksImportCmd)
+}
+
+func runBlocksExport(cmd *cobra.Command, args []string) error {
+	blockStore, stateStore, err := loadStateAndBlockStore(config)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = blockStore.Close()
+		_ = stateStore.Close()
+	}()
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+
+	from, to := exportFrom, exportTo
+	if from == 0 {
+		from = blockStore.Base()
+	}
+	if to == 0 {
+		to = blockStore.Height()
+	}
+
+	file, err := os.Create(args[0])
+	if err != nil {
+		return err
+	}
+	if err := archive.Export(file, blockStore, stateStore, genDoc.ChainID, from, to); err != nil {
+		file.Close()
+		return fmt.Errorf("failed to export blocks: %w", err)
+	}
+	if err := file.Close(); err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported blocks %d to %d to %s\n", from, to, args[0])
+	return nil
+}
+
+func runBlocksImport(cmd *cobra.Command, args []string) error {
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return err
+	}
+	hash, err := hex.DecodeString(importTrustedHash)
+	if err != nil {
+		return fmt.Errorf("invalid --trusted-hash: %w", err)
+	}
+	file, err := os.Open(args[0])
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	dbType := dbm.BackendType(config.DBBackend)
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, config.DBDir())
+	if err != nil {
+		return err
+	}
+	blockStore := store.NewBlockStore(blockStoreDB)
+	defer blockStore.Close()
+	stateDB, err := dbm.NewDB("state", dbType, config.DBDir())
+	if err != nil {
+		return err
+	}
+	stateStore := state.NewStore(stateDB, state.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+	})
+	defer stateStore.Close()
+
+	header, err := archive.Import(file, blockStore, stateStore, genDoc, hash)
+	if err != nil {
+		return fmt.Errorf("failed to import blocks: %w", err)
+	}
+
+	fmt.Printf("Imported blocks %d to %d\n", header.From, header.To)
+	return nil
+}
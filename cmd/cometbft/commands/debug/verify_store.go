@@ -0,0 +1,75 @@
+package debug
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/cli"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/store/verify"
+)
+
+var verifyStoreCmd = &cobra.Command{
+	Use:   "verify-store",
+	Short: "Verify the consistency of the block store of a stopped CometBFT node",
+	Long: `Verify the consistency of the block store of a stopped CometBFT node with
+its state store. From the lowest to the latest height, it checks that the parts of
+every block reassemble to the block ID of its meta, that every block links to the
+previous one, that the commits are signed by the validator sets of the state
+store, that the tx index entries point at the txs, and that the ABCI responses of
+every block hash to the last results hash of the next one. The first height
+failing a check is reported.
+
+Example:
+$ cometbft debug verify-store --home=/path/to/app.d`,
+	Args: cobra.NoArgs,
+	RunE: verifyStoreCmdHandler,
+}
+
+func verifyStoreCmdHandler(_ *cobra.Command, _ []string) error {
+	conf := cfg.DefaultConfig()
+	if err := viper.Unmarshal(conf); err != nil {
+		return err
+	}
+	conf = conf.SetRoot(viper.GetString(cli.HomeFlag))
+
+	dbType := dbm.BackendType(conf.DBBackend)
+	for _, name := range []string{"blockstore", "state"} {
+		if !cmtos.FileExists(filepath.Join(conf.DBDir(), name+".db")) {
+			return fmt.Errorf("no %s database found in %v", name, conf.DBDir())
+		}
+	}
+	blockStoreDB, err := dbm.NewDB("blockstore", dbType, conf.DBDir())
+	if err != nil {
+		return err
+	}
+	coldTier, err := store.NewSegmentStore(conf.ColdStorageDir())
+	if err != nil {
+		return err
+	}
+	blockStore := store.NewBlockStore(blockStoreDB, store.WithColdTier(coldTier))
+	defer blockStore.Close()
+	stateDB, err := dbm.NewDB("state", dbType, conf.DBDir())
+	if err != nil {
+		return err
+	}
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: conf.Storage.DiscardABCIResponses,
+	})
+	defer stateStore.Close()
+
+	logger.Info("verifying block store...", "base", blockStore.Base(), "height", blockStore.Height())
+	if err := verify.Verify(blockStore, stateStore); err != nil {
+		return fmt.Errorf("block store is inconsistent: %w", err)
+	}
+	logger.Info("block store is consistent")
+	return nil
+}
@@ -0,0 +1,51 @@
+package commands
+
+import (
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/inspect"
+)
+
+// InspectCmd is the command for serving the RPC of a stopped node.
+var InspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "Serve the RPC from the data directory of a stopped node",
+	Long: `
+inspect serves the RPC from the block store, the state store and the indexers
+of a node, without starting the application, p2p, consensus or the mempool.
+It can be used to debug a node that halted, for example on an app hash
+mismatch, with the block, block_results, commit, validators, consensus_params,
+tx_search and the other routes that read stored data. The routes that need a
+running node return an error.
+
+The node must be stopped, since its databases are opened by inspect. They are
+opened read-only, which only the goleveldb backend supports.
+`,
+	Example: `
+	cometbft inspect
+	cometbft inspect --rpc.laddr tcp://127.0.0.1:26657
+	`,
+	RunE: runInspect,
+}
+
+func init() {
+	InspectCmd.Flags().String("rpc.laddr", config.RPC.ListenAddress, "RPC listen address. Port required")
+	InspectCmd.Flags().String("db_backend", config.DBBackend, "database backend: goleveldb | cleveldb | boltdb | rocksdb | badgerdb")
+	InspectCmd.Flags().String("db_dir", config.DBPath, "database directory")
+}
+
+func runInspect(cmd *cobra.Command, args []string) error {
+	ctx, cancel := signal.NotifyContext(cmd.Context(), os.Interrupt, syscall.SIGTERM)
+	defer cancel()
+
+	ins, err := inspect.NewFromConfig(config, logger)
+	if err != nil {
+		return err
+	}
+	logger.Info("Starting inspect mode", "rpc.laddr", config.RPC.ListenAddress)
+	return ins.Run(ctx)
+}
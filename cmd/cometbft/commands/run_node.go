@@ -152,8 +152,10 @@ func NewRunNodeCmd(nodeProvider nm.Provider) *cobra.Command {
 				}
 			})
 
-			// Run forever.
-			select {}
+			// Run until the node is stopped, which also happens once it halts
+			// at the halt height or time.
+			<-n.Quit()
+			return nil
 		},
 	}
 
@@ -0,0 +1,105 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/pkg/trace/analysis"
+)
+
+var (
+	traceDir    string
+	traceOutput string
+	traceFormat string
+)
+
+// TraceCmd contains subcommands to analyze trace tables collected from many
+// nodes.
+var TraceCmd = &cobra.Command{
+	Use:   "trace",
+	Short: "Analyze trace tables collected from one or more nodes",
+	Long: `
+Analyze trace tables collected using the trace pull server (GetTable) or
+downloaded from S3 (S3Download). The directory is searched recursively, and
+tables of every node found are decoded, including rotated segments.
+	`,
+}
+
+var traceAnalyzeCmd = &cobra.Command{
+	Use:   "analyze",
+	Short: "Compute latency and traffic reports and write them as csv or json files",
+	Long: `
+Compute the following reports from the tables in --dir and write each of them to
+its own file in --output:
+
+  block_part_latency  block part propagation latency per height
+                      (consensus_block_parts)
+  vote_arrival        vote arrival delay distribution per height, round and
+                      type (consensus_vote)
+  tx_latency          time from a tx being first seen in any mempool to it
+                      being committed (mempool_tx, consensus_committed_txs)
+  peer_bytes          bytes received from and queued for each peer
+                      (received_bytes, pending_bytes)
+	`,
+	Example: `
+	cometbft trace analyze --dir ./traces --output ./reports
+	cometbft trace analyze --dir ./traces --output ./reports --format json
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		report, err := loadTraceReport()
+		if err != nil {
+			return err
+		}
+
+		switch traceFormat {
+		case "csv":
+			err = report.WriteCSV(traceOutput)
+		case "json":
+			err = report.WriteJSON(traceOutput)
+		default:
+			return fmt.Errorf("unknown format %q, expected csv or json", traceFormat)
+		}
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Wrote reports for %d nodes to %s\n", len(report.Nodes), traceOutput)
+		return nil
+	},
+}
+
+var traceReportCmd = &cobra.Command{
+	Use:   "report",
+	Short: "Print a json summary of the latency and traffic reports",
+	Example: `
+	cometbft trace report --dir ./traces
+	`,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		report, err := loadTraceReport()
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(os.Stdout)
+		enc.SetIndent("", "  ")
+		return enc.Encode(report.Summarize())
+	},
+}
+
+func loadTraceReport() (*analysis.Report, error) {
+	ds, err := analysis.Load(traceDir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load trace tables: %w", err)
+	}
+	return analysis.Analyze(ds), nil
+}
+
+func init() {
+	TraceCmd.PersistentFlags().StringVar(&traceDir, "dir", ".", "directory containing the trace tables of one or more nodes")
+	traceAnalyzeCmd.Flags().StringVar(&traceOutput, "output", "trace_reports", "directory to write the reports to")
+	traceAnalyzeCmd.Flags().StringVar(&traceFormat, "format", "csv", "format of the reports: csv or json")
+
+	TraceCmd.AddCommand(traceAnalyzeCmd)
+	TraceCmd.AddCommand(traceReportCmd)
+}
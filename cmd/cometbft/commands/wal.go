@@ -0,0 +1,199 @@
+package commands
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cometbft/cometbft/consensus"
+	cmtjson "github.com/cometbft/cometbft/libs/json"
+)
+
+var (
+	walHeight int64
+	walRound  int32
+	walBackup string
+)
+
+// WALCmd groups the commands inspecting and repairing the consensus WAL.
+var WALCmd = &cobra.Command{
+	Use:   "wal",
+	Short: "Inspect and repair the consensus WAL of a stopped node",
+}
+
+var walHeightsCmd = &cobra.Command{
+	Use:   "heights",
+	Short: "List the heights of the WAL with their number of messages",
+	Long: `
+heights lists the heights of the consensus WAL in order, with the number of
+messages of each kind. The messages of a height are the ones after the end of
+the previous height, up to its own end, which the last height may lack. The
+heights before a corrupted message, if any, are listed before the error.
+`,
+	Args: cobra.NoArgs,
+	RunE: runWALHeights,
+}
+
+var walDumpCmd = &cobra.Command{
+	Use:   "dump",
+	Short: "Print the messages of a height of the WAL as JSON",
+	Long: `
+dump prints the messages of a height of the consensus WAL, one JSON object per
+line: the votes, proposals and block parts received, the timeouts, the round
+steps and the end of the height. With --round, only the messages of the round
+and the end of the height are printed.
+`,
+	Example: `
+	cometbft wal dump --height 100
+	cometbft wal dump --height 100 --round 1
+	`,
+	Args: cobra.NoArgs,
+	RunE: runWALDump,
+}
+
+var walValidateCmd = &cobra.Command{
+	Use:   "validate",
+	Short: "Check the checksums of the messages of all the WAL segments",
+	Long: `
+validate reads the messages of all the segments of the consensus WAL in order,
+checking their checksums and encoding, and reports the segment and the offset
+of the first corrupted message, if any, in which case it fails.
+`,
+	Args: cobra.NoArgs,
+	RunE: runWALValidate,
+}
+
+var walRepairCmd = &cobra.Command{
+	Use:   "repair",
+	Short: "Truncate a corrupted tail of the WAL, keeping a backup",
+	Long: `
+repair truncates the head segment of the consensus WAL before its first
+corrupted message, as left by a crash while writing, once the segment is
+copied to the backup file. The messages of the WAL after the corruption are
+lost: the node replays the WAL up to the truncation on start. It fails if a
+segment other than the head is corrupted, since the WAL would then miss
+messages in the middle.
+`,
+	Example: `
+	cometbft wal repair
+	cometbft wal repair --backup /tmp/wal.backup
+	`,
+	Args: cobra.NoArgs,
+	RunE: runWALRepair,
+}
+
+func init() {
+	walDumpCmd.Flags().Int64Var(&walHeight, "height", 0, "the height to dump")
+	walDumpCmd.Flags().Int32Var(&walRound, "round", -1, "the round to dump, all of them if negative")
+	_ = walDumpCmd.MarkFlagRequired("height")
+	walRepairCmd.Flags().StringVar(&walBackup, "backup", "",
+		"the file to copy the head segment to before truncating it "+
+			"(default: backup-<time>-wal in the WAL directory)")
+
+	WALCmd.AddCommand(walHeightsCmd)
+	WALCmd.AddCommand(walDumpCmd)
+	WALCmd.AddCommand(walValidateCmd)
+	WALCmd.AddCommand(walRepairCmd)
+}
+
+func runWALHeights(cmd *cobra.Command, args []string) error {
+	group, err := consensus.OpenWALGroup(config.Consensus.WalFile())
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	heights, err := consensus.ListWALHeights(group)
+	for _, h := range heights {
+		kinds := make([]string, 0, len(h.Messages))
+		total := 0
+		for kind, n := range h.Messages {
+			kinds = append(kinds, fmt.Sprintf("%s=%d", kind, n))
+			total += n
+		}
+		sort.Strings(kinds)
+		end := ""
+		if !h.Ended {
+			end = ", not ended"
+		}
+		fmt.Printf("height %d: %d messages (%s)%s\n", h.Height, total, strings.Join(kinds, " "), end)
+	}
+	return err
+}
+
+func runWALDump(cmd *cobra.Command, args []string) error {
+	group, err := consensus.OpenWALGroup(config.Consensus.WalFile())
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	msgs, err := consensus.ReadWALHeight(group, walHeight, walRound)
+	for _, msg := range msgs {
+		bz, err := cmtjson.Marshal(msg)
+		if err != nil {
+			return fmt.Errorf("failed to marshal message: %w", err)
+		}
+		fmt.Println(string(bz))
+	}
+	if err != nil {
+		return err
+	}
+	if len(msgs) == 0 {
+		return fmt.Errorf("no messages of height %d in the WAL", walHeight)
+	}
+	return nil
+}
+
+func runWALValidate(cmd *cobra.Command, args []string) error {
+	group, err := consensus.OpenWALGroup(config.Consensus.WalFile())
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	v, err := consensus.ValidateWAL(group)
+	if err != nil {
+		return err
+	}
+	for _, s := range v.Segments {
+		fmt.Printf("segment %d: %s (%d bytes)\n", s.Index, s.Path, s.Size)
+	}
+	if v.Corrupted != nil {
+		return fmt.Errorf("%d valid messages, then a corrupted message in segment %d at offset %d: %w",
+			v.Messages, v.Corrupted.Index, v.Offset, v.Err)
+	}
+	fmt.Printf("%d valid messages\n", v.Messages)
+	return nil
+}
+
+func runWALRepair(cmd *cobra.Command, args []string) error {
+	walFile := config.Consensus.WalFile()
+	group, err := consensus.OpenWALGroup(walFile)
+	if err != nil {
+		return err
+	}
+	defer group.Close()
+
+	backup := walBackup
+	if backup == "" {
+		// the name must not start with the one of the WAL, not to be taken
+		// for a segment of the group.
+		backup = filepath.Join(filepath.Dir(walFile),
+			fmt.Sprintf("backup-%s-%s", time.Now().UTC().Format("20060102T150405"), filepath.Base(walFile)))
+	}
+	truncated, err := consensus.RepairWAL(group, backup)
+	if err != nil {
+		return err
+	}
+	if truncated == 0 {
+		fmt.Println("The WAL isn't corrupted")
+		return nil
+	}
+	fmt.Printf("Truncated %d bytes of the WAL head, backed up to %s\n", truncated, backup)
+	return nil
+}
@@ -155,9 +155,15 @@ func (cfg *Config) ValidateBasic() error {
 	if err := cfg.Consensus.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [consensus] section: %w", err)
 	}
+	if err := cfg.Storage.ValidateBasic(); err != nil {
+		return fmt.Errorf("error in [storage] section: %w", err)
+	}
 	if err := cfg.Instrumentation.ValidateBasic(); err != nil {
 		return fmt.Errorf("error in [instrumentation] section: %w", err)
 	}
+	if cfg.Consensus.CompactBlocks && cfg.Mempool.Version != MempoolV2 {
+		return fmt.Errorf("error in [consensus] section: compact_blocks requires the %s mempool", MempoolV2)
+	}
 	return nil
 }
 
@@ -297,6 +303,12 @@ func (cfg BaseConfig) DBDir() string {
 	return rootify(cfg.DBPath, cfg.RootDir)
 }
 
+// ColdStorageDir returns the full path to the directory of the cold tier of
+// the block store.
+func (cfg BaseConfig) ColdStorageDir() string {
+	return filepath.Join(cfg.DBDir(), "blockstore.cold")
+}
+
 // ValidateBasic performs basic validation (checking param bounds, etc.) and
 // returns an error if any check fails.
 func (cfg BaseConfig) ValidateBasic() error {
@@ -331,7 +343,8 @@ type RPCConfig struct {
 	CORSAllowedHeaders []string `mapstructure:"cors_allowed_headers"`
 
 	// TCP or UNIX socket address for the gRPC server to listen on
-	// NOTE: This server only supports /broadcast_tx_commit
+	// NOTE: This server serves /broadcast_tx_commit and the read-only BlockAPI
+	// and TxAPI services
 	GRPCListenAddress string `mapstructure:"grpc_laddr"`
 
 	// Maximum number of simultaneous connections.
@@ -590,6 +603,14 @@ type P2PConfig struct { //nolint: maligned
 	// Toggle to disable guard against peers connecting from the same ip.
 	AllowDuplicateIP bool `mapstructure:"allow_duplicate_ip"`
 
+	// Peers whose trust score, between 0 and 100, falls below this value after
+	// misbehaving are disconnected and banned. Persistent and unconditional
+	// peers are never banned. 0, the default, disables banning.
+	MinPeerTrustScore int `mapstructure:"min_peer_trust_score"`
+
+	// Duration for which misbehaving peers are banned
+	PeerBanDuration time.Duration `mapstructure:"peer_ban_duration"`
+
 	// Peer connection configuration.
 	HandshakeTimeout time.Duration `mapstructure:"handshake_timeout"`
 	DialTimeout      time.Duration `mapstructure:"dial_timeout"`
@@ -621,6 +642,8 @@ func DefaultP2PConfig() *P2PConfig {
 		PexReactor:                   true,
 		SeedMode:                     false,
 		AllowDuplicateIP:             false,
+		MinPeerTrustScore:            0,
+		PeerBanDuration:              1 * time.Hour,
 		HandshakeTimeout:             20 * time.Second,
 		DialTimeout:                  3 * time.Second,
 		TestDialFail:                 false,
@@ -667,6 +690,12 @@ func (cfg *P2PConfig) ValidateBasic() error {
 	if cfg.RecvRate < 0 {
 		return errors.New("recv_rate can't be negative")
 	}
+	if cfg.MinPeerTrustScore < 0 || cfg.MinPeerTrustScore > 100 {
+		return errors.New("min_peer_trust_score must be between 0 and 100")
+	}
+	if cfg.PeerBanDuration < 0 {
+		return errors.New("peer_ban_duration can't be negative")
+	}
 	return nil
 }
 
@@ -762,6 +791,13 @@ type MempoolConfig struct {
 	// Only applicable to the v2 / CAT mempool
 	// Default is 200ms
 	MaxGossipDelay time.Duration `mapstructure:"max-gossip-delay"`
+
+	// MinReplacementPriorityBump is the minimum percentage by which the
+	// priority of a transaction must exceed the priority of the transaction of
+	// the same sender and sequence it replaces.
+	// Only applicable to the v2 / CAT mempool
+	// Default is 10
+	MinReplacementPriorityBump int64 `mapstructure:"min-replacement-priority-bump"`
 }
 
 // DefaultMempoolConfig returns a default configuration for the CometBFT mempool
@@ -779,6 +815,8 @@ func DefaultMempoolConfig() *MempoolConfig {
 		MaxTxBytes:   1024 * 1024, // 1MB
 		TTLDuration:  0 * time.Second,
 		TTLNumBlocks: 0,
+
+		MinReplacementPriorityBump: 10,
 	}
 }
 
@@ -814,6 +852,9 @@ func (cfg *MempoolConfig) ValidateBasic() error {
 	if cfg.MaxTxBytes < 0 {
 		return errors.New("max_tx_bytes can't be negative")
 	}
+	if cfg.MinReplacementPriorityBump < 0 {
+		return errors.New("min-replacement-priority-bump can't be negative")
+	}
 	return nil
 }
 
@@ -969,6 +1010,18 @@ type ConsensusConfig struct {
 	// Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 	SkipTimeoutCommit bool `mapstructure:"skip_timeout_commit"`
 
+	// AdaptiveTimeouts derives TimeoutPropose from the proposal completion
+	// times, and TimeoutPrevote and TimeoutPrecommit from the times between
+	// any +2/3 of the votes and a +2/3 majority, observed in the latest
+	// AdaptiveTimeoutWindow rounds, bounded by the minimum and maximum below.
+	// The deltas still apply to later rounds.
+	AdaptiveTimeouts      bool          `mapstructure:"adaptive_timeouts"`
+	AdaptiveTimeoutWindow int           `mapstructure:"adaptive_timeout_window"`
+	TimeoutProposeMin     time.Duration `mapstructure:"timeout_propose_min"`
+	TimeoutProposeMax     time.Duration `mapstructure:"timeout_propose_max"`
+	TimeoutVoteMin        time.Duration `mapstructure:"timeout_vote_min"`
+	TimeoutVoteMax        time.Duration `mapstructure:"timeout_vote_max"`
+
 	// EmptyBlocks mode and possible interval between empty blocks
 	CreateEmptyBlocks         bool          `mapstructure:"create_empty_blocks"`
 	CreateEmptyBlocksInterval time.Duration `mapstructure:"create_empty_blocks_interval"`
@@ -977,7 +1030,25 @@ type ConsensusConfig struct {
 	PeerGossipSleepDuration     time.Duration `mapstructure:"peer_gossip_sleep_duration"`
 	PeerQueryMaj23SleepDuration time.Duration `mapstructure:"peer_query_maj23_sleep_duration"`
 
+	// CompactBlocks gossips the proposed blocks to the peers supporting it as
+	// compact blocks, whose txs are identified by their keys in the mempool
+	// of the peers. Requires the v2 (CAT) mempool.
+	CompactBlocks bool `mapstructure:"compact_blocks"`
+
+	// ErasureCodedBlockParts extends the block parts proposed by this node
+	// with Reed-Solomon parity parts, from which the peers can recover the
+	// block with as many parts as the block has, whichever they are. Only
+	// applies from types.ExtendedPartSetHeaderAppVersion. The parity parts
+	// are only exchanged between the nodes which enable it.
+	ErasureCodedBlockParts bool `mapstructure:"erasure_coded_block_parts"`
+
 	DoubleSignCheckHeight int64 `mapstructure:"double_sign_check_height"`
+
+	// The node stops cleanly after committing the block at HaltHeight, or
+	// the first block with a time at or after HaltTime (in seconds since the
+	// Unix epoch). Zero disables either condition.
+	HaltHeight int64 `mapstructure:"halt_height"`
+	HaltTime   int64 `mapstructure:"halt_time"`
 }
 
 // DefaultConsensusConfig returns a default configuration for the consensus service
@@ -992,11 +1063,21 @@ func DefaultConsensusConfig() *ConsensusConfig {
 		TimeoutPrecommitDelta:       500 * time.Millisecond,
 		TimeoutCommit:               1000 * time.Millisecond,
 		SkipTimeoutCommit:           false,
+		AdaptiveTimeouts:            false,
+		AdaptiveTimeoutWindow:       100,
+		TimeoutProposeMin:           1000 * time.Millisecond,
+		TimeoutProposeMax:           10000 * time.Millisecond,
+		TimeoutVoteMin:              500 * time.Millisecond,
+		TimeoutVoteMax:              5000 * time.Millisecond,
 		CreateEmptyBlocks:           true,
 		CreateEmptyBlocksInterval:   0 * time.Second,
 		PeerGossipSleepDuration:     100 * time.Millisecond,
 		PeerQueryMaj23SleepDuration: 2000 * time.Millisecond,
+		CompactBlocks:               false,
+		ErasureCodedBlockParts:      false,
 		DoubleSignCheckHeight:       int64(0),
+		HaltHeight:                  0,
+		HaltTime:                    0,
 	}
 }
 
@@ -1087,6 +1168,24 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.TimeoutCommit < 0 {
 		return errors.New("timeout_commit can't be negative")
 	}
+	if cfg.AdaptiveTimeoutWindow < 0 {
+		return errors.New("adaptive_timeout_window can't be negative")
+	}
+	if cfg.AdaptiveTimeouts && cfg.AdaptiveTimeoutWindow == 0 {
+		return errors.New("adaptive_timeout_window can't be 0 with adaptive_timeouts")
+	}
+	if cfg.TimeoutProposeMin < 0 {
+		return errors.New("timeout_propose_min can't be negative")
+	}
+	if cfg.TimeoutProposeMax < cfg.TimeoutProposeMin {
+		return errors.New("timeout_propose_max can't be less than timeout_propose_min")
+	}
+	if cfg.TimeoutVoteMin < 0 {
+		return errors.New("timeout_vote_min can't be negative")
+	}
+	if cfg.TimeoutVoteMax < cfg.TimeoutVoteMin {
+		return errors.New("timeout_vote_max can't be less than timeout_vote_min")
+	}
 	if cfg.CreateEmptyBlocksInterval < 0 {
 		return errors.New("create_empty_blocks_interval can't be negative")
 	}
@@ -1099,6 +1198,12 @@ func (cfg *ConsensusConfig) ValidateBasic() error {
 	if cfg.DoubleSignCheckHeight < 0 {
 		return errors.New("double_sign_check_height can't be negative")
 	}
+	if cfg.HaltHeight < 0 {
+		return errors.New("halt_height can't be negative")
+	}
+	if cfg.HaltTime < 0 {
+		return errors.New("halt_time can't be negative")
+	}
 	return nil
 }
 
@@ -1112,13 +1217,47 @@ type StorageConfig struct {
 	// required for `/block_results` RPC queries, and to reindex events in the
 	// command-line tool.
 	DiscardABCIResponses bool `mapstructure:"discard_abci_responses"`
+
+	// The number of latest blocks kept in the block store database. The
+	// parts of older blocks are moved to compressed segment files of the cold
+	// tier, in the blockstore.cold directory of the database directory.
+	// 0 keeps every block in the database.
+	ColdStorageDepth int64 `mapstructure:"cold_storage_depth"`
+
+	// The number of blocks per segment file of the cold tier.
+	ColdStorageSegmentSize int64 `mapstructure:"cold_storage_segment_size"`
+
+	// The number of latest heights whose blocks are retained by the pruning
+	// service. 0 disables the pruning of the blocks by the service, leaving
+	// it to the retain height of the application.
+	RetainBlocks int64 `mapstructure:"retain_blocks"`
+
+	// The number of latest heights whose states, i.e. validator sets and
+	// consensus params, are retained by the pruning service. 0 disables the
+	// pruning of the states by the service.
+	RetainStates int64 `mapstructure:"retain_states"`
+
+	// The number of latest heights whose ABCI responses are retained by the
+	// pruning service. 0 prunes them with the states.
+	RetainABCIResponses int64 `mapstructure:"retain_abci_responses"`
+
+	// The number of latest heights whose tx infos, which locate the txs by
+	// hash in the block store, are retained by the pruning service. 0 prunes
+	// them with the blocks.
+	RetainTxInfo int64 `mapstructure:"retain_tx_info"`
+
+	// The interval at which the pruning service checks for data to prune.
+	PruningInterval time.Duration `mapstructure:"pruning_interval"`
 }
 
 // DefaultStorageConfig returns the default configuration options relating to
 // CometBFT storage optimization.
 func DefaultStorageConfig() *StorageConfig {
 	return &StorageConfig{
-		DiscardABCIResponses: false,
+		DiscardABCIResponses:   false,
+		ColdStorageDepth:       0,
+		ColdStorageSegmentSize: 10000,
+		PruningInterval:        10 * time.Second,
 	}
 }
 
@@ -1126,8 +1265,43 @@ func DefaultStorageConfig() *StorageConfig {
 // testing.
 func TestStorageConfig() *StorageConfig {
 	return &StorageConfig{
-		DiscardABCIResponses: false,
+		DiscardABCIResponses:   false,
+		ColdStorageDepth:       0,
+		ColdStorageSegmentSize: 100,
+		PruningInterval:        100 * time.Millisecond,
+	}
+}
+
+// ValidateBasic performs basic validation (checking param bounds, etc.) and
+// returns an error if any check fails.
+func (cfg *StorageConfig) ValidateBasic() error {
+	if cfg.ColdStorageDepth < 0 {
+		return errors.New("cold_storage_depth can't be negative")
+	}
+	if cfg.ColdStorageSegmentSize <= 0 {
+		return errors.New("cold_storage_segment_size must be positive")
+	}
+	if cfg.RetainBlocks < 0 {
+		return errors.New("retain_blocks can't be negative")
+	}
+	if cfg.RetainStates < 0 {
+		return errors.New("retain_states can't be negative")
+	}
+	if cfg.RetainABCIResponses < 0 {
+		return errors.New("retain_abci_responses can't be negative")
+	}
+	if cfg.RetainTxInfo < 0 {
+		return errors.New("retain_tx_info can't be negative")
+	}
+	if cfg.PruningInterval <= 0 {
+		return errors.New("pruning_interval must be positive")
 	}
+	return nil
+}
+
+// PruningEnabled returns whether the pruning service prunes any data.
+func (cfg *StorageConfig) PruningEnabled() bool {
+	return cfg.RetainBlocks > 0 || cfg.RetainStates > 0 || cfg.RetainABCIResponses > 0 || cfg.RetainTxInfo > 0
 }
 
 // -----------------------------------------------------------------------------
@@ -1200,9 +1374,19 @@ type InstrumentationConfig struct {
 	// pulling data.
 	TracePullAddress string `mapstructure:"trace_pull_address"`
 
-	// TraceType is the type of tracer used. Options are "local" and "noop".
+	// TraceType is the type of tracer used. Options are "local", "otlp" and
+	// "noop".
 	TraceType string `mapstructure:"trace_type"`
 
+	// TraceOTLPEndpoint is the base URL of the OTLP/HTTP collector that traces
+	// are exported to when using the "otlp" tracer. For example,
+	// "http://localhost:4318".
+	TraceOTLPEndpoint string `mapstructure:"trace_otlp_endpoint"`
+
+	// TraceOTLPFlushInterval is the maximum amount of time traces are batched
+	// before being exported when using the "otlp" tracer.
+	TraceOTLPFlushInterval time.Duration `mapstructure:"trace_otlp_flush_interval"`
+
 	// TraceBufferSize is the number of traces to write in a single batch.
 	TraceBufferSize int `mapstructure:"trace_push_batch_size"`
 
@@ -1211,6 +1395,23 @@ type InstrumentationConfig struct {
 	// comma separate string. For example: "consensus_round_state,mempool_tx".
 	TracingTables string `mapstructure:"tracing_tables"`
 
+	// TraceRotateSize is the size in bytes after which the file of a table is
+	// sealed into a segment and a new file is started. 0 disables size based
+	// rotation.
+	TraceRotateSize int64 `mapstructure:"trace_rotate_size"`
+
+	// TraceRotateInterval is the amount of time after which the file of a
+	// table is sealed into a segment and a new file is started. 0 disables
+	// time based rotation.
+	TraceRotateInterval time.Duration `mapstructure:"trace_rotate_interval"`
+
+	// TraceRetainSegments is the number of sealed segments kept per table.
+	// Older segments are deleted. 0 keeps all segments.
+	TraceRetainSegments int `mapstructure:"trace_retain_segments"`
+
+	// TraceCompressSegments enables gzip compression of sealed segments.
+	TraceCompressSegments bool `mapstructure:"trace_compress_segments"`
+
 	// PyroscopeURL is the pyroscope url used to establish a connection with a
 	// pyroscope continuous profiling server.
 	PyroscopeURL string `mapstructure:"pyroscope_url"`
@@ -1230,17 +1431,23 @@ type InstrumentationConfig struct {
 // reporting.
 func DefaultInstrumentationConfig() *InstrumentationConfig {
 	return &InstrumentationConfig{
-		Prometheus:           false,
-		PrometheusListenAddr: ":26660",
-		MaxOpenConnections:   3,
-		Namespace:            "cometbft",
-		TracePushConfig:      "",
-		TracePullAddress:     "",
-		TraceType:            "noop",
-		TraceBufferSize:      1000,
-		TracingTables:        DefaultTracingTables,
-		PyroscopeURL:         "",
-		PyroscopeTrace:       false,
+		Prometheus:             false,
+		PrometheusListenAddr:   ":26660",
+		MaxOpenConnections:     3,
+		Namespace:              "cometbft",
+		TracePushConfig:        "",
+		TracePullAddress:       "",
+		TraceType:              "noop",
+		TraceOTLPEndpoint:      "",
+		TraceOTLPFlushInterval: 5 * time.Second,
+		TraceBufferSize:        1000,
+		TracingTables:          DefaultTracingTables,
+		TraceRotateSize:        0,
+		TraceRotateInterval:    0,
+		TraceRetainSegments:    0,
+		TraceCompressSegments:  true,
+		PyroscopeURL:           "",
+		PyroscopeTrace:         false,
 		PyroscopeProfileTypes: strings.Join([]string{
 			"cpu",
 			"alloc_objects",
@@ -1273,6 +1480,21 @@ func (cfg *InstrumentationConfig) ValidateBasic() error {
 	if cfg.TraceBufferSize < 0 {
 		return fmt.Errorf("trace buffer size must be greater than 0")
 	}
+	if cfg.TraceType == "otlp" && cfg.TraceOTLPEndpoint == "" {
+		return errors.New("trace_otlp_endpoint must be set when using the otlp tracer")
+	}
+	if cfg.TraceOTLPFlushInterval < 0 {
+		return errors.New("trace_otlp_flush_interval can't be negative")
+	}
+	if cfg.TraceRotateSize < 0 {
+		return errors.New("trace_rotate_size can't be negative")
+	}
+	if cfg.TraceRotateInterval < 0 {
+		return errors.New("trace_rotate_interval can't be negative")
+	}
+	if cfg.TraceRetainSegments < 0 {
+		return errors.New("trace_retain_segments can't be negative")
+	}
 	return nil
 }
 
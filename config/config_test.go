@@ -36,6 +36,14 @@ func TestConfigValidateBasic(t *testing.T) {
 	// tamper with timeout_propose
 	cfg.Consensus.TimeoutPropose = -10 * time.Second
 	assert.Error(t, cfg.ValidateBasic())
+
+	// compact blocks require the CAT mempool
+	cfg = DefaultConfig()
+	cfg.Consensus.CompactBlocks = true
+	cfg.Mempool.Version = MempoolV1
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.Mempool.Version = MempoolV2
+	assert.NoError(t, cfg.ValidateBasic())
 }
 
 func TestTLSConfiguration(t *testing.T) {
@@ -95,6 +103,8 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		"MaxPacketMsgPayloadSize",
 		"SendRate",
 		"RecvRate",
+		"MinPeerTrustScore",
+		"PeerBanDuration",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -102,6 +112,9 @@ func TestP2PConfigValidateBasic(t *testing.T) {
 		assert.Error(t, cfg.ValidateBasic())
 		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(0)
 	}
+
+	cfg.MinPeerTrustScore = 101
+	assert.Error(t, cfg.ValidateBasic())
 }
 
 func TestMempoolConfigValidateBasic(t *testing.T) {
@@ -113,6 +126,7 @@ func TestMempoolConfigValidateBasic(t *testing.T) {
 		"MaxTxsBytes",
 		"CacheSize",
 		"MaxTxBytes",
+		"MinReplacementPriorityBump",
 	}
 
 	for _, fieldName := range fieldsToTest {
@@ -159,11 +173,20 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 		"TimeoutPrecommitDelta negative":       {func(c *ConsensusConfig) { c.TimeoutPrecommitDelta = -1 }, true},
 		"TimeoutCommit":                        {func(c *ConsensusConfig) { c.TimeoutCommit = time.Second }, false},
 		"TimeoutCommit negative":               {func(c *ConsensusConfig) { c.TimeoutCommit = -1 }, true},
+		"AdaptiveTimeoutWindow negative":       {func(c *ConsensusConfig) { c.AdaptiveTimeoutWindow = -1 }, true},
+		"AdaptiveTimeouts without window":      {func(c *ConsensusConfig) { c.AdaptiveTimeouts, c.AdaptiveTimeoutWindow = true, 0 }, true},
+		"AdaptiveTimeouts":                     {func(c *ConsensusConfig) { c.AdaptiveTimeouts = true }, false},
+		"TimeoutProposeMin negative":           {func(c *ConsensusConfig) { c.TimeoutProposeMin = -1 }, true},
+		"TimeoutProposeMax below min":          {func(c *ConsensusConfig) { c.TimeoutProposeMax = c.TimeoutProposeMin - 1 }, true},
+		"TimeoutVoteMin negative":              {func(c *ConsensusConfig) { c.TimeoutVoteMin = -1 }, true},
+		"TimeoutVoteMax below min":             {func(c *ConsensusConfig) { c.TimeoutVoteMax = c.TimeoutVoteMin - 1 }, true},
 		"PeerGossipSleepDuration":              {func(c *ConsensusConfig) { c.PeerGossipSleepDuration = time.Second }, false},
 		"PeerGossipSleepDuration negative":     {func(c *ConsensusConfig) { c.PeerGossipSleepDuration = -1 }, true},
 		"PeerQueryMaj23SleepDuration":          {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = time.Second }, false},
 		"PeerQueryMaj23SleepDuration negative": {func(c *ConsensusConfig) { c.PeerQueryMaj23SleepDuration = -1 }, true},
 		"DoubleSignCheckHeight negative":       {func(c *ConsensusConfig) { c.DoubleSignCheckHeight = -1 }, true},
+		"HaltHeight negative":                  {func(c *ConsensusConfig) { c.HaltHeight = -1 }, true},
+		"HaltTime negative":                    {func(c *ConsensusConfig) { c.HaltTime = -1 }, true},
 	}
 
 	for desc, tc := range testcases {
@@ -182,6 +205,31 @@ func TestConsensusConfig_ValidateBasic(t *testing.T) {
 	}
 }
 
+func TestStorageConfigValidateBasic(t *testing.T) {
+	cfg := TestStorageConfig()
+	assert.NoError(t, cfg.ValidateBasic())
+
+	cfg.ColdStorageDepth = -1
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestStorageConfig()
+	cfg.ColdStorageSegmentSize = 0
+	assert.Error(t, cfg.ValidateBasic())
+
+	fieldsToTest := []string{
+		"RetainBlocks",
+		"RetainStates",
+		"RetainABCIResponses",
+		"RetainTxInfo",
+		"PruningInterval",
+	}
+	for _, fieldName := range fieldsToTest {
+		cfg = TestStorageConfig()
+		reflect.ValueOf(cfg).Elem().FieldByName(fieldName).SetInt(-1)
+		assert.Error(t, cfg.ValidateBasic(), fieldName)
+	}
+}
+
 func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	cfg := TestInstrumentationConfig()
 	assert.NoError(t, cfg.ValidateBasic())
@@ -189,4 +237,18 @@ func TestInstrumentationConfigValidateBasic(t *testing.T) {
 	// tamper with maximum open connections
 	cfg.MaxOpenConnections = -1
 	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestInstrumentationConfig()
+	cfg.TraceRotateSize = -1
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestInstrumentationConfig()
+	cfg.TraceRetainSegments = -1
+	assert.Error(t, cfg.ValidateBasic())
+
+	cfg = TestInstrumentationConfig()
+	cfg.TraceType = "otlp"
+	assert.Error(t, cfg.ValidateBasic())
+	cfg.TraceOTLPEndpoint = "http://localhost:4318"
+	assert.NoError(t, cfg.ValidateBasic())
 }
@@ -172,7 +172,7 @@ cors_allowed_methods = [{{ range .RPC.CORSAllowedMethods }}{{ printf "%q, " . }}
 cors_allowed_headers = [{{ range .RPC.CORSAllowedHeaders }}{{ printf "%q, " . }}{{end}}]
 
 # TCP or UNIX socket address for the gRPC server to listen on
-# NOTE: This server only supports /broadcast_tx_commit
+# NOTE: This server serves /broadcast_tx_commit and the read-only BlockAPI and TxAPI services
 grpc_laddr = "{{ .RPC.GRPCListenAddress }}"
 
 # Maximum number of simultaneous connections.
@@ -333,6 +333,14 @@ private_peer_ids = "{{ .P2P.PrivatePeerIDs }}"
 # Toggle to disable guard against peers connecting from the same ip.
 allow_duplicate_ip = {{ .P2P.AllowDuplicateIP }}
 
+# Peers whose trust score, between 0 and 100, falls below this value after
+# misbehaving are disconnected and banned. Persistent and unconditional
+# peers are never banned. 0, the default, disables banning.
+min_peer_trust_score = {{ .P2P.MinPeerTrustScore }}
+
+# Duration for which misbehaving peers are banned
+peer_ban_duration = "{{ .P2P.PeerBanDuration }}"
+
 # Peer connection configuration.
 handshake_timeout = "{{ .P2P.HandshakeTimeout }}"
 dial_timeout = "{{ .P2P.DialTimeout }}"
@@ -404,6 +412,13 @@ ttl-num-blocks = {{ .Mempool.TTLNumBlocks }}
 # Default is 200ms
 max-gossip-delay = "{{ .Mempool.MaxGossipDelay }}"
 
+# min-replacement-priority-bump is the minimum percentage by which the priority
+# of a transaction must exceed the priority of the transaction of the same
+# sender and sequence it replaces
+# Only applicable to the v2 / CAT mempool
+# Default is 10
+min-replacement-priority-bump = {{ .Mempool.MinReplacementPriorityBump }}
+
 #######################################################
 ###         State Sync Configuration Options        ###
 #######################################################
@@ -481,9 +496,30 @@ timeout_commit = "{{ .Consensus.TimeoutCommit }}"
 # So, validators should stop the state machine, wait for some blocks, and then restart the state machine to avoid panic.
 double_sign_check_height = {{ .Consensus.DoubleSignCheckHeight }}
 
+# The node stops cleanly after committing the block at halt_height, or the
+# first block with a time at or after halt_time (in seconds since the Unix
+# epoch), and refuses to sign votes and proposals for later heights. It can be
+# used to upgrade the binary of all nodes at the same height. 0 disables either
+# condition. Both can be changed at runtime with the unsafe_set_halt RPC method.
+halt_height = {{ .Consensus.HaltHeight }}
+halt_time = {{ .Consensus.HaltTime }}
+
 # Make progress as soon as we have all the precommits (as if TimeoutCommit = 0)
 skip_timeout_commit = {{ .Consensus.SkipTimeoutCommit }}
 
+# Derive timeout_propose from the times taken to complete the proposals, and
+# timeout_prevote and timeout_precommit from the times taken from any +2/3 of
+# the votes to +2/3 of them for the same block or nil, observed in the latest
+# adaptive_timeout_window rounds, or their timeouts if they expired. The
+# derived timeouts are bounded by the minimum and maximum below, and still
+# increase by the deltas with each round.
+adaptive_timeouts = {{ .Consensus.AdaptiveTimeouts }}
+adaptive_timeout_window = {{ .Consensus.AdaptiveTimeoutWindow }}
+timeout_propose_min = "{{ .Consensus.TimeoutProposeMin }}"
+timeout_propose_max = "{{ .Consensus.TimeoutProposeMax }}"
+timeout_vote_min = "{{ .Consensus.TimeoutVoteMin }}"
+timeout_vote_max = "{{ .Consensus.TimeoutVoteMax }}"
+
 # EmptyBlocks mode and possible interval between empty blocks
 create_empty_blocks = {{ .Consensus.CreateEmptyBlocks }}
 create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
@@ -492,6 +528,19 @@ create_empty_blocks_interval = "{{ .Consensus.CreateEmptyBlocksInterval }}"
 peer_gossip_sleep_duration = "{{ .Consensus.PeerGossipSleepDuration }}"
 peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 
+# Gossip the proposed blocks to the peers supporting it as compact blocks,
+# whose txs are identified by their keys and looked up in the mempool of the
+# peers, which only fetch the txs they are missing. Requires the v2 (CAT)
+# mempool.
+compact_blocks = {{ .Consensus.CompactBlocks }}
+
+# Extend the block parts proposed by this node with Reed-Solomon parity parts,
+# so that the peers can recover the block from any of the parts and parity
+# parts, as many as the block parts. The parts of blocks of 256 parts or more
+# aren't extended. Only applies once the app version of the chain is 4 or
+# higher. The parity parts are only exchanged between the nodes enabling it.
+erasure_coded_block_parts = {{ .Consensus.ErasureCodedBlockParts }}
+
 #######################################################
 ###         Storage Configuration Options           ###
 #######################################################
@@ -503,6 +552,42 @@ peer_query_maj23_sleep_duration = "{{ .Consensus.PeerQueryMaj23SleepDuration }}"
 # reindex events in the command-line tool.
 discard_abci_responses = {{ .Storage.DiscardABCIResponses}}
 
+# The number of latest blocks kept in the block store database. The parts of
+# older blocks are moved to compressed segment files of the cold tier, in the
+# blockstore.cold directory of the database directory, which keeps the database
+# of archive nodes small. 0 keeps every block in the database.
+cold_storage_depth = {{ .Storage.ColdStorageDepth }}
+
+# The number of blocks per segment file of the cold tier.
+cold_storage_segment_size = {{ .Storage.ColdStorageSegmentSize }}
+
+# The pruning service prunes the data older than the following numbers of latest
+# heights in the background, independently of the retain height of the
+# application, e.g. to keep the txs of months but the blocks of days.
+#
+# The number of latest heights whose blocks are retained. 0 leaves the pruning
+# of the blocks to the retain height of the application. The blocks needed to
+# verify evidence, within the evidence max age, are retained regardless.
+retain_blocks = {{ .Storage.RetainBlocks }}
+
+# The number of latest heights whose states, i.e. validator sets and consensus
+# params, are retained. The states needed to verify evidence, within the
+# evidence max age, are retained regardless. 0 leaves their pruning to the
+# retain height of the application.
+retain_states = {{ .Storage.RetainStates }}
+
+# The number of latest heights whose ABCI responses are retained. 0 prunes them
+# with the states.
+retain_abci_responses = {{ .Storage.RetainABCIResponses }}
+
+# The number of latest heights whose tx infos, used to locate txs by hash in the
+# block store, are retained. 0 prunes them with the blocks. The tx indexer is
+# not pruned.
+retain_tx_info = {{ .Storage.RetainTxInfo }}
+
+# The interval at which the pruning service checks for data to prune.
+pruning_interval = "{{ .Storage.PruningInterval }}"
+
 #######################################################
 ###   Transaction Indexer Configuration Options     ###
 #######################################################
@@ -557,9 +642,18 @@ trace_push_config = "{{ .Instrumentation.TracePushConfig }}"
 # event collection. If empty, the pull based server will not be started.
 trace_pull_address = "{{ .Instrumentation.TracePullAddress }}"
 
-# The tracer type to use for collecting trace data.
+# The tracer type to use for collecting trace data. Options are "local",
+# "otlp" and "noop".
 trace_type = "{{ .Instrumentation.TraceType }}"
 
+# The base URL of the OTLP/HTTP collector that traces are exported to when
+# trace_type is "otlp". For example, "http://localhost:4318".
+trace_otlp_endpoint = "{{ .Instrumentation.TraceOTLPEndpoint }}"
+
+# The maximum amount of time traces are batched before being exported when
+# trace_type is "otlp".
+trace_otlp_flush_interval = "{{ .Instrumentation.TraceOTLPFlushInterval }}"
+
 # The size of the batches that are sent to the database.
 trace_push_batch_size = {{ .Instrumentation.TraceBufferSize }}
 
@@ -569,6 +663,21 @@ trace_push_batch_size = {{ .Instrumentation.TraceBufferSize }}
 # For example: "consensus_round_state,mempool_tx".
 tracing_tables = "{{ .Instrumentation.TracingTables }}"
 
+# The size in bytes after which the file of a table is sealed into a segment
+# and a new file is started. 0 disables size based rotation.
+trace_rotate_size = {{ .Instrumentation.TraceRotateSize }}
+
+# The amount of time after which the file of a table is sealed into a segment
+# and a new file is started. 0 disables time based rotation.
+trace_rotate_interval = "{{ .Instrumentation.TraceRotateInterval }}"
+
+# The number of sealed segments kept per table. Older segments are deleted.
+# 0 keeps all segments.
+trace_retain_segments = {{ .Instrumentation.TraceRetainSegments }}
+
+# When true, sealed segments are compressed using gzip.
+trace_compress_segments = {{ .Instrumentation.TraceCompressSegments }}
+
 # The URL of the pyroscope instance to use for continuous profiling.
 # If empty, continuous profiling is disabled.
 pyroscope_url = "{{ .Instrumentation.PyroscopeURL }}"
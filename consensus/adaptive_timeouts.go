@@ -0,0 +1,219 @@
+package consensus
+
+import (
+	"time"
+
+	cfg "github.com/cometbft/cometbft/config"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+)
+
+// adaptiveTimeout is the timeout of a step derived from the latest durations
+// of the interval it covers in a round, such as from entering the propose step
+// to receiving the whole proposal block. It is the largest duration of the
+// window plus half of it, bounded by min and max, or initial until a duration
+// is measured. A step that expired is recorded capped at the duration the
+// current timeout is derived from, so that timeouts don't ratchet up to max.
+type adaptiveTimeout struct {
+	initial, min, max time.Duration
+
+	// ring buffer of the latest durations.
+	window []time.Duration
+	next   int
+	size   int
+
+	// the latest measurement, in progress if pending.
+	height  int64
+	round   int32
+	start   time.Time
+	begun   bool
+	pending bool
+}
+
+func newAdaptiveTimeout(initial, min, max time.Duration, windowSize int) *adaptiveTimeout {
+	return &adaptiveTimeout{
+		initial: initial,
+		min:     min,
+		max:     max,
+		window:  make([]time.Duration, windowSize),
+	}
+}
+
+// begin starts measuring the duration of the round, replacing the
+// measurement in progress if any, unless the round was already measured.
+func (at *adaptiveTimeout) begin(height int64, round int32, now time.Time) {
+	if at.begun && at.height == height && at.round == round {
+		return
+	}
+	at.height = height
+	at.round = round
+	at.start = now
+	at.begun = true
+	at.pending = true
+}
+
+// end ends the measurement of the duration of the round, and returns whether
+// it was in progress and added to the window.
+func (at *adaptiveTimeout) end(height int64, round int32, now time.Time) bool {
+	if !at.pending || at.height != height || at.round != round {
+		return false
+	}
+	at.pending = false
+	at.add(now.Sub(at.start))
+	return true
+}
+
+// expire ends the measurement of the duration of the round at the timeout
+// which expired, and returns whether it was in progress and added to the
+// window. The timeout is capped at the duration the current timeout is derived
+// from: the step took at least as long, but recording the timeout itself would
+// raise the timeout by half at every expiry.
+func (at *adaptiveTimeout) expire(height int64, round int32, timeout time.Duration) bool {
+	if !at.pending || at.height != height || at.round != round {
+		return false
+	}
+	at.pending = false
+	// rounded up, so that the timeout derived from the limit isn't shorter.
+	if limit := (at.timeout()*2 + 2) / 3; timeout > limit {
+		timeout = limit
+	}
+	at.add(timeout)
+	return true
+}
+
+func (at *adaptiveTimeout) add(d time.Duration) {
+	if d < 0 {
+		d = 0
+	}
+	at.window[at.next] = d
+	at.next = (at.next + 1) % len(at.window)
+	if at.size < len(at.window) {
+		at.size++
+	}
+}
+
+// timeout returns the timeout derived from the window.
+func (at *adaptiveTimeout) timeout() time.Duration {
+	timeout := at.initial
+	if at.size > 0 {
+		var largest time.Duration
+		for _, d := range at.window[:at.size] {
+			if d > largest {
+				largest = d
+			}
+		}
+		timeout = largest + largest/2
+	}
+	if timeout < at.min {
+		return at.min
+	}
+	if timeout > at.max {
+		return at.max
+	}
+	return timeout
+}
+
+// adaptiveTimeouts are the timeouts of the propose, prevote and precommit
+// steps, derived from the proposal completion times, and from the times
+// between receiving any +2/3 of the votes and a +2/3 majority.
+type adaptiveTimeouts struct {
+	propose   *adaptiveTimeout
+	prevote   *adaptiveTimeout
+	precommit *adaptiveTimeout
+}
+
+func newAdaptiveTimeouts(config *cfg.ConsensusConfig) *adaptiveTimeouts {
+	return &adaptiveTimeouts{
+		propose: newAdaptiveTimeout(config.TimeoutPropose,
+			config.TimeoutProposeMin, config.TimeoutProposeMax, config.AdaptiveTimeoutWindow),
+		prevote: newAdaptiveTimeout(config.TimeoutPrevote,
+			config.TimeoutVoteMin, config.TimeoutVoteMax, config.AdaptiveTimeoutWindow),
+		precommit: newAdaptiveTimeout(config.TimeoutPrecommit,
+			config.TimeoutVoteMin, config.TimeoutVoteMax, config.AdaptiveTimeoutWindow),
+	}
+}
+
+func (ats *adaptiveTimeouts) step(step cstypes.RoundStepType) (*adaptiveTimeout, string) {
+	switch step {
+	case cstypes.RoundStepPropose:
+		return ats.propose, "propose"
+	case cstypes.RoundStepPrevoteWait:
+		return ats.prevote, "prevote"
+	case cstypes.RoundStepPrecommitWait:
+		return ats.precommit, "precommit"
+	default:
+		panic("no adaptive timeout for step " + step.String())
+	}
+}
+
+// proposeTimeout returns how long to wait for a proposal in the round.
+func (cs *State) proposeTimeout(round int32) time.Duration {
+	if cs.adaptiveTimeouts == nil {
+		return cs.config.Propose(round)
+	}
+	return cs.adaptiveTimeouts.propose.timeout() + cs.config.TimeoutProposeDelta*time.Duration(round)
+}
+
+// prevoteTimeout returns how long to wait for straggler prevotes after
+// receiving any +2/3 prevotes in the round.
+func (cs *State) prevoteTimeout(round int32) time.Duration {
+	if cs.adaptiveTimeouts == nil {
+		return cs.config.Prevote(round)
+	}
+	return cs.adaptiveTimeouts.prevote.timeout() + cs.config.TimeoutPrevoteDelta*time.Duration(round)
+}
+
+// precommitTimeout returns how long to wait for straggler precommits after
+// receiving any +2/3 precommits in the round.
+func (cs *State) precommitTimeout(round int32) time.Duration {
+	if cs.adaptiveTimeouts == nil {
+		return cs.config.Precommit(round)
+	}
+	return cs.adaptiveTimeouts.precommit.timeout() + cs.config.TimeoutPrecommitDelta*time.Duration(round)
+}
+
+// beginStepMeasurement starts measuring the time taken to complete the
+// proposal, or to receive a +2/3 majority of the votes after any +2/3 of them,
+// of the step of the round. The messages replayed from the WAL aren't
+// measured.
+func (cs *State) beginStepMeasurement(step cstypes.RoundStepType, height int64, round int32) {
+	if cs.adaptiveTimeouts == nil || cs.replayMode {
+		return
+	}
+	at, _ := cs.adaptiveTimeouts.step(step)
+	at.begin(height, round, cs.now())
+}
+
+// endStepMeasurement ends the measurement of the step of the round, if in
+// progress, and updates its timeout.
+func (cs *State) endStepMeasurement(step cstypes.RoundStepType, height int64, round int32) {
+	if cs.adaptiveTimeouts == nil || cs.replayMode {
+		return
+	}
+	at, name := cs.adaptiveTimeouts.step(step)
+	if at.end(height, round, cs.now()) {
+		cs.metrics.AdaptiveTimeoutSeconds.With("step", name).Set(at.timeout().Seconds())
+	}
+}
+
+// expireStepMeasurement ends the measurement of the step of the round, if in
+// progress, at the timeout which expired, and updates its timeout.
+func (cs *State) expireStepMeasurement(step cstypes.RoundStepType, height int64, round int32,
+	timeout time.Duration) {
+	if cs.adaptiveTimeouts == nil || cs.replayMode {
+		return
+	}
+	at, name := cs.adaptiveTimeouts.step(step)
+	if at.expire(height, round, timeout) {
+		cs.metrics.AdaptiveTimeoutSeconds.With("step", name).Set(at.timeout().Seconds())
+	}
+}
+
+// recordAdaptiveTimeouts sets the metrics of the adaptive timeouts.
+func (cs *State) recordAdaptiveTimeouts() {
+	for _, step := range []cstypes.RoundStepType{
+		cstypes.RoundStepPropose, cstypes.RoundStepPrevoteWait, cstypes.RoundStepPrecommitWait,
+	} {
+		at, name := cs.adaptiveTimeouts.step(step)
+		cs.metrics.AdaptiveTimeoutSeconds.With("step", name).Set(at.timeout().Seconds())
+	}
+}
@@ -0,0 +1,65 @@
+package consensus
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAdaptiveTimeout(t *testing.T) {
+	at := newAdaptiveTimeout(3*time.Second, time.Second, 10*time.Second, 3)
+	now := time.Now()
+
+	// the initial timeout is used until a duration is measured
+	assert.Equal(t, 3*time.Second, at.timeout())
+	assert.False(t, at.end(1, 0, now))
+
+	at.begin(1, 0, now)
+	assert.False(t, at.end(1, 1, now.Add(time.Second)))
+	assert.False(t, at.end(2, 0, now.Add(time.Second)))
+	assert.True(t, at.end(1, 0, now.Add(4*time.Second)))
+	assert.Equal(t, 6*time.Second, at.timeout())
+	// the measurement ended
+	assert.False(t, at.end(1, 0, now.Add(8*time.Second)))
+	assert.Equal(t, 6*time.Second, at.timeout())
+
+	// bounded by max
+	at.begin(1, 1, now)
+	assert.True(t, at.end(1, 1, now.Add(8*time.Second)))
+	assert.Equal(t, 10*time.Second, at.timeout())
+
+	// the largest durations leave the window, bounded by min
+	for round := int32(2); round < 5; round++ {
+		at.begin(1, round, now)
+		assert.True(t, at.end(1, round, now.Add(100*time.Millisecond)))
+	}
+	assert.Equal(t, time.Second, at.timeout())
+
+	at.begin(2, 0, now)
+	// the round is only measured from its first begin
+	at.begin(2, 0, now.Add(time.Second))
+	assert.True(t, at.end(2, 0, now.Add(time.Second)))
+	assert.Equal(t, 1500*time.Millisecond, at.timeout())
+	at.begin(2, 0, now.Add(2*time.Second))
+	assert.False(t, at.end(2, 0, now.Add(3*time.Second)))
+
+	// the expired rounds are measured at their timeout, capped so that the
+	// timeout doesn't grow
+	at.begin(2, 1, now)
+	assert.False(t, at.expire(2, 0, 4*time.Second))
+	assert.True(t, at.expire(2, 1, 4*time.Second))
+	assert.False(t, at.end(2, 1, now.Add(time.Second)))
+	assert.Equal(t, 1500*time.Millisecond, at.timeout())
+	for round := int32(2); round < 10; round++ {
+		at.begin(2, round, now)
+		assert.True(t, at.expire(2, round, at.timeout()))
+	}
+	assert.Equal(t, 1500*time.Millisecond, at.timeout())
+
+	// shorter expired timeouts, e.g. before a duration is measured, are kept
+	at = newAdaptiveTimeout(3*time.Second, time.Second, 10*time.Second, 3)
+	at.begin(1, 0, now)
+	assert.True(t, at.expire(1, 0, 1200*time.Millisecond))
+	assert.Equal(t, 1800*time.Millisecond, at.timeout())
+}
@@ -593,6 +593,28 @@ func ensureNewProposal(proposalCh <-chan cmtpubsub.Message, height int64, round
 	}
 }
 
+// ensureNewProposalBlockHash is like ensureNewProposal, and returns the hash of
+// the proposal block.
+func ensureNewProposalBlockHash(proposalCh <-chan cmtpubsub.Message, height int64, round int32) []byte {
+	select {
+	case <-time.After(ensureTimeout):
+		panic("Timeout expired while waiting for NewProposal event")
+	case msg := <-proposalCh:
+		proposalEvent, ok := msg.Data().(types.EventDataCompleteProposal)
+		if !ok {
+			panic(fmt.Sprintf("expected a EventDataCompleteProposal, got %T. Wrong subscription channel?",
+				msg.Data()))
+		}
+		if proposalEvent.Height != height {
+			panic(fmt.Sprintf("expected height %v, got %v", height, proposalEvent.Height))
+		}
+		if proposalEvent.Round != round {
+			panic(fmt.Sprintf("expected round %v, got %v", round, proposalEvent.Round))
+		}
+		return proposalEvent.BlockID.Hash
+	}
+}
+
 func ensureNewValidBlock(validBlockCh <-chan cmtpubsub.Message, height int64, round int32) {
 	ensureNewEvent(validBlockCh, height, round, ensureTimeout,
 		"Timeout expired while waiting for NewValidBlock event")
@@ -0,0 +1,521 @@
+package consensus
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cometbft/cometbft/behaviour"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/libs/bits"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/p2p"
+	cmtcons "github.com/cometbft/cometbft/proto/tendermint/consensus"
+	"github.com/cometbft/cometbft/types"
+)
+
+const (
+	// maxCompactBlockTxsBytes is the maximum size of the txs of a
+	// CompactBlockTxsMessage, leaving room for the rest of the message.
+	maxCompactBlockTxsBytes = maxMsgSize - 1024
+
+	// compactBlockTimeout is the time given to a peer to send the missing
+	// txs of its compact block, after which they are requested from the next
+	// peer which sent the compact block, or the block parts from all the
+	// peers.
+	compactBlockTimeout = time.Second
+)
+
+// TxFetcher looks up the txs of a mempool by their keys, as the CAT mempool
+// does.
+type TxFetcher interface {
+	GetTxByKey(key types.TxKey) (types.Tx, bool)
+}
+
+// compactBlockStatus is the status of the exchange of the compact block of a
+// proposal block with a peer.
+type compactBlockStatus int
+
+const (
+	// no compact block was exchanged with the peer.
+	compactBlockNone compactBlockStatus = iota
+	// a compact block was sent to the peer or received from it, so it isn't
+	// sent the block parts.
+	compactBlockExchanged
+	// the peer requested the block parts instead.
+	compactBlockFailed
+)
+
+// ownCompactBlock is the compact block of the proposal block of the node,
+// built once for all the peers.
+type ownCompactBlock struct {
+	height int64
+	round  int32
+	block  *types.Block
+	// nil if the compact block exceeds the maximum message size.
+	msg *cmtcons.CompactBlock
+}
+
+// pendingCompactBlock is a compact block received whose block is
+// reconstructed from the mempool, and from the txs requested to the peer.
+type pendingCompactBlock struct {
+	peer    p2p.Peer
+	msg     *CompactBlockMessage
+	keys    []types.TxKey
+	txs     types.Txs
+	missing map[uint32]struct{}
+	// the txs received from the peers, kept when moving to an alternate.
+	received map[types.TxKey]types.Tx
+	// the compact blocks of the same proposal received from the other peers,
+	// reconstructed in turn if the peer fails to send the missing txs.
+	alternates []alternateCompactBlock
+	timer      *time.Timer
+	// the block was reconstructed, or its parts requested.
+	done bool
+}
+
+// alternateCompactBlock is a compact block received from another peer while
+// a compact block of the same proposal was being reconstructed.
+type alternateCompactBlock struct {
+	peer p2p.Peer
+	ps   *PeerState
+	msg  *CompactBlockMessage
+}
+
+func supportsCompactBlocks(peer p2p.Peer) bool {
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && ni.HasChannel(CompactBlockChannel)
+}
+
+// gossipCompactBlock sends the compact block of the proposal block to the
+// peer, instead of the block parts, once the node has the whole block. It
+// returns whether the compact block was sent.
+func (conR *Reactor) gossipCompactBlock(
+	logger log.Logger,
+	rs *cstypes.RoundState,
+	prs *cstypes.PeerRoundState,
+	ps *PeerState,
+	peer p2p.Peer,
+) bool {
+	if rs.Height != prs.Height || rs.Round != prs.Round ||
+		prs.ProposalBlockParts == nil || !prs.ProposalBlockParts.IsEmpty() ||
+		!rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) ||
+		rs.Proposal == nil || !rs.Proposal.BlockID.PartSetHeader.Equals(prs.ProposalBlockPartSetHeader) ||
+		!supportsCompactBlocks(peer) ||
+		ps.getCompactBlockStatus(rs.Height, rs.Round) != compactBlockNone ||
+		rs.ProposalBlock == nil {
+		return false
+	}
+
+	msg := conR.ownCompactBlock(rs)
+	if msg == nil {
+		return false
+	}
+	logger.Debug("Sending compact block", "height", rs.Height, "round", rs.Round)
+	if !p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
+		ChannelID: CompactBlockChannel,
+		Message:   msg,
+	}, logger) {
+		return false
+	}
+	ps.SetHasProposal(rs.Proposal)
+	ps.setCompactBlockStatus(rs.Height, rs.Round, compactBlockExchanged)
+	ps.setHasProposalBlockParts(rs.Height, rs.Round, true)
+	return true
+}
+
+// ownCompactBlock returns the compact block of the proposal block of the
+// round state, or nil if it exceeds the maximum message size. The txs the
+// mempool doesn't have, with the same bytes, are included in full.
+func (conR *Reactor) ownCompactBlock(rs *cstypes.RoundState) *cmtcons.CompactBlock {
+	conR.compactMtx.Lock()
+	defer conR.compactMtx.Unlock()
+
+	own := conR.ownCompact
+	if own != nil && own.height == rs.Height && own.round == rs.Round && own.block == rs.ProposalBlock {
+		return own.msg
+	}
+	own = &ownCompactBlock{height: rs.Height, round: rs.Round, block: rs.ProposalBlock}
+	conR.ownCompact = own
+
+	block, err := rs.ProposalBlock.ToProto()
+	if err != nil {
+		conR.Logger.Error("Failed to convert the proposal block to proto", "err", err)
+		return nil
+	}
+	block.Data.Txs = nil
+	msg := &cmtcons.CompactBlock{
+		Proposal: *rs.Proposal.ToProto(),
+		Block:    block,
+		TxKeys:   make([][]byte, len(rs.ProposalBlock.Txs)),
+	}
+	for i, tx := range rs.ProposalBlock.Txs {
+		key := tx.Key()
+		if memTx, ok := conR.compactBlocks.GetTxByKey(key); ok && bytes.Equal(memTx, tx) {
+			msg.TxKeys[i] = key[:]
+		} else {
+			msg.Txs = append(msg.Txs, tx)
+		}
+	}
+	if proto.Size(msg.Wrap()) > maxMsgSize {
+		return nil
+	}
+	own.msg = msg
+	return msg
+}
+
+func (conR *Reactor) receiveCompactBlockMessage(peer p2p.Peer, ps *PeerState, msg Message) {
+	switch msg := msg.(type) {
+	case *CompactBlockMessage:
+		conR.handleCompactBlock(peer, ps, msg)
+	case *WantCompactBlockTxsMessage:
+		conR.handleWantCompactBlockTxs(peer, ps, msg)
+	case *CompactBlockTxsMessage:
+		conR.handleCompactBlockTxs(peer, msg)
+	case *WantBlockPartsMessage:
+		// resume the gossip of the block parts to the peer.
+		ps.setCompactBlockStatus(msg.Height, msg.Round, compactBlockFailed)
+		ps.setHasProposalBlockParts(msg.Height, msg.Round, false)
+	default:
+		conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+	}
+}
+
+// handleCompactBlock reconstructs the block of a compact block of the current
+// height and round from the mempool, once its proposal is verified, and
+// requests the missing txs to the peer. The compact blocks of the same
+// proposal received from other peers meanwhile are kept as alternates.
+func (conR *Reactor) handleCompactBlock(peer p2p.Peer, ps *PeerState, msg *CompactBlockMessage) {
+	height, round := msg.Proposal.Height, msg.Proposal.Round
+	rs := conR.getRoundState()
+	if rs.Height != height || rs.Round != round ||
+		(rs.Proposal != nil && !rs.Proposal.BlockID.Equals(msg.Proposal.BlockID)) {
+		// the peer must send the block parts instead if it is ahead of us, or
+		// its proposal isn't ours.
+		conR.Logger.Debug("Ignoring compact block", "height", height, "round", round, "peer", peer.ID())
+		conR.wantBlockParts(peer, height, round)
+		return
+	}
+	proposer := rs.Validators.GetProposer()
+	if !proposer.PubKey.VerifySignature(
+		types.ProposalSignBytes(conR.conS.GetState().ChainID, msg.Proposal.ToProto()), msg.Proposal.Signature,
+	) {
+		_ = conR.reporter.Report(behaviour.BadMessage(peer.ID(), ErrInvalidProposalSignature.Error()))
+		return
+	}
+	ps.SetHasProposal(msg.Proposal)
+	ps.setCompactBlockStatus(height, round, compactBlockExchanged)
+	if rs.ProposalBlock != nil && rs.ProposalBlock.HashesTo(msg.Proposal.BlockID.Hash) {
+		ps.setHasProposalBlockParts(height, round, true)
+		return
+	}
+
+	conR.compactMtx.Lock()
+	if p := conR.pendingCompact; p != nil && p.msg.Proposal.Height == height && p.msg.Proposal.Round == round {
+		// the block of the round is already being reconstructed.
+		switch {
+		case p.done:
+			conR.compactMtx.Unlock()
+			ps.setHasProposalBlockParts(height, round, true)
+		case p.msg.Proposal.BlockID.Equals(msg.Proposal.BlockID):
+			p.alternates = append(p.alternates, alternateCompactBlock{peer: peer, ps: ps, msg: msg})
+			conR.compactMtx.Unlock()
+		default:
+			conR.compactMtx.Unlock()
+			conR.wantBlockParts(peer, height, round)
+		}
+		return
+	}
+	if p := conR.pendingCompact; p != nil && !p.done {
+		// give up on the compact block of a previous round.
+		p.done = true
+		p.timer.Stop()
+	}
+	p := &pendingCompactBlock{received: make(map[types.TxKey]types.Tx)}
+	conR.pendingCompact = p
+	conR.startCompactBlock(p, peer, msg)
+}
+
+// startCompactBlock reconstructs the block of the compact block of the peer
+// from the mempool and the txs already received, and requests the missing
+// txs to the peer. It is called with compactMtx locked, which it unlocks.
+func (conR *Reactor) startCompactBlock(p *pendingCompactBlock, peer p2p.Peer, msg *CompactBlockMessage) {
+	p.peer, p.msg = peer, msg
+	p.keys = make([]types.TxKey, len(msg.TxKeys))
+	p.txs = make(types.Txs, len(msg.TxKeys))
+	p.missing = make(map[uint32]struct{})
+	var indexes []uint32
+	full := msg.Txs
+	for i, key := range msg.TxKeys {
+		if key == (types.TxKey{}) {
+			p.txs[i], full = full[0], full[1:]
+			p.keys[i] = p.txs[i].Key()
+			continue
+		}
+		p.keys[i] = key
+		if tx, ok := p.received[key]; ok {
+			p.txs[i] = tx
+		} else if tx, ok := conR.compactBlocks.GetTxByKey(key); ok {
+			p.txs[i] = tx
+		} else {
+			p.missing[uint32(i)] = struct{}{}
+			indexes = append(indexes, uint32(i))
+		}
+	}
+	if len(indexes) == 0 {
+		p.done = true
+		conR.compactMtx.Unlock()
+		conR.completeCompactBlock(p)
+		return
+	}
+	if p.timer != nil {
+		p.timer.Stop()
+	}
+	p.timer = time.AfterFunc(compactBlockTimeout, func() {
+		conR.retryCompactBlock(p, peer, "timed out waiting for the missing txs")
+	})
+	conR.compactMtx.Unlock()
+
+	height, round := msg.Proposal.Height, msg.Proposal.Round
+	conR.Metrics.CompactBlockMissingTxs.Add(float64(len(indexes)))
+	if !p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
+		ChannelID: CompactBlockChannel,
+		Message:   &cmtcons.WantCompactBlockTxs{Height: height, Round: round, Indexes: indexes},
+	}, conR.Logger) {
+		conR.retryCompactBlock(p, peer, "failed to request the missing txs")
+	}
+}
+
+// retryCompactBlock moves on to the next alternate of a compact block which
+// can't be reconstructed with the txs of the peer, or requests the block
+// parts to all the peers if there is none left.
+func (conR *Reactor) retryCompactBlock(p *pendingCompactBlock, peer p2p.Peer, reason string) {
+	conR.compactMtx.Lock()
+	if p.done || p.peer.ID() != peer.ID() {
+		conR.compactMtx.Unlock()
+		return
+	}
+	height, round := p.msg.Proposal.Height, p.msg.Proposal.Round
+	conR.Logger.Info("Failed to reconstruct compact block",
+		"height", height, "round", round, "peer", peer.ID(), "reason", reason)
+	if len(p.alternates) == 0 {
+		p.done = true
+		conR.compactMtx.Unlock()
+		conR.failCompactBlock(p)
+		return
+	}
+	alt := p.alternates[0]
+	p.alternates = p.alternates[1:]
+	conR.startCompactBlock(p, alt.peer, alt.msg)
+}
+
+// wantBlockParts requests the block parts of a proposal to the peer which sent
+// its compact block.
+func (conR *Reactor) wantBlockParts(peer p2p.Peer, height int64, round int32) {
+	p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
+		ChannelID: CompactBlockChannel,
+		Message:   &cmtcons.WantBlockParts{Height: height, Round: round},
+	}, conR.Logger)
+}
+
+// handleWantCompactBlockTxs sends the requested txs of our compact block to
+// the peer, or resumes the gossip of the block parts if we can't.
+func (conR *Reactor) handleWantCompactBlockTxs(peer p2p.Peer, ps *PeerState, msg *WantCompactBlockTxsMessage) {
+	conR.compactMtx.Lock()
+	own := conR.ownCompact
+	conR.compactMtx.Unlock()
+
+	fallback := func() {
+		ps.setCompactBlockStatus(msg.Height, msg.Round, compactBlockFailed)
+		ps.setHasProposalBlockParts(msg.Height, msg.Round, false)
+	}
+	if own == nil || own.height != msg.Height || own.round != msg.Round || own.msg == nil {
+		fallback()
+		return
+	}
+	var (
+		responses []*cmtcons.CompactBlockTxs
+		size      int
+	)
+	for _, i := range msg.Indexes {
+		if int(i) >= len(own.block.Txs) || len(own.block.Txs[i]) > maxCompactBlockTxsBytes {
+			fallback()
+			return
+		}
+		tx := own.block.Txs[i]
+		if len(responses) == 0 || size+len(tx) > maxCompactBlockTxsBytes {
+			responses = append(responses, &cmtcons.CompactBlockTxs{Height: msg.Height, Round: msg.Round})
+			size = 0
+		}
+		resp := responses[len(responses)-1]
+		resp.Indexes = append(resp.Indexes, i)
+		resp.Txs = append(resp.Txs, tx)
+		size += len(tx)
+	}
+	for _, resp := range responses {
+		if !p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
+			ChannelID: CompactBlockChannel,
+			Message:   resp,
+		}, conR.Logger) {
+			fallback()
+			return
+		}
+	}
+}
+
+// handleCompactBlockTxs adds the txs received to the pending compact block,
+// and completes it once it has all of its txs.
+func (conR *Reactor) handleCompactBlockTxs(peer p2p.Peer, msg *CompactBlockTxsMessage) {
+	conR.compactMtx.Lock()
+	p := conR.pendingCompact
+	if p == nil || p.done || p.peer.ID() != peer.ID() ||
+		p.msg.Proposal.Height != msg.Height || p.msg.Proposal.Round != msg.Round {
+		conR.compactMtx.Unlock()
+		return
+	}
+	for j, i := range msg.Indexes {
+		if _, ok := p.missing[i]; !ok {
+			continue
+		}
+		if msg.Txs[j].Key() != p.keys[i] {
+			conR.compactMtx.Unlock()
+			conR.retryCompactBlock(p, peer, "peer sent a tx not matching its key")
+			return
+		}
+		p.txs[i] = msg.Txs[j]
+		p.received[p.keys[i]] = msg.Txs[j]
+		delete(p.missing, i)
+	}
+	if len(p.missing) > 0 {
+		conR.compactMtx.Unlock()
+		return
+	}
+	p.done = true
+	p.timer.Stop()
+	conR.compactMtx.Unlock()
+	conR.completeCompactBlock(p)
+}
+
+// completeCompactBlock rebuilds the part set of the block of a compact block
+// with all of its txs, and hands the proposal and its parts to the consensus
+// state if they match. Otherwise, it moves on to the next alternate.
+func (conR *Reactor) completeCompactBlock(p *pendingCompactBlock) {
+	parts, err := p.partSet()
+	if err != nil {
+		conR.compactMtx.Lock()
+		p.done = false
+		conR.compactMtx.Unlock()
+		conR.retryCompactBlock(p, p.peer, err.Error())
+		return
+	}
+
+	conR.Metrics.CompactBlocksReceived.With("status", "reconstructed").Add(1)
+	height, round := p.msg.Proposal.Height, p.msg.Proposal.Round
+	conR.conS.peerMsgQueue <- msgInfo{&ProposalMessage{Proposal: p.msg.Proposal}, p.peer.ID()}
+	// the parts are rebuilt locally, so they don't count as sent by the peer.
+	for i := 0; i < int(parts.Total()); i++ {
+		conR.conS.peerMsgQueue <- msgInfo{&BlockPartMessage{Height: height, Round: round, Part: parts.GetPart(i)}, ""}
+	}
+
+	// the peers which sent the compact block have the block.
+	conR.compactMtx.Lock()
+	alternates := p.alternates
+	p.alternates = nil
+	conR.compactMtx.Unlock()
+	if ps, ok := p.peer.Get(types.PeerStateKey).(*PeerState); ok {
+		ps.setHasProposalBlockParts(height, round, true)
+	}
+	for _, alt := range alternates {
+		alt.ps.setHasProposalBlockParts(height, round, true)
+	}
+}
+
+// partSet rebuilds the part set of the block of the compact block, which must
+// match its proposal.
+func (p *pendingCompactBlock) partSet() (*types.PartSet, error) {
+	block, err := p.msg.Block.ToProto()
+	if err != nil {
+		return nil, err
+	}
+	block.Data.Txs = p.txs.ToSliceOfBytes()
+	bz, err := proto.Marshal(block)
+	if err != nil {
+		return nil, err
+	}
+	parts := types.NewPartSetFromData(bz, types.BlockPartSizeBytes)
+	if !parts.Header().Equals(p.msg.Proposal.BlockID.PartSetHeader) {
+		return nil, errors.New("reconstructed block doesn't match the proposal")
+	}
+	return parts, nil
+}
+
+// failCompactBlock requests the block parts of a compact block that can't be
+// reconstructed to all the peers.
+func (conR *Reactor) failCompactBlock(p *pendingCompactBlock) {
+	height, round := p.msg.Proposal.Height, p.msg.Proposal.Round
+	conR.Logger.Info("Requesting the block parts of compact block", "height", height, "round", round)
+	conR.Metrics.CompactBlocksReceived.With("status", "failed").Add(1)
+	conR.Switch.BroadcastEnvelope(p2p.Envelope{
+		ChannelID: CompactBlockChannel,
+		Message:   &cmtcons.WantBlockParts{Height: height, Round: round},
+	})
+}
+
+// removeCompactBlockPeer gives up on the compact block being reconstructed
+// with the txs of the peer, if any, and on its alternate.
+func (conR *Reactor) removeCompactBlockPeer(peer p2p.Peer) {
+	conR.compactMtx.Lock()
+	p := conR.pendingCompact
+	if p == nil || p.done {
+		conR.compactMtx.Unlock()
+		return
+	}
+	alternates := p.alternates[:0]
+	for _, alt := range p.alternates {
+		if alt.peer.ID() != peer.ID() {
+			alternates = append(alternates, alt)
+		}
+	}
+	p.alternates = alternates
+	conR.compactMtx.Unlock()
+	conR.retryCompactBlock(p, peer, "peer removed")
+}
+
+func (ps *PeerState) getCompactBlockStatus(height int64, round int32) compactBlockStatus {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.compactHeight != height || ps.compactRound != round {
+		return compactBlockNone
+	}
+	return ps.compactStatus
+}
+
+func (ps *PeerState) setCompactBlockStatus(height int64, round int32, status compactBlockStatus) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	ps.compactHeight, ps.compactRound, ps.compactStatus = height, round, status
+}
+
+// setHasProposalBlockParts sets all the proposal block parts as known, or
+// unknown, for the peer.
+func (ps *PeerState) setHasProposalBlockParts(height int64, round int32, has bool) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != height || ps.PRS.Round != round || ps.PRS.ProposalBlockParts == nil {
+		return
+	}
+	parts := bits.NewBitArray(ps.PRS.ProposalBlockParts.Size())
+	if has {
+		parts = parts.Not()
+	} else if ps.PRS.ProposalBlockParityParts != nil {
+		ps.PRS.ProposalBlockParityParts = bits.NewBitArray(ps.PRS.ProposalBlockParityParts.Size())
+	}
+	ps.PRS.ProposalBlockParts = parts
+}
@@ -0,0 +1,47 @@
+// Package driven gives the simulations of consensus/simulation access to the
+// driven mode of consensus.State, without adding it to the API of the
+// consensus package. A driven State is driven by its caller instead of its
+// routines: the caller delivers the messages of the peers and the timeouts
+// fired one at a time, and sends the messages of the node they resulted in to
+// the peers. A node must run the State with Start instead.
+//
+// The consensus package sets the hooks below when it is initialized. As they
+// can't name its types, each is documented with the type of the function it
+// holds, which the simulations assert it to.
+package driven
+
+import (
+	"time"
+
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+)
+
+// Timeout is a timeout of a round step, scheduled by a driven State.
+type Timeout struct {
+	Duration time.Duration
+	Height   int64
+	Round    int32
+	Step     cstypes.RoundStepType
+}
+
+var (
+	// Start is a func(*consensus.State). It prepares the State to be driven:
+	// it only schedules the first round on the TimeoutTicker, which isn't
+	// started. The WAL isn't replayed nor written. Start must not be called on
+	// a driven State.
+	Start interface{}
+
+	// HandlePeerMessage is a
+	// func(*consensus.State, consensus.Message, p2p.ID) []consensus.Message.
+	// It handles a proposal, block part or vote of a peer, and returns the
+	// proposals, block parts and votes of the node it resulted in, in order.
+	HandlePeerMessage interface{}
+
+	// NewTimeoutTicker is a func(*consensus.State,
+	// func(Timeout, func() []consensus.Message)) consensus.TimeoutTicker.
+	// It returns the TimeoutTicker of a driven State, which passes each
+	// timeout scheduled to the function, with the function handling it once
+	// fired. The latter returns the messages of the node, as
+	// HandlePeerMessage.
+	NewTimeoutTicker interface{}
+)
@@ -96,6 +96,18 @@ type Metrics struct {
 
 	// The amount of proposals that failed to be received in time
 	TimedOutProposals metrics.Counter
+
+	// Number of compact blocks received, labeled by whether the block was
+	// reconstructed from them or its parts were requested instead.
+	CompactBlocksReceived metrics.Counter
+
+	// Number of txs of the compact blocks received that were missing from the
+	// mempool, and fetched from the peers.
+	CompactBlockMissingTxs metrics.Counter
+
+	// The timeouts of the propose, prevote and precommit steps derived by the
+	// adaptive timeouts, labeled by step.
+	AdaptiveTimeoutSeconds metrics.Gauge
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -267,6 +279,25 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "timed_out_proposals",
 			Help:      "Number of proposals that failed to be received in time",
 		}, labels).With(labelsAndValues...),
+		CompactBlocksReceived: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_blocks_received",
+			Help: "Number of compact blocks received, labeled by whether the block was " +
+				"reconstructed or its parts were requested instead.",
+		}, append(labels, "status")).With(labelsAndValues...),
+		CompactBlockMissingTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "compact_block_missing_txs",
+			Help:      "Number of txs of the compact blocks received missing from the mempool.",
+		}, labels).With(labelsAndValues...),
+		AdaptiveTimeoutSeconds: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "adaptive_timeout_seconds",
+			Help:      "Timeout of the step derived by the adaptive timeouts, in seconds.",
+		}, append(labels, "step")).With(labelsAndValues...),
 	}
 }
 
@@ -304,6 +335,9 @@ func NopMetrics() *Metrics {
 		FullPrevoteMessageDelay:      discard.NewGauge(),
 		ApplicationRejectedProposals: discard.NewCounter(),
 		TimedOutProposals:            discard.NewCounter(),
+		CompactBlocksReceived:        discard.NewCounter(),
+		CompactBlockMissingTxs:       discard.NewCounter(),
+		AdaptiveTimeoutSeconds:       discard.NewGauge(),
 	}
 }
 
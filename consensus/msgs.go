@@ -115,6 +115,48 @@ func MsgToProto(msg Message) (*cmtcons.Message, error) {
 
 		return m.Wrap().(*cmtcons.Message), nil
 
+	case *CompactBlockMessage:
+		block, err := msg.Block.ToProto()
+		if err != nil {
+			return nil, fmt.Errorf("msg to proto error: %w", err)
+		}
+		m := &cmtcons.CompactBlock{
+			Proposal: *msg.Proposal.ToProto(),
+			Block:    block,
+			TxKeys:   make([][]byte, len(msg.TxKeys)),
+		}
+		for i, key := range msg.TxKeys {
+			if key != (types.TxKey{}) {
+				m.TxKeys[i] = key[:]
+			}
+		}
+		m.Txs = msg.Txs.ToSliceOfBytes()
+		return m.Wrap().(*cmtcons.Message), nil
+
+	case *WantCompactBlockTxsMessage:
+		m := &cmtcons.WantCompactBlockTxs{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}
+		return m.Wrap().(*cmtcons.Message), nil
+
+	case *CompactBlockTxsMessage:
+		m := &cmtcons.CompactBlockTxs{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     msg.Txs.ToSliceOfBytes(),
+		}
+		return m.Wrap().(*cmtcons.Message), nil
+
+	case *WantBlockPartsMessage:
+		m := &cmtcons.WantBlockParts{
+			Height: msg.Height,
+			Round:  msg.Round,
+		}
+		return m.Wrap().(*cmtcons.Message), nil
+
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
@@ -230,6 +272,48 @@ func MsgFromProto(p *cmtcons.Message) (Message, error) {
 			BlockID: *bi,
 			Votes:   bits,
 		}
+	case *cmtcons.CompactBlock:
+		proposal, err := types.ProposalFromProto(&msg.Proposal)
+		if err != nil {
+			return nil, fmt.Errorf("compactBlock msg to proto error: %w", err)
+		}
+		block, err := types.BlockFromProto(msg.Block)
+		if err != nil {
+			return nil, fmt.Errorf("compactBlock msg to proto error: %w", err)
+		}
+		keys := make([]types.TxKey, len(msg.TxKeys))
+		for i, key := range msg.TxKeys {
+			if len(key) == 0 {
+				continue
+			}
+			if keys[i], err = types.TxKeyFromBytes(key); err != nil {
+				return nil, fmt.Errorf("compactBlock msg to proto error: %w", err)
+			}
+		}
+		pb = &CompactBlockMessage{
+			Proposal: proposal,
+			Block:    block,
+			TxKeys:   keys,
+			Txs:      types.ToTxs(msg.Txs),
+		}
+	case *cmtcons.WantCompactBlockTxs:
+		pb = &WantCompactBlockTxsMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+		}
+	case *cmtcons.CompactBlockTxs:
+		pb = &CompactBlockTxsMessage{
+			Height:  msg.Height,
+			Round:   msg.Round,
+			Indexes: msg.Indexes,
+			Txs:     types.ToTxs(msg.Txs),
+		}
+	case *cmtcons.WantBlockParts:
+		pb = &WantBlockPartsMessage{
+			Height: msg.Height,
+			Round:  msg.Round,
+		}
 	default:
 		return nil, fmt.Errorf("consensus: message not recognized: %T", msg)
 	}
@@ -167,6 +167,39 @@ func TestMsgToProto(t *testing.T) {
 			Votes:   *pbBits,
 		}).Wrap().(*cmtcons.Message),
 
+			false},
+		{"successful WantCompactBlockTxs", &WantCompactBlockTxsMessage{
+			Height:  1,
+			Round:   1,
+			Indexes: []uint32{0, 2},
+		}, (&cmtcons.WantCompactBlockTxs{
+			Height:  1,
+			Round:   1,
+			Indexes: []uint32{0, 2},
+		}).Wrap().(*cmtcons.Message),
+
+			false},
+		{"successful CompactBlockTxs", &CompactBlockTxsMessage{
+			Height:  1,
+			Round:   1,
+			Indexes: []uint32{0, 2},
+			Txs:     types.Txs{types.Tx("tx0"), types.Tx("tx2")},
+		}, (&cmtcons.CompactBlockTxs{
+			Height:  1,
+			Round:   1,
+			Indexes: []uint32{0, 2},
+			Txs:     [][]byte{[]byte("tx0"), []byte("tx2")},
+		}).Wrap().(*cmtcons.Message),
+
+			false},
+		{"successful WantBlockParts", &WantBlockPartsMessage{
+			Height: 1,
+			Round:  1,
+		}, (&cmtcons.WantBlockParts{
+			Height: 1,
+			Round:  1,
+		}).Wrap().(*cmtcons.Message),
+
 			false},
 		{"failure", nil, &cmtcons.Message{}, true},
 	}
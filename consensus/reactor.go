@@ -7,11 +7,13 @@ import (
 	"sync"
 	"time"
 
+	"github.com/cometbft/cometbft/behaviour"
 	cstypes "github.com/cometbft/cometbft/consensus/types"
 	"github.com/cometbft/cometbft/libs/bits"
 	cmtevents "github.com/cometbft/cometbft/libs/events"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/pkg/trace"
@@ -29,6 +31,11 @@ const (
 	DataChannel        = byte(0x21)
 	VoteChannel        = byte(0x22)
 	VoteSetBitsChannel = byte(0x23)
+	// CompactBlockChannel is only used by the nodes with compact blocks enabled.
+	CompactBlockChannel = byte(0x24)
+	// ParityPartChannel carries the parity parts of the proposal blocks. It is
+	// only used by the nodes with erasure coded block parts enabled.
+	ParityPartChannel = byte(0x25)
 
 	maxMsgSize = 1048576 // 1MB; NOTE/TODO: keep in sync with types.PartSet sizes.
 
@@ -51,6 +58,16 @@ type Reactor struct {
 
 	Metrics     *Metrics
 	traceClient trace.Tracer
+	reporter    behaviour.Reporter
+
+	// compactBlocks is the mempool the compact blocks are reconstructed from,
+	// nil if compact blocks are disabled.
+	compactBlocks TxFetcher
+	compactMtx    cmtsync.Mutex
+	// the compact block of our proposal block, and the latest compact block
+	// received, if any.
+	ownCompact     *ownCompactBlock
+	pendingCompact *pendingCompactBlock
 }
 
 type ReactorOption func(*Reactor)
@@ -78,6 +95,7 @@ func NewReactor(consensusState *State, waitSync bool, options ...ReactorOption)
 // broadcasted to other peers and starting state if we're not in fast sync.
 func (conR *Reactor) OnStart() error {
 	conR.Logger.Info("Reactor ", "waitSync", conR.WaitSync())
+	conR.reporter = behaviour.NewSwitchReporter(conR.Switch)
 
 	// start routine that computes peer statistics for evaluating peer quality
 	go conR.peerStatsRoutine()
@@ -107,6 +125,14 @@ func (conR *Reactor) OnStop() {
 	}
 }
 
+// ShouldHalt returns true if the node halts after committing the block of the
+// given height and time, so that fast sync stops there too.
+func (conR *Reactor) ShouldHalt(height int64, blockTime time.Time) bool {
+	conR.conS.mtx.RLock()
+	defer conR.conS.mtx.RUnlock()
+	return conR.conS.shouldHalt(height, blockTime)
+}
+
 // SwitchToConsensus switches from fast_sync mode to consensus mode.
 // It resets the state, turns off fast_sync, and starts the consensus state-machine
 func (conR *Reactor) SwitchToConsensus(state sm.State, skipWAL bool) {
@@ -150,7 +176,7 @@ conR:
 // GetChannels implements Reactor
 func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 	// TODO optimize
-	return []*p2p.ChannelDescriptor{
+	channels := []*p2p.ChannelDescriptor{
 		{
 			ID:                  StateChannel,
 			Priority:            6,
@@ -184,6 +210,27 @@ func (conR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 			MessageType:         &cmtcons.Message{},
 		},
 	}
+	if conR.parityParts() {
+		channels = append(channels, &p2p.ChannelDescriptor{
+			ID:                  ParityPartChannel,
+			Priority:            10,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		})
+	}
+	if conR.compactBlocks != nil {
+		channels = append(channels, &p2p.ChannelDescriptor{
+			ID:                  CompactBlockChannel,
+			Priority:            10,
+			SendQueueCapacity:   100,
+			RecvBufferCapacity:  50 * 4096,
+			RecvMessageCapacity: maxMsgSize,
+			MessageType:         &cmtcons.Message{},
+		})
+	}
+	return channels
 }
 
 // InitPeer implements Reactor by creating a state for the peer.
@@ -216,11 +263,15 @@ func (conR *Reactor) AddPeer(peer p2p.Peer) {
 	}
 }
 
-// RemovePeer is a noop.
+// RemovePeer implements Reactor by giving up on the compact block received
+// from the peer, if any.
 func (conR *Reactor) RemovePeer(peer p2p.Peer, reason interface{}) {
 	if !conR.IsRunning() {
 		return
 	}
+	if conR.compactBlocks != nil {
+		conR.removeCompactBlockPeer(peer)
+	}
 	// TODO
 	// ps, ok := peer.Get(PeerStateKey).(*PeerState)
 	// if !ok {
@@ -247,13 +298,13 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	msg, err := MsgFromProto(m.(*cmtcons.Message))
 	if err != nil {
 		conR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
-		conR.Switch.StopPeerForError(e.Src, err)
+		_ = conR.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 		return
 	}
 
 	if err = msg.ValidateBasic(); err != nil {
 		conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", e.Message, "err", err)
-		conR.Switch.StopPeerForError(e.Src, err)
+		_ = conR.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 		return
 	}
 
@@ -283,7 +334,7 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			)
 			if err = msg.ValidateHeight(initialHeight); err != nil {
 				conR.Logger.Error("Peer sent us invalid msg", "peer", e.Src, "msg", msg, "err", err)
-				conR.Switch.StopPeerForError(e.Src, err)
+				_ = conR.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 				return
 			}
 			ps.ApplyNewRoundStepMessage(msg)
@@ -327,7 +378,7 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			// Peer claims to have a maj23 for some BlockID at H,R,S,
 			err := votes.SetPeerMaj23(msg.Round, msg.Type, ps.peer.ID(), msg.BlockID)
 			if err != nil {
-				conR.Switch.StopPeerForError(e.Src, err)
+				_ = conR.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 				return
 			}
 			// Respond with a VoteSetBitsMessage showing which votes we have.
@@ -460,6 +511,28 @@ func (conR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
 		}
 
+	case ParityPartChannel:
+		if conR.WaitSync() {
+			conR.Logger.Info("Ignoring message received during sync", "msg", msg)
+			return
+		}
+		switch msg := msg.(type) {
+		case *BlockPartMessage:
+			ps.SetHasProposalBlockPart(msg.Height, msg.Round, int(msg.Part.Index))
+			conR.Metrics.BlockParts.With("peer_id", string(e.Src.ID())).Add(1)
+			schema.WriteBlockPart(conR.traceClient, msg.Height, msg.Round, msg.Part.Index, false, string(e.Src.ID()), schema.Download)
+			conR.conS.peerMsgQueue <- msgInfo{msg, e.Src.ID()}
+		default:
+			conR.Logger.Error(fmt.Sprintf("Unknown message type %v", reflect.TypeOf(msg)))
+		}
+
+	case CompactBlockChannel:
+		if conR.WaitSync() {
+			conR.Logger.Info("Ignoring message received during sync", "msg", msg)
+			return
+		}
+		conR.receiveCompactBlockMessage(e.Src, ps, msg)
+
 	default:
 		conR.Logger.Error(fmt.Sprintf("Unknown chId %X", e.ChannelID))
 	}
@@ -677,17 +750,33 @@ OUTER_LOOP:
 		rs := conR.getRoundState()
 		prs := ps.GetRoundState()
 
+		// Send the compact block instead of the parts?
+		if conR.compactBlocks != nil && conR.gossipCompactBlock(logger, rs, prs, ps, peer) {
+			continue OUTER_LOOP
+		}
+
 		// Send proposal Block parts?
 		if rs.ProposalBlockParts.HasHeader(prs.ProposalBlockPartSetHeader) {
-			if index, ok := rs.ProposalBlockParts.BitArray().Sub(prs.ProposalBlockParts.Copy()).PickRandom(); ok {
+			if !prs.ProposalBlockParityParts.IsEmpty() && rs.ProposalBlockParts.ParityDiscarded() {
+				// the parity parts don't match the parts, so the peer drops
+				// them as well.
+				ps.clearProposalBlockParityParts(prs.Height, prs.Round)
+				prs.ProposalBlockParityParts = bits.NewBitArray(prs.ProposalBlockParityParts.Size())
+			}
+			if index, ok := pickBlockPartToSend(rs.ProposalBlockParts, prs,
+				conR.parityParts() && supportsParityParts(peer)); ok {
 				part := rs.ProposalBlockParts.GetPart(index)
 				parts, err := part.ToProto()
 				if err != nil {
 					panic(err)
 				}
+				chID := DataChannel
+				if index >= int(rs.ProposalBlockParts.Total()) {
+					chID = ParityPartChannel
+				}
 				logger.Debug("Sending block part", "height", prs.Height, "round", prs.Round)
 				if p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
-					ChannelID: DataChannel,
+					ChannelID: chID,
 					Message: &cmtcons.BlockPart{
 						Height: rs.Height, // This tells peer that this part applies to us.
 						Round:  rs.Round,  // This tells peer that this part applies to us.
@@ -790,6 +879,46 @@ OUTER_LOOP:
 	}
 }
 
+// pickBlockPartToSend picks a random part of the proposal block the peer
+// lacks. If the parts are extended with parity parts and the peer can receive
+// them, it picks among both until the peer has enough of them to recover the
+// block, and among the parts only then, in case the parity parts don't match
+// the parts.
+func pickBlockPartToSend(parts *types.PartSet, prs *cstypes.PeerRoundState, parity bool) (int, bool) {
+	missing := parts.BitArray().Sub(prs.ProposalBlockParts.Copy())
+	if !parity || prs.ProposalBlockParityParts == nil {
+		return missing.PickRandom()
+	}
+	total := int(parts.Total())
+	var missingParity *bits.BitArray
+	if prs.ProposalBlockParts.Count()+prs.ProposalBlockParityParts.Count() < total {
+		missingParity = parts.ParityBitArray().Sub(prs.ProposalBlockParityParts.Copy())
+	}
+	n, m := missing.Count(), missingParity.Count()
+	if n+m == 0 {
+		return 0, false
+	}
+	if cmtrand.Intn(n+m) < n {
+		return missing.PickRandom()
+	}
+	index, _ := missingParity.PickRandom()
+	return total + index, true
+}
+
+// parityParts returns whether the node exchanges the parity parts of the
+// proposal blocks with its peers, only if it extends its own block parts with
+// them. The others still receive all the parts of the blocks.
+func (conR *Reactor) parityParts() bool {
+	return conR.conS.config.ErasureCodedBlockParts
+}
+
+// supportsParityParts returns whether the peer can receive the parity parts of
+// the proposal blocks.
+func supportsParityParts(peer p2p.Peer) bool {
+	ni, ok := peer.NodeInfo().(p2p.DefaultNodeInfo)
+	return ok && ni.HasChannel(ParityPartChannel)
+}
+
 func (conR *Reactor) gossipDataForCatchup(logger log.Logger, rs *cstypes.RoundState,
 	prs *cstypes.PeerRoundState, ps *PeerState, peer p2p.Peer) {
 
@@ -1159,11 +1288,11 @@ func (conR *Reactor) peerStatsRoutine() {
 			switch msg.Msg.(type) {
 			case *VoteMessage:
 				if numVotes := ps.RecordVote(); numVotes%votesToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					_ = conR.reporter.Report(behaviour.ConsensusVote(peer.ID(), "voted"))
 				}
 			case *BlockPartMessage:
 				if numParts := ps.RecordBlockPart(); numParts%blocksToContributeToBecomeGoodPeer == 0 {
-					conR.Switch.MarkPeerAsGood(peer)
+					_ = conR.reporter.Report(behaviour.BlockPart(peer.ID(), "block part"))
 				}
 			}
 		case <-conR.conS.Quit():
@@ -1207,6 +1336,12 @@ func ReactorTracing(traceClient trace.Tracer) ReactorOption {
 	return func(conR *Reactor) { conR.traceClient = traceClient }
 }
 
+// ReactorCompactBlocks enables compact blocks, reconstructed from the txs of
+// the given mempool.
+func ReactorCompactBlocks(txs TxFetcher) ReactorOption {
+	return func(conR *Reactor) { conR.compactBlocks = txs }
+}
+
 //-----------------------------------------------------------------------------
 
 var (
@@ -1225,6 +1360,12 @@ type PeerState struct {
 	mtx   sync.Mutex             // NOTE: Modify below using setters, never directly.
 	PRS   cstypes.PeerRoundState `json:"round_state"` // Exposed.
 	Stats *peerStateStats        `json:"stats"`       // Exposed.
+
+	// The exchange of the compact block of the proposal block at compactHeight
+	// and compactRound with the peer.
+	compactHeight int64
+	compactRound  int32
+	compactStatus compactBlockStatus
 }
 
 // peerStateStats holds internal statistics for a peer.
@@ -1308,6 +1449,9 @@ func (ps *PeerState) SetHasProposal(proposal *types.Proposal) {
 
 	ps.PRS.ProposalBlockPartSetHeader = proposal.BlockID.PartSetHeader
 	ps.PRS.ProposalBlockParts = bits.NewBitArray(int(proposal.BlockID.PartSetHeader.Total))
+	if proposal.ExtendedPartSetHeader != nil {
+		ps.PRS.ProposalBlockParityParts = bits.NewBitArray(int(proposal.ExtendedPartSetHeader.ParityTotal))
+	}
 	ps.PRS.ProposalPOLRound = proposal.POLRound
 	ps.PRS.ProposalPOL = nil // Nil until ProposalPOLMessage received.
 }
@@ -1326,6 +1470,7 @@ func (ps *PeerState) InitProposalBlockParts(partSetHeader types.PartSetHeader) {
 }
 
 // SetHasProposalBlockPart sets the given block part index as known for the peer.
+// The indexes of the parity parts follow the ones of the parts.
 func (ps *PeerState) SetHasProposalBlockPart(height int64, round int32, index int) {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
@@ -1334,9 +1479,25 @@ func (ps *PeerState) SetHasProposalBlockPart(height int64, round int32, index in
 		return
 	}
 
+	if total := int(ps.PRS.ProposalBlockPartSetHeader.Total); index >= total {
+		ps.PRS.ProposalBlockParityParts.SetIndex(index-total, true)
+		return
+	}
 	ps.PRS.ProposalBlockParts.SetIndex(index, true)
 }
 
+// clearProposalBlockParityParts sets the parity parts of the proposal block as
+// unknown for the peer.
+func (ps *PeerState) clearProposalBlockParityParts(height int64, round int32) {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.PRS.Height != height || ps.PRS.Round != round || ps.PRS.ProposalBlockParityParts == nil {
+		return
+	}
+	ps.PRS.ProposalBlockParityParts = bits.NewBitArray(ps.PRS.ProposalBlockParityParts.Size())
+}
+
 // PickSendVote picks a vote and sends it to the peer.
 // Returns the vote if vote was sent. Otherwise, returns nil.
 func (ps *PeerState) PickSendVote(votes types.VoteSetReader) *types.Vote {
@@ -1579,6 +1740,7 @@ func (ps *PeerState) ApplyNewRoundStepMessage(msg *NewRoundStepMessage) {
 		ps.PRS.Proposal = false
 		ps.PRS.ProposalBlockPartSetHeader = types.PartSetHeader{}
 		ps.PRS.ProposalBlockParts = nil
+		ps.PRS.ProposalBlockParityParts = nil
 		ps.PRS.ProposalPOLRound = -1
 		ps.PRS.ProposalPOL = nil
 		// We'll update the BitArray capacity later.
@@ -1620,6 +1782,9 @@ func (ps *PeerState) ApplyNewValidBlockMessage(msg *NewValidBlockMessage) {
 		return
 	}
 
+	if !ps.PRS.ProposalBlockPartSetHeader.Equals(msg.BlockPartSetHeader) {
+		ps.PRS.ProposalBlockParityParts = nil
+	}
 	ps.PRS.ProposalBlockPartSetHeader = msg.BlockPartSetHeader
 	ps.PRS.ProposalBlockParts = msg.BlockParts
 }
@@ -1712,6 +1877,10 @@ func init() {
 	cmtjson.RegisterType(&HasVoteMessage{}, "tendermint/HasVote")
 	cmtjson.RegisterType(&VoteSetMaj23Message{}, "tendermint/VoteSetMaj23")
 	cmtjson.RegisterType(&VoteSetBitsMessage{}, "tendermint/VoteSetBits")
+	cmtjson.RegisterType(&CompactBlockMessage{}, "tendermint/CompactBlock")
+	cmtjson.RegisterType(&WantCompactBlockTxsMessage{}, "tendermint/WantCompactBlockTxs")
+	cmtjson.RegisterType(&CompactBlockTxsMessage{}, "tendermint/CompactBlockTxs")
+	cmtjson.RegisterType(&WantBlockPartsMessage{}, "tendermint/WantBlockParts")
 }
 
 //-------------------------------------
@@ -2009,3 +2178,134 @@ func (m *VoteSetBitsMessage) String() string {
 }
 
 //-------------------------------------
+
+// CompactBlockMessage is sent instead of the parts of a proposed block, to the
+// peers supporting compact blocks. The block has no txs, which are identified
+// in order by TxKeys. The txs the sender couldn't find in its mempool have a
+// zero key, and are in Txs instead, in order.
+type CompactBlockMessage struct {
+	Proposal *types.Proposal
+	Block    *types.Block
+	TxKeys   []types.TxKey
+	Txs      types.Txs
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockMessage) ValidateBasic() error {
+	if m.Proposal == nil {
+		return errors.New("nil Proposal")
+	}
+	if err := m.Proposal.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong Proposal: %v", err)
+	}
+	if err := m.Block.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong Block: %v", err)
+	}
+	if m.Block.Height != m.Proposal.Height {
+		return fmt.Errorf("block height %v doesn't match proposal height %v", m.Block.Height, m.Proposal.Height)
+	}
+	if len(m.Block.Txs) != 0 {
+		return errors.New("block has txs")
+	}
+	full := 0
+	for _, key := range m.TxKeys {
+		if key == (types.TxKey{}) {
+			full++
+		}
+	}
+	if full != len(m.Txs) {
+		return fmt.Errorf("got %d txs for %d zero tx keys", len(m.Txs), full)
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockMessage) String() string {
+	return fmt.Sprintf("[CompactBlock %v/%02d K:%v T:%v]", m.Proposal.Height, m.Proposal.Round, len(m.TxKeys), len(m.Txs))
+}
+
+//-------------------------------------
+
+// WantCompactBlockTxsMessage is sent to request the txs of a compact block
+// missing from the mempool, by their indexes in the block.
+type WantCompactBlockTxsMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+}
+
+// ValidateBasic performs basic validation.
+func (m *WantCompactBlockTxsMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if len(m.Indexes) == 0 {
+		return errors.New("no Indexes")
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *WantCompactBlockTxsMessage) String() string {
+	return fmt.Sprintf("[WantCompactBlockTxs %v/%02d I:%v]", m.Height, m.Round, len(m.Indexes))
+}
+
+//-------------------------------------
+
+// CompactBlockTxsMessage is sent in response to WantCompactBlockTxsMessage,
+// with the txs at the requested indexes.
+type CompactBlockTxsMessage struct {
+	Height  int64
+	Round   int32
+	Indexes []uint32
+	Txs     types.Txs
+}
+
+// ValidateBasic performs basic validation.
+func (m *CompactBlockTxsMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	if len(m.Indexes) != len(m.Txs) {
+		return fmt.Errorf("got %d txs for %d indexes", len(m.Txs), len(m.Indexes))
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *CompactBlockTxsMessage) String() string {
+	return fmt.Sprintf("[CompactBlockTxs %v/%02d T:%v]", m.Height, m.Round, len(m.Txs))
+}
+
+//-------------------------------------
+
+// WantBlockPartsMessage is sent when a compact block can't be reconstructed,
+// to request the parts of the block instead.
+type WantBlockPartsMessage struct {
+	Height int64
+	Round  int32
+}
+
+// ValidateBasic performs basic validation.
+func (m *WantBlockPartsMessage) ValidateBasic() error {
+	if m.Height < 0 {
+		return errors.New("negative Height")
+	}
+	if m.Round < 0 {
+		return errors.New("negative Round")
+	}
+	return nil
+}
+
+// String returns a string representation.
+func (m *WantBlockPartsMessage) String() string {
+	return fmt.Sprintf("[WantBlockParts %v/%02d]", m.Height, m.Round)
+}
+
+//-------------------------------------
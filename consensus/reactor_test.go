@@ -8,6 +8,7 @@ import (
 	"runtime"
 	"runtime/pprof"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -21,6 +22,7 @@ import (
 	abcicli "github.com/cometbft/cometbft/abci/client"
 	"github.com/cometbft/cometbft/abci/example/kvstore"
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/behaviour"
 	cfg "github.com/cometbft/cometbft/config"
 	cstypes "github.com/cometbft/cometbft/consensus/types"
 	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
@@ -28,6 +30,7 @@ import (
 	"github.com/cometbft/cometbft/libs/bits"
 	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/libs/log"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	mempl "github.com/cometbft/cometbft/mempool"
 	mempoolv2 "github.com/cometbft/cometbft/mempool/cat"
@@ -48,7 +51,7 @@ import (
 
 var defaultTestTime = time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
 
-func startConsensusNet(t *testing.T, css []*State, n int) (
+func startConsensusNet(t *testing.T, css []*State, n int, options ...ReactorOption) (
 	[]*Reactor,
 	[]types.Subscription,
 	[]*types.EventBus,
@@ -59,7 +62,7 @@ func startConsensusNet(t *testing.T, css []*State, n int) (
 	for i := 0; i < n; i++ {
 		/*logger, err := cmtflags.ParseLogLevel("consensus:info,*:error", logger, "info")
 		if err != nil {	t.Fatal(err)}*/
-		reactors[i] = NewReactor(css[i], true) // so we dont start the consensus states
+		reactors[i] = NewReactor(css[i], true, options...) // so we dont start the consensus states
 		reactors[i].SetLogger(css[i].Logger)
 
 		// eventBus is already started with the cs
@@ -125,6 +128,221 @@ func TestReactorBasic(t *testing.T) {
 	}, css)
 }
 
+// countingTxFetcher counts the txs missing from the mempool.
+type countingTxFetcher struct {
+	TxFetcher
+	missing atomic.Int32
+}
+
+func (f *countingTxFetcher) GetTxByKey(key types.TxKey) (types.Tx, bool) {
+	tx, ok := f.TxFetcher.GetTxByKey(key)
+	if !ok {
+		f.missing.Add(1)
+	}
+	return tx, ok
+}
+
+// Ensure a testnet makes blocks with txs using compact blocks, with a
+// validator fetching all the txs from its peers.
+func TestReactorCompactBlocks(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(N, "consensus_reactor_test", newMockTickerFunc(true), newCounter)
+	defer cleanup()
+	fetcher := &countingTxFetcher{TxFetcher: assertMempool(css[N-1].txNotifier)}
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N, func(conR *Reactor) {
+		if conR.conS == css[N-1] {
+			conR.compactBlocks = fetcher
+		} else {
+			conR.compactBlocks = assertMempool(conR.conS.txNotifier)
+		}
+	})
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+	for _, r := range reactors {
+		for _, peer := range r.Switch.Peers().List() {
+			require.True(t, supportsCompactBlocks(peer))
+			// without erasure coded block parts
+			require.False(t, supportsParityParts(peer))
+		}
+	}
+
+	// only the mempool of the last validator misses the txs
+	for i := 0; i < N-1; i++ {
+		deliverTxsRange(css[i], 0, 10)
+	}
+	// wait till everyone commits a block with txs
+	timeoutWaitGroup(t, N, func(j int) {
+		for {
+			msg := <-blocksSubs[j].Out()
+			if len(msg.Data().(types.EventDataNewBlock).Block.Txs) > 0 {
+				return
+			}
+		}
+	}, css)
+	assert.Positive(t, fetcher.missing.Load())
+}
+
+func TestReactorErasureCodedBlockParts(t *testing.T) {
+	N := 4
+	css, cleanup := randConsensusNet(N, "consensus_reactor_test", newMockTickerFunc(true), newCounter,
+		func(c *cfg.Config) {
+			c.Consensus.ErasureCodedBlockParts = true
+		})
+	defer cleanup()
+	for _, cs := range css {
+		cs.state.Version.Consensus.App = types.ExtendedPartSetHeaderAppVersion
+	}
+	reactors, blocksSubs, eventBuses := startConsensusNet(t, css, N)
+	defer stopConsensusNet(log.TestingLogger(), reactors, eventBuses)
+	for _, peer := range reactors[0].Switch.Peers().List() {
+		assert.True(t, supportsParityParts(peer))
+	}
+
+	// wait till everyone makes a few blocks, recovered from any of their parts
+	// and parity parts
+	for i := 0; i < 3; i++ {
+		timeoutWaitGroup(t, N, func(j int) {
+			<-blocksSubs[j].Out()
+		}, css)
+	}
+}
+
+func TestPickBlockPartToSend(t *testing.T) {
+	parts := types.NewPartSetFromData(cmtrand.Bytes(4*int(types.BlockPartSizeBytes)), types.BlockPartSizeBytes)
+	require.NoError(t, parts.Extend())
+	total := int(parts.Total())
+
+	// the peer has all but one data part, and enough parity parts to recover
+	// the block, but it still gets the data part it lacks
+	prs := &cstypes.PeerRoundState{
+		ProposalBlockParts:       bits.NewBitArray(total),
+		ProposalBlockParityParts: bits.NewBitArray(int(parts.ParityTotal())),
+	}
+	for i := 0; i < total-1; i++ {
+		prs.ProposalBlockParts.SetIndex(i, true)
+	}
+	prs.ProposalBlockParityParts.SetIndex(0, true)
+	for i := 0; i < 10; i++ {
+		index, ok := pickBlockPartToSend(parts, prs, true)
+		require.True(t, ok)
+		assert.Equal(t, total-1, index)
+	}
+
+	// a peer that can't receive the parity parts only gets the data parts
+	prs.ProposalBlockParts = bits.NewBitArray(total)
+	prs.ProposalBlockParityParts = bits.NewBitArray(int(parts.ParityTotal()))
+	for i := 0; i < 10; i++ {
+		index, ok := pickBlockPartToSend(parts, prs, false)
+		require.True(t, ok)
+		assert.Less(t, index, total)
+	}
+}
+
+// missingTxFetcher is a mempool without any tx.
+type missingTxFetcher struct{}
+
+func (missingTxFetcher) GetTxByKey(types.TxKey) (types.Tx, bool) { return nil, false }
+
+// Ensure a compact block is only reconstructed for the current round once its
+// proposal is verified, and the compact blocks of other peers are kept as
+// alternates.
+func TestReactorCompactBlockProposal(t *testing.T) {
+	cs1, vss := randState(2)
+	conR := NewReactor(cs1, true, ReactorCompactBlocks(missingTxFetcher{}))
+	conR.SetLogger(log.TestingLogger())
+	reporter := behaviour.NewMockReporter()
+	conR.reporter = reporter
+
+	var proposer *validatorStub
+	for _, vs := range vss {
+		pubKey, err := vs.GetPubKey()
+		require.NoError(t, err)
+		if pubKey.Address().String() == cs1.Validators.GetProposer().Address.String() {
+			proposer = vs
+		}
+	}
+	proposal, block := decideProposal(cs1, proposer, 1, 0)
+	block.Txs = nil
+	msg := &CompactBlockMessage{
+		Proposal: proposal,
+		Block:    block,
+		TxKeys:   []types.TxKey{types.Tx("tx").Key()},
+	}
+	newPeer := func() (p2p.Peer, *PeerState) {
+		peer := p2pmock.NewPeer(nil)
+		ps := NewPeerState(peer).SetLogger(log.TestingLogger())
+		peer.Set(types.PeerStateKey, ps)
+		return peer, ps
+	}
+	peer1, ps1 := newPeer()
+	peer2, ps2 := newPeer()
+
+	// a proposal not signed by the proposer
+	badProposal := *proposal
+	badProposal.Signature = []byte("signature")
+	conR.handleCompactBlock(peer1, ps1, &CompactBlockMessage{Proposal: &badProposal, Block: block, TxKeys: msg.TxKeys})
+	require.Nil(t, conR.pendingCompact)
+	require.Len(t, reporter.GetBehaviours(peer1.ID()), 1)
+
+	// a proposal of another round
+	otherProposal := *proposal
+	otherProposal.Round = 1
+	conR.handleCompactBlock(peer1, ps1, &CompactBlockMessage{Proposal: &otherProposal, Block: block, TxKeys: msg.TxKeys})
+	require.Nil(t, conR.pendingCompact)
+
+	conR.handleCompactBlock(peer1, ps1, msg)
+	p := conR.pendingCompact
+	require.NotNil(t, p)
+	require.Equal(t, peer1.ID(), p.peer.ID())
+	require.Len(t, p.missing, 1)
+
+	conR.handleCompactBlock(peer2, ps2, msg)
+	conR.compactMtx.Lock()
+	require.Len(t, p.alternates, 1)
+	conR.compactMtx.Unlock()
+
+	// the missing txs are requested from the alternate once the peer fails
+	conR.retryCompactBlock(p, peer1, "test")
+	conR.compactMtx.Lock()
+	require.Equal(t, peer2.ID(), p.peer.ID())
+	require.Empty(t, p.alternates)
+	require.False(t, p.done)
+	p.done = true
+	p.timer.Stop()
+	conR.compactMtx.Unlock()
+}
+
+// Ensure the parts of a block reconstructed from a compact block aren't
+// attributed to the peer, which didn't send them.
+func TestReactorCompactBlockParts(t *testing.T) {
+	cs1, vss := randState(2)
+	conR := NewReactor(cs1, true, ReactorCompactBlocks(missingTxFetcher{}))
+	conR.SetLogger(log.TestingLogger())
+
+	var proposer *validatorStub
+	for _, vs := range vss {
+		pubKey, err := vs.GetPubKey()
+		require.NoError(t, err)
+		if pubKey.Address().String() == cs1.Validators.GetProposer().Address.String() {
+			proposer = vs
+		}
+	}
+	proposal, block := decideProposal(cs1, proposer, 1, 0)
+	require.Empty(t, block.Txs)
+	peer := p2pmock.NewPeer(nil)
+	ps := NewPeerState(peer).SetLogger(log.TestingLogger())
+	peer.Set(types.PeerStateKey, ps)
+
+	conR.handleCompactBlock(peer, ps, &CompactBlockMessage{Proposal: proposal, Block: block})
+	mi := <-cs1.peerMsgQueue
+	require.IsType(t, &ProposalMessage{}, mi.Msg)
+	require.Equal(t, peer.ID(), mi.PeerID)
+	for i := 0; i < int(proposal.BlockID.PartSetHeader.Total); i++ {
+		mi := <-cs1.peerMsgQueue
+		require.IsType(t, &BlockPartMessage{}, mi.Msg)
+		require.Empty(t, mi.PeerID)
+	}
+}
+
 // Ensure we can process blocks with evidence
 func TestReactorWithEvidence(t *testing.T) {
 	nValidators := 4
@@ -1044,3 +1262,50 @@ func TestVoteSetBitsMessageValidateBasic(t *testing.T) {
 		})
 	}
 }
+
+func TestCompactBlockMessageValidateBasic(t *testing.T) {
+	blockID := types.BlockID{
+		Hash:          tmhash.Sum([]byte("block")),
+		PartSetHeader: types.PartSetHeader{Total: 1, Hash: tmhash.Sum([]byte("parts"))},
+	}
+	makeBlock := func(height int64, txs types.Txs) *types.Block {
+		block := types.MakeBlock(height, txs, &types.Commit{}, nil)
+		block.ProposerAddress = tmhash.SumTruncated([]byte("proposer"))
+		return block
+	}
+	testCases := []struct {
+		malleateFn func(*CompactBlockMessage)
+		expErr     string
+	}{
+		{func(msg *CompactBlockMessage) {}, ""},
+		{func(msg *CompactBlockMessage) { msg.Proposal = nil }, "nil Proposal"},
+		{func(msg *CompactBlockMessage) { msg.Block = nil }, "wrong Block"},
+		{func(msg *CompactBlockMessage) { msg.Block = makeBlock(2, nil) },
+			"block height 2 doesn't match proposal height 1"},
+		{func(msg *CompactBlockMessage) { msg.Block = makeBlock(1, types.Txs{types.Tx("tx")}) },
+			"block has txs"},
+		{func(msg *CompactBlockMessage) { msg.Txs = nil }, "got 0 txs for 1 zero tx keys"},
+	}
+
+	for i, tc := range testCases {
+		tc := tc
+		t.Run(fmt.Sprintf("#%d", i), func(t *testing.T) {
+			proposal := types.NewProposal(1, 0, -1, blockID)
+			proposal.Signature = []byte("signature")
+			msg := &CompactBlockMessage{
+				Proposal: proposal,
+				Block:    makeBlock(1, nil),
+				TxKeys:   []types.TxKey{types.Tx("tx0").Key(), {}},
+				Txs:      types.Txs{types.Tx("tx1")},
+			}
+
+			tc.malleateFn(msg)
+			err := msg.ValidateBasic()
+			if tc.expErr == "" {
+				assert.NoError(t, err)
+			} else if assert.Error(t, err) {
+				assert.Contains(t, err.Error(), tc.expErr)
+			}
+		})
+	}
+}
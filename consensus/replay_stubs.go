@@ -52,6 +52,8 @@ func (emptyMempool) CloseWAL()                               {}
 func (emptyMempool) GetTxByKey(types.TxKey) (types.Tx, bool) { return nil, false }
 func (emptyMempool) WasRecentlyEvicted(types.TxKey) bool     { return false }
 
+func (emptyMempool) WasRecentlyRejected(types.TxKey) (uint32, string, bool) { return 0, "", false }
+
 //-----------------------------------------------------------------------------
 // mockProxyApp uses ABCIResponses to give the right results.
 //
@@ -22,6 +22,7 @@ import (
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto"
 	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	mempl "github.com/cometbft/cometbft/mempool"
@@ -1249,6 +1250,11 @@ func (bs *mockBlockStore) PruneBlocks(height int64) (uint64, error) {
 
 func (bs *mockBlockStore) DeleteLatestBlock() error { return nil }
 
+func (bs *mockBlockStore) LoadDataCommitment(start, end int64) []byte { return nil }
+func (bs *mockBlockStore) LoadDataRootInclusionProof(height, start, end int64) *merkle.Proof {
+	return nil
+}
+
 // ---------------------------------------
 // Test handshake/init chain
 
@@ -0,0 +1,87 @@
+package simulation
+
+import (
+	"container/heap"
+	"time"
+
+	"github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/consensus/internal/driven"
+)
+
+// event is a function run at a time of the simulation, elapsed since its
+// start. The events of the same time run in the order they were scheduled.
+type event struct {
+	at  time.Duration
+	seq uint64
+	fn  func()
+}
+
+// eventQueue is a heap of events, the next one first.
+type eventQueue []*event
+
+var _ heap.Interface = (*eventQueue)(nil)
+
+func (q eventQueue) Len() int { return len(q) }
+
+func (q eventQueue) Less(i, j int) bool {
+	if q[i].at != q[j].at {
+		return q[i].at < q[j].at
+	}
+	return q[i].seq < q[j].seq
+}
+
+func (q eventQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+
+func (q *eventQueue) Push(x interface{}) { *q = append(*q, x.(*event)) }
+
+func (q *eventQueue) Pop() interface{} {
+	old := *q
+	ev := old[len(old)-1]
+	old[len(old)-1] = nil
+	*q = old[:len(old)-1]
+	return ev
+}
+
+// ticker fires the timeouts scheduled by the TimeoutTicker of a validator in
+// the virtual time of the simulation. As the ticker of the consensus, it only
+// schedules a timeout for a later height, round or step than the last one,
+// which it replaces.
+type ticker struct {
+	sim  *Simulation
+	node *Node
+
+	ti driven.Timeout
+	// incremented on each timeout scheduled, so that the replaced ones
+	// don't fire.
+	gen uint64
+}
+
+func newTicker(sim *Simulation, node *Node) *ticker {
+	return &ticker{sim: sim, node: node}
+}
+
+// schedule schedules the timeout, handled by fire.
+func (t *ticker) schedule(newti driven.Timeout, fire func() []consensus.Message) {
+	ti := t.ti
+	if newti.Height < ti.Height {
+		return
+	} else if newti.Height == ti.Height {
+		if newti.Round < ti.Round {
+			return
+		} else if newti.Round == ti.Round && ti.Step > 0 && newti.Step <= ti.Step {
+			return
+		}
+	}
+
+	t.ti = newti
+	t.gen++
+	gen := t.gen
+	t.sim.schedule(newti.Duration, func() {
+		if gen != t.gen {
+			return
+		}
+		t.sim.logger.Debug("timeout", "elapsed", t.sim.elapsed, "validator", t.node.Index,
+			"height", newti.Height, "round", newti.Round, "step", newti.Step)
+		t.sim.broadcast(t.node, fire())
+	})
+}
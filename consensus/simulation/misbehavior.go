@@ -0,0 +1,88 @@
+package simulation
+
+import (
+	"fmt"
+
+	"github.com/cometbft/cometbft/consensus"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// MisbehaviorList encompasses a list of all possible behaviors
+var MisbehaviorList = map[string]Misbehavior{
+	"double-prevote":   DoublePrevoteMisbehavior(),
+	"double-precommit": DoublePrecommitMisbehavior(),
+	"silence":          SilenceMisbehavior(),
+}
+
+// Misbehavior makes a Byzantine validator send its peers other messages than
+// the ones of its State, which stays honest, as the misbehaviors of the
+// maverick node.
+type Misbehavior struct {
+	Name string
+
+	// Send returns the messages the validator sends to its peer of index idx
+	// among its peers, in place of msg, one of its proposals, block parts or
+	// votes.
+	Send func(n *Node, msg consensus.Message, idx int) []consensus.Message
+}
+
+// DoublePrevoteMisbehavior makes a validator prevote both a block, to half of
+// its peers, and nil, to the other half, in the same height and round.
+func DoublePrevoteMisbehavior() Misbehavior {
+	return doubleVoteMisbehavior("double-prevote", cmtproto.PrevoteType)
+}
+
+// DoublePrecommitMisbehavior makes a validator precommit both a block, to
+// half of its peers, and nil, to the other half, in the same height and round.
+func DoublePrecommitMisbehavior() Misbehavior {
+	return doubleVoteMisbehavior("double-precommit", cmtproto.PrecommitType)
+}
+
+func doubleVoteMisbehavior(name string, voteType cmtproto.SignedMsgType) Misbehavior {
+	return Misbehavior{
+		Name: name,
+		Send: func(n *Node, msg consensus.Message, idx int) []consensus.Message {
+			vm, ok := msg.(*consensus.VoteMessage)
+			// as the maverick node, send the vote for the block to the even
+			// peers, and the nil vote to the odd ones.
+			if !ok || vm.Vote.Type != voteType || vm.Vote.BlockID.IsZero() || idx%2 == 0 {
+				return []consensus.Message{msg}
+			}
+			nilVote, err := n.nilVote(vm.Vote)
+			if err != nil {
+				panic(fmt.Sprintf("failed to sign a conflicting vote: %v", err))
+			}
+			return []consensus.Message{&consensus.VoteMessage{Vote: nilVote}}
+		},
+	}
+}
+
+// SilenceMisbehavior makes a validator send none of its proposals, block
+// parts and votes, while still relaying the ones of the others.
+func SilenceMisbehavior() Misbehavior {
+	return Misbehavior{
+		Name: "silence",
+		Send: func(n *Node, msg consensus.Message, idx int) []consensus.Message {
+			return nil
+		},
+	}
+}
+
+// nilVote returns the vote of the validator for nil, signed once, of the same
+// type, height and round as the vote.
+func (n *Node) nilVote(vote *types.Vote) (*types.Vote, error) {
+	key := fmt.Sprintf("%v/%d/%d", vote.Type, vote.Height, vote.Round)
+	if nilVote, ok := n.nilVotes[key]; ok {
+		return nilVote, nil
+	}
+	nilVote := vote.Copy()
+	nilVote.BlockID = types.BlockID{}
+	v := nilVote.ToProto()
+	if err := n.PrivValidator.SignVote(chainID, v); err != nil {
+		return nil, err
+	}
+	nilVote.Signature = v.Signature
+	n.nilVotes[key] = nilVote
+	return nilVote, nil
+}
@@ -0,0 +1,454 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+	"time"
+
+	"github.com/cometbft/cometbft/consensus"
+	cstypes "github.com/cometbft/cometbft/consensus/types"
+	"github.com/cometbft/cometbft/libs/bits"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Link is the link from a validator to another.
+type Link struct {
+	// the delay of the messages is drawn uniformly in
+	// [Latency, Latency+Jitter).
+	Latency time.Duration
+	Jitter  time.Duration
+	// Loss is the probability for a message to be lost, in [0, 1].
+	Loss float64
+}
+
+func (l Link) validate() error {
+	if l.Latency < 0 || l.Jitter < 0 {
+		return fmt.Errorf("negative link latency or jitter: %v, %v", l.Latency, l.Jitter)
+	}
+	if l.Loss < 0 || l.Loss > 1 {
+		return fmt.Errorf("link loss %v not in [0, 1]", l.Loss)
+	}
+	return nil
+}
+
+// SetLink sets the link from the validator of index from to the one of index
+// to, for the messages sent from now on.
+func (s *Simulation) SetLink(from, to int, link Link) error {
+	if err := link.validate(); err != nil {
+		return err
+	}
+	s.links[from][to] = link
+	return nil
+}
+
+// Partition cuts the links between the validators of different groups, and
+// of the validators of no group. The messages sent before are still
+// delivered.
+func (s *Simulation) Partition(groups ...[]int) {
+	group := make([]int, len(s.nodes))
+	for i := range group {
+		group[i] = -1 - i
+	}
+	for g, indexes := range groups {
+		for _, i := range indexes {
+			group[i] = g
+		}
+	}
+	for i := range s.cut {
+		for j := range s.cut[i] {
+			s.cut[i][j] = group[i] != group[j]
+		}
+	}
+}
+
+// Heal restores the links cut by Partition.
+func (s *Simulation) Heal() {
+	for i := range s.cut {
+		for j := range s.cut[i] {
+			s.cut[i][j] = false
+		}
+	}
+}
+
+// peers returns the other validators than n, by index.
+func (s *Simulation) peers(n *Node) []*Node {
+	peers := make([]*Node, 0, len(s.nodes)-1)
+	for _, peer := range s.nodes {
+		if peer != n {
+			peers = append(peers, peer)
+		}
+	}
+	return peers
+}
+
+// broadcast sends the messages of the validator to its peers.
+func (s *Simulation) broadcast(n *Node, msgs []consensus.Message) {
+	for _, msg := range msgs {
+		for idx, peer := range s.peers(n) {
+			s.sendOwn(n, idx, peer, msg)
+		}
+	}
+}
+
+// sendOwn sends a message of the validator to its peer of index idx among
+// its peers, through the misbehavior of the validator at the height of the
+// message if any.
+func (s *Simulation) sendOwn(n *Node, idx int, peer *Node, msg consensus.Message) {
+	mb, ok := n.misbehaviors[messageHeight(msg)]
+	if !ok {
+		s.send(n, peer, msg)
+		return
+	}
+	for _, m := range mb.Send(n, msg, idx) {
+		s.send(n, peer, m)
+	}
+}
+
+// send sends a message over the link between the validators, unless cut or
+// the message is lost. The message is delivered as encoded on the wire.
+func (s *Simulation) send(from, to *Node, msg consensus.Message) {
+	if s.cut[from.Index][to.Index] {
+		return
+	}
+	link := s.links[from.Index][to.Index]
+	if link.Loss > 0 && s.rng.Float64() < link.Loss {
+		s.logger.Debug("lost message", "elapsed", s.elapsed, "from", from.Index, "to", to.Index, "msg", msg)
+		return
+	}
+	delay := link.Latency
+	if link.Jitter > 0 {
+		delay += time.Duration(s.rng.Int63n(int64(link.Jitter)))
+	}
+
+	pb, err := consensus.MsgToProto(msg)
+	if err != nil {
+		panic(fmt.Sprintf("failed to encode %T: %v", msg, err))
+	}
+	s.schedule(delay, func() {
+		msg, err := consensus.MsgFromProto(pb)
+		if err != nil {
+			s.logger.Error("invalid message", "from", from.Index, "to", to.Index, "err", err)
+			return
+		}
+		s.logger.Debug("delivered message", "elapsed", s.elapsed, "from", from.Index, "to", to.Index, "msg", msg)
+		s.deliver(from, to, msg)
+	})
+}
+
+// deliver hands a message of a peer to the validator, which learns from it
+// what the peer has, and tells its peers of the votes it didn't have, as the
+// reactor does with HasVoteMessage. A vote it already had is acknowledged to
+// the peer only, so that the peer stops sending it.
+func (s *Simulation) deliver(from, to *Node, msg consensus.Message) {
+	to.peers[from.Index].receive(msg)
+	var vote *types.Vote
+	switch msg := msg.(type) {
+	case *consensus.NewRoundStepMessage, *consensus.NewValidBlockMessage, *consensus.HasVoteMessage:
+		return
+	case *consensus.VoteMessage:
+		vote = msg.Vote
+	}
+
+	had := vote != nil && hasVote(to.State.GetRoundState(), vote)
+	s.broadcast(to, handlePeerMessage(to.State, msg, from.ID))
+	if vote == nil {
+		return
+	}
+	hasVoteMsg := &consensus.HasVoteMessage{
+		Height: vote.Height, Round: vote.Round, Type: vote.Type, Index: vote.ValidatorIndex,
+	}
+	switch {
+	case had:
+		s.send(to, from, hasVoteMsg)
+	case hasVote(to.State.GetRoundState(), vote):
+		for _, peer := range s.peers(to) {
+			s.send(to, peer, hasVoteMsg)
+		}
+	}
+}
+
+// gossip sends to each peer of each validator its round state, and the
+// proposal, block parts and votes of its height the validator doesn't know
+// the peer to have, as the reactor does, and schedules the next gossip. A
+// validator only knows of a peer what it received from it, so that the
+// messages are sent again until the peer tells it has them, or moves on, and
+// relayed to the validators the others can't reach directly.
+func (s *Simulation) gossip() {
+	for _, n := range s.nodes {
+		rs := n.State.GetRoundState()
+		status := s.statusMessages(rs)
+		address := n.PrivValidator.PrivKey.PubKey().Address()
+		// the proposal of the round, and its block parts, are the validator's
+		// own if it is the proposer.
+		ownProposal := rs.Proposal != nil && bytes.Equal(rs.Validators.GetProposer().Address, address)
+
+		for idx, peer := range s.peers(n) {
+			if s.cut[n.Index][peer.Index] {
+				continue
+			}
+			for _, msg := range status {
+				s.send(n, peer, msg)
+			}
+
+			ps := n.peers[peer.Index]
+			var msgs []consensus.Message
+			switch {
+			case ps.height == rs.Height:
+				msgs = roundMessages(rs, ps)
+			case ps.height > 0 && ps.height < rs.Height:
+				msgs = catchupMessages(n.BlockStore, ps)
+			}
+			for _, msg := range msgs {
+				own := ownProposal
+				if vm, ok := msg.(*consensus.VoteMessage); ok {
+					own = bytes.Equal(vm.Vote.ValidatorAddress, address)
+				}
+				if own {
+					s.sendOwn(n, idx, peer, msg)
+				} else {
+					s.send(n, peer, msg)
+				}
+			}
+		}
+	}
+	s.schedule(s.config.Consensus.PeerGossipSleepDuration, s.gossip)
+}
+
+// statusMessages returns the messages telling the peers the round state of
+// the validator, and the block parts it has once it has the whole proposal
+// block or is committing a block, as the NewRoundStepMessage and
+// NewValidBlockMessage of the reactor.
+func (s *Simulation) statusMessages(rs *cstypes.RoundState) []consensus.Message {
+	msgs := []consensus.Message{&consensus.NewRoundStepMessage{
+		Height:                rs.Height,
+		Round:                 rs.Round,
+		Step:                  rs.Step,
+		SecondsSinceStartTime: int64(s.Now().Sub(rs.StartTime).Seconds()),
+		LastCommitRound:       rs.LastCommit.GetRound(),
+	}}
+	parts := rs.ProposalBlockParts
+	if parts != nil && (parts.IsComplete() || rs.Step == cstypes.RoundStepCommit) {
+		msgs = append(msgs, &consensus.NewValidBlockMessage{
+			Height:             rs.Height,
+			Round:              rs.Round,
+			BlockPartSetHeader: parts.Header(),
+			BlockParts:         parts.BitArray(),
+			IsCommit:           rs.Step == cstypes.RoundStepCommit,
+		})
+	}
+	return msgs
+}
+
+// roundMessages returns the proposal, block parts and votes of the round
+// state that a peer at the same height isn't known to have.
+func roundMessages(rs *cstypes.RoundState, ps *peerState) []consensus.Message {
+	var msgs []consensus.Message
+	if rs.Proposal != nil && ps.round == rs.Round && !ps.proposals[roundKey{rs.Height, rs.Round}] {
+		msgs = append(msgs, &consensus.ProposalMessage{Proposal: rs.Proposal})
+	}
+	// the peer accepts the parts of the proposal of its round, or of the
+	// block it is committing.
+	if parts := rs.ProposalBlockParts; parts != nil {
+		has := ps.partsOf(rs.Height, parts.Header())
+		if has != nil || ps.round == rs.Round {
+			for i := 0; i < int(parts.Total()); i++ {
+				part := parts.GetPart(i)
+				if part != nil && !has.GetIndex(i) {
+					msgs = append(msgs, &consensus.BlockPartMessage{Height: rs.Height, Round: rs.Round, Part: part})
+				}
+			}
+		}
+	}
+	for round := int32(0); round <= rs.Votes.Round(); round++ {
+		for _, votes := range []*types.VoteSet{rs.Votes.Prevotes(round), rs.Votes.Precommits(round)} {
+			if votes == nil {
+				continue
+			}
+			for i := 0; i < votes.Size(); i++ {
+				vote := votes.GetByIndex(int32(i))
+				if vote != nil && !ps.hasVote(vote) {
+					msgs = append(msgs, &consensus.VoteMessage{Vote: vote})
+				}
+			}
+		}
+	}
+	return msgs
+}
+
+// catchupMessages returns the precommits and block parts of the committed
+// block at the height of a lagging peer, that it isn't known to have.
+func catchupMessages(blockStore *store.BlockStore, ps *peerState) []consensus.Message {
+	meta := blockStore.LoadBlockMeta(ps.height)
+	if meta == nil {
+		return nil
+	}
+	commit := blockStore.LoadBlockCommit(ps.height)
+	if commit == nil {
+		commit = blockStore.LoadSeenCommit(ps.height)
+	}
+
+	var msgs []consensus.Message
+	for i, sig := range commit.Signatures {
+		if sig.Absent() {
+			continue
+		}
+		if vote := commit.GetVote(int32(i)); !ps.hasVote(vote) {
+			msgs = append(msgs, &consensus.VoteMessage{Vote: vote})
+		}
+	}
+	// the peer accepts the parts once it knows the block from the precommits,
+	// and tells it is committing it.
+	if has := ps.partsOf(ps.height, meta.BlockID.PartSetHeader); has != nil {
+		for i := 0; i < int(meta.BlockID.PartSetHeader.Total); i++ {
+			if has.GetIndex(i) {
+				continue
+			}
+			if part := blockStore.LoadBlockPart(ps.height, i); part != nil {
+				msgs = append(msgs, &consensus.BlockPartMessage{Height: ps.height, Round: ps.round, Part: part})
+			}
+		}
+	}
+	return msgs
+}
+
+// hasVote returns whether the round state has a vote of the same type, height,
+// round and validator as the vote.
+func hasVote(rs *cstypes.RoundState, vote *types.Vote) bool {
+	if vote.Height != rs.Height {
+		return false
+	}
+	var votes *types.VoteSet
+	switch vote.Type {
+	case cmtproto.PrevoteType:
+		votes = rs.Votes.Prevotes(vote.Round)
+	case cmtproto.PrecommitType:
+		votes = rs.Votes.Precommits(vote.Round)
+	}
+	return votes != nil && votes.GetByIndex(vote.ValidatorIndex) != nil
+}
+
+// messageHeight returns the height of a proposal, block part or vote.
+func messageHeight(msg consensus.Message) int64 {
+	switch m := msg.(type) {
+	case *consensus.ProposalMessage:
+		return m.Proposal.Height
+	case *consensus.BlockPartMessage:
+		return m.Height
+	case *consensus.VoteMessage:
+		return m.Vote.Height
+	default:
+		return 0
+	}
+}
+
+// peerState is what a validator knows of a peer, learned only from the
+// messages it received from it: the round state it told, and the proposals,
+// block parts and votes it sent or told it has.
+type peerState struct {
+	height int64
+	round  int32
+	step   cstypes.RoundStepType
+
+	proposals map[roundKey]bool
+	// the part set of the block the peer has at each height, and the parts
+	// it has of it.
+	parts map[int64]*peerParts
+	votes map[voteKey]bool
+}
+
+type roundKey struct {
+	height int64
+	round  int32
+}
+
+type voteKey struct {
+	height   int64
+	round    int32
+	voteType cmtproto.SignedMsgType
+	index    int32
+}
+
+type peerParts struct {
+	header types.PartSetHeader
+	has    *bits.BitArray
+}
+
+func newPeerState() *peerState {
+	return &peerState{
+		proposals: make(map[roundKey]bool),
+		parts:     make(map[int64]*peerParts),
+		votes:     make(map[voteKey]bool),
+	}
+}
+
+// receive learns from a message of the peer what it has.
+func (ps *peerState) receive(msg consensus.Message) {
+	switch msg := msg.(type) {
+	case *consensus.NewRoundStepMessage:
+		if consensus.CompareHRS(msg.Height, msg.Round, msg.Step, ps.height, ps.round, ps.step) > 0 {
+			ps.height, ps.round, ps.step = msg.Height, msg.Round, msg.Step
+			ps.prune()
+		}
+	case *consensus.NewValidBlockMessage:
+		pp := ps.setParts(msg.Height, msg.BlockPartSetHeader)
+		pp.has = pp.has.Or(msg.BlockParts)
+		ps.proposals[roundKey{msg.Height, msg.Round}] = true
+	case *consensus.HasVoteMessage:
+		ps.votes[voteKey{msg.Height, msg.Round, msg.Type, msg.Index}] = true
+	case *consensus.ProposalMessage:
+		ps.proposals[roundKey{msg.Proposal.Height, msg.Proposal.Round}] = true
+		ps.setParts(msg.Proposal.Height, msg.Proposal.BlockID.PartSetHeader)
+	case *consensus.BlockPartMessage:
+		if pp, ok := ps.parts[msg.Height]; ok {
+			pp.has.SetIndex(int(msg.Part.Index), true)
+		}
+	case *consensus.VoteMessage:
+		ps.votes[voteKey{msg.Vote.Height, msg.Vote.Round, msg.Vote.Type, msg.Vote.ValidatorIndex}] = true
+	}
+}
+
+// setParts sets the part set the peer has at the height, keeping the parts
+// known of it if the same, and returns it.
+func (ps *peerState) setParts(height int64, header types.PartSetHeader) *peerParts {
+	pp, ok := ps.parts[height]
+	if !ok || !pp.header.Equals(header) {
+		pp = &peerParts{header: header, has: bits.NewBitArray(int(header.Total))}
+		ps.parts[height] = pp
+	}
+	return pp
+}
+
+// partsOf returns the parts the peer has of the part set at the height, or
+// nil if it has another part set or none.
+func (ps *peerState) partsOf(height int64, header types.PartSetHeader) *bits.BitArray {
+	pp, ok := ps.parts[height]
+	if !ok || !pp.header.Equals(header) {
+		return nil
+	}
+	return pp.has
+}
+
+func (ps *peerState) hasVote(vote *types.Vote) bool {
+	return ps.votes[voteKey{vote.Height, vote.Round, vote.Type, vote.ValidatorIndex}]
+}
+
+// prune forgets what the peer has of the heights below its own.
+func (ps *peerState) prune() {
+	for key := range ps.proposals {
+		if key.height < ps.height {
+			delete(ps.proposals, key)
+		}
+	}
+	for height := range ps.parts {
+		if height < ps.height {
+			delete(ps.parts, height)
+		}
+	}
+	for key := range ps.votes {
+		if key.height < ps.height {
+			delete(ps.votes, key)
+		}
+	}
+}
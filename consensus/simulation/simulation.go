@@ -0,0 +1,400 @@
+// Package simulation runs the consensus of several validators in a single
+// process and goroutine, deterministically, to reproduce consensus bugs.
+//
+// The State of each validator is driven by the simulation instead of its
+// routines: the messages between the validators go through an in-memory
+// network, whose links have a latency and may lose messages or be cut by
+// partitions, and the timeouts fire in a virtual time, which is also the
+// clock of the validators. Each validator gossips to its peers what it
+// doesn't know them to have, as the reactor, knowing of them only what it
+// received from them. All the random choices derive from a seed, so that
+// a run is replayed exactly from its seed and configuration. Byzantine
+// validators are given misbehaviors at some heights, as the maverick node of
+// test/maverick.
+//
+// The runs fail on the first safety violation, that is two validators
+// committing different blocks at the same height, or when the validators
+// don't reach a height in time:
+//
+//	sim, err := simulation.New(config)
+//	...
+//	defer sim.Stop()
+//	if err := sim.RunUntilHeight(10, time.Minute); err != nil {
+//		// errors.Is(err, simulation.ErrSafety) or simulation.ErrLiveness
+//	}
+package simulation
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+	"math/rand"
+	"sort"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abcicli "github.com/cometbft/cometbft/abci/client"
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/consensus/internal/driven"
+	"github.com/cometbft/cometbft/crypto/ed25519"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	"github.com/cometbft/cometbft/mempool/mock"
+	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+const chainID = "simulation"
+
+// genesisTime is the start of the virtual time of the simulations.
+var genesisTime = time.Date(2023, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// the hooks of the driven mode of consensus.State.
+var (
+	startDriven       = driven.Start.(func(*consensus.State))
+	handlePeerMessage = driven.HandlePeerMessage.(func(*consensus.State, consensus.Message, p2p.ID) []consensus.Message)
+	newTimeoutTicker  = driven.NewTimeoutTicker.(func(*consensus.State,
+		func(driven.Timeout, func() []consensus.Message)) consensus.TimeoutTicker)
+)
+
+var (
+	// ErrSafety is returned by the runs when two validators committed
+	// different blocks at the same height.
+	ErrSafety = errors.New("safety violation")
+	// ErrLiveness is returned by RunUntilHeight when the validators didn't
+	// commit the height in time.
+	ErrLiveness = errors.New("liveness violation")
+)
+
+// Config is the configuration of a simulation.
+type Config struct {
+	// Seed seeds all the random choices of the simulation: the keys of the
+	// validators and the latencies and losses of the messages.
+	Seed int64
+	// Validators is the number of validators, of equal voting power.
+	Validators int
+	// Consensus is the consensus configuration of the validators. The
+	// messages a peer lacks are gossiped to it every PeerGossipSleepDuration.
+	Consensus *cfg.ConsensusConfig
+	// Link is the link between any two validators, unless set by SetLink.
+	Link Link
+	// Misbehaviors are the misbehaviors of the Byzantine validators at some
+	// heights, by index of validator. The safety and the liveness are only
+	// checked for the other validators.
+	Misbehaviors map[int]map[int64]Misbehavior
+	// Logger logs the messages delivered and the timeouts fired, at debug
+	// level, and the consensus of the validators.
+	Logger log.Logger
+}
+
+// DefaultConfig returns a configuration of 4 honest validators, with the
+// default consensus configuration, over links of 50 to 100ms.
+func DefaultConfig() Config {
+	return Config{
+		Validators: 4,
+		Consensus:  cfg.DefaultConsensusConfig(),
+		Link:       Link{Latency: 50 * time.Millisecond, Jitter: 50 * time.Millisecond},
+		Logger:     log.NewNopLogger(),
+	}
+}
+
+// Node is a validator of the simulation.
+type Node struct {
+	// Index is the index of the validator in the validator set.
+	Index         int
+	ID            p2p.ID
+	PrivValidator types.MockPV
+	State         *consensus.State
+	BlockStore    *store.BlockStore
+
+	eventBus     *types.EventBus
+	misbehaviors map[int64]Misbehavior
+	// what the validator knows of each of its peers, by index.
+	peers []*peerState
+	// the nil votes signed by the misbehaviors, by vote type, height and
+	// round, to send the same ones again.
+	nilVotes map[string]*types.Vote
+}
+
+// Byzantine returns whether the validator misbehaves at any height.
+func (n *Node) Byzantine() bool {
+	return len(n.misbehaviors) > 0
+}
+
+// Simulation is a deterministic run of the consensus of several validators.
+// It isn't safe for concurrent use.
+type Simulation struct {
+	config Config
+	logger log.Logger
+	rng    *rand.Rand
+
+	elapsed time.Duration
+	seq     uint64
+	events  eventQueue
+
+	nodes []*Node
+	links [][]Link
+	cut   [][]bool
+
+	// the block committed at each height, with the first validator to
+	// commit it, and the last height checked of each validator.
+	commits map[int64]commit
+	checked []int64
+	err     error
+}
+
+type commit struct {
+	blockID types.BlockID
+	node    int
+}
+
+// New creates the validators of a simulation, and schedules their first
+// round.
+func New(config Config) (*Simulation, error) {
+	if config.Validators < 1 {
+		return nil, errors.New("a simulation needs at least one validator")
+	}
+	if config.Consensus == nil {
+		return nil, errors.New("no consensus configuration")
+	}
+	if err := config.Consensus.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid consensus configuration: %w", err)
+	}
+	if config.Consensus.PeerGossipSleepDuration <= 0 {
+		return nil, errors.New("peer_gossip_sleep_duration must be positive")
+	}
+	if err := config.Link.validate(); err != nil {
+		return nil, err
+	}
+	for i := range config.Misbehaviors {
+		if i < 0 || i >= config.Validators {
+			return nil, fmt.Errorf("misbehaviors of validator %d, out of %d", i, config.Validators)
+		}
+	}
+	if config.Logger == nil {
+		config.Logger = log.NewNopLogger()
+	}
+
+	s := &Simulation{
+		config:  config,
+		logger:  config.Logger.With("module", "simulation"),
+		rng:     rand.New(rand.NewSource(config.Seed)), //nolint:gosec
+		commits: make(map[int64]commit),
+		checked: make([]int64, config.Validators),
+	}
+
+	// the keys derive from the seed, and the validators are sorted by address
+	// as in the validator set, all of the same voting power.
+	privVals := make([]types.MockPV, config.Validators)
+	for i := range privVals {
+		secret := make([]byte, 32)
+		s.rng.Read(secret)
+		privVals[i] = types.NewMockPVWithParams(ed25519.GenPrivKeyFromSecret(secret), false, false)
+	}
+	sort.Slice(privVals, func(i, j int) bool {
+		return privVals[i].PrivKey.PubKey().Address().String() < privVals[j].PrivKey.PubKey().Address().String()
+	})
+	genDoc := &types.GenesisDoc{
+		GenesisTime:   genesisTime,
+		ChainID:       chainID,
+		InitialHeight: 1,
+		Validators:    make([]types.GenesisValidator, config.Validators),
+	}
+	for i, pv := range privVals {
+		genDoc.Validators[i] = types.GenesisValidator{PubKey: pv.PrivKey.PubKey(), Power: 10}
+	}
+	state, err := sm.MakeGenesisState(genDoc)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, pv := range privVals {
+		n, err := s.newNode(i, pv, state.Copy())
+		if err != nil {
+			s.Stop()
+			return nil, err
+		}
+		s.nodes = append(s.nodes, n)
+	}
+	s.links = make([][]Link, config.Validators)
+	s.cut = make([][]bool, config.Validators)
+	for i := range s.links {
+		s.links[i] = make([]Link, config.Validators)
+		s.cut[i] = make([]bool, config.Validators)
+		for j := range s.links[i] {
+			s.links[i][j] = config.Link
+		}
+	}
+
+	for _, n := range s.nodes {
+		startDriven(n.State)
+	}
+	s.schedule(config.Consensus.PeerGossipSleepDuration, s.gossip)
+	return s, nil
+}
+
+// newNode creates the validator of index i, with an in-memory store and
+// kvstore application, and no transactions nor evidence.
+func (s *Simulation) newNode(i int, pv types.MockPV, state sm.State) (*Node, error) {
+	logger := s.config.Logger.With("validator", i)
+	n := &Node{
+		Index:         i,
+		ID:            p2p.PubKeyToID(pv.PrivKey.PubKey()),
+		PrivValidator: pv,
+		misbehaviors:  s.config.Misbehaviors[i],
+		peers:         make([]*peerState, s.config.Validators),
+		nilVotes:      make(map[string]*types.Vote),
+	}
+	for j := range n.peers {
+		if j != i {
+			n.peers[j] = newPeerState()
+		}
+	}
+
+	db := dbm.NewMemDB()
+	stateStore := sm.NewStore(db, sm.StoreOptions{DiscardABCIResponses: false})
+	if err := stateStore.Save(state); err != nil {
+		return nil, err
+	}
+	n.BlockStore = store.NewBlockStore(db)
+
+	proxyApp := proxy.NewAppConnConsensus(abcicli.NewLocalClient(new(cmtsync.Mutex), kvstore.NewApplication()))
+	mempool := mock.Mempool{}
+	evpool := sm.EmptyEvidencePool{}
+	blockExec := sm.NewBlockExecutor(stateStore, logger.With("module", "state"), proxyApp, mempool, evpool)
+
+	n.State = consensus.NewState(s.config.Consensus, state, blockExec, n.BlockStore, mempool, evpool,
+		consensus.StateClock(s.Now))
+	n.State.SetTimeoutTicker(newTimeoutTicker(n.State, newTicker(s, n).schedule))
+	n.State.SetLogger(logger.With("module", "consensus"))
+	n.State.SetPrivValidator(pv)
+
+	n.eventBus = types.NewEventBus()
+	n.eventBus.SetLogger(logger.With("module", "events"))
+	if err := n.eventBus.Start(); err != nil {
+		return nil, err
+	}
+	n.State.SetEventBus(n.eventBus)
+	return n, nil
+}
+
+// Stop stops the validators.
+func (s *Simulation) Stop() {
+	for _, n := range s.nodes {
+		if err := n.eventBus.Stop(); err != nil {
+			s.logger.Error("failed to stop the event bus", "validator", n.Index, "err", err)
+		}
+	}
+}
+
+// Nodes returns the validators, by index.
+func (s *Simulation) Nodes() []*Node {
+	return s.nodes
+}
+
+// Now returns the virtual time of the simulation, which is the clock of the
+// validators.
+func (s *Simulation) Now() time.Time {
+	return genesisTime.Add(s.elapsed)
+}
+
+// Elapsed returns the virtual time elapsed since the start of the simulation.
+func (s *Simulation) Elapsed() time.Duration {
+	return s.elapsed
+}
+
+// At runs fn once the virtual time elapsed reaches at, as to change the links
+// or partition the validators during a run.
+func (s *Simulation) At(at time.Duration, fn func()) {
+	s.schedule(at-s.elapsed, fn)
+}
+
+// schedule runs fn after the delay in virtual time, at the earliest after
+// the events already scheduled for the same time.
+func (s *Simulation) schedule(delay time.Duration, fn func()) {
+	if delay < 0 {
+		delay = 0
+	}
+	s.seq++
+	heap.Push(&s.events, &event{at: s.elapsed + delay, seq: s.seq, fn: fn})
+}
+
+// Run runs the simulation for the duration d of virtual time, and returns an
+// ErrSafety error on the first safety violation.
+func (s *Simulation) Run(d time.Duration) error {
+	return s.run(s.elapsed+d, func() bool { return false })
+}
+
+// RunUntilHeight runs the simulation until the honest validators all
+// committed the height, and returns an ErrLiveness error if they didn't within
+// the timeout of virtual time, or an ErrSafety error on the first safety
+// violation.
+func (s *Simulation) RunUntilHeight(height int64, timeout time.Duration) error {
+	reached := func() bool {
+		for _, n := range s.nodes {
+			if !n.Byzantine() && n.BlockStore.Height() < height {
+				return false
+			}
+		}
+		return true
+	}
+	if err := s.run(s.elapsed+timeout, reached); err != nil {
+		return err
+	}
+	if !reached() {
+		for _, n := range s.nodes {
+			if !n.Byzantine() && n.BlockStore.Height() < height {
+				return fmt.Errorf("%w: validator %d committed height %d, not %d, after %v",
+					ErrLiveness, n.Index, n.BlockStore.Height(), height, s.elapsed)
+			}
+		}
+	}
+	return nil
+}
+
+// run runs the events until the virtual time until or done, and checks the
+// safety after each event.
+func (s *Simulation) run(until time.Duration, done func() bool) error {
+	for s.err == nil && !done() {
+		if len(s.events) == 0 || s.events[0].at > until {
+			s.elapsed = until
+			break
+		}
+		ev := heap.Pop(&s.events).(*event)
+		s.elapsed = ev.at
+		ev.fn()
+		s.err = s.checkSafety()
+	}
+	return s.err
+}
+
+// checkSafety checks that the honest validators committed the same blocks
+// as the others at the heights they committed since the last check.
+func (s *Simulation) checkSafety() error {
+	for _, n := range s.nodes {
+		if n.Byzantine() {
+			continue
+		}
+		for h := s.checked[n.Index] + 1; h <= n.BlockStore.Height(); h++ {
+			meta := n.BlockStore.LoadBlockMeta(h)
+			if meta == nil {
+				return fmt.Errorf("validator %d has no block at height %d", n.Index, h)
+			}
+			c, ok := s.commits[h]
+			if !ok {
+				s.commits[h] = commit{blockID: meta.BlockID, node: n.Index}
+			} else if !c.blockID.Equals(meta.BlockID) {
+				return fmt.Errorf("%w at height %d: validator %d committed %v, validator %d committed %v",
+					ErrSafety, h, c.node, c.blockID, n.Index, meta.BlockID)
+			}
+			s.checked[n.Index] = h
+		}
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+package simulation
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/types"
+)
+
+func newSimulation(t *testing.T, config Config) *Simulation {
+	t.Helper()
+	sim, err := New(config)
+	require.NoError(t, err)
+	t.Cleanup(sim.Stop)
+	return sim
+}
+
+func TestSimulationHonest(t *testing.T) {
+	for _, seed := range []int64{0, 1, 2} {
+		config := DefaultConfig()
+		config.Seed = seed
+		sim := newSimulation(t, config)
+		require.NoError(t, sim.RunUntilHeight(5, time.Minute), "seed %d", seed)
+	}
+}
+
+func TestSimulationReplay(t *testing.T) {
+	run := func() ([]types.BlockID, time.Duration) {
+		config := DefaultConfig()
+		config.Seed = 42
+		config.Link.Loss = 0.1
+		sim := newSimulation(t, config)
+		require.NoError(t, sim.RunUntilHeight(5, time.Minute))
+
+		var blockIDs []types.BlockID
+		for h := int64(1); h <= 5; h++ {
+			blockIDs = append(blockIDs, sim.Nodes()[0].BlockStore.LoadBlockMeta(h).BlockID)
+		}
+		return blockIDs, sim.Elapsed()
+	}
+
+	blockIDs, elapsed := run()
+	replayedBlockIDs, replayedElapsed := run()
+	assert.Equal(t, blockIDs, replayedBlockIDs)
+	assert.Equal(t, elapsed, replayedElapsed)
+}
+
+func TestSimulationLinks(t *testing.T) {
+	config := DefaultConfig()
+	config.Link = Link{Latency: 100 * time.Millisecond, Jitter: 400 * time.Millisecond, Loss: 0.3}
+	sim := newSimulation(t, config)
+	require.NoError(t, sim.SetLink(0, 1, Link{Latency: 2 * time.Second}))
+	require.Error(t, sim.SetLink(1, 0, Link{Loss: 2}))
+	require.NoError(t, sim.RunUntilHeight(5, 5*time.Minute))
+}
+
+func TestSimulationPartition(t *testing.T) {
+	config := DefaultConfig()
+	sim := newSimulation(t, config)
+	require.NoError(t, sim.RunUntilHeight(2, time.Minute))
+
+	// no group has +2/3 of the voting power.
+	sim.Partition([]int{0, 1}, []int{2, 3})
+	height := sim.Nodes()[0].BlockStore.Height() + 2
+	err := sim.RunUntilHeight(height, time.Minute)
+	require.True(t, errors.Is(err, ErrLiveness), "%v", err)
+
+	sim.At(sim.Elapsed()+10*time.Second, sim.Heal)
+	require.NoError(t, sim.RunUntilHeight(height, time.Minute))
+}
+
+func TestSimulationCatchup(t *testing.T) {
+	config := DefaultConfig()
+	sim := newSimulation(t, config)
+	nodes := sim.Nodes()
+
+	// the others commit without validator 3, and only know of it what they
+	// received from it.
+	sim.Partition([]int{0, 1, 2})
+	require.NoError(t, sim.Run(10*time.Second))
+	assert.GreaterOrEqual(t, nodes[0].BlockStore.Height(), int64(3))
+	assert.Zero(t, nodes[3].BlockStore.Height())
+	assert.Zero(t, nodes[0].peers[3].height)
+
+	// validator 3 catches up from the blocks committed by the others.
+	sim.Heal()
+	require.NoError(t, sim.RunUntilHeight(nodes[0].BlockStore.Height()+2, time.Minute))
+	assert.GreaterOrEqual(t, nodes[0].peers[3].height, nodes[3].BlockStore.Height())
+}
+
+func TestSimulationByzantine(t *testing.T) {
+	for name, misbehavior := range MisbehaviorList {
+		t.Run(name, func(t *testing.T) {
+			config := DefaultConfig()
+			config.Misbehaviors = map[int]map[int64]Misbehavior{
+				2: {2: misbehavior, 3: misbehavior, 4: misbehavior},
+			}
+			sim := newSimulation(t, config)
+			require.True(t, sim.Nodes()[2].Byzantine())
+			require.NoError(t, sim.RunUntilHeight(6, time.Minute))
+			if name != "silence" {
+				assert.NotEmpty(t, sim.Nodes()[2].nilVotes, "no conflicting vote sent")
+			}
+		})
+	}
+}
+
+func TestSimulationSafety(t *testing.T) {
+	config := DefaultConfig()
+	sim := newSimulation(t, config)
+	require.NoError(t, sim.RunUntilHeight(1, time.Minute))
+
+	// record another block as committed by a validator at height 1.
+	blockID := sim.commits[1].blockID
+	blockID.Hash = make([]byte, len(blockID.Hash))
+	sim.commits[1] = commit{blockID: blockID, node: 3}
+	sim.checked[0] = 0
+	err := sim.Run(time.Second)
+	require.True(t, errors.Is(err, ErrSafety), "%v", err)
+}
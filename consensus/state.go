@@ -36,10 +36,12 @@ import (
 var (
 	ErrInvalidProposalSignature   = errors.New("error invalid proposal signature")
 	ErrInvalidProposalPOLRound    = errors.New("error invalid proposal POL round")
+	ErrUnexpectedExtendedHeader   = errors.New("error proposal extended part set header before its app version")
 	ErrAddingVote                 = errors.New("error adding vote")
 	ErrSignatureFoundInPastBlocks = errors.New("found signature from the same key")
 
 	errPubKeyIsNotSet = errors.New("pubkey is not set. Look for \"Can't get private validator pubkey\" errors")
+	errHalted         = errors.New("refusing to sign beyond the halt height or time")
 )
 
 var msgQueueSize = 1000
@@ -143,7 +145,22 @@ type State struct {
 	// for reporting metrics
 	metrics *Metrics
 
+	// the clock of the heights start time and of the proposals and votes
+	// timestamps, cmttime.Now unless set by StateClock.
+	now func() time.Time
+
+	// the timeouts derived from the observed proposal completion and vote
+	// quorum times, nil unless config.AdaptiveTimeouts.
+	adaptiveTimeouts *adaptiveTimeouts
+
 	traceClient trace.Tracer
+
+	// the node halts after committing haltHeight, or the first block with a
+	// time at or after haltTime. Protected by mtx.
+	haltHeight int64
+	haltTime   time.Time
+	// closed once the node halted
+	halted chan struct{}
 }
 
 // StateOption sets an optional parameter on the State.
@@ -174,7 +191,13 @@ func NewState(
 		evpool:           evpool,
 		evsw:             cmtevents.NewEventSwitch(),
 		metrics:          NopMetrics(),
+		now:              cmttime.Now,
 		traceClient:      trace.NoOpTracer(),
+		haltHeight:       config.HaltHeight,
+		halted:           make(chan struct{}),
+	}
+	if config.HaltTime > 0 {
+		cs.haltTime = time.Unix(config.HaltTime, 0)
 	}
 
 	// set function defaults (may be overwritten before calling Start)
@@ -196,6 +219,11 @@ func NewState(
 		option(cs)
 	}
 
+	if config.AdaptiveTimeouts {
+		cs.adaptiveTimeouts = newAdaptiveTimeouts(config)
+		cs.recordAdaptiveTimeouts()
+	}
+
 	return cs
 }
 
@@ -216,6 +244,18 @@ func StateMetrics(metrics *Metrics) StateOption {
 	return func(cs *State) { cs.metrics = metrics }
 }
 
+// StateClock sets the clock of the State, used instead of the system clock for
+// the start time of the heights and the timestamps of the proposals and votes.
+func StateClock(now func() time.Time) StateOption {
+	return func(cs *State) {
+		cs.now = now
+		// NewState set the start time of the first height with the system clock.
+		if cs.CommitTime.IsZero() {
+			cs.StartTime = cs.config.Commit(now())
+		}
+	}
+}
+
 // SetTraceClient sets the remote event collector.
 func SetTraceClient(ec trace.Tracer) StateOption {
 	return func(cs *State) { cs.traceClient = ec }
@@ -387,12 +427,18 @@ func (cs *State) OnStart() error {
 		return err
 	}
 
+	// The node may have been restarted without removing the halt condition,
+	// or have replayed the halt height from the WAL.
+	cs.haltAtLastBlock()
+
 	// now start the receiveRoutine
 	go cs.receiveRoutine(0)
 
 	// schedule the first round!
 	// use GetRoundState so we don't race the receiveRoutine for access
-	cs.scheduleRound0(cs.GetRoundState())
+	if !cs.isHalted() {
+		cs.scheduleRound0(cs.GetRoundState())
+	}
 
 	return nil
 }
@@ -546,8 +592,8 @@ func (cs *State) updateRoundStep(round int32, step cstypes.RoundStepType) {
 
 // enterNewRound(height, 0) at cs.StartTime.
 func (cs *State) scheduleRound0(rs *cstypes.RoundState) {
-	// cs.Logger.Info("scheduleRound0", "now", cmttime.Now(), "startTime", cs.StartTime)
-	sleepDuration := rs.StartTime.Sub(cmttime.Now())
+	// cs.Logger.Info("scheduleRound0", "now", cs.now(), "startTime", cs.StartTime)
+	sleepDuration := rs.StartTime.Sub(cs.now())
 	cs.scheduleTimeout(sleepDuration, rs.Height, 0, cstypes.RoundStepNewHeight)
 }
 
@@ -672,7 +718,7 @@ func (cs *State) updateToState(state sm.State) {
 		// to be gathered for the first block.
 		// And alternative solution that relies on clocks:
 		// cs.StartTime = state.LastBlockTime.Add(timeoutCommit)
-		cs.StartTime = cs.config.Commit(cmttime.Now())
+		cs.StartTime = cs.config.Commit(cs.now())
 	} else {
 		cs.StartTime = cs.config.Commit(cs.CommitTime)
 	}
@@ -823,6 +869,9 @@ func (cs *State) receiveRoutine(maxSteps int) {
 func (cs *State) handleMsg(mi msgInfo) {
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
+	if cs.isHalted() {
+		return
+	}
 	var (
 		added bool
 		err   error
@@ -923,6 +972,9 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 	// the timeout will now cause a state transition
 	cs.mtx.Lock()
 	defer cs.mtx.Unlock()
+	if cs.isHalted() {
+		return
+	}
 
 	switch ti.Step {
 	case cstypes.RoundStepNewHeight:
@@ -934,6 +986,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 		cs.enterPropose(ti.Height, 0)
 
 	case cstypes.RoundStepPropose:
+		cs.expireStepMeasurement(ti.Step, ti.Height, ti.Round, ti.Duration)
 		if err := cs.eventBus.PublishEventTimeoutPropose(cs.RoundStateEvent()); err != nil {
 			cs.Logger.Error("failed publishing timeout propose", "err", err)
 		}
@@ -941,6 +994,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 		cs.enterPrevote(ti.Height, ti.Round)
 
 	case cstypes.RoundStepPrevoteWait:
+		cs.expireStepMeasurement(ti.Step, ti.Height, ti.Round, ti.Duration)
 		if err := cs.eventBus.PublishEventTimeoutWait(cs.RoundStateEvent()); err != nil {
 			cs.Logger.Error("failed publishing timeout wait", "err", err)
 		}
@@ -948,6 +1002,7 @@ func (cs *State) handleTimeout(ti timeoutInfo, rs cstypes.RoundState) {
 		cs.enterPrecommit(ti.Height, ti.Round)
 
 	case cstypes.RoundStepPrecommitWait:
+		cs.expireStepMeasurement(ti.Step, ti.Height, ti.Round, ti.Duration)
 		if err := cs.eventBus.PublishEventTimeoutWait(cs.RoundStateEvent()); err != nil {
 			cs.Logger.Error("failed publishing timeout wait", "err", err)
 		}
@@ -977,7 +1032,7 @@ func (cs *State) handleTxsAvailable() {
 		}
 
 		// +1ms to ensure RoundStepNewRound timeout always happens after RoundStepNewHeight
-		timeoutCommit := cs.StartTime.Sub(cmttime.Now()) + 1*time.Millisecond
+		timeoutCommit := cs.StartTime.Sub(cs.now()) + 1*time.Millisecond
 		cs.scheduleTimeout(timeoutCommit, cs.Height, 0, cstypes.RoundStepNewRound)
 
 	case cstypes.RoundStepNewRound: // after timeoutCommit
@@ -1008,7 +1063,12 @@ func (cs *State) enterNewRound(height int64, round int32) {
 		return
 	}
 
-	if now := cmttime.Now(); cs.StartTime.After(now) {
+	if cs.isHalted() {
+		logger.Debug("entering new round after halting; ignoring")
+		return
+	}
+
+	if now := cs.now(); cs.StartTime.After(now) {
 		logger.Debug("need to set a buffer and log message here for sanity", "start_time", cs.StartTime, "now", now)
 	}
 
@@ -1107,7 +1167,8 @@ func (cs *State) enterPropose(height int64, round int32) {
 	}()
 
 	// If we don't get the proposal and all block parts quick enough, enterPrevote
-	cs.scheduleTimeout(cs.config.Propose(round), height, round, cstypes.RoundStepPropose)
+	cs.beginStepMeasurement(cstypes.RoundStepPropose, height, round)
+	cs.scheduleTimeout(cs.proposeTimeout(round), height, round, cstypes.RoundStepPropose)
 
 	// Nothing more to do if we're not a validator
 	if cs.privValidator == nil {
@@ -1148,6 +1209,11 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 	var block *types.Block
 	var blockParts *types.PartSet
 
+	if cs.isBeyondHalt() {
+		cs.Logger.Error("propose step; not proposing", "height", height, "round", round, "err", errHalted)
+		return
+	}
+
 	// Decide on block
 	if cs.TwoThirdPrevoteBlock != nil {
 		// If there is valid block, choose that.
@@ -1168,9 +1234,21 @@ func (cs *State) defaultDecideProposal(height int64, round int32) {
 		cs.Logger.Error("failed flushing WAL to disk")
 	}
 
+	extended := extendedPartSetHeaderEnabled(cs.state)
+	if extended && cs.config.ErasureCodedBlockParts {
+		if err := blockParts.Extend(); err != nil {
+			cs.Logger.Error("propose step; failed extending block parts", "height", height, "round", round, "err", err)
+		}
+	}
+
 	// Make proposal
 	propBlockID := types.BlockID{Hash: block.Hash(), PartSetHeader: blockParts.Header()}
 	proposal := types.NewProposal(height, round, cs.TwoThirdPrevoteRound, propBlockID)
+	proposal.Timestamp = cs.now()
+	if extended && blockParts.ParityTotal() > 0 {
+		extendedHeader := blockParts.ExtendedHeader()
+		proposal.ExtendedPartSetHeader = &extendedHeader
+	}
 	p := proposal.ToProto()
 	if err := cs.privValidator.SignProposal(cs.state.ChainID, p); err == nil {
 		proposal.Signature = p.Signature
@@ -1355,7 +1433,7 @@ func (cs *State) enterPrevoteWait(height int64, round int32) {
 	}()
 
 	// Wait for some more prevotes; enterPrecommit
-	cs.scheduleTimeout(cs.config.Prevote(round), height, round, cstypes.RoundStepPrevoteWait)
+	cs.scheduleTimeout(cs.prevoteTimeout(round), height, round, cstypes.RoundStepPrevoteWait)
 }
 
 // Enter: `timeoutPrevote` after any +2/3 prevotes.
@@ -1514,7 +1592,7 @@ func (cs *State) enterPrecommitWait(height int64, round int32) {
 	}()
 
 	// wait for some more precommits; enterNewRound
-	cs.scheduleTimeout(cs.config.Precommit(round), height, round, cstypes.RoundStepPrecommitWait)
+	cs.scheduleTimeout(cs.precommitTimeout(round), height, round, cstypes.RoundStepPrecommitWait)
 }
 
 // Enter: +2/3 precommits for block
@@ -1536,7 +1614,7 @@ func (cs *State) enterCommit(height int64, commitRound int32) {
 		// keep cs.Round the same, commitRound points to the right Precommits set.
 		cs.updateRoundStep(cs.Round, cstypes.RoundStepCommit)
 		cs.CommitRound = commitRound
-		cs.CommitTime = cmttime.Now()
+		cs.CommitTime = cs.now()
 		cs.newStep()
 
 		// Maybe finalize immediately.
@@ -1739,6 +1817,11 @@ func (cs *State) finalizeCommit(height int64) {
 		logger.Error("failed to get private validator pubkey", "err", err)
 	}
 
+	if cs.shouldHalt(height, block.Time) {
+		cs.halt(height)
+		return
+	}
+
 	// cs.StartTime is already set.
 	// Schedule Round0 to start soon.
 	cs.scheduleRound0(&cs.RoundState)
@@ -1749,6 +1832,80 @@ func (cs *State) finalizeCommit(height int64) {
 	// * cs.StartTime is set to when we will start round0.
 }
 
+// SetHalt sets the height after which, and the block time from which, the node
+// halts. A zero height or time disables the corresponding condition. The
+// height must not be committed yet.
+func (cs *State) SetHalt(height int64, haltTime time.Time) error {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	if height < 0 {
+		return fmt.Errorf("negative halt height %d", height)
+	}
+	if height > 0 && height < cs.Height {
+		return fmt.Errorf("height %d is already committed, the next height is %d", height, cs.Height)
+	}
+	cs.haltHeight = height
+	cs.haltTime = haltTime
+	cs.Logger.Info("set the halt height and time", "halt_height", height, "halt_time", haltTime)
+	return nil
+}
+
+// Halted returns a channel that is closed once the node halted, after
+// committing the halt height or the first block at or after the halt time.
+func (cs *State) Halted() <-chan struct{} {
+	return cs.halted
+}
+
+// shouldHalt returns true if the node must halt after committing the block of
+// the given height and time.
+func (cs *State) shouldHalt(height int64, blockTime time.Time) bool {
+	return (cs.haltHeight > 0 && height >= cs.haltHeight) ||
+		(!cs.haltTime.IsZero() && !blockTime.Before(cs.haltTime))
+}
+
+// haltAtLastBlock halts the state machine if the node must halt after the
+// last committed block.
+func (cs *State) haltAtLastBlock() {
+	cs.mtx.Lock()
+	defer cs.mtx.Unlock()
+	if cs.state.LastBlockHeight > 0 && cs.shouldHalt(cs.state.LastBlockHeight, cs.state.LastBlockTime) {
+		cs.halt(cs.state.LastBlockHeight)
+	}
+}
+
+// halt stops the state machine after committing the given height. The WAL is
+// flushed, and messages and timeouts are no longer handled, so the node
+// doesn't sign anything for later heights.
+func (cs *State) halt(height int64) {
+	if err := cs.wal.FlushAndSync(); err != nil {
+		cs.Logger.Error("failed flushing WAL to disk", "err", err)
+	}
+	cs.Logger.Info("halting the node", "height", height, "halt_height", cs.haltHeight, "halt_time", cs.haltTime)
+	if !cs.isHalted() {
+		close(cs.halted)
+	}
+}
+
+func (cs *State) isHalted() bool {
+	select {
+	case <-cs.halted:
+		return true
+	default:
+		return false
+	}
+}
+
+// isBeyondHalt returns true if signing at the current height is not allowed.
+func (cs *State) isBeyondHalt() bool {
+	return cs.isHalted() || (cs.haltHeight > 0 && cs.Height > cs.haltHeight)
+}
+
+// extendedPartSetHeaderEnabled returns whether the proposals of the state can
+// extend their block parts with parity parts.
+func extendedPartSetHeaderEnabled(state sm.State) bool {
+	return state.Version.Consensus.App >= types.ExtendedPartSetHeaderAppVersion
+}
+
 func (cs *State) pruneBlocks(retainHeight int64) (uint64, error) {
 	base := cs.blockStore.Base()
 	if retainHeight <= base {
@@ -1851,6 +2008,7 @@ func (cs *State) recordMetrics(height int64, block *types.Block) {
 
 	// trace some metadata about the block
 	schema.WriteBlockSummary(cs.traceClient, block, blockSize)
+	schema.WriteCommittedTxs(cs.traceClient, block.Height, block.Data.Txs)
 
 	cs.metrics.NumTxs.Set(float64(len(block.Data.Txs)))
 	cs.metrics.TotalTxs.Add(float64(len(block.Data.Txs)))
@@ -1878,6 +2036,10 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 		return ErrInvalidProposalPOLRound
 	}
 
+	if proposal.ExtendedPartSetHeader != nil && !extendedPartSetHeaderEnabled(cs.state) {
+		return ErrUnexpectedExtendedHeader
+	}
+
 	p := proposal.ToProto()
 	// Verify signature
 	if !cs.Validators.GetProposer().PubKey.VerifySignature(
@@ -1892,7 +2054,11 @@ func (cs *State) defaultSetProposal(proposal *types.Proposal) error {
 	// This happens if we're already in cstypes.RoundStepCommit or if there is a valid block in the current round.
 	// TODO: We can check if Proposal is for a different block as this is a sign of misbehavior!
 	if cs.ProposalBlockParts == nil {
-		cs.ProposalBlockParts = types.NewPartSetFromHeader(proposal.BlockID.PartSetHeader)
+		if proposal.ExtendedPartSetHeader != nil {
+			cs.ProposalBlockParts = types.NewPartSetFromExtendedHeader(*proposal.ExtendedPartSetHeader)
+		} else {
+			cs.ProposalBlockParts = types.NewPartSetFromHeader(proposal.BlockID.PartSetHeader)
+		}
 	}
 
 	cs.Logger.Info("received proposal", "proposal", proposal)
@@ -1964,6 +2130,11 @@ func (cs *State) addProposalBlockPart(msg *BlockPartMessage, peerID p2p.ID) (add
 		// NOTE: it's possible to receive complete proposal blocks for future rounds without having the proposal
 		cs.Logger.Info("received complete proposal block", "height", cs.ProposalBlock.Height, "hash", cs.ProposalBlock.Hash())
 
+		// our own proposal blocks are complete at once.
+		if cs.privValidatorPubKey == nil || !cs.isProposer(cs.privValidatorPubKey.Address()) {
+			cs.endStepMeasurement(cstypes.RoundStepPropose, cs.Height, cs.Round)
+		}
+
 		if err := cs.eventBus.PublishEventCompleteProposal(cs.CompleteProposalEvent()); err != nil {
 			cs.Logger.Error("failed publishing event complete proposal", "err", err)
 		}
@@ -2119,6 +2290,15 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 		prevotes := cs.Votes.Prevotes(vote.Round)
 		cs.Logger.Debug("added vote to prevote", "vote", vote, "prevotes", prevotes.StringShort())
 
+		// the prevote wait covers the time from any +2/3 prevotes to a
+		// +2/3 majority.
+		if prevotes.HasTwoThirdsAny() {
+			cs.beginStepMeasurement(cstypes.RoundStepPrevoteWait, height, vote.Round)
+		}
+		if _, ok := prevotes.TwoThirdsMajority(); ok {
+			cs.endStepMeasurement(cstypes.RoundStepPrevoteWait, height, vote.Round)
+		}
+
 		// If +2/3 prevotes for a block or nil for *any* round:
 		if blockID, ok := prevotes.TwoThirdsMajority(); ok {
 			// There was a polka!
@@ -2209,8 +2389,15 @@ func (cs *State) addVote(vote *types.Vote, peerID p2p.ID) (added bool, err error
 			"vote_timestamp", vote.Timestamp,
 			"data", precommits.LogString())
 
+		// the precommit wait covers the time from any +2/3 precommits to a
+		// +2/3 majority.
+		if precommits.HasTwoThirdsAny() {
+			cs.beginStepMeasurement(cstypes.RoundStepPrecommitWait, height, vote.Round)
+		}
 		blockID, ok := precommits.TwoThirdsMajority()
 		if ok {
+			cs.endStepMeasurement(cstypes.RoundStepPrecommitWait, height, vote.Round)
+
 			// Executed as TwoThirdsMajority could be from a higher round
 			cs.enterNewRound(height, vote.Round)
 			cs.enterPrecommit(height, vote.Round)
@@ -2241,6 +2428,10 @@ func (cs *State) signVote(
 	hash []byte,
 	header types.PartSetHeader,
 ) (*types.Vote, error) {
+	if cs.isBeyondHalt() {
+		return nil, errHalted
+	}
+
 	// Flush the WAL. Otherwise, we may not recompute the same vote to sign,
 	// and the privValidator will refuse to sign anything.
 	if err := cs.wal.FlushAndSync(); err != nil {
@@ -2273,7 +2464,7 @@ func (cs *State) signVote(
 }
 
 func (cs *State) voteTime() time.Time {
-	now := cmttime.Now()
+	now := cs.now()
 	minVoteTime := now
 	// Minimum time increment between blocks
 	const timeIota = time.Millisecond
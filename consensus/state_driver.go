@@ -0,0 +1,103 @@
+package consensus
+
+import (
+	"github.com/cometbft/cometbft/consensus/internal/driven"
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/p2p"
+)
+
+// The driven mode of the State below is only meant for the deterministic
+// simulations of the consensus/simulation package, which reach it through the
+// hooks of the internal driven package: a node must run the State with Start.
+func init() {
+	driven.Start = (*State).startDriven
+	driven.HandlePeerMessage = (*State).handleDrivenPeerMessage
+	driven.NewTimeoutTicker = newDrivenTicker
+}
+
+// startDriven prepares the State to be driven by its caller instead of its
+// routines: it only schedules the first round on the TimeoutTicker, which
+// isn't started. The caller then delivers the messages of the peers with
+// handleDrivenPeerMessage and the timeouts fired by the ticker, one at a
+// time, and sends the messages of the node they return to the peers. The WAL
+// isn't replayed nor written.
+func (cs *State) startDriven() {
+	cs.haltAtLastBlock()
+	if !cs.isHalted() {
+		cs.scheduleRound0(cs.GetRoundState())
+	}
+}
+
+// handleDrivenPeerMessage handles a proposal, block part or vote of a peer of
+// a driven State, and returns the proposals, block parts and votes of the
+// node it resulted in, in order, once handled.
+func (cs *State) handleDrivenPeerMessage(msg Message, peerID p2p.ID) []Message {
+	cs.handleMsg(msgInfo{msg, peerID})
+	cs.drainStatsMsgQueue()
+	return cs.handleInternalMsgs()
+}
+
+// handleDrivenTimeout handles a timeout fired by the TimeoutTicker of a driven
+// State, and returns the messages of the node it resulted in, as
+// handleDrivenPeerMessage.
+func (cs *State) handleDrivenTimeout(ti timeoutInfo) []Message {
+	cs.handleTimeout(ti, cs.RoundState)
+	return cs.handleInternalMsgs()
+}
+
+// handleInternalMsgs handles the messages of the node queued by the state
+// transitions, until none is left, as the receiveRoutine does, and returns
+// them.
+func (cs *State) handleInternalMsgs() []Message {
+	var msgs []Message
+	for {
+		select {
+		case mi := <-cs.internalMsgQueue:
+			cs.handleMsg(mi)
+			cs.drainStatsMsgQueue()
+			msgs = append(msgs, mi.Msg)
+		default:
+			return msgs
+		}
+	}
+}
+
+// drainStatsMsgQueue drops the statistics for the reactor, which a driven
+// State has none of, so that handleMsg doesn't block on a full queue.
+func (cs *State) drainStatsMsgQueue() {
+	for {
+		select {
+		case <-cs.statsMsgQueue:
+		default:
+			return
+		}
+	}
+}
+
+// drivenTicker is the TimeoutTicker of a driven State. It passes the timeouts
+// scheduled to the caller, which fires them in its own time.
+type drivenTicker struct {
+	service.BaseService
+
+	cs       *State
+	schedule func(driven.Timeout, func() []Message)
+}
+
+var _ TimeoutTicker = (*drivenTicker)(nil)
+
+func newDrivenTicker(cs *State, schedule func(driven.Timeout, func() []Message)) TimeoutTicker {
+	t := &drivenTicker{cs: cs, schedule: schedule}
+	t.BaseService = *service.NewBaseService(nil, "DrivenTicker", t)
+	return t
+}
+
+// Chan implements TimeoutTicker. The timeouts are fired by the caller instead.
+func (t *drivenTicker) Chan() <-chan timeoutInfo {
+	return nil
+}
+
+// ScheduleTimeout implements TimeoutTicker.
+func (t *drivenTicker) ScheduleTimeout(ti timeoutInfo) {
+	t.schedule(driven.Timeout{Duration: ti.Duration, Height: ti.Height, Round: ti.Round, Step: ti.Step},
+		func() []Message { return t.cs.handleDrivenTimeout(ti) })
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"fmt"
+	"os"
 	"testing"
 	"time"
 
@@ -297,6 +298,93 @@ func TestStateOversizedBlock(t *testing.T) {
 	signAddVotes(cs1, cmtproto.PrecommitType, propBlock.Hash(), propBlock.MakePartSet(partSize).Header(), vs2)
 }
 
+func TestStateErasureCodedBlockParts(t *testing.T) {
+	cs1, vss := randState(2)
+	height, round := cs1.Height, cs1.Round
+	vs2 := vss[1]
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+	voteCh := subscribe(cs1.eventBus, types.EventQueryVote)
+
+	// the extended header is rejected before the app version enables it
+	early := types.NewProposal(height, round, -1, types.BlockID{})
+	early.ExtendedPartSetHeader = &types.ExtendedPartSetHeader{}
+	require.ErrorIs(t, cs1.defaultSetProposal(early), ErrUnexpectedExtendedHeader)
+	cs1.state.Version.Consensus.App = types.ExtendedPartSetHeaderAppVersion
+
+	propBlock, _ := cs1.createProposalBlock()
+	propBlock.Data.Txs = []types.Tx{cmtrand.Bytes(3 * int(types.BlockPartSizeBytes))}
+	propBlock.Header.DataHash = propBlock.Data.Hash()
+
+	// make the second validator the proposer by incrementing round
+	round++
+	incrementRound(vss[1:]...)
+
+	propBlockParts := propBlock.MakePartSet(types.BlockPartSizeBytes)
+	require.NoError(t, propBlockParts.Extend())
+	extendedHeader := propBlockParts.ExtendedHeader()
+	blockID := types.BlockID{Hash: propBlock.Hash(), PartSetHeader: propBlockParts.Header()}
+
+	proposal := types.NewProposal(height, round, -1, blockID)
+	proposal.ExtendedPartSetHeader = &extendedHeader
+	p := proposal.ToProto()
+	require.NoError(t, vs2.SignProposal(config.ChainID(), p))
+	proposal.Signature = p.Signature
+
+	// cs1 only receives the parity parts, and the last part
+	require.NoError(t, cs1.SetProposal(proposal, "some peer"))
+	total := int(propBlockParts.Total())
+	for i := total - 1; i < total+int(extendedHeader.ParityTotal)-1; i++ {
+		require.NoError(t, cs1.AddProposalBlockPart(height, round, propBlockParts.GetPart(i), "some peer"))
+	}
+
+	startTestRound(cs1, height, round)
+
+	require.Equal(t, []byte(propBlock.Hash()), ensureNewProposalBlockHash(proposalCh, height, round))
+	ensurePrevote(voteCh, height, round)
+	validatePrevote(t, cs1, round, vss[0], propBlock.Hash())
+}
+
+func TestStateProposeErasureCodedBlockParts(t *testing.T) {
+	config := ResetConfig("consensus_state_erasure_coded_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Consensus.ErasureCodedBlockParts = true
+	state, privVals := randGenesisState(2, false, 10)
+	state.Version.Consensus.App = types.ExtendedPartSetHeaderAppVersion
+	cs1 := newStateWithConfig(config, state, privVals[0], counter.NewApplication(true))
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+
+	rs := cs1.GetRoundState()
+	require.NotNil(t, rs.Proposal.ExtendedPartSetHeader)
+	assert.Equal(t, rs.ProposalBlockParts.Total(), rs.Proposal.ExtendedPartSetHeader.ParityTotal)
+	assert.Equal(t, rs.Proposal.ExtendedPartSetHeader.ParityTotal, rs.ProposalBlockParts.ParityTotal())
+	assert.True(t, rs.ProposalBlockParts.ParityBitArray().IsFull())
+}
+
+func TestStateProposeErasureCodedBlockPartsBeforeAppVersion(t *testing.T) {
+	config := ResetConfig("consensus_state_erasure_coded_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Consensus.ErasureCodedBlockParts = true
+	state, privVals := randGenesisState(2, false, 10)
+	cs1 := newStateWithConfig(config, state, privVals[0], counter.NewApplication(true))
+	height, round := cs1.Height, cs1.Round
+
+	proposalCh := subscribe(cs1.eventBus, types.EventQueryCompleteProposal)
+
+	startTestRound(cs1, height, round)
+	ensureNewProposal(proposalCh, height, round)
+
+	// the proposal is the one every node can verify
+	rs := cs1.GetRoundState()
+	assert.Nil(t, rs.Proposal.ExtendedPartSetHeader)
+	assert.Zero(t, rs.ProposalBlockParts.ParityTotal())
+}
+
 //----------------------------------------------------------------------------------------------------
 // FullRoundSuite
 
@@ -326,8 +414,9 @@ func TestStateFullRound1(t *testing.T) {
 
 	ensureNewRound(newRoundCh, height, round)
 
-	ensureNewProposal(propCh, height, round)
-	propBlockHash := cs.GetRoundState().ProposalBlock.Hash()
+	// NOTE: the proposal block hash is taken from the event since the round
+	// state can't be read once the state blocks on the unbuffered vote channel.
+	propBlockHash := ensureNewProposalBlockHash(propCh, height, round)
 
 	ensurePrevote(voteCh, height, round) // wait for prevote
 	validatePrevote(t, cs, round, vss[0], propBlockHash)
@@ -394,6 +483,102 @@ func TestStateFullRound2(t *testing.T) {
 	ensureNewBlock(newBlockCh, height)
 }
 
+func TestStateAdaptiveTimeouts(t *testing.T) {
+	config := ResetConfig("consensus_state_adaptive_timeouts_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Consensus.AdaptiveTimeouts = true
+	config.Consensus.TimeoutProposeMin = time.Millisecond
+	config.Consensus.TimeoutPrevote = 5 * time.Second
+	config.Consensus.TimeoutPrecommit = 5 * time.Second
+	config.Consensus.TimeoutVoteMin = time.Millisecond
+	config.Consensus.TimeoutVoteMax = 10 * time.Second
+	state, privVals := randGenesisState(4, false, 10)
+	cs1 := newStateWithConfig(config, state, privVals[0], counter.NewApplication(true))
+	vss := make([]*validatorStub, len(privVals))
+	for i, privVal := range privVals {
+		vss[i] = newValidatorStub(privVal, int32(i))
+	}
+	incrementHeight(vss[1:]...)
+	vs2, vs3, vs4 := vss[1], vss[2], vss[3]
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	rs := cs1.GetRoundState()
+	propBlockHash, propPartSetHeader := rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header()
+
+	// any +2/3 of the votes arrive first, and the last vote late: the wait
+	// steps are measured from the former to the latter
+	delay := 100 * time.Millisecond
+	signAddVotes(cs1, cmtproto.PrevoteType, nil, types.PartSetHeader{}, vs2)
+	signAddVotes(cs1, cmtproto.PrevoteType, propBlockHash, propPartSetHeader, vs3)
+	ensurePrevote(voteCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	time.Sleep(delay)
+	signAddVotes(cs1, cmtproto.PrevoteType, propBlockHash, propPartSetHeader, vs4)
+	ensurePrevote(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+
+	signAddVotes(cs1, cmtproto.PrecommitType, nil, types.PartSetHeader{}, vs2)
+	signAddVotes(cs1, cmtproto.PrecommitType, propBlockHash, propPartSetHeader, vs3)
+	ensurePrecommit(voteCh, height, round)
+	ensurePrecommit(voteCh, height, round)
+	time.Sleep(2 * delay)
+	signAddVotes(cs1, cmtproto.PrecommitType, propBlockHash, propPartSetHeader, vs4)
+	ensurePrecommit(voteCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	cs1.mtx.RLock()
+	defer cs1.mtx.RUnlock()
+	assert.GreaterOrEqual(t, cs1.prevoteTimeout(0), delay*3/2)
+	assert.Less(t, cs1.prevoteTimeout(0), 3*delay)
+	assert.GreaterOrEqual(t, cs1.precommitTimeout(0), 3*delay)
+	assert.Equal(t, cs1.precommitTimeout(0)+2*config.Consensus.TimeoutPrecommitDelta, cs1.precommitTimeout(2))
+	// the proposals of cs1 aren't measured
+	assert.Equal(t, config.Consensus.TimeoutPropose, cs1.proposeTimeout(0))
+}
+
+func TestStateAdaptiveTimeoutsExpired(t *testing.T) {
+	config := ResetConfig("consensus_state_adaptive_timeouts_test")
+	defer os.RemoveAll(config.RootDir)
+	config.Consensus.AdaptiveTimeouts = true
+	config.Consensus.TimeoutVoteMin = time.Millisecond
+	config.Consensus.TimeoutVoteMax = 10 * time.Second
+	state, privVals := randGenesisState(4, false, 10)
+	cs1 := newStateWithConfig(config, state, privVals[0], counter.NewApplication(true))
+	vss := make([]*validatorStub, len(privVals))
+	for i, privVal := range privVals {
+		vss[i] = newValidatorStub(privVal, int32(i))
+	}
+	incrementHeight(vss[1:]...)
+	vs2, vs3 := vss[1], vss[2]
+	height, round := cs1.Height, cs1.Round
+
+	voteCh := subscribeUnBuffered(cs1.eventBus, types.EventQueryVote)
+	timeoutWaitCh := subscribe(cs1.eventBus, types.EventQueryTimeoutWait)
+
+	startTestRound(cs1, height, round)
+	ensurePrevote(voteCh, height, round)
+
+	// the last prevote never arrives: the prevote wait is measured at its
+	// timeout, which doesn't raise the timeout
+	rs := cs1.GetRoundState()
+	signAddVotes(cs1, cmtproto.PrevoteType, nil, types.PartSetHeader{}, vs2)
+	signAddVotes(cs1, cmtproto.PrevoteType, rs.ProposalBlock.Hash(), rs.ProposalBlockParts.Header(), vs3)
+	ensurePrevote(voteCh, height, round)
+	ensurePrevote(voteCh, height, round)
+	ensureNewTimeout(timeoutWaitCh, height, round, config.Consensus.TimeoutPrevote.Nanoseconds())
+	ensurePrecommit(voteCh, height, round)
+
+	cs1.mtx.RLock()
+	defer cs1.mtx.RUnlock()
+	assert.Equal(t, config.Consensus.TimeoutPrevote, cs1.prevoteTimeout(0))
+}
+
 //------------------------------------------------------------------------------------------
 // LockSuite
 
@@ -1897,6 +2082,45 @@ func TestSignSameVoteTwice(t *testing.T) {
 	require.Equal(t, vote, vote2)
 }
 
+// the node halts after committing the halt height and stops signing.
+func TestStateHaltHeight(t *testing.T) {
+	cs1, _ := randState(1)
+	height, round := cs1.Height, cs1.Round
+	require.NoError(t, cs1.SetHalt(height, time.Time{}))
+
+	newBlockCh := subscribe(cs1.eventBus, types.EventQueryNewBlock)
+	newRoundCh := subscribe(cs1.eventBus, types.EventQueryNewRound)
+
+	startTestRound(cs1, height, round)
+	ensureNewRound(newRoundCh, height, round)
+	ensureNewBlock(newBlockCh, height)
+
+	select {
+	case <-cs1.Halted():
+	case <-time.After(ensureTimeout):
+		t.Fatal("timed out waiting for the node to halt")
+	}
+	ensureNoNewEvent(newRoundCh, ensureTimeout, "unexpected new round after halting")
+
+	_, err := cs1.signVote(cmtproto.PrevoteType, nil, types.PartSetHeader{})
+	require.ErrorIs(t, err, errHalted)
+}
+
+func TestStateSetHalt(t *testing.T) {
+	cs1, _ := randState(1)
+	cs1.Height = 5
+
+	require.Error(t, cs1.SetHalt(-1, time.Time{}))
+	require.Error(t, cs1.SetHalt(4, time.Time{}))
+	require.NoError(t, cs1.SetHalt(5, time.Time{}))
+	require.NoError(t, cs1.SetHalt(0, time.Time{}))
+
+	haltTime := time.Now()
+	require.NoError(t, cs1.SetHalt(0, haltTime))
+	require.False(t, cs1.shouldHalt(5, haltTime.Add(-time.Second)))
+	require.True(t, cs1.shouldHalt(5, haltTime))
+}
+
 // subscribe subscribes test client to the given query and returns a channel with cap = 1.
 func subscribe(eventBus *types.EventBus, q cmtpubsub.Query) <-chan cmtpubsub.Message {
 	sub, err := eventBus.Subscribe(context.Background(), testSubscriber, q)
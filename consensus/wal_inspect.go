@@ -0,0 +1,293 @@
+package consensus
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	auto "github.com/cometbft/cometbft/libs/autofile"
+	"github.com/cometbft/cometbft/types"
+)
+
+// WALHeight is the summary of the messages of a height in the WAL: the ones
+// after the EndHeightMessage of the previous height, up to its own.
+type WALHeight struct {
+	Height int64 `json:"height"`
+	// the number of messages of each kind, as returned by WALMessageKind.
+	Messages map[string]int `json:"messages"`
+	// false for the height in progress, or whose end was lost.
+	Ended bool `json:"ended"`
+}
+
+// WALMessageKind returns the kind of a WAL message: proposal, block_part,
+// vote, timeout, round_state or end_height, or the type of the message of a
+// peer otherwise.
+func WALMessageKind(msg WALMessage) string {
+	switch m := msg.(type) {
+	case msgInfo:
+		switch m.Msg.(type) {
+		case *ProposalMessage:
+			return "proposal"
+		case *BlockPartMessage:
+			return "block_part"
+		case *VoteMessage:
+			return "vote"
+		default:
+			return fmt.Sprintf("%T", m.Msg)
+		}
+	case timeoutInfo:
+		return "timeout"
+	case types.EventDataRoundState:
+		return "round_state"
+	case EndHeightMessage:
+		return "end_height"
+	default:
+		return fmt.Sprintf("%T", msg)
+	}
+}
+
+// walMessageHeightRound returns the height and the round of a WAL message,
+// and false if it has none, as the EndHeightMessage.
+func walMessageHeightRound(msg WALMessage) (int64, int32, bool) {
+	switch m := msg.(type) {
+	case msgInfo:
+		switch mm := m.Msg.(type) {
+		case *ProposalMessage:
+			return mm.Proposal.Height, mm.Proposal.Round, true
+		case *BlockPartMessage:
+			return mm.Height, mm.Round, true
+		case *VoteMessage:
+			return mm.Vote.Height, mm.Vote.Round, true
+		}
+	case timeoutInfo:
+		return m.Height, m.Round, true
+	case types.EventDataRoundState:
+		return m.Height, m.Round, true
+	}
+	return 0, 0, false
+}
+
+// forEachWALHeight calls fn with the messages of each height of the WAL
+// group, in order, up to the first corrupted message if any. The height of
+// the messages before the first EndHeightMessage, whose previous segments
+// were pruned, is the one of this message, or of the first message with a
+// height if there is none.
+func forEachWALHeight(group *auto.Group, fn func(height int64, msgs []*TimedWALMessage, ended bool)) error {
+	gr, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return err
+	}
+	defer gr.Close()
+
+	var (
+		height  int64 = -1
+		msgs    []*TimedWALMessage
+		readErr error
+	)
+	dec := NewWALDecoder(gr)
+	for {
+		msg, err := dec.Decode()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			readErr = err
+			break
+		}
+		msgs = append(msgs, msg)
+		if m, ok := msg.Msg.(EndHeightMessage); ok {
+			fn(m.Height, msgs, true)
+			height, msgs = m.Height+1, nil
+		}
+	}
+	if len(msgs) > 0 {
+		if height < 0 {
+			for _, msg := range msgs {
+				if h, _, ok := walMessageHeightRound(msg.Msg); ok {
+					height = h
+					break
+				}
+			}
+		}
+		fn(height, msgs, false)
+	}
+	return readErr
+}
+
+// ListWALHeights returns the summary of each height of the WAL group, in
+// order. If the WAL is corrupted, the heights before the corruption are
+// returned with the DataCorruptionError.
+func ListWALHeights(group *auto.Group) ([]WALHeight, error) {
+	var heights []WALHeight
+	err := forEachWALHeight(group, func(height int64, msgs []*TimedWALMessage, ended bool) {
+		wh := WALHeight{Height: height, Messages: make(map[string]int), Ended: ended}
+		for _, msg := range msgs {
+			wh.Messages[WALMessageKind(msg.Msg)]++
+		}
+		heights = append(heights, wh)
+	})
+	return heights, err
+}
+
+// ReadWALHeight returns the messages of the height in the WAL group, of the
+// round only unless negative. The messages without a round, as the
+// EndHeightMessage, are always returned.
+func ReadWALHeight(group *auto.Group, height int64, round int32) ([]*TimedWALMessage, error) {
+	var msgs []*TimedWALMessage
+	err := forEachWALHeight(group, func(h int64, heightMsgs []*TimedWALMessage, _ bool) {
+		if h != height {
+			return
+		}
+		for _, msg := range heightMsgs {
+			if _, r, ok := walMessageHeightRound(msg.Msg); ok && round >= 0 && r != round {
+				continue
+			}
+			msgs = append(msgs, msg)
+		}
+	})
+	return msgs, err
+}
+
+// WALSegment is a file of the WAL group.
+type WALSegment struct {
+	Index int    `json:"index"`
+	Path  string `json:"path"`
+	Size  int64  `json:"size"`
+}
+
+// WALValidation is the result of the validation of a WAL group.
+type WALValidation struct {
+	Segments []WALSegment `json:"segments"`
+	// the number of valid messages, up to the corruption if any.
+	Messages int `json:"messages"`
+	// the segment of the first corrupted message, and its offset in the
+	// segment, if any.
+	Corrupted *WALSegment `json:"corrupted,omitempty"`
+	Offset    int64       `json:"offset,omitempty"`
+	Err       error       `json:"-"`
+}
+
+// countingReader counts the bytes read.
+type countingReader struct {
+	rd io.Reader
+	n  int64
+}
+
+func (cr *countingReader) Read(p []byte) (int, error) {
+	n, err := cr.rd.Read(p)
+	cr.n += int64(n)
+	return n, err
+}
+
+// ValidateWAL checks the CRC and the encoding of the messages of all the
+// segments of the WAL group, in order, up to the first corrupted message.
+// Since messages may span segments, the segments are read as a whole.
+func ValidateWAL(group *auto.Group) (*WALValidation, error) {
+	var v WALValidation
+	for index := group.MinIndex(); index <= group.MaxIndex(); index++ {
+		path := group.FilePathForIndex(index)
+		fi, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		v.Segments = append(v.Segments, WALSegment{Index: index, Path: path, Size: fi.Size()})
+	}
+
+	gr, err := group.NewReader(group.MinIndex())
+	if err != nil {
+		return nil, err
+	}
+	defer gr.Close()
+	cr := &countingReader{rd: gr}
+	dec := NewWALDecoder(cr)
+	for {
+		// the offset of the message in the group.
+		offset := cr.n
+		_, err := dec.Decode()
+		if err == io.EOF {
+			if cr.n == offset {
+				return &v, nil
+			}
+			// the decoder doesn't report a partially written checksum.
+			err = DataCorruptionError{fmt.Errorf("truncated message of %d bytes", cr.n-offset)}
+		}
+		if IsDataCorruptionError(err) {
+			for i := range v.Segments {
+				if offset < v.Segments[i].Size || i == len(v.Segments)-1 {
+					v.Corrupted, v.Offset, v.Err = &v.Segments[i], offset, err
+					return &v, nil
+				}
+				offset -= v.Segments[i].Size
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		v.Messages++
+	}
+}
+
+// RepairWAL truncates the head of the WAL group before its first corrupted
+// message, once copied to backupPath, and returns the number of bytes
+// truncated, 0 if the WAL isn't corrupted. Only a corrupted tail can be
+// truncated: an error is returned if a previous segment is corrupted. The
+// WAL must not be in use.
+func RepairWAL(group *auto.Group, backupPath string) (int64, error) {
+	v, err := ValidateWAL(group)
+	if err != nil {
+		return 0, err
+	}
+	if v.Corrupted == nil {
+		return 0, nil
+	}
+	if v.Corrupted.Index != group.MaxIndex() {
+		return 0, fmt.Errorf("segment %s is corrupted at offset %d, only the head can be truncated: %w",
+			v.Corrupted.Path, v.Offset, v.Err)
+	}
+
+	if err := copyFile(v.Corrupted.Path, backupPath); err != nil {
+		return 0, fmt.Errorf("failed to back up the WAL head: %w", err)
+	}
+	if err := os.Truncate(v.Corrupted.Path, v.Offset); err != nil {
+		return 0, err
+	}
+	return v.Corrupted.Size - v.Offset, nil
+}
+
+// copyFile copies the file at src to dst, which must not exist, and syncs it.
+func copyFile(src, dst string) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer in.Close()
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close()
+		return err
+	}
+	if err := out.Sync(); err != nil {
+		out.Close()
+		return err
+	}
+	return out.Close()
+}
+
+// ErrWALNotFound is returned by OpenWALGroup if there is no WAL at the path.
+var ErrWALNotFound = errors.New("WAL not found")
+
+// OpenWALGroup opens the autofile group of an existing WAL, to inspect or
+// repair it while the node is stopped. The caller must close the group.
+func OpenWALGroup(walFile string) (*auto.Group, error) {
+	if _, err := os.Stat(walFile); err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%w at %s", ErrWALNotFound, walFile)
+		}
+		return nil, err
+	}
+	return auto.OpenGroup(walFile)
+}
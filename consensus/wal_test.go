@@ -175,6 +175,123 @@ func TestWALSearchForEndHeight(t *testing.T) {
 	assert.Equal(t, rs.Height, h+1, "wrong height")
 }
 
+func TestWALInspect(t *testing.T) {
+	walBody, err := WALWithNBlocks(t, 3)
+	require.NoError(t, err)
+	walFile := filepath.Join(t.TempDir(), "wal")
+	require.NoError(t, os.WriteFile(walFile, walBody, 0o600))
+
+	group, err := OpenWALGroup(walFile)
+	require.NoError(t, err)
+	defer group.Close()
+
+	heights, err := ListWALHeights(group)
+	require.NoError(t, err)
+	require.Len(t, heights, 4)
+	for i, h := range heights {
+		assert.EqualValues(t, i, h.Height)
+		// the last height is in progress
+		assert.Equal(t, i < 3, h.Ended)
+		if i > 0 {
+			assert.Equal(t, 1, h.Messages["proposal"])
+			assert.Equal(t, 1, h.Messages["block_part"])
+			assert.Equal(t, 2, h.Messages["vote"])
+		}
+	}
+
+	msgs, err := ReadWALHeight(group, 2, -1)
+	require.NoError(t, err)
+	require.NotEmpty(t, msgs)
+	assert.Equal(t, EndHeightMessage{2}, msgs[len(msgs)-1].Msg)
+	for _, msg := range msgs[:len(msgs)-1] {
+		height, _, ok := walMessageHeightRound(msg.Msg)
+		require.True(t, ok)
+		assert.EqualValues(t, 2, height)
+	}
+	msgs, err = ReadWALHeight(group, 2, 1)
+	require.NoError(t, err)
+	assert.Len(t, msgs, 1)
+
+	v, err := ValidateWAL(group)
+	require.NoError(t, err)
+	assert.Nil(t, v.Corrupted)
+	assert.Equal(t, 30, v.Messages)
+	require.Len(t, v.Segments, 1)
+	assert.EqualValues(t, len(walBody), v.Segments[0].Size)
+
+	_, err = OpenWALGroup(filepath.Join(t.TempDir(), "wal"))
+	assert.ErrorIs(t, err, ErrWALNotFound)
+}
+
+func TestWALRepair(t *testing.T) {
+	walBody, err := WALWithNBlocks(t, 3)
+	require.NoError(t, err)
+	corrupted := append([]byte(nil), walBody...)
+	corrupted[len(corrupted)/2] ^= 0xFF
+
+	testCases := map[string][]byte{
+		"truncated message": append(walBody[:len(walBody):len(walBody)], walBody[:10]...),
+		"truncated header":  append(walBody[:len(walBody):len(walBody)], walBody[:2]...),
+		"corrupted message": corrupted,
+	}
+	for name, data := range testCases {
+		t.Run(name, func(t *testing.T) {
+			dir := t.TempDir()
+			walFile := filepath.Join(dir, "wal")
+			require.NoError(t, os.WriteFile(walFile, data, 0o600))
+			group, err := OpenWALGroup(walFile)
+			require.NoError(t, err)
+			defer group.Close()
+
+			v, err := ValidateWAL(group)
+			require.NoError(t, err)
+			require.NotNil(t, v.Corrupted)
+			assert.Equal(t, walFile, v.Corrupted.Path)
+			assert.True(t, IsDataCorruptionError(v.Err))
+
+			backup := filepath.Join(dir, "backup")
+			truncated, err := RepairWAL(group, backup)
+			require.NoError(t, err)
+			assert.EqualValues(t, len(data)-int(v.Offset), truncated)
+			bz, err := os.ReadFile(backup)
+			require.NoError(t, err)
+			assert.Equal(t, data, bz)
+			bz, err = os.ReadFile(walFile)
+			require.NoError(t, err)
+			assert.Equal(t, data[:v.Offset], bz)
+
+			v, err = ValidateWAL(group)
+			require.NoError(t, err)
+			assert.Nil(t, v.Corrupted)
+			truncated, err = RepairWAL(group, backup)
+			require.NoError(t, err)
+			assert.Zero(t, truncated)
+		})
+	}
+
+	t.Run("corrupted segment", func(t *testing.T) {
+		dir := t.TempDir()
+		walFile := filepath.Join(dir, "wal")
+		require.NoError(t, os.WriteFile(walFile+".000", corrupted, 0o600))
+		require.NoError(t, os.WriteFile(walFile, walBody, 0o600))
+		group, err := OpenWALGroup(walFile)
+		require.NoError(t, err)
+		defer group.Close()
+
+		v, err := ValidateWAL(group)
+		require.NoError(t, err)
+		require.Len(t, v.Segments, 2)
+		require.NotNil(t, v.Corrupted)
+		assert.Equal(t, walFile+".000", v.Corrupted.Path)
+
+		_, err = RepairWAL(group, filepath.Join(dir, "backup"))
+		assert.Error(t, err)
+		bz, err := os.ReadFile(walFile + ".000")
+		require.NoError(t, err)
+		assert.Equal(t, corrupted, bz)
+	})
+}
+
 func TestWALPeriodicSync(t *testing.T) {
 	walDir, err := os.MkdirTemp("", "wal")
 	require.NoError(t, err)
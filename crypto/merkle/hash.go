@@ -42,3 +42,15 @@ func innerHashOpt(h hash.Hash, left []byte, right []byte) []byte {
 	h.Write(right)
 	return h.Sum(nil)
 }
+
+// LeafHash returns the hash of a leaf of a tree hashed by HashFromByteSlices,
+// for the trees whose subtrees are stored outside of this package.
+func LeafHash(leaf []byte) []byte {
+	return leafHash(leaf)
+}
+
+// InnerHash returns the hash of a node of a tree hashed by HashFromByteSlices
+// whose children hash to left and right.
+func InnerHash(left []byte, right []byte) []byte {
+	return innerHash(left, right)
+}
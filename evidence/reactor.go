@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/cometbft/cometbft/behaviour"
 	clist "github.com/cometbft/cometbft/libs/clist"
 	"github.com/cometbft/cometbft/libs/log"
 	"github.com/cometbft/cometbft/p2p"
@@ -31,6 +32,7 @@ type Reactor struct {
 	p2p.BaseReactor
 	evpool   *Pool
 	eventBus *types.EventBus
+	reporter behaviour.Reporter
 }
 
 // NewReactor returns a new Reactor with the given config and evpool.
@@ -48,6 +50,12 @@ func (evR *Reactor) SetLogger(l log.Logger) {
 	evR.evpool.SetLogger(l)
 }
 
+// OnStart implements service.Service.
+func (evR *Reactor) OnStart() error {
+	evR.reporter = behaviour.NewSwitchReporter(evR.Switch)
+	return nil
+}
+
 // GetChannels implements Reactor.
 // It returns the list of channels for this reactor.
 func (evR *Reactor) GetChannels() []*p2p.ChannelDescriptor {
@@ -72,7 +80,7 @@ func (evR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	evis, err := evidenceListFromProto(e.Message)
 	if err != nil {
 		evR.Logger.Error("Error decoding message", "src", e.Src, "chId", e.ChannelID, "err", err)
-		evR.Switch.StopPeerForError(e.Src, err)
+		_ = evR.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 		return
 	}
 
@@ -82,9 +90,10 @@ func (evR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		case *types.ErrInvalidEvidence:
 			evR.Logger.Error(err.Error())
 			// punish peer
-			evR.Switch.StopPeerForError(e.Src, err)
+			_ = evR.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 			return
 		case nil:
+			_ = evR.reporter.Report(behaviour.GoodMessage(e.Src.ID(), "sent valid evidence"))
 		default:
 			// continue to the next piece of evidence
 			evR.Logger.Error("Evidence has not been added", "evidence", evis, "err", err)
@@ -0,0 +1,216 @@
+// Package inspect serves the RPC of a node from its data directory, without
+// starting the node. It is meant for debugging a node that halted, for example
+// on an app hash mismatch: the block store, the state store and the indexers
+// are opened, but neither the application, p2p, consensus nor the mempool are
+// started, so only the routes that read stored data are available.
+package inspect
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtos "github.com/cometbft/cometbft/libs/os"
+	rpccore "github.com/cometbft/cometbft/rpc/core"
+	rpcserver "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/indexer"
+	blockidxkv "github.com/cometbft/cometbft/state/indexer/block/kv"
+	blockidxnull "github.com/cometbft/cometbft/state/indexer/block/null"
+	"github.com/cometbft/cometbft/state/indexer/sink/psql"
+	"github.com/cometbft/cometbft/state/txindex"
+	"github.com/cometbft/cometbft/state/txindex/kv"
+	"github.com/cometbft/cometbft/state/txindex/null"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Inspector serves the RPC routes that only read the stored blocks, states
+// and indexed events of a node.
+type Inspector struct {
+	config *cfg.RPCConfig
+	env    *rpccore.Environment
+	logger log.Logger
+
+	// closers close the databases opened by NewFromConfig once Run returns.
+	closers []func() error
+}
+
+// New returns an Inspector serving the data of the given stores on the RPC
+// listen addresses of the config.
+func New(
+	config *cfg.RPCConfig,
+	blockStore sm.BlockStore,
+	stateStore sm.Store,
+	genDoc *types.GenesisDoc,
+	txIndexer txindex.TxIndexer,
+	blockIndexer indexer.BlockIndexer,
+	logger log.Logger,
+) *Inspector {
+	return &Inspector{
+		config: config,
+		env: &rpccore.Environment{
+			ProxyAppQuery: noApp{},
+			StateStore:    stateStore,
+			BlockStore:    blockStore,
+			GenDoc:        genDoc,
+			TxIndexer:     txIndexer,
+			BlockIndexer:  blockIndexer,
+			Logger:        logger.With("module", "rpc"),
+			Config:        *config,
+		},
+		logger: logger,
+	}
+}
+
+// NewFromConfig opens the block store, the state store and the indexers of
+// the node with the given config, read-only, and returns an Inspector serving
+// them. They are closed once Run returns.
+func NewFromConfig(config *cfg.Config, logger log.Logger) (ins *Inspector, err error) {
+	genDoc, err := types.GenesisDocFromFile(config.GenesisFile())
+	if err != nil {
+		return nil, err
+	}
+	// don't create empty databases when pointed at the wrong directory.
+	for _, name := range []string{"blockstore", "state"} {
+		if !cmtos.FileExists(filepath.Join(config.DBDir(), name+".db")) {
+			return nil, fmt.Errorf("no %s found in %v", name, config.DBDir())
+		}
+	}
+
+	var closers []func() error
+	defer func() {
+		if err != nil {
+			closeAll(closers, logger)
+		}
+	}()
+	blockStoreDB, err := openDB("blockstore", config)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, blockStoreDB.Close)
+	coldTier, err := store.NewSegmentStore(config.ColdStorageDir())
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, coldTier.Close)
+	stateDB, err := openDB("state", config)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, stateDB.Close)
+	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
+		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+	})
+	txIndexer, blockIndexer, closeIndexers, err := loadIndexers(config, genDoc.ChainID)
+	if err != nil {
+		return nil, err
+	}
+	closers = append(closers, closeIndexers)
+	blockStore := store.NewBlockStore(blockStoreDB, store.WithColdTier(coldTier))
+	ins = New(config.RPC, blockStore, stateStore, genDoc, txIndexer, blockIndexer, logger)
+	ins.closers = closers
+	return ins, nil
+}
+
+// openDB opens the database read-only, so that inspecting a node can't modify
+// its data. Only the goleveldb backend supports it.
+func openDB(name string, config *cfg.Config) (dbm.DB, error) {
+	if dbm.BackendType(config.DBBackend) != dbm.GoLevelDBBackend {
+		return nil, fmt.Errorf("the %s backend can't be opened read-only, only %s can be inspected",
+			config.DBBackend, dbm.GoLevelDBBackend)
+	}
+	return dbm.NewGoLevelDBWithOpts(name, config.DBDir(), &opt.Options{ReadOnly: true})
+}
+
+// loadIndexers opens the indexers configured for the node, without starting
+// the indexer service, and returns the function closing them.
+func loadIndexers(config *cfg.Config, chainID string) (txindex.TxIndexer, indexer.BlockIndexer, func() error, error) {
+	switch config.TxIndex.Indexer {
+	case "kv":
+		store, err := openDB("tx_index", config)
+		if err != nil {
+			return nil, nil, nil, err
+		}
+		return kv.NewTxIndex(store), blockidxkv.New(dbm.NewPrefixDB(store, []byte("block_events"))), store.Close, nil
+
+	case "psql":
+		if config.TxIndex.PsqlConn == "" {
+			return nil, nil, nil, errors.New(`no psql-conn is set for the "psql" indexer`)
+		}
+		es, err := psql.NewEventSink(config.TxIndex.PsqlConn, chainID)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("creating psql indexer: %w", err)
+		}
+		return es.TxIndexer(), es.BlockIndexer(), es.Stop, nil
+
+	default:
+		return &null.TxIndex{}, &blockidxnull.BlockerIndexer{}, func() error { return nil }, nil
+	}
+}
+
+func closeAll(closers []func() error, logger log.Logger) {
+	for _, closer := range closers {
+		if err := closer(); err != nil {
+			logger.Error("Error closing the inspected databases", "err", err)
+		}
+	}
+}
+
+// Run serves the RPC until ctx is cancelled.
+func (ins *Inspector) Run(ctx context.Context) error {
+	defer closeAll(ins.closers, ins.logger)
+	rpccore.SetEnvironment(ins.env)
+	if err := rpccore.InitGenesisChunks(); err != nil {
+		return err
+	}
+
+	config := rpcserver.DefaultConfig()
+	config.MaxBodyBytes = ins.config.MaxBodyBytes
+	config.MaxHeaderBytes = ins.config.MaxHeaderBytes
+	config.MaxOpenConnections = ins.config.MaxOpenConnections
+
+	mux := http.NewServeMux()
+	rpcLogger := ins.logger.With("module", "rpc-server")
+	rpcserver.RegisterRPCFuncs(mux, Routes(), rpcLogger)
+
+	var listeners []net.Listener
+	defer func() {
+		for _, listener := range listeners {
+			listener.Close()
+		}
+	}()
+	errCh := make(chan error, 1)
+	for _, listenAddr := range strings.Split(ins.config.ListenAddress, ",") {
+		listener, err := rpcserver.Listen(strings.TrimSpace(listenAddr), config)
+		if err != nil {
+			return err
+		}
+		listeners = append(listeners, listener)
+		go func() {
+			if err := rpcserver.Serve(listener, mux, rpcLogger, config); err != nil && ctx.Err() == nil {
+				select {
+				case errCh <- err:
+				default:
+				}
+			}
+		}()
+	}
+	ins.logger.Info("Inspecting the node data", "laddr", ins.config.ListenAddress)
+
+	select {
+	case <-ctx.Done():
+		return nil
+	case err := <-errCh:
+		return err
+	}
+}
@@ -0,0 +1,116 @@
+package inspect_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/inspect"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtnet "github.com/cometbft/cometbft/libs/net"
+	httpclient "github.com/cometbft/cometbft/rpc/client/http"
+	"github.com/cometbft/cometbft/state/indexer/block/null"
+	"github.com/cometbft/cometbft/state/mocks"
+	txnull "github.com/cometbft/cometbft/state/txindex/null"
+	"github.com/cometbft/cometbft/types"
+)
+
+func TestInspect(t *testing.T) {
+	block := types.MakeBlock(10, []types.Tx{types.Tx("tx")}, nil, nil)
+	blockStore := mocks.NewBlockStore(t)
+	blockStore.On("Base").Return(int64(1))
+	blockStore.On("Height").Return(int64(10))
+	blockStore.On("LoadBlock", int64(10)).Return(block)
+	blockStore.On("LoadBlockMeta", int64(10)).Return(&types.BlockMeta{BlockID: types.BlockID{Hash: block.Hash()}})
+	stateStore := mocks.NewStore(t)
+	stateStore.On("LoadConsensusParams", mock.Anything).Return(*types.DefaultConsensusParams(), nil)
+
+	port, err := cmtnet.GetFreePort()
+	require.NoError(t, err)
+	cfg := config.TestRPCConfig()
+	cfg.ListenAddress = fmt.Sprintf("tcp://127.0.0.1:%d", port)
+	genDoc := &types.GenesisDoc{ChainID: "inspect-test", GenesisTime: time.Now()}
+	require.NoError(t, genDoc.ValidateAndComplete())
+	ins := inspect.New(cfg, blockStore, stateStore, genDoc, &txnull.TxIndex{}, &null.BlockerIndexer{}, log.TestingLogger())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error)
+	go func() { done <- ins.Run(ctx) }()
+	defer func() {
+		cancel()
+		require.NoError(t, <-done)
+	}()
+
+	c, err := httpclient.New(cfg.ListenAddress, "/websocket")
+	require.NoError(t, err)
+	require.Eventually(t, func() bool {
+		_, err := c.Health(ctx)
+		return err == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	// routes reading the stored data are served.
+	height := int64(10)
+	res, err := c.Block(ctx, &height)
+	require.NoError(t, err)
+	require.Equal(t, block.Hash(), res.Block.Hash())
+
+	params, err := c.ConsensusParams(ctx, nil)
+	require.NoError(t, err)
+	require.Equal(t, int64(11), params.BlockHeight)
+
+	genesis, err := c.Genesis(ctx)
+	require.NoError(t, err)
+	require.Equal(t, genDoc.ChainID, genesis.Genesis.ChainID)
+
+	// routes that need a running node fail.
+	_, err = c.Status(ctx)
+	require.ErrorContains(t, err, inspect.ErrNodeNotRunning.Error())
+	_, err = c.BroadcastTxSync(ctx, types.Tx("tx"))
+	require.ErrorContains(t, err, inspect.ErrNodeNotRunning.Error())
+	_, err = c.Tx(ctx, types.Tx("tx").Hash(), true)
+	require.Error(t, err)
+}
+
+func TestNewFromConfigReadOnly(t *testing.T) {
+	cfg := config.ResetTestRoot("inspect")
+	t.Cleanup(func() { os.RemoveAll(cfg.RootDir) })
+	cfg.DBBackend = string(dbm.GoLevelDBBackend)
+	for _, name := range []string{"blockstore", "state"} {
+		db, err := dbm.NewGoLevelDB(name, cfg.DBDir())
+		require.NoError(t, err)
+		require.NoError(t, db.Close())
+	}
+
+	// the missing tx index isn't created, and the databases opened before
+	// are closed.
+	cfg.TxIndex.Indexer = "kv"
+	_, err := inspect.NewFromConfig(cfg, log.TestingLogger())
+	require.Error(t, err)
+	require.NoDirExists(t, filepath.Join(cfg.DBDir(), "tx_index.db"))
+	db, err := dbm.NewGoLevelDB("blockstore", cfg.DBDir())
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	cfg.TxIndex.Indexer = "null"
+	ins, err := inspect.NewFromConfig(cfg, log.TestingLogger())
+	require.NoError(t, err)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.NoError(t, ins.Run(ctx))
+	// the databases are closed once Run returns.
+	db, err = dbm.NewGoLevelDB("blockstore", cfg.DBDir())
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	cfg.DBBackend = string(dbm.MemDBBackend)
+	_, err = inspect.NewFromConfig(cfg, log.TestingLogger())
+	require.Error(t, err)
+}
@@ -0,0 +1,66 @@
+package inspect
+
+import (
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	rpccore "github.com/cometbft/cometbft/rpc/core"
+	rpc "github.com/cometbft/cometbft/rpc/jsonrpc/server"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// ErrNodeNotRunning is returned by the routes, and the parts of routes, that
+// need the application, p2p, consensus or the mempool of a running node.
+var ErrNodeNotRunning = errors.New("not available in inspect mode, the node is not running")
+
+// inspectRoutes are the routes served from the stored data.
+var inspectRoutes = []string{
+	"health",
+	"genesis",
+	"genesis_chunked",
+	"blockchain",
+	"block",
+	"signed_block",
+	"block_by_hash",
+	"block_results",
+	"commit",
+	"header",
+	"header_by_hash",
+	"tx",
+	"tx_search",
+	"block_search",
+	"validators",
+	"consensus_params",
+}
+
+// Routes returns the routes of a running node, where the routes that need a
+// running node fail with ErrNodeNotRunning.
+func Routes() map[string]*rpc.RPCFunc {
+	routes := make(map[string]*rpc.RPCFunc, len(rpccore.Routes))
+	for name := range rpccore.Routes {
+		routes[name] = rpc.NewRPCFunc(notRunning(name), "")
+	}
+	for _, name := range inspectRoutes {
+		routes[name] = rpccore.Routes[name]
+	}
+	return routes
+}
+
+func notRunning(route string) func(*rpctypes.Context) (interface{}, error) {
+	return func(*rpctypes.Context) (interface{}, error) {
+		return nil, fmt.Errorf("%s: %w", route, ErrNodeNotRunning)
+	}
+}
+
+// noApp is the connection to the application of a stopped node, used by the
+// routes that prove the inclusion of transactions.
+type noApp struct{}
+
+func (noApp) Error() error { return ErrNodeNotRunning }
+
+func (noApp) EchoSync(string) (*abci.ResponseEcho, error) { return nil, ErrNodeNotRunning }
+
+func (noApp) InfoSync(abci.RequestInfo) (*abci.ResponseInfo, error) { return nil, ErrNodeNotRunning }
+
+func (noApp) QuerySync(abci.RequestQuery) (*abci.ResponseQuery, error) { return nil, ErrNodeNotRunning }
@@ -410,6 +410,14 @@ func (g *Group) readGroupInfo() GroupInfo {
 	return GroupInfo{minIndex, maxIndex, totalSize, headSize}
 }
 
+// FilePathForIndex returns the path of the file of the group at index, the
+// head being at the max index.
+func (g *Group) FilePathForIndex(index int) string {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	return filePathForIndex(g.Head.Path, index, g.maxIndex)
+}
+
 func filePathForIndex(headPath string, index int, maxIndex int) string {
 	if index == maxIndex {
 		return headPath
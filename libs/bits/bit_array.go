@@ -3,6 +3,7 @@ package bits
 import (
 	"encoding/binary"
 	"fmt"
+	"math/bits"
 	"regexp"
 	"strings"
 	"sync"
@@ -238,6 +239,24 @@ func (bA *BitArray) IsFull() bool {
 	return (lastElem+1)&((uint64(1)<<uint(lastElemBits))-1) == 0
 }
 
+// Count returns the number of bits set in the bit array.
+func (bA *BitArray) Count() int {
+	if bA == nil {
+		return 0
+	}
+	bA.mtx.Lock()
+	defer bA.mtx.Unlock()
+	count := 0
+	for i, e := range bA.Elems {
+		if i == len(bA.Elems)-1 {
+			// ignore the bits past the end, which Not sets
+			e &= ^uint64(0) >> uint(63-(bA.Bits+63)%64)
+		}
+		count += bits.OnesCount64(e)
+	}
+	return count
+}
+
 // PickRandom returns a random index for a set bit in the bit array.
 // If there is no such value, it returns 0, false.
 // It uses the global randomness in `random.go` to get this index.
@@ -188,6 +188,19 @@ func TestEmptyFull(t *testing.T) {
 	}
 }
 
+func TestCount(t *testing.T) {
+	var nilBA *BitArray
+	assert.Equal(t, 0, nilBA.Count())
+
+	bA := NewBitArray(123)
+	assert.Equal(t, 0, bA.Count())
+	for i := 0; i < 123; i += 3 {
+		bA.SetIndex(i, true)
+	}
+	assert.Equal(t, 41, bA.Count())
+	assert.Equal(t, 82, bA.Not().Count())
+}
+
 func TestUpdateNeverPanics(t *testing.T) {
 	newRandBitArray := func(n int) *BitArray {
 		ba, _ := randBitArray(n)
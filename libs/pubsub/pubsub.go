@@ -37,6 +37,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"time"
 
 	"github.com/cometbft/cometbft/libs/service"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
@@ -51,6 +52,11 @@ const (
 	shutdown
 )
 
+// outOfCapacityGracePeriod is how long a buffered subscription may stay full
+// before it is cancelled with ErrOutOfCapacity. The client may be ready to
+// read but not scheduled yet, e.g. with a single processor.
+const outOfCapacityGracePeriod = 100 * time.Millisecond
+
 var (
 	// ErrSubscriptionNotFound is returned when a client tries to unsubscribe
 	// from not existing subscription.
@@ -422,7 +428,13 @@ func (state *state) send(msg interface{}, events map[string][]string) error {
 					select {
 					case subscription.out <- NewMessage(msg, events):
 					default:
-						state.remove(clientID, qStr, ErrOutOfCapacity)
+						timer := time.NewTimer(outOfCapacityGracePeriod)
+						select {
+						case subscription.out <- NewMessage(msg, events):
+							timer.Stop()
+						case <-timer.C:
+							state.remove(clientID, qStr, ErrOutOfCapacity)
+						}
 					}
 				}
 			}
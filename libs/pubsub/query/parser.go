@@ -0,0 +1,182 @@
+package query
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MaxInValues is the maximum number of values of an IN condition.
+const MaxInValues = 100
+
+// parse parses the query s with the grammar of query.peg and returns its
+// syntax tree.
+func parse(s string) (Expr, error) {
+	p := &QueryParser{Buffer: fmt.Sprintf(`"%s"`, s)}
+	p.Init()
+	if err := p.Parse(); err != nil {
+		return Expr{}, err
+	}
+	// the positions of the tokens are in runes.
+	b := &exprBuilder{buffer: []rune(p.Buffer)}
+	return b.disjunction(p.AST().up)
+}
+
+// exprBuilder builds the syntax tree of a query from its parse tree, where
+// each node is a rule of the grammar and its children the rules it matched.
+type exprBuilder struct {
+	buffer []rune
+}
+
+func (b *exprBuilder) text(node *node32) string {
+	return string(b.buffer[node.begin:node.end])
+}
+
+// disjunction and conjunction return the expression of their single operand,
+// or join their operands with OR and AND.
+func (b *exprBuilder) disjunction(node *node32) (Expr, error) {
+	return b.join(node, ExprOr, ruleconjunction, b.conjunction)
+}
+
+func (b *exprBuilder) conjunction(node *node32) (Expr, error) {
+	return b.join(node, ExprAnd, ruleunary, b.unary)
+}
+
+func (b *exprBuilder) join(
+	node *node32,
+	kind ExprKind,
+	operandRule pegRule,
+	operand func(*node32) (Expr, error),
+) (Expr, error) {
+	var operands []Expr
+	for child := node.up; child != nil; child = child.next {
+		if child.pegRule != operandRule {
+			continue
+		}
+		expr, err := operand(child)
+		if err != nil {
+			return Expr{}, err
+		}
+		operands = append(operands, expr)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return Expr{Kind: kind, Operands: operands}, nil
+}
+
+func (b *exprBuilder) unary(node *node32) (Expr, error) {
+	child := node.up
+	switch child.pegRule {
+	case rulenot:
+		operand, err := b.unary(child.next)
+		if err != nil {
+			return Expr{}, err
+		}
+		return Expr{Kind: ExprNot, Operands: []Expr{operand}}, nil
+	case ruledisjunction:
+		return b.disjunction(child)
+	default:
+		return b.condition(child)
+	}
+}
+
+// condition returns the expression of a condition. An IN condition is the
+// disjunction of the equality conditions for each of its values.
+func (b *exprBuilder) condition(node *node32) (Expr, error) {
+	tag := node.up
+	opNode := tag.next
+	eventAttr := b.text(tag)
+
+	var op Operator
+	switch opNode.pegRule {
+	case rulele:
+		op = OpLessEqual
+	case rulege:
+		op = OpGreaterEqual
+	case rulel:
+		op = OpLess
+	case ruleg:
+		op = OpGreater
+	case ruleequal, rulein:
+		op = OpEqual
+	case rulecontains:
+		op = OpContains
+	case ruleexists:
+		return conditionExpr(eventAttr, OpExists, nil), nil
+	}
+
+	var operands []Expr
+	for node := opNode.next; node != nil; node = node.next {
+		operandNode := node
+		if operandNode.pegRule == ruleoperand {
+			operandNode = operandNode.up
+		}
+		operand, err := b.operand(operandNode)
+		if err != nil {
+			return Expr{}, err
+		}
+		operands = append(operands, conditionExpr(eventAttr, op, operand))
+	}
+	if len(operands) > MaxInValues {
+		return Expr{}, fmt.Errorf("%s has %d values, the maximum is %d", eventAttr, len(operands), MaxInValues)
+	}
+	if len(operands) == 1 {
+		return operands[0], nil
+	}
+	return Expr{Kind: ExprOr, Operands: operands}, nil
+}
+
+func (b *exprBuilder) operand(node *node32) (interface{}, error) {
+	text := b.text(node.up)
+	switch node.pegRule {
+	case rulevalue:
+		// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
+		return text[1 : len(text)-1], nil
+
+	case rulenumber:
+		if strings.ContainsAny(text, ".") { // if it looks like a floating-point number
+			value, err := strconv.ParseFloat(text, 64)
+			if err != nil {
+				return nil, fmt.Errorf(
+					"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
+					err, text,
+				)
+			}
+			return value, nil
+		}
+		value, err := strconv.ParseInt(text, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
+				err, text,
+			)
+		}
+		return value, nil
+
+	case ruletime:
+		value, err := time.Parse(TimeLayout, text)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
+				err, text,
+			)
+		}
+		return value, nil
+
+	default: // ruledate
+		value, err := time.Parse(DateLayout, text)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
+				err, text,
+			)
+		}
+		return value, nil
+	}
+}
+
+func conditionExpr(tag string, op Operator, operand interface{}) Expr {
+	return Expr{Kind: ExprCondition, Condition: Condition{CompositeKey: tag, Op: op, Operand: operand}}
+}
@@ -1,6 +1,7 @@
 package query_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -84,6 +85,34 @@ func TestParser(t *testing.T) {
 
 		{"hash='136E18F7E4C348B780CF873A0BF43922E5BAFA63'", true},
 		{"hash=136E18F7E4C348B780CF873A0BF43922E5BAFA63", false},
+
+		{"tx.sender = 'a' OR tx.sender = 'b'", true},
+		{"tx.sender = 'a' OR", false},
+		{"OR tx.sender = 'a'", false},
+		{"tx.sender = 'a' OR tx.fee > 5 AND tx.fee < 10", true},
+		{"(tx.sender = 'a' OR tx.sender = 'b') AND tx.fee > 5", true},
+		{"((tx.sender = 'a'))", true},
+		{"(tx.sender = 'a'", false},
+		{"tx.sender = 'a')", false},
+		{"()", false},
+
+		{"NOT tx.sender = 'a'", true},
+		{"tx.fee > 5 AND NOT (tx.sender = 'a' OR tx.sender = 'b')", true},
+		{"NOT NOT slashing EXISTS", true},
+		{"tx.sender NOT = 'a'", false},
+		{"NOT", false},
+
+		{"tx.sender IN ('a', 'b')", true},
+		{"tx.fee IN (1,2,3)", true},
+		{"tx.date IN (DATE 2013-05-03, DATE 2013-05-04)", true},
+		{"tx.sender IN ('a')", true},
+		{"tx.sender IN ()", false},
+		{"tx.sender IN ('a',)", false},
+		{"tx.sender IN ('a' 'b')", false},
+		{"tx.sender IN 'a'", false},
+		{"tx.fee IN (1AND)", false},
+		{"tx.sender IN (" + strings.Repeat("'a', ", query.MaxInValues-1) + "'a')", true},
+		{"tx.sender IN (" + strings.Repeat("'a', ", query.MaxInValues) + "'a')", false},
 	}
 
 	for _, c := range cases {
@@ -1,11 +1,15 @@
 // Package query implements the custom query format used to filter event
-// subscriptions in CometBFT.
+// subscriptions and search the indexed events in CometBFT.
 //
-//	abci.invoice.number=22 AND abci.invoice.owner=Ivan
+//	abci.invoice.number=22 AND abci.invoice.owner='Ivan'
+//	tx.sender = 'a' OR (tx.sender IN ('b', 'c') AND NOT tx.fee < 100)
 //
 // See query.peg for the grammar, which is a https://en.wikipedia.org/wiki/Parsing_expression_grammar.
 // More: https://github.com/PhilippeSigaud/Pegged/wiki/PEG-Basics
 //
+// Conditions are joined with AND, OR and NOT, AND binding tighter than OR, and
+// grouped with parentheses.
+//
 // It has a support for numbers (integer and floating point), dates and times.
 package query
 
@@ -22,10 +26,10 @@ var (
 	numRegex = regexp.MustCompile(`([0-9\.]+)`)
 )
 
-// Query holds the query string and the query parser.
+// Query holds the query string and its syntax tree.
 type Query struct {
-	str    string
-	parser *QueryParser
+	str  string
+	expr Expr
 }
 
 // Condition represents a single condition within a query and consists of composite key
@@ -39,12 +43,11 @@ type Condition struct {
 // New parses the given string and returns a query or error if the string is
 // invalid.
 func New(s string) (*Query, error) {
-	p := &QueryParser{Buffer: fmt.Sprintf(`"%s"`, s)}
-	p.Init()
-	if err := p.Parse(); err != nil {
+	expr, err := parse(s)
+	if err != nil {
 		return nil, err
 	}
-	return &Query{str: s, parser: p}, nil
+	return &Query{str: s, expr: expr}, nil
 }
 
 // MustParse turns the given string into a query or panics; for tests or others
@@ -90,105 +93,64 @@ const (
 	TimeLayout = time.RFC3339
 )
 
-// Conditions returns a list of conditions. It returns an error if there is any
-// error with the provided grammar in the Query.
-func (q *Query) Conditions() ([]Condition, error) {
-	var (
-		eventAttr string
-		op        Operator
-	)
-
-	conditions := make([]Condition, 0)
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order: tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
-		case ruletag:
-			eventAttr = buffer[begin:end]
-
-		case rulele:
-			op = OpLessEqual
-
-		case rulege:
-			op = OpGreaterEqual
-
-		case rulel:
-			op = OpLess
-
-		case ruleg:
-			op = OpGreater
-
-		case ruleequal:
-			op = OpEqual
-
-		case rulecontains:
-			op = OpContains
-
-		case ruleexists:
-			op = OpExists
-			conditions = append(conditions, Condition{eventAttr, op, nil})
-
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
-			conditions = append(conditions, Condition{eventAttr, op, valueWithoutSingleQuotes})
-
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return nil, err
-				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return nil, err
-				}
-
-				conditions = append(conditions, Condition{eventAttr, op, value})
-			}
+// ExprKind is the kind of a node in the syntax tree of a query.
+type ExprKind uint8
 
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return nil, err
-			}
+const (
+	// ExprCondition is a single condition, e.g. "tx.gas > 7".
+	ExprCondition ExprKind = iota
+	// ExprAnd holds if all of its operands hold.
+	ExprAnd
+	// ExprOr holds if any of its operands holds.
+	ExprOr
+	// ExprNot holds if its single operand doesn't hold.
+	ExprNot
+)
 
-			conditions = append(conditions, Condition{eventAttr, op, value})
+// Expr is a node in the syntax tree of a query. An IN condition is the
+// disjunction of the equality conditions for each of its operands.
+type Expr struct {
+	Kind ExprKind
+	// Condition is set for an ExprCondition.
+	Condition Condition
+	// Operands are set for an ExprAnd, ExprOr and ExprNot.
+	Operands []Expr
+}
 
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return nil, err
+// Conditions returns the conditions of the expression and true if it is a
+// single condition or the conjunction of conditions, and false otherwise.
+func (e Expr) Conditions() ([]Condition, bool) {
+	switch e.Kind {
+	case ExprCondition:
+		return []Condition{e.Condition}, true
+	case ExprAnd:
+		conditions := make([]Condition, 0, len(e.Operands))
+		for _, operand := range e.Operands {
+			c, ok := operand.Conditions()
+			if !ok {
+				return nil, false
 			}
-
-			conditions = append(conditions, Condition{eventAttr, op, value})
+			conditions = append(conditions, c...)
 		}
+		return conditions, true
+	default:
+		return nil, false
 	}
+}
 
+// Expr returns the syntax tree of the query.
+func (q *Query) Expr() Expr {
+	return q.expr
+}
+
+// Conditions returns a list of conditions. It returns an error if the
+// conditions of the Query are not all joined with AND, in which case Expr must
+// be used instead.
+func (q *Query) Conditions() ([]Condition, error) {
+	conditions, ok := q.expr.Conditions()
+	if !ok {
+		return nil, fmt.Errorf("query %q is not a conjunction of conditions", q.str)
+	}
 	return conditions, nil
 }
 
@@ -203,161 +165,63 @@ func (q *Query) Matches(events map[string][]string) (bool, error) {
 	if len(events) == 0 {
 		return false, nil
 	}
+	return q.expr.matches(events)
+}
 
-	var (
-		eventAttr string
-		op        Operator
-	)
-
-	buffer, begin, end := q.parser.Buffer, 0, 0
-
-	// tokens must be in the following order:
-
-	// tag ("tx.gas") -> operator ("=") -> operand ("7")
-	for token := range q.parser.Tokens() {
-		switch token.pegRule {
-		case rulePegText:
-			begin, end = int(token.begin), int(token.end)
-
-		case ruletag:
-			eventAttr = buffer[begin:end]
-
-		case rulele:
-			op = OpLessEqual
-
-		case rulege:
-			op = OpGreaterEqual
-
-		case rulel:
-			op = OpLess
-
-		case ruleg:
-			op = OpGreater
-
-		case ruleequal:
-			op = OpEqual
-
-		case rulecontains:
-			op = OpContains
-		case ruleexists:
-			op = OpExists
-			if strings.Contains(eventAttr, ".") {
-				// Searching for a full "type.attribute" event.
-				_, ok := events[eventAttr]
-				if !ok {
-					return false, nil
-				}
-			} else {
-				foundEvent := false
-
-			loop:
-				for compositeKey := range events {
-					if strings.Index(compositeKey, eventAttr) == 0 {
-						foundEvent = true
-						break loop
-					}
-				}
-				if !foundEvent {
-					return false, nil
-				}
-			}
-
-		case rulevalue:
-			// strip single quotes from value (i.e. "'NewBlock'" -> "NewBlock")
-			valueWithoutSingleQuotes := buffer[begin+1 : end-1]
-
-			// see if the triplet (event attribute, operator, operand) matches any event
-			// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
-			match, err := match(eventAttr, op, reflect.ValueOf(valueWithoutSingleQuotes), events)
-			if err != nil {
+func (e Expr) matches(events map[string][]string) (bool, error) {
+	switch e.Kind {
+	case ExprAnd:
+		for _, operand := range e.Operands {
+			match, err := operand.matches(events)
+			if err != nil || !match {
 				return false, err
 			}
+		}
+		return true, nil
 
-			if !match {
-				return false, nil
-			}
-
-		case rulenumber:
-			number := buffer[begin:end]
-			if strings.ContainsAny(number, ".") { // if it looks like a floating-point number
-				value, err := strconv.ParseFloat(number, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as float64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			} else {
-				value, err := strconv.ParseInt(number, 10, 64)
-				if err != nil {
-					err = fmt.Errorf(
-						"got %v while trying to parse %s as int64 (should never happen if the grammar is correct)",
-						err, number,
-					)
-					return false, err
-				}
-
-				match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-				if err != nil {
-					return false, err
-				}
-
-				if !match {
-					return false, nil
-				}
-			}
-
-		case ruletime:
-			value, err := time.Parse(TimeLayout, buffer[begin:end])
+	case ExprOr:
+		for _, operand := range e.Operands {
+			match, err := operand.matches(events)
 			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / RFC3339 (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
 				return false, err
 			}
-
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
+			if match {
+				return true, nil
 			}
+		}
+		return false, nil
 
-			if !match {
-				return false, nil
-			}
+	case ExprNot:
+		match, err := e.Operands[0].matches(events)
+		if err != nil {
+			return false, err
+		}
+		return !match, nil
 
-		case ruledate:
-			value, err := time.Parse("2006-01-02", buffer[begin:end])
-			if err != nil {
-				err = fmt.Errorf(
-					"got %v while trying to parse %s as time.Time / '2006-01-02' (should never happen if the grammar is correct)",
-					err, buffer[begin:end],
-				)
-				return false, err
-			}
+	default:
+		return e.Condition.matches(events)
+	}
+}
 
-			match, err := match(eventAttr, op, reflect.ValueOf(value), events)
-			if err != nil {
-				return false, err
-			}
+// matches returns true if the condition matches any event.
+func (c Condition) matches(events map[string][]string) (bool, error) {
+	if c.Op != OpExists {
+		// see if the triplet (event attribute, operator, operand) matches any event
+		// "tx.gas", "=", "7", { "tx.gas": 7, "tx.ID": "4AE393495334" }
+		return match(c.CompositeKey, c.Op, reflect.ValueOf(c.Operand), events)
+	}
 
-			if !match {
-				return false, nil
-			}
+	if strings.Contains(c.CompositeKey, ".") {
+		// Searching for a full "type.attribute" event.
+		_, ok := events[c.CompositeKey]
+		return ok, nil
+	}
+	for compositeKey := range events {
+		if strings.Index(compositeKey, c.CompositeKey) == 0 {
+			return true, nil
 		}
 	}
-
-	return true, nil
+	return false, nil
 }
 
 // match returns true if the given triplet (attribute, operator, operand) matches
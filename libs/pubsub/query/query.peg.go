@@ -15,7 +15,11 @@ type pegRule uint8
 const (
 	ruleUnknown pegRule = iota
 	rulee
+	ruledisjunction
+	ruleconjunction
+	ruleunary
 	rulecondition
+	ruleoperand
 	ruletag
 	rulevalue
 	rulenumber
@@ -26,9 +30,12 @@ const (
 	rulemonth
 	ruleday
 	ruleand
+	ruleor
+	rulenot
 	ruleequal
 	rulecontains
 	ruleexists
+	rulein
 	rulele
 	rulege
 	rulel
@@ -43,7 +50,11 @@ const (
 var rul3s = [...]string{
 	"Unknown",
 	"e",
+	"disjunction",
+	"conjunction",
+	"unary",
 	"condition",
+	"operand",
 	"tag",
 	"value",
 	"number",
@@ -54,9 +65,12 @@ var rul3s = [...]string{
 	"month",
 	"day",
 	"and",
+	"or",
+	"not",
 	"equal",
 	"contains",
 	"exists",
+	"in",
 	"le",
 	"ge",
 	"l",
@@ -355,7 +369,7 @@ func (t *tokens32) Expand(index int) {
 type QueryParser struct {
 	Buffer string
 	buffer []rune
-	rules  [21]func() bool
+	rules  [28]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
 	Pretty bool
@@ -493,7 +507,7 @@ func (p *QueryParser) Init() {
 
 	_rules = [...]func() bool{
 		nil,
-		/* 0 e <- <('"' condition (' '+ and ' '+ condition)* '"' !.)> */
+		/* 0 e <- <('"' ' '* disjunction ' '* '"' !.)> */
 		func() bool {
 			position0, tokenIndex0, depth0 := position, tokenIndex, depth
 			{
@@ -503,9 +517,6 @@ func (p *QueryParser) Init() {
 					goto l0
 				}
 				position++
-				if !_rules[rulecondition]() {
-					goto l0
-				}
 			l2:
 				{
 					position3, tokenIndex3, depth3 := position, tokenIndex, depth
@@ -513,1324 +524,1751 @@ func (p *QueryParser) Init() {
 						goto l3
 					}
 					position++
-				l4:
+					goto l2
+				l3:
+					position, tokenIndex, depth = position3, tokenIndex3, depth3
+				}
+				if !_rules[ruledisjunction]() {
+					goto l0
+				}
+			l4:
+				{
+					position5, tokenIndex5, depth5 := position, tokenIndex, depth
+					if buffer[position] != rune(' ') {
+						goto l5
+					}
+					position++
+					goto l4
+				l5:
+					position, tokenIndex, depth = position5, tokenIndex5, depth5
+				}
+				if buffer[position] != rune('"') {
+					goto l0
+				}
+				position++
+				{
+					position6, tokenIndex6, depth6 := position, tokenIndex, depth
+					if !matchDot() {
+						goto l6
+					}
+					goto l0
+				l6:
+					position, tokenIndex, depth = position6, tokenIndex6, depth6
+				}
+				depth--
+				add(rulee, position1)
+			}
+			return true
+		l0:
+			position, tokenIndex, depth = position0, tokenIndex0, depth0
+			return false
+		},
+		/* 1 disjunction <- <(conjunction (' '+ or ' '+ conjunction)*)> */
+		func() bool {
+			position7, tokenIndex7, depth7 := position, tokenIndex, depth
+			{
+				position8 := position
+				depth++
+				if !_rules[ruleconjunction]() {
+					goto l7
+				}
+			l9:
+				{
+					position10, tokenIndex10, depth10 := position, tokenIndex, depth
+					if buffer[position] != rune(' ') {
+						goto l10
+					}
+					position++
+				l11:
 					{
-						position5, tokenIndex5, depth5 := position, tokenIndex, depth
+						position12, tokenIndex12, depth12 := position, tokenIndex, depth
 						if buffer[position] != rune(' ') {
-							goto l5
+							goto l12
 						}
 						position++
-						goto l4
-					l5:
-						position, tokenIndex, depth = position5, tokenIndex5, depth5
+						goto l11
+					l12:
+						position, tokenIndex, depth = position12, tokenIndex12, depth12
 					}
 					{
-						position6 := position
+						position13 := position
 						depth++
 						{
-							position7, tokenIndex7, depth7 := position, tokenIndex, depth
-							if buffer[position] != rune('a') {
-								goto l8
-							}
-							position++
-							goto l7
-						l8:
-							position, tokenIndex, depth = position7, tokenIndex7, depth7
-							if buffer[position] != rune('A') {
-								goto l3
+							position14, tokenIndex14, depth14 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l15
 							}
 							position++
-						}
-					l7:
-						{
-							position9, tokenIndex9, depth9 := position, tokenIndex, depth
-							if buffer[position] != rune('n') {
+							goto l14
+						l15:
+							position, tokenIndex, depth = position14, tokenIndex14, depth14
+							if buffer[position] != rune('O') {
 								goto l10
 							}
 							position++
-							goto l9
-						l10:
-							position, tokenIndex, depth = position9, tokenIndex9, depth9
-							if buffer[position] != rune('N') {
-								goto l3
-							}
-							position++
 						}
-					l9:
+					l14:
 						{
-							position11, tokenIndex11, depth11 := position, tokenIndex, depth
-							if buffer[position] != rune('d') {
-								goto l12
+							position16, tokenIndex16, depth16 := position, tokenIndex, depth
+							if buffer[position] != rune('r') {
+								goto l17
 							}
 							position++
-							goto l11
-						l12:
-							position, tokenIndex, depth = position11, tokenIndex11, depth11
-							if buffer[position] != rune('D') {
-								goto l3
+							goto l16
+						l17:
+							position, tokenIndex, depth = position16, tokenIndex16, depth16
+							if buffer[position] != rune('R') {
+								goto l10
 							}
 							position++
 						}
-					l11:
+					l16:
 						depth--
-						add(ruleand, position6)
+						add(ruleor, position13)
 					}
 					if buffer[position] != rune(' ') {
-						goto l3
+						goto l10
 					}
 					position++
-				l13:
+				l18:
 					{
-						position14, tokenIndex14, depth14 := position, tokenIndex, depth
+						position19, tokenIndex19, depth19 := position, tokenIndex, depth
 						if buffer[position] != rune(' ') {
-							goto l14
+							goto l19
 						}
 						position++
-						goto l13
-					l14:
-						position, tokenIndex, depth = position14, tokenIndex14, depth14
+						goto l18
+					l19:
+						position, tokenIndex, depth = position19, tokenIndex19, depth19
 					}
-					if !_rules[rulecondition]() {
-						goto l3
-					}
-					goto l2
-				l3:
-					position, tokenIndex, depth = position3, tokenIndex3, depth3
-				}
-				if buffer[position] != rune('"') {
-					goto l0
-				}
-				position++
-				{
-					position15, tokenIndex15, depth15 := position, tokenIndex, depth
-					if !matchDot() {
-						goto l15
+					if !_rules[ruleconjunction]() {
+						goto l10
 					}
-					goto l0
-				l15:
-					position, tokenIndex, depth = position15, tokenIndex15, depth15
+					goto l9
+				l10:
+					position, tokenIndex, depth = position10, tokenIndex10, depth10
 				}
 				depth--
-				add(rulee, position1)
+				add(ruledisjunction, position8)
 			}
 			return true
-		l0:
-			position, tokenIndex, depth = position0, tokenIndex0, depth0
+		l7:
+			position, tokenIndex, depth = position7, tokenIndex7, depth7
 			return false
 		},
-		/* 1 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
+		/* 2 conjunction <- <(unary (' '+ and ' '+ unary)*)> */
 		func() bool {
-			position16, tokenIndex16, depth16 := position, tokenIndex, depth
+			position20, tokenIndex20, depth20 := position, tokenIndex, depth
 			{
-				position17 := position
+				position21 := position
 				depth++
+				if !_rules[ruleunary]() {
+					goto l20
+				}
+			l22:
 				{
-					position18 := position
-					depth++
+					position23, tokenIndex23, depth23 := position, tokenIndex, depth
+					if buffer[position] != rune(' ') {
+						goto l23
+					}
+					position++
+				l24:
+					{
+						position25, tokenIndex25, depth25 := position, tokenIndex, depth
+						if buffer[position] != rune(' ') {
+							goto l25
+						}
+						position++
+						goto l24
+					l25:
+						position, tokenIndex, depth = position25, tokenIndex25, depth25
+					}
 					{
-						position19 := position
+						position26 := position
 						depth++
 						{
-							position22, tokenIndex22, depth22 := position, tokenIndex, depth
-							{
-								switch buffer[position] {
-								case '<':
-									if buffer[position] != rune('<') {
-										goto l22
-									}
-									position++
-									break
-								case '>':
-									if buffer[position] != rune('>') {
-										goto l22
-									}
-									position++
-									break
-								case '=':
-									if buffer[position] != rune('=') {
-										goto l22
-									}
-									position++
-									break
-								case '\'':
-									if buffer[position] != rune('\'') {
-										goto l22
-									}
-									position++
-									break
-								case '"':
-									if buffer[position] != rune('"') {
-										goto l22
-									}
-									position++
-									break
-								case ')':
-									if buffer[position] != rune(')') {
-										goto l22
-									}
-									position++
-									break
-								case '(':
-									if buffer[position] != rune('(') {
-										goto l22
-									}
-									position++
-									break
-								case '\\':
-									if buffer[position] != rune('\\') {
-										goto l22
-									}
-									position++
-									break
-								case '\r':
-									if buffer[position] != rune('\r') {
-										goto l22
-									}
-									position++
-									break
-								case '\n':
-									if buffer[position] != rune('\n') {
-										goto l22
-									}
-									position++
-									break
-								case '\t':
-									if buffer[position] != rune('\t') {
-										goto l22
-									}
-									position++
-									break
-								default:
-									if buffer[position] != rune(' ') {
-										goto l22
-									}
-									position++
-									break
-								}
+							position27, tokenIndex27, depth27 := position, tokenIndex, depth
+							if buffer[position] != rune('a') {
+								goto l28
 							}
-
-							goto l16
-						l22:
-							position, tokenIndex, depth = position22, tokenIndex22, depth22
+							position++
+							goto l27
+						l28:
+							position, tokenIndex, depth = position27, tokenIndex27, depth27
+							if buffer[position] != rune('A') {
+								goto l23
+							}
+							position++
 						}
-						if !matchDot() {
-							goto l16
+					l27:
+						{
+							position29, tokenIndex29, depth29 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l30
+							}
+							position++
+							goto l29
+						l30:
+							position, tokenIndex, depth = position29, tokenIndex29, depth29
+							if buffer[position] != rune('N') {
+								goto l23
+							}
+							position++
 						}
-					l20:
+					l29:
 						{
-							position21, tokenIndex21, depth21 := position, tokenIndex, depth
-							{
-								position24, tokenIndex24, depth24 := position, tokenIndex, depth
-								{
-									switch buffer[position] {
-									case '<':
-										if buffer[position] != rune('<') {
-											goto l24
-										}
-										position++
-										break
-									case '>':
-										if buffer[position] != rune('>') {
-											goto l24
-										}
-										position++
-										break
-									case '=':
-										if buffer[position] != rune('=') {
-											goto l24
-										}
-										position++
-										break
-									case '\'':
-										if buffer[position] != rune('\'') {
-											goto l24
-										}
-										position++
-										break
-									case '"':
-										if buffer[position] != rune('"') {
-											goto l24
-										}
-										position++
-										break
-									case ')':
-										if buffer[position] != rune(')') {
-											goto l24
-										}
-										position++
-										break
-									case '(':
-										if buffer[position] != rune('(') {
-											goto l24
-										}
-										position++
-										break
-									case '\\':
-										if buffer[position] != rune('\\') {
-											goto l24
-										}
-										position++
-										break
-									case '\r':
-										if buffer[position] != rune('\r') {
-											goto l24
-										}
-										position++
-										break
-									case '\n':
-										if buffer[position] != rune('\n') {
-											goto l24
-										}
-										position++
-										break
-									case '\t':
-										if buffer[position] != rune('\t') {
-											goto l24
-										}
-										position++
-										break
-									default:
-										if buffer[position] != rune(' ') {
-											goto l24
-										}
-										position++
-										break
-									}
-								}
-
-								goto l21
-							l24:
-								position, tokenIndex, depth = position24, tokenIndex24, depth24
+							position31, tokenIndex31, depth31 := position, tokenIndex, depth
+							if buffer[position] != rune('d') {
+								goto l32
 							}
-							if !matchDot() {
-								goto l21
+							position++
+							goto l31
+						l32:
+							position, tokenIndex, depth = position31, tokenIndex31, depth31
+							if buffer[position] != rune('D') {
+								goto l23
 							}
-							goto l20
-						l21:
-							position, tokenIndex, depth = position21, tokenIndex21, depth21
+							position++
 						}
+					l31:
 						depth--
-						add(rulePegText, position19)
+						add(ruleand, position26)
 					}
-					depth--
-					add(ruletag, position18)
-				}
-			l26:
-				{
-					position27, tokenIndex27, depth27 := position, tokenIndex, depth
 					if buffer[position] != rune(' ') {
-						goto l27
+						goto l23
 					}
 					position++
-					goto l26
-				l27:
-					position, tokenIndex, depth = position27, tokenIndex27, depth27
+				l33:
+					{
+						position34, tokenIndex34, depth34 := position, tokenIndex, depth
+						if buffer[position] != rune(' ') {
+							goto l34
+						}
+						position++
+						goto l33
+					l34:
+						position, tokenIndex, depth = position34, tokenIndex34, depth34
+					}
+					if !_rules[ruleunary]() {
+						goto l23
+					}
+					goto l22
+				l23:
+					position, tokenIndex, depth = position23, tokenIndex23, depth23
 				}
+				depth--
+				add(ruleconjunction, position21)
+			}
+			return true
+		l20:
+			position, tokenIndex, depth = position20, tokenIndex20, depth20
+			return false
+		},
+		/* 3 unary <- <((not (' '+ / &'(') unary) / ('(' ' '* disjunction ' '* ')') / condition)> */
+		func() bool {
+			position35, tokenIndex35, depth35 := position, tokenIndex, depth
+			{
+				position36 := position
+				depth++
 				{
-					position28, tokenIndex28, depth28 := position, tokenIndex, depth
+					position37, tokenIndex37, depth37 := position, tokenIndex, depth
 					{
-						position30 := position
+						position39 := position
 						depth++
-						if buffer[position] != rune('<') {
-							goto l29
+						{
+							position40, tokenIndex40, depth40 := position, tokenIndex, depth
+							if buffer[position] != rune('n') {
+								goto l41
+							}
+							position++
+							goto l40
+						l41:
+							position, tokenIndex, depth = position40, tokenIndex40, depth40
+							if buffer[position] != rune('N') {
+								goto l38
+							}
+							position++
 						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l29
+					l40:
+						{
+							position42, tokenIndex42, depth42 := position, tokenIndex, depth
+							if buffer[position] != rune('o') {
+								goto l43
+							}
+							position++
+							goto l42
+						l43:
+							position, tokenIndex, depth = position42, tokenIndex42, depth42
+							if buffer[position] != rune('O') {
+								goto l38
+							}
+							position++
 						}
-						position++
+					l42:
+						{
+							position44, tokenIndex44, depth44 := position, tokenIndex, depth
+							if buffer[position] != rune('t') {
+								goto l45
+							}
+							position++
+							goto l44
+						l45:
+							position, tokenIndex, depth = position44, tokenIndex44, depth44
+							if buffer[position] != rune('T') {
+								goto l38
+							}
+							position++
+						}
+					l44:
 						depth--
-						add(rulele, position30)
+						add(rulenot, position39)
 					}
-				l31:
 					{
-						position32, tokenIndex32, depth32 := position, tokenIndex, depth
+						position46, tokenIndex46, depth46 := position, tokenIndex, depth
 						if buffer[position] != rune(' ') {
-							goto l32
+							goto l47
 						}
 						position++
-						goto l31
-					l32:
-						position, tokenIndex, depth = position32, tokenIndex32, depth32
-					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l29
-							}
-							break
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l29
+					l48:
+						{
+							position49, tokenIndex49, depth49 := position, tokenIndex, depth
+							if buffer[position] != rune(' ') {
+								goto l49
 							}
-							break
-						default:
-							if !_rules[rulenumber]() {
-								goto l29
+							position++
+							goto l48
+						l49:
+							position, tokenIndex, depth = position49, tokenIndex49, depth49
+						}
+						goto l46
+					l47:
+						position, tokenIndex, depth = position46, tokenIndex46, depth46
+						{
+							position50, tokenIndex50, depth50 := position, tokenIndex, depth
+							if buffer[position] != rune('(') {
+								goto l38
 							}
-							break
+							position++
+							position, tokenIndex, depth = position50, tokenIndex50, depth50
 						}
 					}
-
-					goto l28
-				l29:
-					position, tokenIndex, depth = position28, tokenIndex28, depth28
+				l46:
+					if !_rules[ruleunary]() {
+						goto l38
+					}
+					goto l37
+				l38:
+					position, tokenIndex, depth = position37, tokenIndex37, depth37
+					if buffer[position] != rune('(') {
+						goto l51
+					}
+					position++
+				l52:
 					{
-						position35 := position
-						depth++
-						if buffer[position] != rune('>') {
-							goto l34
-						}
-						position++
-						if buffer[position] != rune('=') {
-							goto l34
+						position53, tokenIndex53, depth53 := position, tokenIndex, depth
+						if buffer[position] != rune(' ') {
+							goto l53
 						}
 						position++
-						depth--
-						add(rulege, position35)
+						goto l52
+					l53:
+						position, tokenIndex, depth = position53, tokenIndex53, depth53
 					}
-				l36:
+					if !_rules[ruledisjunction]() {
+						goto l51
+					}
+				l54:
 					{
-						position37, tokenIndex37, depth37 := position, tokenIndex, depth
+						position55, tokenIndex55, depth55 := position, tokenIndex, depth
 						if buffer[position] != rune(' ') {
-							goto l37
+							goto l55
 						}
 						position++
-						goto l36
-					l37:
-						position, tokenIndex, depth = position37, tokenIndex37, depth37
+						goto l54
+					l55:
+						position, tokenIndex, depth = position55, tokenIndex55, depth55
 					}
-					{
-						switch buffer[position] {
-						case 'D', 'd':
-							if !_rules[ruledate]() {
-								goto l34
-							}
-							break
-						case 'T', 't':
-							if !_rules[ruletime]() {
-								goto l34
-							}
-							break
-						default:
-							if !_rules[rulenumber]() {
-								goto l34
-							}
-							break
-						}
+					if buffer[position] != rune(')') {
+						goto l51
 					}
-
-					goto l28
-				l34:
-					position, tokenIndex, depth = position28, tokenIndex28, depth28
+					position++
+					goto l37
+				l51:
+					position, tokenIndex, depth = position37, tokenIndex37, depth37
 					{
-						switch buffer[position] {
-						case 'E', 'e':
+						position56 := position
+						depth++
+						{
+							position57 := position
+							depth++
 							{
-								position40 := position
+								position58 := position
 								depth++
 								{
-									position41, tokenIndex41, depth41 := position, tokenIndex, depth
-									if buffer[position] != rune('e') {
-										goto l42
-									}
-									position++
-									goto l41
-								l42:
-									position, tokenIndex, depth = position41, tokenIndex41, depth41
-									if buffer[position] != rune('E') {
-										goto l16
-									}
-									position++
-								}
-							l41:
-								{
-									position43, tokenIndex43, depth43 := position, tokenIndex, depth
-									if buffer[position] != rune('x') {
-										goto l44
-									}
-									position++
-									goto l43
-								l44:
-									position, tokenIndex, depth = position43, tokenIndex43, depth43
-									if buffer[position] != rune('X') {
-										goto l16
-									}
-									position++
-								}
-							l43:
-								{
-									position45, tokenIndex45, depth45 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l46
-									}
-									position++
-									goto l45
-								l46:
-									position, tokenIndex, depth = position45, tokenIndex45, depth45
-									if buffer[position] != rune('I') {
-										goto l16
-									}
-									position++
-								}
-							l45:
-								{
-									position47, tokenIndex47, depth47 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l48
-									}
-									position++
-									goto l47
-								l48:
-									position, tokenIndex, depth = position47, tokenIndex47, depth47
-									if buffer[position] != rune('S') {
-										goto l16
+									position61, tokenIndex61, depth61 := position, tokenIndex, depth
+									{
+										switch buffer[position] {
+										case '<':
+											if buffer[position] != rune('<') {
+												goto l61
+											}
+											position++
+											break
+										case '>':
+											if buffer[position] != rune('>') {
+												goto l61
+											}
+											position++
+											break
+										case '=':
+											if buffer[position] != rune('=') {
+												goto l61
+											}
+											position++
+											break
+										case '\'':
+											if buffer[position] != rune('\'') {
+												goto l61
+											}
+											position++
+											break
+										case '"':
+											if buffer[position] != rune('"') {
+												goto l61
+											}
+											position++
+											break
+										case ')':
+											if buffer[position] != rune(')') {
+												goto l61
+											}
+											position++
+											break
+										case '(':
+											if buffer[position] != rune('(') {
+												goto l61
+											}
+											position++
+											break
+										case '\\':
+											if buffer[position] != rune('\\') {
+												goto l61
+											}
+											position++
+											break
+										case '\r':
+											if buffer[position] != rune('\r') {
+												goto l61
+											}
+											position++
+											break
+										case '\n':
+											if buffer[position] != rune('\n') {
+												goto l61
+											}
+											position++
+											break
+										case '\t':
+											if buffer[position] != rune('\t') {
+												goto l61
+											}
+											position++
+											break
+										default:
+											if buffer[position] != rune(' ') {
+												goto l61
+											}
+											position++
+											break
+										}
 									}
-									position++
+
+									goto l35
+								l61:
+									position, tokenIndex, depth = position61, tokenIndex61, depth61
 								}
-							l47:
-								{
-									position49, tokenIndex49, depth49 := position, tokenIndex, depth
-									if buffer[position] != rune('t') {
-										goto l50
-									}
-									position++
-									goto l49
-								l50:
-									position, tokenIndex, depth = position49, tokenIndex49, depth49
-									if buffer[position] != rune('T') {
-										goto l16
-									}
-									position++
+								if !matchDot() {
+									goto l35
 								}
-							l49:
+							l59:
 								{
-									position51, tokenIndex51, depth51 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l52
+									position60, tokenIndex60, depth60 := position, tokenIndex, depth
+									{
+										position63, tokenIndex63, depth63 := position, tokenIndex, depth
+										{
+											switch buffer[position] {
+											case '<':
+												if buffer[position] != rune('<') {
+													goto l63
+												}
+												position++
+												break
+											case '>':
+												if buffer[position] != rune('>') {
+													goto l63
+												}
+												position++
+												break
+											case '=':
+												if buffer[position] != rune('=') {
+													goto l63
+												}
+												position++
+												break
+											case '\'':
+												if buffer[position] != rune('\'') {
+													goto l63
+												}
+												position++
+												break
+											case '"':
+												if buffer[position] != rune('"') {
+													goto l63
+												}
+												position++
+												break
+											case ')':
+												if buffer[position] != rune(')') {
+													goto l63
+												}
+												position++
+												break
+											case '(':
+												if buffer[position] != rune('(') {
+													goto l63
+												}
+												position++
+												break
+											case '\\':
+												if buffer[position] != rune('\\') {
+													goto l63
+												}
+												position++
+												break
+											case '\r':
+												if buffer[position] != rune('\r') {
+													goto l63
+												}
+												position++
+												break
+											case '\n':
+												if buffer[position] != rune('\n') {
+													goto l63
+												}
+												position++
+												break
+											case '\t':
+												if buffer[position] != rune('\t') {
+													goto l63
+												}
+												position++
+												break
+											default:
+												if buffer[position] != rune(' ') {
+													goto l63
+												}
+												position++
+												break
+											}
+										}
+
+										goto l60
+									l63:
+										position, tokenIndex, depth = position63, tokenIndex63, depth63
 									}
-									position++
-									goto l51
-								l52:
-									position, tokenIndex, depth = position51, tokenIndex51, depth51
-									if buffer[position] != rune('S') {
-										goto l16
+									if !matchDot() {
+										goto l60
 									}
-									position++
+									goto l59
+								l60:
+									position, tokenIndex, depth = position60, tokenIndex60, depth60
 								}
-							l51:
 								depth--
-								add(ruleexists, position40)
+								add(rulePegText, position58)
+							}
+							depth--
+							add(ruletag, position57)
+						}
+					l65:
+						{
+							position66, tokenIndex66, depth66 := position, tokenIndex, depth
+							if buffer[position] != rune(' ') {
+								goto l66
 							}
-							break
-						case '=':
+							position++
+							goto l65
+						l66:
+							position, tokenIndex, depth = position66, tokenIndex66, depth66
+						}
+						{
+							position67, tokenIndex67, depth67 := position, tokenIndex, depth
 							{
-								position53 := position
+								position69 := position
 								depth++
+								if buffer[position] != rune('<') {
+									goto l68
+								}
+								position++
 								if buffer[position] != rune('=') {
-									goto l16
+									goto l68
 								}
 								position++
 								depth--
-								add(ruleequal, position53)
+								add(rulele, position69)
 							}
-						l54:
+						l70:
 							{
-								position55, tokenIndex55, depth55 := position, tokenIndex, depth
+								position71, tokenIndex71, depth71 := position, tokenIndex, depth
 								if buffer[position] != rune(' ') {
-									goto l55
+									goto l71
 								}
 								position++
-								goto l54
-							l55:
-								position, tokenIndex, depth = position55, tokenIndex55, depth55
+								goto l70
+							l71:
+								position, tokenIndex, depth = position71, tokenIndex71, depth71
 							}
 							{
 								switch buffer[position] {
-								case '\'':
-									if !_rules[rulevalue]() {
-										goto l16
-									}
-									break
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l68
 									}
 									break
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l68
 									}
 									break
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l68
 									}
 									break
 								}
 							}
 
-							break
-						case '>':
+							goto l67
+						l68:
+							position, tokenIndex, depth = position67, tokenIndex67, depth67
 							{
-								position57 := position
+								position74 := position
 								depth++
 								if buffer[position] != rune('>') {
-									goto l16
+									goto l73
+								}
+								position++
+								if buffer[position] != rune('=') {
+									goto l73
 								}
 								position++
 								depth--
-								add(ruleg, position57)
+								add(rulege, position74)
 							}
-						l58:
+						l75:
 							{
-								position59, tokenIndex59, depth59 := position, tokenIndex, depth
+								position76, tokenIndex76, depth76 := position, tokenIndex, depth
 								if buffer[position] != rune(' ') {
-									goto l59
+									goto l76
 								}
 								position++
-								goto l58
-							l59:
-								position, tokenIndex, depth = position59, tokenIndex59, depth59
+								goto l75
+							l76:
+								position, tokenIndex, depth = position76, tokenIndex76, depth76
 							}
 							{
 								switch buffer[position] {
 								case 'D', 'd':
 									if !_rules[ruledate]() {
-										goto l16
+										goto l73
 									}
 									break
 								case 'T', 't':
 									if !_rules[ruletime]() {
-										goto l16
+										goto l73
 									}
 									break
 								default:
 									if !_rules[rulenumber]() {
-										goto l16
+										goto l73
 									}
 									break
 								}
 							}
 
-							break
-						case '<':
-							{
-								position61 := position
-								depth++
-								if buffer[position] != rune('<') {
-									goto l16
-								}
-								position++
-								depth--
-								add(rulel, position61)
-							}
-						l62:
-							{
-								position63, tokenIndex63, depth63 := position, tokenIndex, depth
-								if buffer[position] != rune(' ') {
-									goto l63
-								}
-								position++
-								goto l62
-							l63:
-								position, tokenIndex, depth = position63, tokenIndex63, depth63
-							}
+							goto l67
+						l73:
+							position, tokenIndex, depth = position67, tokenIndex67, depth67
 							{
 								switch buffer[position] {
-								case 'D', 'd':
-									if !_rules[ruledate]() {
-										goto l16
-									}
-									break
-								case 'T', 't':
-									if !_rules[ruletime]() {
-										goto l16
+								case 'I', 'i':
+									{
+										position79 := position
+										depth++
+										{
+											position80, tokenIndex80, depth80 := position, tokenIndex, depth
+											if buffer[position] != rune('i') {
+												goto l81
+											}
+											position++
+											goto l80
+										l81:
+											position, tokenIndex, depth = position80, tokenIndex80, depth80
+											if buffer[position] != rune('I') {
+												goto l35
+											}
+											position++
+										}
+									l80:
+										{
+											position82, tokenIndex82, depth82 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l83
+											}
+											position++
+											goto l82
+										l83:
+											position, tokenIndex, depth = position82, tokenIndex82, depth82
+											if buffer[position] != rune('N') {
+												goto l35
+											}
+											position++
+										}
+									l82:
+										depth--
+										add(rulein, position79)
 									}
-									break
-								default:
-									if !_rules[rulenumber]() {
-										goto l16
+								l84:
+									{
+										position85, tokenIndex85, depth85 := position, tokenIndex, depth
+										if buffer[position] != rune(' ') {
+											goto l85
+										}
+										position++
+										goto l84
+									l85:
+										position, tokenIndex, depth = position85, tokenIndex85, depth85
 									}
-									break
-								}
-							}
-
-							break
-						default:
-							{
-								position65 := position
-								depth++
-								{
-									position66, tokenIndex66, depth66 := position, tokenIndex, depth
-									if buffer[position] != rune('c') {
-										goto l67
+									if buffer[position] != rune('(') {
+										goto l35
 									}
 									position++
-									goto l66
-								l67:
-									position, tokenIndex, depth = position66, tokenIndex66, depth66
-									if buffer[position] != rune('C') {
-										goto l16
+								l86:
+									{
+										position87, tokenIndex87, depth87 := position, tokenIndex, depth
+										if buffer[position] != rune(' ') {
+											goto l87
+										}
+										position++
+										goto l86
+									l87:
+										position, tokenIndex, depth = position87, tokenIndex87, depth87
 									}
-									position++
-								}
-							l66:
-								{
-									position68, tokenIndex68, depth68 := position, tokenIndex, depth
-									if buffer[position] != rune('o') {
-										goto l69
+									if !_rules[ruleoperand]() {
+										goto l35
 									}
-									position++
-									goto l68
-								l69:
-									position, tokenIndex, depth = position68, tokenIndex68, depth68
-									if buffer[position] != rune('O') {
-										goto l16
+								l88:
+									{
+										position89, tokenIndex89, depth89 := position, tokenIndex, depth
+									l90:
+										{
+											position91, tokenIndex91, depth91 := position, tokenIndex, depth
+											if buffer[position] != rune(' ') {
+												goto l91
+											}
+											position++
+											goto l90
+										l91:
+											position, tokenIndex, depth = position91, tokenIndex91, depth91
+										}
+										if buffer[position] != rune(',') {
+											goto l89
+										}
+										position++
+									l92:
+										{
+											position93, tokenIndex93, depth93 := position, tokenIndex, depth
+											if buffer[position] != rune(' ') {
+												goto l93
+											}
+											position++
+											goto l92
+										l93:
+											position, tokenIndex, depth = position93, tokenIndex93, depth93
+										}
+										if !_rules[ruleoperand]() {
+											goto l89
+										}
+										goto l88
+									l89:
+										position, tokenIndex, depth = position89, tokenIndex89, depth89
 									}
-									position++
-								}
-							l68:
-								{
-									position70, tokenIndex70, depth70 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l71
+								l94:
+									{
+										position95, tokenIndex95, depth95 := position, tokenIndex, depth
+										if buffer[position] != rune(' ') {
+											goto l95
+										}
+										position++
+										goto l94
+									l95:
+										position, tokenIndex, depth = position95, tokenIndex95, depth95
 									}
-									position++
-									goto l70
-								l71:
-									position, tokenIndex, depth = position70, tokenIndex70, depth70
-									if buffer[position] != rune('N') {
-										goto l16
+									if buffer[position] != rune(')') {
+										goto l35
 									}
 									position++
-								}
-							l70:
-								{
-									position72, tokenIndex72, depth72 := position, tokenIndex, depth
-									if buffer[position] != rune('t') {
-										goto l73
+									break
+								case 'E', 'e':
+									{
+										position96 := position
+										depth++
+										{
+											position97, tokenIndex97, depth97 := position, tokenIndex, depth
+											if buffer[position] != rune('e') {
+												goto l98
+											}
+											position++
+											goto l97
+										l98:
+											position, tokenIndex, depth = position97, tokenIndex97, depth97
+											if buffer[position] != rune('E') {
+												goto l35
+											}
+											position++
+										}
+									l97:
+										{
+											position99, tokenIndex99, depth99 := position, tokenIndex, depth
+											if buffer[position] != rune('x') {
+												goto l100
+											}
+											position++
+											goto l99
+										l100:
+											position, tokenIndex, depth = position99, tokenIndex99, depth99
+											if buffer[position] != rune('X') {
+												goto l35
+											}
+											position++
+										}
+									l99:
+										{
+											position101, tokenIndex101, depth101 := position, tokenIndex, depth
+											if buffer[position] != rune('i') {
+												goto l102
+											}
+											position++
+											goto l101
+										l102:
+											position, tokenIndex, depth = position101, tokenIndex101, depth101
+											if buffer[position] != rune('I') {
+												goto l35
+											}
+											position++
+										}
+									l101:
+										{
+											position103, tokenIndex103, depth103 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l104
+											}
+											position++
+											goto l103
+										l104:
+											position, tokenIndex, depth = position103, tokenIndex103, depth103
+											if buffer[position] != rune('S') {
+												goto l35
+											}
+											position++
+										}
+									l103:
+										{
+											position105, tokenIndex105, depth105 := position, tokenIndex, depth
+											if buffer[position] != rune('t') {
+												goto l106
+											}
+											position++
+											goto l105
+										l106:
+											position, tokenIndex, depth = position105, tokenIndex105, depth105
+											if buffer[position] != rune('T') {
+												goto l35
+											}
+											position++
+										}
+									l105:
+										{
+											position107, tokenIndex107, depth107 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l108
+											}
+											position++
+											goto l107
+										l108:
+											position, tokenIndex, depth = position107, tokenIndex107, depth107
+											if buffer[position] != rune('S') {
+												goto l35
+											}
+											position++
+										}
+									l107:
+										depth--
+										add(ruleexists, position96)
 									}
-									position++
-									goto l72
-								l73:
-									position, tokenIndex, depth = position72, tokenIndex72, depth72
-									if buffer[position] != rune('T') {
-										goto l16
+									break
+								case '=':
+									{
+										position109 := position
+										depth++
+										if buffer[position] != rune('=') {
+											goto l35
+										}
+										position++
+										depth--
+										add(ruleequal, position109)
 									}
-									position++
-								}
-							l72:
-								{
-									position74, tokenIndex74, depth74 := position, tokenIndex, depth
-									if buffer[position] != rune('a') {
-										goto l75
+								l110:
+									{
+										position111, tokenIndex111, depth111 := position, tokenIndex, depth
+										if buffer[position] != rune(' ') {
+											goto l111
+										}
+										position++
+										goto l110
+									l111:
+										position, tokenIndex, depth = position111, tokenIndex111, depth111
 									}
-									position++
-									goto l74
-								l75:
-									position, tokenIndex, depth = position74, tokenIndex74, depth74
-									if buffer[position] != rune('A') {
-										goto l16
+									{
+										switch buffer[position] {
+										case '\'':
+											if !_rules[rulevalue]() {
+												goto l35
+											}
+											break
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l35
+											}
+											break
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l35
+											}
+											break
+										default:
+											if !_rules[rulenumber]() {
+												goto l35
+											}
+											break
+										}
 									}
-									position++
-								}
-							l74:
-								{
-									position76, tokenIndex76, depth76 := position, tokenIndex, depth
-									if buffer[position] != rune('i') {
-										goto l77
+
+									break
+								case '>':
+									{
+										position113 := position
+										depth++
+										if buffer[position] != rune('>') {
+											goto l35
+										}
+										position++
+										depth--
+										add(ruleg, position113)
 									}
-									position++
-									goto l76
-								l77:
-									position, tokenIndex, depth = position76, tokenIndex76, depth76
-									if buffer[position] != rune('I') {
-										goto l16
+								l114:
+									{
+										position115, tokenIndex115, depth115 := position, tokenIndex, depth
+										if buffer[position] != rune(' ') {
+											goto l115
+										}
+										position++
+										goto l114
+									l115:
+										position, tokenIndex, depth = position115, tokenIndex115, depth115
 									}
-									position++
-								}
-							l76:
-								{
-									position78, tokenIndex78, depth78 := position, tokenIndex, depth
-									if buffer[position] != rune('n') {
-										goto l79
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l35
+											}
+											break
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l35
+											}
+											break
+										default:
+											if !_rules[rulenumber]() {
+												goto l35
+											}
+											break
+										}
 									}
-									position++
-									goto l78
-								l79:
-									position, tokenIndex, depth = position78, tokenIndex78, depth78
-									if buffer[position] != rune('N') {
-										goto l16
+
+									break
+								case '<':
+									{
+										position117 := position
+										depth++
+										if buffer[position] != rune('<') {
+											goto l35
+										}
+										position++
+										depth--
+										add(rulel, position117)
 									}
-									position++
-								}
-							l78:
-								{
-									position80, tokenIndex80, depth80 := position, tokenIndex, depth
-									if buffer[position] != rune('s') {
-										goto l81
+								l118:
+									{
+										position119, tokenIndex119, depth119 := position, tokenIndex, depth
+										if buffer[position] != rune(' ') {
+											goto l119
+										}
+										position++
+										goto l118
+									l119:
+										position, tokenIndex, depth = position119, tokenIndex119, depth119
 									}
-									position++
-									goto l80
-								l81:
-									position, tokenIndex, depth = position80, tokenIndex80, depth80
-									if buffer[position] != rune('S') {
-										goto l16
+									{
+										switch buffer[position] {
+										case 'D', 'd':
+											if !_rules[ruledate]() {
+												goto l35
+											}
+											break
+										case 'T', 't':
+											if !_rules[ruletime]() {
+												goto l35
+											}
+											break
+										default:
+											if !_rules[rulenumber]() {
+												goto l35
+											}
+											break
+										}
 									}
-									position++
-								}
-							l80:
-								depth--
-								add(rulecontains, position65)
-							}
-						l82:
-							{
-								position83, tokenIndex83, depth83 := position, tokenIndex, depth
-								if buffer[position] != rune(' ') {
-									goto l83
+
+									break
+								default:
+									{
+										position121 := position
+										depth++
+										{
+											position122, tokenIndex122, depth122 := position, tokenIndex, depth
+											if buffer[position] != rune('c') {
+												goto l123
+											}
+											position++
+											goto l122
+										l123:
+											position, tokenIndex, depth = position122, tokenIndex122, depth122
+											if buffer[position] != rune('C') {
+												goto l35
+											}
+											position++
+										}
+									l122:
+										{
+											position124, tokenIndex124, depth124 := position, tokenIndex, depth
+											if buffer[position] != rune('o') {
+												goto l125
+											}
+											position++
+											goto l124
+										l125:
+											position, tokenIndex, depth = position124, tokenIndex124, depth124
+											if buffer[position] != rune('O') {
+												goto l35
+											}
+											position++
+										}
+									l124:
+										{
+											position126, tokenIndex126, depth126 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l127
+											}
+											position++
+											goto l126
+										l127:
+											position, tokenIndex, depth = position126, tokenIndex126, depth126
+											if buffer[position] != rune('N') {
+												goto l35
+											}
+											position++
+										}
+									l126:
+										{
+											position128, tokenIndex128, depth128 := position, tokenIndex, depth
+											if buffer[position] != rune('t') {
+												goto l129
+											}
+											position++
+											goto l128
+										l129:
+											position, tokenIndex, depth = position128, tokenIndex128, depth128
+											if buffer[position] != rune('T') {
+												goto l35
+											}
+											position++
+										}
+									l128:
+										{
+											position130, tokenIndex130, depth130 := position, tokenIndex, depth
+											if buffer[position] != rune('a') {
+												goto l131
+											}
+											position++
+											goto l130
+										l131:
+											position, tokenIndex, depth = position130, tokenIndex130, depth130
+											if buffer[position] != rune('A') {
+												goto l35
+											}
+											position++
+										}
+									l130:
+										{
+											position132, tokenIndex132, depth132 := position, tokenIndex, depth
+											if buffer[position] != rune('i') {
+												goto l133
+											}
+											position++
+											goto l132
+										l133:
+											position, tokenIndex, depth = position132, tokenIndex132, depth132
+											if buffer[position] != rune('I') {
+												goto l35
+											}
+											position++
+										}
+									l132:
+										{
+											position134, tokenIndex134, depth134 := position, tokenIndex, depth
+											if buffer[position] != rune('n') {
+												goto l135
+											}
+											position++
+											goto l134
+										l135:
+											position, tokenIndex, depth = position134, tokenIndex134, depth134
+											if buffer[position] != rune('N') {
+												goto l35
+											}
+											position++
+										}
+									l134:
+										{
+											position136, tokenIndex136, depth136 := position, tokenIndex, depth
+											if buffer[position] != rune('s') {
+												goto l137
+											}
+											position++
+											goto l136
+										l137:
+											position, tokenIndex, depth = position136, tokenIndex136, depth136
+											if buffer[position] != rune('S') {
+												goto l35
+											}
+											position++
+										}
+									l136:
+										depth--
+										add(rulecontains, position121)
+									}
+								l138:
+									{
+										position139, tokenIndex139, depth139 := position, tokenIndex, depth
+										if buffer[position] != rune(' ') {
+											goto l139
+										}
+										position++
+										goto l138
+									l139:
+										position, tokenIndex, depth = position139, tokenIndex139, depth139
+									}
+									if !_rules[rulevalue]() {
+										goto l35
+									}
+									break
 								}
-								position++
-								goto l82
-							l83:
-								position, tokenIndex, depth = position83, tokenIndex83, depth83
-							}
-							if !_rules[rulevalue]() {
-								goto l16
 							}
-							break
+
 						}
+					l67:
+						depth--
+						add(rulecondition, position56)
 					}
-
 				}
-			l28:
+			l37:
 				depth--
-				add(rulecondition, position17)
+				add(ruleunary, position36)
 			}
 			return true
-		l16:
-			position, tokenIndex, depth = position16, tokenIndex16, depth16
+		l35:
+			position, tokenIndex, depth = position35, tokenIndex35, depth35
 			return false
 		},
-		/* 2 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
+		/* 4 condition <- <(tag ' '* ((le ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / (ge ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))) / ((&('I' | 'i') (in ' '* '(' ' '* operand (' '* ',' ' '* operand)* ' '* ')')) | (&('E' | 'e') exists) | (&('=') (equal ' '* ((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('>') (g ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('<') (l ' '* ((&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number)))) | (&('C' | 'c') (contains ' '* value)))))> */
 		nil,
-		/* 3 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
+		/* 5 operand <- <((&('\'') value) | (&('D' | 'd') date) | (&('T' | 't') time) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number))> */
 		func() bool {
-			position85, tokenIndex85, depth85 := position, tokenIndex, depth
+			position141, tokenIndex141, depth141 := position, tokenIndex, depth
 			{
-				position86 := position
+				position142 := position
 				depth++
 				{
-					position87 := position
+					switch buffer[position] {
+					case '\'':
+						if !_rules[rulevalue]() {
+							goto l141
+						}
+						break
+					case 'D', 'd':
+						if !_rules[ruledate]() {
+							goto l141
+						}
+						break
+					case 'T', 't':
+						if !_rules[ruletime]() {
+							goto l141
+						}
+						break
+					default:
+						if !_rules[rulenumber]() {
+							goto l141
+						}
+						break
+					}
+				}
+
+				depth--
+				add(ruleoperand, position142)
+			}
+			return true
+		l141:
+			position, tokenIndex, depth = position141, tokenIndex141, depth141
+			return false
+		},
+		/* 6 tag <- <<(!((&('<') '<') | (&('>') '>') | (&('=') '=') | (&('\'') '\'') | (&('"') '"') | (&(')') ')') | (&('(') '(') | (&('\\') '\\') | (&('\r') '\r') | (&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ')) .)+>> */
+		nil,
+		/* 7 value <- <<('\'' (!('"' / '\'') .)* '\'')>> */
+		func() bool {
+			position145, tokenIndex145, depth145 := position, tokenIndex, depth
+			{
+				position146 := position
+				depth++
+				{
+					position147 := position
 					depth++
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l145
 					}
 					position++
-				l88:
+				l148:
 					{
-						position89, tokenIndex89, depth89 := position, tokenIndex, depth
+						position149, tokenIndex149, depth149 := position, tokenIndex, depth
 						{
-							position90, tokenIndex90, depth90 := position, tokenIndex, depth
+							position150, tokenIndex150, depth150 := position, tokenIndex, depth
 							{
-								position91, tokenIndex91, depth91 := position, tokenIndex, depth
+								position151, tokenIndex151, depth151 := position, tokenIndex, depth
 								if buffer[position] != rune('"') {
-									goto l92
+									goto l152
 								}
 								position++
-								goto l91
-							l92:
-								position, tokenIndex, depth = position91, tokenIndex91, depth91
+								goto l151
+							l152:
+								position, tokenIndex, depth = position151, tokenIndex151, depth151
 								if buffer[position] != rune('\'') {
-									goto l90
+									goto l150
 								}
 								position++
 							}
-						l91:
-							goto l89
-						l90:
-							position, tokenIndex, depth = position90, tokenIndex90, depth90
+						l151:
+							goto l149
+						l150:
+							position, tokenIndex, depth = position150, tokenIndex150, depth150
 						}
 						if !matchDot() {
-							goto l89
+							goto l149
 						}
-						goto l88
-					l89:
-						position, tokenIndex, depth = position89, tokenIndex89, depth89
+						goto l148
+					l149:
+						position, tokenIndex, depth = position149, tokenIndex149, depth149
 					}
 					if buffer[position] != rune('\'') {
-						goto l85
+						goto l145
 					}
 					position++
 					depth--
-					add(rulePegText, position87)
+					add(rulePegText, position147)
 				}
 				depth--
-				add(rulevalue, position86)
+				add(rulevalue, position146)
 			}
 			return true
-		l85:
-			position, tokenIndex, depth = position85, tokenIndex85, depth85
+		l145:
+			position, tokenIndex, depth = position145, tokenIndex145, depth145
 			return false
 		},
-		/* 4 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
+		/* 8 number <- <<('0' / ([1-9] digit* ('.' digit*)?))>> */
 		func() bool {
-			position93, tokenIndex93, depth93 := position, tokenIndex, depth
+			position153, tokenIndex153, depth153 := position, tokenIndex, depth
 			{
-				position94 := position
+				position154 := position
 				depth++
 				{
-					position95 := position
+					position155 := position
 					depth++
 					{
-						position96, tokenIndex96, depth96 := position, tokenIndex, depth
+						position156, tokenIndex156, depth156 := position, tokenIndex, depth
 						if buffer[position] != rune('0') {
-							goto l97
+							goto l157
 						}
 						position++
-						goto l96
-					l97:
-						position, tokenIndex, depth = position96, tokenIndex96, depth96
+						goto l156
+					l157:
+						position, tokenIndex, depth = position156, tokenIndex156, depth156
 						if c := buffer[position]; c < rune('1') || c > rune('9') {
-							goto l93
+							goto l153
 						}
 						position++
-					l98:
+					l158:
 						{
-							position99, tokenIndex99, depth99 := position, tokenIndex, depth
+							position159, tokenIndex159, depth159 := position, tokenIndex, depth
 							if !_rules[ruledigit]() {
-								goto l99
+								goto l159
 							}
-							goto l98
-						l99:
-							position, tokenIndex, depth = position99, tokenIndex99, depth99
+							goto l158
+						l159:
+							position, tokenIndex, depth = position159, tokenIndex159, depth159
 						}
 						{
-							position100, tokenIndex100, depth100 := position, tokenIndex, depth
+							position160, tokenIndex160, depth160 := position, tokenIndex, depth
 							if buffer[position] != rune('.') {
-								goto l100
+								goto l160
 							}
 							position++
-						l102:
+						l162:
 							{
-								position103, tokenIndex103, depth103 := position, tokenIndex, depth
+								position163, tokenIndex163, depth163 := position, tokenIndex, depth
 								if !_rules[ruledigit]() {
-									goto l103
+									goto l163
 								}
-								goto l102
-							l103:
-								position, tokenIndex, depth = position103, tokenIndex103, depth103
+								goto l162
+							l163:
+								position, tokenIndex, depth = position163, tokenIndex163, depth163
 							}
-							goto l101
-						l100:
-							position, tokenIndex, depth = position100, tokenIndex100, depth100
+							goto l161
+						l160:
+							position, tokenIndex, depth = position160, tokenIndex160, depth160
 						}
-					l101:
+					l161:
 					}
-				l96:
+				l156:
 					depth--
-					add(rulePegText, position95)
+					add(rulePegText, position155)
 				}
 				depth--
-				add(rulenumber, position94)
+				add(rulenumber, position154)
 			}
 			return true
-		l93:
-			position, tokenIndex, depth = position93, tokenIndex93, depth93
+		l153:
+			position, tokenIndex, depth = position153, tokenIndex153, depth153
 			return false
 		},
-		/* 5 digit <- <[0-9]> */
+		/* 9 digit <- <[0-9]> */
 		func() bool {
-			position104, tokenIndex104, depth104 := position, tokenIndex, depth
+			position164, tokenIndex164, depth164 := position, tokenIndex, depth
 			{
-				position105 := position
+				position165 := position
 				depth++
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l104
+					goto l164
 				}
 				position++
 				depth--
-				add(ruledigit, position105)
+				add(ruledigit, position165)
 			}
 			return true
-		l104:
-			position, tokenIndex, depth = position104, tokenIndex104, depth104
+		l164:
+			position, tokenIndex, depth = position164, tokenIndex164, depth164
 			return false
 		},
-		/* 6 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
+		/* 10 time <- <(('t' / 'T') ('i' / 'I') ('m' / 'M') ('e' / 'E') ' ' <(year '-' month '-' day 'T' digit digit ':' digit digit ':' digit digit ((('-' / '+') digit digit ':' digit digit) / 'Z'))>)> */
 		func() bool {
-			position106, tokenIndex106, depth106 := position, tokenIndex, depth
+			position166, tokenIndex166, depth166 := position, tokenIndex, depth
 			{
-				position107 := position
+				position167 := position
 				depth++
 				{
-					position108, tokenIndex108, depth108 := position, tokenIndex, depth
+					position168, tokenIndex168, depth168 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l109
+						goto l169
 					}
 					position++
-					goto l108
-				l109:
-					position, tokenIndex, depth = position108, tokenIndex108, depth108
+					goto l168
+				l169:
+					position, tokenIndex, depth = position168, tokenIndex168, depth168
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l166
 					}
 					position++
 				}
-			l108:
+			l168:
 				{
-					position110, tokenIndex110, depth110 := position, tokenIndex, depth
+					position170, tokenIndex170, depth170 := position, tokenIndex, depth
 					if buffer[position] != rune('i') {
-						goto l111
+						goto l171
 					}
 					position++
-					goto l110
-				l111:
-					position, tokenIndex, depth = position110, tokenIndex110, depth110
+					goto l170
+				l171:
+					position, tokenIndex, depth = position170, tokenIndex170, depth170
 					if buffer[position] != rune('I') {
-						goto l106
+						goto l166
 					}
 					position++
 				}
-			l110:
+			l170:
 				{
-					position112, tokenIndex112, depth112 := position, tokenIndex, depth
+					position172, tokenIndex172, depth172 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l113
+						goto l173
 					}
 					position++
-					goto l112
-				l113:
-					position, tokenIndex, depth = position112, tokenIndex112, depth112
+					goto l172
+				l173:
+					position, tokenIndex, depth = position172, tokenIndex172, depth172
 					if buffer[position] != rune('M') {
-						goto l106
+						goto l166
 					}
 					position++
 				}
-			l112:
+			l172:
 				{
-					position114, tokenIndex114, depth114 := position, tokenIndex, depth
+					position174, tokenIndex174, depth174 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l115
+						goto l175
 					}
 					position++
-					goto l114
-				l115:
-					position, tokenIndex, depth = position114, tokenIndex114, depth114
+					goto l174
+				l175:
+					position, tokenIndex, depth = position174, tokenIndex174, depth174
 					if buffer[position] != rune('E') {
-						goto l106
+						goto l166
 					}
 					position++
 				}
-			l114:
+			l174:
 				if buffer[position] != rune(' ') {
-					goto l106
+					goto l166
 				}
 				position++
 				{
-					position116 := position
+					position176 := position
 					depth++
 					if !_rules[ruleyear]() {
-						goto l106
+						goto l166
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l166
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l106
+						goto l166
 					}
 					if buffer[position] != rune('-') {
-						goto l106
+						goto l166
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l106
+						goto l166
 					}
 					if buffer[position] != rune('T') {
-						goto l106
+						goto l166
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l166
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l166
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l166
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l166
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l166
 					}
 					if buffer[position] != rune(':') {
-						goto l106
+						goto l166
 					}
 					position++
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l166
 					}
 					if !_rules[ruledigit]() {
-						goto l106
+						goto l166
 					}
 					{
-						position117, tokenIndex117, depth117 := position, tokenIndex, depth
+						position177, tokenIndex177, depth177 := position, tokenIndex, depth
 						{
-							position119, tokenIndex119, depth119 := position, tokenIndex, depth
+							position179, tokenIndex179, depth179 := position, tokenIndex, depth
 							if buffer[position] != rune('-') {
-								goto l120
+								goto l180
 							}
 							position++
-							goto l119
-						l120:
-							position, tokenIndex, depth = position119, tokenIndex119, depth119
+							goto l179
+						l180:
+							position, tokenIndex, depth = position179, tokenIndex179, depth179
 							if buffer[position] != rune('+') {
-								goto l118
+								goto l178
 							}
 							position++
 						}
-					l119:
+					l179:
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l178
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l178
 						}
 						if buffer[position] != rune(':') {
-							goto l118
+							goto l178
 						}
 						position++
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l178
 						}
 						if !_rules[ruledigit]() {
-							goto l118
+							goto l178
 						}
-						goto l117
-					l118:
-						position, tokenIndex, depth = position117, tokenIndex117, depth117
+						goto l177
+					l178:
+						position, tokenIndex, depth = position177, tokenIndex177, depth177
 						if buffer[position] != rune('Z') {
-							goto l106
+							goto l166
 						}
 						position++
 					}
-				l117:
+				l177:
 					depth--
-					add(rulePegText, position116)
+					add(rulePegText, position176)
 				}
 				depth--
-				add(ruletime, position107)
+				add(ruletime, position167)
 			}
 			return true
-		l106:
-			position, tokenIndex, depth = position106, tokenIndex106, depth106
+		l166:
+			position, tokenIndex, depth = position166, tokenIndex166, depth166
 			return false
 		},
-		/* 7 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
+		/* 11 date <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('e' / 'E') ' ' <(year '-' month '-' day)>)> */
 		func() bool {
-			position121, tokenIndex121, depth121 := position, tokenIndex, depth
+			position181, tokenIndex181, depth181 := position, tokenIndex, depth
 			{
-				position122 := position
+				position182 := position
 				depth++
 				{
-					position123, tokenIndex123, depth123 := position, tokenIndex, depth
+					position183, tokenIndex183, depth183 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l124
+						goto l184
 					}
 					position++
-					goto l123
-				l124:
-					position, tokenIndex, depth = position123, tokenIndex123, depth123
+					goto l183
+				l184:
+					position, tokenIndex, depth = position183, tokenIndex183, depth183
 					if buffer[position] != rune('D') {
-						goto l121
+						goto l181
 					}
 					position++
 				}
-			l123:
+			l183:
 				{
-					position125, tokenIndex125, depth125 := position, tokenIndex, depth
+					position185, tokenIndex185, depth185 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l126
+						goto l186
 					}
 					position++
-					goto l125
-				l126:
-					position, tokenIndex, depth = position125, tokenIndex125, depth125
+					goto l185
+				l186:
+					position, tokenIndex, depth = position185, tokenIndex185, depth185
 					if buffer[position] != rune('A') {
-						goto l121
+						goto l181
 					}
 					position++
 				}
-			l125:
+			l185:
 				{
-					position127, tokenIndex127, depth127 := position, tokenIndex, depth
+					position187, tokenIndex187, depth187 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l128
+						goto l188
 					}
 					position++
-					goto l127
-				l128:
-					position, tokenIndex, depth = position127, tokenIndex127, depth127
+					goto l187
+				l188:
+					position, tokenIndex, depth = position187, tokenIndex187, depth187
 					if buffer[position] != rune('T') {
-						goto l121
+						goto l181
 					}
 					position++
 				}
-			l127:
+			l187:
 				{
-					position129, tokenIndex129, depth129 := position, tokenIndex, depth
+					position189, tokenIndex189, depth189 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l130
+						goto l190
 					}
 					position++
-					goto l129
-				l130:
-					position, tokenIndex, depth = position129, tokenIndex129, depth129
+					goto l189
+				l190:
+					position, tokenIndex, depth = position189, tokenIndex189, depth189
 					if buffer[position] != rune('E') {
-						goto l121
+						goto l181
 					}
 					position++
 				}
-			l129:
+			l189:
 				if buffer[position] != rune(' ') {
-					goto l121
+					goto l181
 				}
 				position++
 				{
-					position131 := position
+					position191 := position
 					depth++
 					if !_rules[ruleyear]() {
-						goto l121
+						goto l181
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l181
 					}
 					position++
 					if !_rules[rulemonth]() {
-						goto l121
+						goto l181
 					}
 					if buffer[position] != rune('-') {
-						goto l121
+						goto l181
 					}
 					position++
 					if !_rules[ruleday]() {
-						goto l121
+						goto l181
 					}
 					depth--
-					add(rulePegText, position131)
+					add(rulePegText, position191)
 				}
 				depth--
-				add(ruledate, position122)
+				add(ruledate, position182)
 			}
 			return true
-		l121:
-			position, tokenIndex, depth = position121, tokenIndex121, depth121
+		l181:
+			position, tokenIndex, depth = position181, tokenIndex181, depth181
 			return false
 		},
-		/* 8 year <- <(('1' / '2') digit digit digit)> */
+		/* 12 year <- <(('1' / '2') digit digit digit)> */
 		func() bool {
-			position132, tokenIndex132, depth132 := position, tokenIndex, depth
+			position192, tokenIndex192, depth192 := position, tokenIndex, depth
 			{
-				position133 := position
+				position193 := position
 				depth++
 				{
-					position134, tokenIndex134, depth134 := position, tokenIndex, depth
+					position194, tokenIndex194, depth194 := position, tokenIndex, depth
 					if buffer[position] != rune('1') {
-						goto l135
+						goto l195
 					}
 					position++
-					goto l134
-				l135:
-					position, tokenIndex, depth = position134, tokenIndex134, depth134
+					goto l194
+				l195:
+					position, tokenIndex, depth = position194, tokenIndex194, depth194
 					if buffer[position] != rune('2') {
-						goto l132
+						goto l192
 					}
 					position++
 				}
-			l134:
+			l194:
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l192
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l192
 				}
 				if !_rules[ruledigit]() {
-					goto l132
+					goto l192
 				}
 				depth--
-				add(ruleyear, position133)
+				add(ruleyear, position193)
 			}
 			return true
-		l132:
-			position, tokenIndex, depth = position132, tokenIndex132, depth132
+		l192:
+			position, tokenIndex, depth = position192, tokenIndex192, depth192
 			return false
 		},
-		/* 9 month <- <(('0' / '1') digit)> */
+		/* 13 month <- <(('0' / '1') digit)> */
 		func() bool {
-			position136, tokenIndex136, depth136 := position, tokenIndex, depth
+			position196, tokenIndex196, depth196 := position, tokenIndex, depth
 			{
-				position137 := position
+				position197 := position
 				depth++
 				{
-					position138, tokenIndex138, depth138 := position, tokenIndex, depth
+					position198, tokenIndex198, depth198 := position, tokenIndex, depth
 					if buffer[position] != rune('0') {
-						goto l139
+						goto l199
 					}
 					position++
-					goto l138
-				l139:
-					position, tokenIndex, depth = position138, tokenIndex138, depth138
+					goto l198
+				l199:
+					position, tokenIndex, depth = position198, tokenIndex198, depth198
 					if buffer[position] != rune('1') {
-						goto l136
+						goto l196
 					}
 					position++
 				}
-			l138:
+			l198:
 				if !_rules[ruledigit]() {
-					goto l136
+					goto l196
 				}
 				depth--
-				add(rulemonth, position137)
+				add(rulemonth, position197)
 			}
 			return true
-		l136:
-			position, tokenIndex, depth = position136, tokenIndex136, depth136
+		l196:
+			position, tokenIndex, depth = position196, tokenIndex196, depth196
 			return false
 		},
-		/* 10 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
+		/* 14 day <- <(((&('3') '3') | (&('2') '2') | (&('1') '1') | (&('0') '0')) digit)> */
 		func() bool {
-			position140, tokenIndex140, depth140 := position, tokenIndex, depth
+			position200, tokenIndex200, depth200 := position, tokenIndex, depth
 			{
-				position141 := position
+				position201 := position
 				depth++
 				{
 					switch buffer[position] {
 					case '3':
 						if buffer[position] != rune('3') {
-							goto l140
+							goto l200
 						}
 						position++
 						break
 					case '2':
 						if buffer[position] != rune('2') {
-							goto l140
+							goto l200
 						}
 						position++
 						break
 					case '1':
 						if buffer[position] != rune('1') {
-							goto l140
+							goto l200
 						}
 						position++
 						break
 					default:
 						if buffer[position] != rune('0') {
-							goto l140
+							goto l200
 						}
 						position++
 						break
@@ -1838,31 +2276,37 @@ func (p *QueryParser) Init() {
 				}
 
 				if !_rules[ruledigit]() {
-					goto l140
+					goto l200
 				}
 				depth--
-				add(ruleday, position141)
+				add(ruleday, position201)
 			}
 			return true
-		l140:
-			position, tokenIndex, depth = position140, tokenIndex140, depth140
+		l200:
+			position, tokenIndex, depth = position200, tokenIndex200, depth200
 			return false
 		},
-		/* 11 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		/* 15 and <- <(('a' / 'A') ('n' / 'N') ('d' / 'D'))> */
+		nil,
+		/* 16 or <- <(('o' / 'O') ('r' / 'R'))> */
+		nil,
+		/* 17 not <- <(('n' / 'N') ('o' / 'O') ('t' / 'T'))> */
+		nil,
+		/* 18 equal <- <'='> */
 		nil,
-		/* 12 equal <- <'='> */
+		/* 19 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
 		nil,
-		/* 13 contains <- <(('c' / 'C') ('o' / 'O') ('n' / 'N') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('n' / 'N') ('s' / 'S'))> */
+		/* 20 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
 		nil,
-		/* 14 exists <- <(('e' / 'E') ('x' / 'X') ('i' / 'I') ('s' / 'S') ('t' / 'T') ('s' / 'S'))> */
+		/* 21 in <- <(('i' / 'I') ('n' / 'N'))> */
 		nil,
-		/* 15 le <- <('<' '=')> */
+		/* 22 le <- <('<' '=')> */
 		nil,
-		/* 16 ge <- <('>' '=')> */
+		/* 23 ge <- <('>' '=')> */
 		nil,
-		/* 17 l <- <'<'> */
+		/* 24 l <- <'<'> */
 		nil,
-		/* 18 g <- <'>'> */
+		/* 25 g <- <'>'> */
 		nil,
 		nil,
 	}
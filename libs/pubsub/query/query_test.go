@@ -150,6 +150,35 @@ func TestMatches(t *testing.T) {
 			false,
 			false,
 		},
+		{"tx.sender = 'a' OR tx.sender = 'b'", map[string][]string{"tx.sender": {"b"}}, false, true, false},
+		{"tx.sender = 'a' OR tx.sender = 'b'", map[string][]string{"tx.sender": {"c"}}, false, false, false},
+		{"tx.sender IN ('a', 'b')", map[string][]string{"tx.sender": {"b"}}, false, true, false},
+		{"tx.fee IN (1, 2)", map[string][]string{"tx.fee": {"3"}}, false, false, false},
+		{"account.owner = 'Иван' AND tx.fee = 1", map[string][]string{"account.owner": {"Иван"}, "tx.fee": {"1"}}, false, true, false},
+		{"NOT tx.sender = 'a'", map[string][]string{"tx.sender": {"b"}}, false, true, false},
+		{"NOT tx.sender = 'a'", map[string][]string{"tx.sender": {"a", "b"}}, false, false, false},
+		{"NOT slash EXISTS", map[string][]string{"tx.sender": {"a"}}, false, true, false},
+		{
+			"tx.sender = 'a' OR tx.fee > 5 AND tx.fee < 10",
+			map[string][]string{"tx.sender": {"b"}, "tx.fee": {"7"}},
+			false,
+			true,
+			false,
+		},
+		{
+			"(tx.sender = 'a' OR tx.fee > 5) AND tx.fee < 10",
+			map[string][]string{"tx.sender": {"a"}, "tx.fee": {"12"}},
+			false,
+			false,
+			false,
+		},
+		{
+			"tx.fee > 5 AND NOT (tx.sender = 'a' OR tx.sender = 'b')",
+			map[string][]string{"tx.sender": {"c"}, "tx.fee": {"7"}},
+			false,
+			true,
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -220,3 +249,37 @@ func TestConditions(t *testing.T) {
 		assert.Equal(t, tc.conditions, c)
 	}
 }
+
+func TestExpr(t *testing.T) {
+	sender := func(v string) query.Expr {
+		return query.Expr{
+			Kind:      query.ExprCondition,
+			Condition: query.Condition{CompositeKey: "tx.sender", Op: query.OpEqual, Operand: v},
+		}
+	}
+	fee := query.Expr{
+		Kind:      query.ExprCondition,
+		Condition: query.Condition{CompositeKey: "tx.fee", Op: query.OpGreater, Operand: int64(5)},
+	}
+
+	q, err := query.New("tx.fee > 5 AND NOT tx.sender IN ('a', 'b') OR tx.sender = 'c'")
+	require.NoError(t, err)
+	assert.Equal(t, query.Expr{Kind: query.ExprOr, Operands: []query.Expr{
+		{Kind: query.ExprAnd, Operands: []query.Expr{
+			fee,
+			{Kind: query.ExprNot, Operands: []query.Expr{
+				{Kind: query.ExprOr, Operands: []query.Expr{sender("a"), sender("b")}},
+			}},
+		}},
+		sender("c"),
+	}}, q.Expr())
+
+	// only conjunctions have a list of conditions.
+	_, err = q.Conditions()
+	assert.Error(t, err)
+	q, err = query.New("(tx.fee > 5 AND tx.sender IN ('a')) AND tx.sender = 'c'")
+	require.NoError(t, err)
+	conditions, err := q.Conditions()
+	require.NoError(t, err)
+	assert.Equal(t, []query.Condition{fee.Condition, sender("a").Condition, sender("c").Condition}, conditions)
+}
@@ -41,6 +41,12 @@ func RPCRoutes(c *lrpc.Client) map[string]*rpcserver.RPCFunc {
 		"num_unconfirmed_txs":  rpcserver.NewRPCFunc(makeNumUnconfirmedTxsFunc(c), ""),
 		"tx_status":            rpcserver.NewRPCFunc(makeTxStatusFunc(c), "hash"),
 
+		// data availability API
+		"prove_shares":              rpcserver.NewRPCFunc(makeProveSharesFunc(c), "height,startShare,endShare", rpcserver.Cacheable()),
+		"blobs_by_namespace":        rpcserver.NewRPCFunc(makeBlobsByNamespaceFunc(c), "height,namespace", rpcserver.Cacheable()),
+		"data_commitment":           rpcserver.NewRPCFunc(makeDataCommitmentFunc(c), "start,end", rpcserver.Cacheable()),
+		"data_root_inclusion_proof": rpcserver.NewRPCFunc(makeDataRootInclusionProofFunc(c), "height,start,end", rpcserver.Cacheable()),
+
 		// tx broadcast API
 		"broadcast_tx_commit": rpcserver.NewRPCFunc(makeBroadcastTxCommitFunc(c), "tx"),
 		"broadcast_tx_sync":   rpcserver.NewRPCFunc(makeBroadcastTxSyncFunc(c), "tx"),
@@ -152,6 +158,57 @@ func makeTxStatusFunc(c *lrpc.Client) rpcTxStatusFunc {
 	}
 }
 
+type rpcProveSharesFunc func(
+	ctx *rpctypes.Context,
+	height uint64,
+	startShare uint64,
+	endShare uint64,
+) (*ctypes.ResultShareProof, error)
+
+func makeProveSharesFunc(c *lrpc.Client) rpcProveSharesFunc {
+	return func(
+		ctx *rpctypes.Context,
+		height uint64,
+		startShare uint64,
+		endShare uint64,
+	) (*ctypes.ResultShareProof, error) {
+		return c.ProveShares(ctx.Context(), height, startShare, endShare)
+	}
+}
+
+type rpcBlobsByNamespaceFunc func(
+	ctx *rpctypes.Context,
+	height uint64,
+	namespace []byte,
+) (*ctypes.ResultBlobsByNamespace, error)
+
+func makeBlobsByNamespaceFunc(c *lrpc.Client) rpcBlobsByNamespaceFunc {
+	return func(ctx *rpctypes.Context, height uint64, namespace []byte) (*ctypes.ResultBlobsByNamespace, error) {
+		return c.BlobsByNamespace(ctx.Context(), height, namespace)
+	}
+}
+
+type rpcDataCommitmentFunc func(ctx *rpctypes.Context, start, end uint64) (*ctypes.ResultDataCommitment, error)
+
+func makeDataCommitmentFunc(c *lrpc.Client) rpcDataCommitmentFunc {
+	return func(ctx *rpctypes.Context, start, end uint64) (*ctypes.ResultDataCommitment, error) {
+		return c.DataCommitment(ctx.Context(), start, end)
+	}
+}
+
+type rpcDataRootInclusionProofFunc func(
+	ctx *rpctypes.Context,
+	height uint64,
+	start uint64,
+	end uint64,
+) (*ctypes.ResultDataRootInclusionProof, error)
+
+func makeDataRootInclusionProofFunc(c *lrpc.Client) rpcDataRootInclusionProofFunc {
+	return func(ctx *rpctypes.Context, height, start, end uint64) (*ctypes.ResultDataRootInclusionProof, error) {
+		return c.DataRootInclusionProof(ctx.Context(), height, start, end)
+	}
+}
+
 type rpcCommitFunc func(ctx *rpctypes.Context, height *int64) (*ctypes.ResultCommit, error)
 
 func makeCommitFunc(c *lrpc.Client) rpcCommitFunc {
@@ -540,24 +540,65 @@ func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommi
 	}, nil
 }
 
+// DataCommitment calls rpcclient#DataCommitment method and verifies the data
+// commitment by recomputing it over the data roots of the trusted headers of
+// the end exclusive range of heights [start, end).
 func (c *Client) DataCommitment(
 	ctx context.Context,
 	start uint64,
 	end uint64,
 ) (*ctypes.ResultDataCommitment, error) {
-	return c.next.DataCommitment(ctx, start, end)
+	res, err := c.next.DataCommitment(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	headers, err := c.trustedHeaders(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	dataCommitment, err := types.DataCommitment(headers)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(dataCommitment, res.DataCommitment) {
+		return nil, fmt.Errorf("data commitment does not match the trusted headers. (%X != %X)",
+			res.DataCommitment, dataCommitment)
+	}
+	return res, nil
 }
 
 // DataRootInclusionProof calls rpcclient#DataRootInclusionProof method and returns
 // a merkle proof for the data root of block height `height` to the set of blocks
-// defined by `start` and `end`.
+// defined by `start` and `end`. The proof is verified against the data
+// commitment recomputed over the trusted headers of the range.
 func (c *Client) DataRootInclusionProof(
 	ctx context.Context,
 	height uint64,
 	start uint64,
 	end uint64,
 ) (*ctypes.ResultDataRootInclusionProof, error) {
-	return c.next.DataRootInclusionProof(ctx, height, start, end)
+	res, err := c.next.DataRootInclusionProof(ctx, height, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if height < start || height >= end {
+		return nil, fmt.Errorf("height %d is not in the range [%d, %d)", height, start, end)
+	}
+
+	headers, err := c.trustedHeaders(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	dataCommitment, err := types.DataCommitment(headers)
+	if err != nil {
+		return nil, err
+	}
+	err = types.VerifyDataRootInclusion(&res.Proof, headers[height-start], start, end, dataCommitment)
+	if err != nil {
+		return nil, fmt.Errorf("invalid data root inclusion proof: %w", err)
+	}
+	return res, nil
 }
 
 // Tx calls rpcclient#Tx method and then verifies the proof if such was
@@ -601,7 +642,77 @@ func (c *Client) ProveShares(
 	endShare uint64,
 ) (*ctypes.ResultShareProof, error) {
 	res, err := c.next.ProveShares(ctx, height, startShare, endShare)
-	return res, err
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the light client if we're behind.
+	h := int64(height)
+	l, err := c.updateLightClientIfNeededTo(ctx, &h)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyShareProof(res.Proof, l.DataHash); err != nil {
+		return nil, err
+	}
+	if err := verifyShareRange(res.Proof, startShare, endShare); err != nil {
+		return nil, err
+	}
+	return res, nil
+}
+
+// BlobsByNamespace calls rpcclient#BlobsByNamespace method and returns the
+// blobs of a namespace included in the block at the given height. The shares
+// of every blob are verified against the trusted data root, and the blob is
+// checked to be the one stored in those shares.
+//
+// NOTE: the proofs only show that the returned blobs are included in the
+// block, not that no other blob of the namespace was omitted.
+func (c *Client) BlobsByNamespace(
+	ctx context.Context,
+	height uint64,
+	namespace []byte,
+) (*ctypes.ResultBlobsByNamespace, error) {
+	res, err := c.next.BlobsByNamespace(ctx, height, namespace)
+	if err != nil {
+		return nil, err
+	}
+
+	// Update the light client if we're behind.
+	h := int64(height)
+	l, err := c.updateLightClientIfNeededTo(ctx, &h)
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Height != h || !bytes.Equal(res.Namespace, namespace) {
+		return nil, fmt.Errorf("expected blobs of namespace %X at height %d, got namespace %X at height %d",
+			namespace, h, res.Namespace, res.Height)
+	}
+	for i, blob := range res.Blobs {
+		if err := verifyShareProof(blob.Proof, l.DataHash); err != nil {
+			return nil, fmt.Errorf("blob %d: %w", i, err)
+		}
+		if err := verifyShareRange(blob.Proof, blob.StartShare, blob.EndShare); err != nil {
+			return nil, fmt.Errorf("blob %d: %w", i, err)
+		}
+		proofNamespace, err := blob.Proof.Namespace()
+		if err != nil {
+			return nil, fmt.Errorf("blob %d: %w", i, err)
+		}
+		if !bytes.Equal(proofNamespace, namespace) {
+			return nil, fmt.Errorf("blob %d: expected shares of namespace %X, got %X", i, namespace, proofNamespace)
+		}
+		blobNamespace, shareVersion, data, err := types.ParseSparseShares(blob.Proof.Data)
+		if err != nil {
+			return nil, fmt.Errorf("blob %d: %w", i, err)
+		}
+		if !bytes.Equal(blobNamespace, namespace) || shareVersion != blob.ShareVersion || !bytes.Equal(data, blob.Data) {
+			return nil, fmt.Errorf("blob %d does not match the proven shares", i)
+		}
+	}
+	return res, nil
 }
 
 func (c *Client) TxSearch(
@@ -671,6 +782,49 @@ func (c *Client) UnsubscribeAll(ctx context.Context, subscriber string) error {
 	return c.next.UnsubscribeAll(ctx, subscriber)
 }
 
+// trustedHeaders returns the verified headers of the end exclusive range of
+// heights [start, end).
+func (c *Client) trustedHeaders(ctx context.Context, start, end uint64) ([]*types.Header, error) {
+	if start == 0 || start >= end {
+		return nil, fmt.Errorf("invalid range of heights [%d, %d)", start, end)
+	}
+	headers := make([]*types.Header, 0, end-start)
+	for height := int64(start); height < int64(end); height++ {
+		l, err := c.updateLightClientIfNeededTo(ctx, &height)
+		if err != nil {
+			return nil, err
+		}
+		headers = append(headers, l.Header)
+	}
+	return headers, nil
+}
+
+// verifyShareProof checks that the proof is correctly constructed and that
+// the shares are included in the data root.
+func verifyShareProof(proof types.ShareProof, dataRoot []byte) error {
+	if err := proof.Validate(); err != nil {
+		return err
+	}
+	if !proof.VerifyProof(dataRoot) {
+		return errors.New("invalid shares proof")
+	}
+	return nil
+}
+
+// verifyShareRange checks that the proof is of the end exclusive range of
+// shares [start, end) of the original data square. VerifyProof only shows that
+// the proven shares are in the data root, whichever shares they are.
+func verifyShareRange(proof types.ShareProof, start, end uint64) error {
+	proofStart, proofEnd, err := proof.ShareRange()
+	if err != nil {
+		return err
+	}
+	if proofStart != start || proofEnd != end {
+		return fmt.Errorf("expected a proof of shares [%d, %d), got [%d, %d)", start, end, proofStart, proofEnd)
+	}
+	return nil
+}
+
 func (c *Client) updateLightClientIfNeededTo(ctx context.Context, height *int64) (*types.LightBlock, error) {
 	var (
 		l   *types.LightBlock
@@ -0,0 +1,196 @@
+package rpc
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"testing"
+
+	"github.com/celestiaorg/nmt"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+	lcmock "github.com/cometbft/cometbft/light/rpc/mocks"
+	"github.com/cometbft/cometbft/pkg/consts"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// dataClient is a client whose data commitment, inclusion and share proofs
+// are set by the test.
+type dataClient struct {
+	rpcclient.Client
+	dataCommitment []byte
+	proofs         []*merkle.Proof
+	shareProof     types.ShareProof
+	blobs          *ctypes.ResultBlobsByNamespace
+}
+
+func (c *dataClient) DataCommitment(_ context.Context, _, _ uint64) (*ctypes.ResultDataCommitment, error) {
+	return &ctypes.ResultDataCommitment{DataCommitment: c.dataCommitment}, nil
+}
+
+func (c *dataClient) DataRootInclusionProof(_ context.Context, height, start, _ uint64) (*ctypes.ResultDataRootInclusionProof, error) {
+	return &ctypes.ResultDataRootInclusionProof{Proof: *c.proofs[height-start]}, nil
+}
+
+func (c *dataClient) ProveShares(_ context.Context, _, _, _ uint64) (*ctypes.ResultShareProof, error) {
+	return &ctypes.ResultShareProof{Proof: c.shareProof}, nil
+}
+
+func (c *dataClient) BlobsByNamespace(_ context.Context, _ uint64, _ []byte) (*ctypes.ResultBlobsByNamespace, error) {
+	return c.blobs, nil
+}
+
+func TestClientDataCommitment(t *testing.T) {
+	const start, end = 1, 5
+	lc := &lcmock.LightClient{}
+	var leaves [][]byte
+	for height := int64(start); height < end; height++ {
+		header := &types.Header{Height: height, DataHash: cmtrand.Bytes(32)}
+		lc.On("VerifyLightBlockAtHeight", mock.Anything, height, mock.Anything).
+			Return(&types.LightBlock{SignedHeader: &types.SignedHeader{Header: header}}, nil)
+		leaves = append(leaves, types.EncodeDataRootTuple(uint64(height), *(*[32]byte)(header.DataHash)))
+	}
+	dataCommitment, proofs := merkle.ProofsFromByteSlices(leaves)
+
+	next := &dataClient{dataCommitment: dataCommitment, proofs: proofs}
+	c := NewClient(next, lc)
+	ctx := context.Background()
+
+	res, err := c.DataCommitment(ctx, start, end)
+	require.NoError(t, err)
+	assert.EqualValues(t, dataCommitment, res.DataCommitment)
+	for height := uint64(start); height < end; height++ {
+		_, err = c.DataRootInclusionProof(ctx, height, start, end)
+		require.NoError(t, err)
+	}
+
+	// proofs that don't match the trusted headers are rejected.
+	next.proofs = []*merkle.Proof{proofs[1], proofs[0], proofs[2], proofs[3]}
+	_, err = c.DataRootInclusionProof(ctx, start, start, end)
+	assert.Error(t, err)
+
+	next.dataCommitment = cmtrand.Bytes(32)
+	_, err = c.DataCommitment(ctx, start, end)
+	assert.Error(t, err)
+
+	// share proofs that don't match the trusted data root are rejected.
+	next.shareProof = types.ShareProof{Data: [][]byte{cmtrand.Bytes(512)}}
+	_, err = c.ProveShares(ctx, start, 0, 1)
+	assert.Error(t, err)
+}
+
+func TestClientShareProofRange(t *testing.T) {
+	namespaceA := append(make([]byte, consts.NamespaceSize-1), 0xA)
+	namespaceB := append(make([]byte, consts.NamespaceSize-1), 0xB)
+	tailPadding := bytes.Repeat([]byte{0xFF}, consts.NamespaceSize)
+	data := []byte("blob")
+	// a square of width 2: a blob of namespace A, one of namespace B, and a
+	// row of tail padding.
+	shares := [][]byte{
+		blobShare(namespaceA, data),
+		blobShare(namespaceB, data),
+		blobShare(tailPadding, nil),
+		blobShare(tailPadding, nil),
+	}
+	dataRoot, proveShares := squareProofs(t, shares, 2)
+
+	lc := &lcmock.LightClient{}
+	header := &types.Header{Height: 1, DataHash: dataRoot}
+	lc.On("VerifyLightBlockAtHeight", mock.Anything, int64(1), mock.Anything).
+		Return(&types.LightBlock{SignedHeader: &types.SignedHeader{Header: header}}, nil)
+	next := &dataClient{}
+	c := NewClient(next, lc)
+	ctx := context.Background()
+
+	next.shareProof = proveShares(0, 1)
+	_, err := c.ProveShares(ctx, 1, 0, 1)
+	require.NoError(t, err)
+	// a valid proof of other shares than the requested ones is rejected.
+	_, err = c.ProveShares(ctx, 1, 1, 2)
+	assert.Error(t, err)
+
+	blob := ctypes.NamespacedBlob{Data: data, StartShare: 0, EndShare: 1, Proof: proveShares(0, 1)}
+	next.blobs = &ctypes.ResultBlobsByNamespace{Height: 1, Namespace: namespaceA, Blobs: []ctypes.NamespacedBlob{blob}}
+	_, err = c.BlobsByNamespace(ctx, 1, namespaceA)
+	require.NoError(t, err)
+
+	// the blob of namespace B, claimed to be at the shares of the blob of
+	// namespace A.
+	blob = ctypes.NamespacedBlob{Data: data, StartShare: 0, EndShare: 1, Proof: proveShares(1, 2)}
+	next.blobs = &ctypes.ResultBlobsByNamespace{Height: 1, Namespace: namespaceB, Blobs: []ctypes.NamespacedBlob{blob}}
+	_, err = c.BlobsByNamespace(ctx, 1, namespaceB)
+	assert.Error(t, err)
+
+	// the blob of namespace A, returned for namespace B.
+	blob = ctypes.NamespacedBlob{Data: data, StartShare: 0, EndShare: 1, Proof: proveShares(0, 1)}
+	next.blobs = &ctypes.ResultBlobsByNamespace{Height: 1, Namespace: namespaceB, Blobs: []ctypes.NamespacedBlob{blob}}
+	_, err = c.BlobsByNamespace(ctx, 1, namespaceB)
+	assert.Error(t, err)
+}
+
+// blobShare returns the single share of version 0 storing a blob.
+func blobShare(namespace, data []byte) []byte {
+	share := append([]byte{}, namespace...)
+	share = append(share, 1)
+	share = binary.BigEndian.AppendUint32(share, uint32(len(data)))
+	share = append(share, data...)
+	return append(share, make([]byte, consts.ShareSize-len(share))...)
+}
+
+// squareProofs returns the data root of a square of shares of the given width
+// that isn't extended, committing to stand-ins for the roots of the parity
+// rows and of the columns, and a function proving the shares [start, end) of
+// a single row.
+func squareProofs(t *testing.T, shares [][]byte, width int) ([]byte, func(start, end int) types.ShareProof) {
+	var (
+		rows  []*nmt.NamespacedMerkleTree
+		roots [][]byte
+	)
+	for r := 0; r < width; r++ {
+		tree := nmt.New(sha256.New(), nmt.NamespaceIDSize(consts.NamespaceSize), nmt.IgnoreMaxNamespace(true))
+		for _, share := range shares[r*width : (r+1)*width] {
+			require.NoError(t, tree.Push(append(append([]byte{}, share[:consts.NamespaceSize]...), share...)))
+		}
+		root, err := tree.Root()
+		require.NoError(t, err)
+		rows = append(rows, tree)
+		roots = append(roots, root)
+	}
+	for i := width; i < 4*width; i++ {
+		roots = append(roots, cmtrand.Bytes(32))
+	}
+	dataRoot, rowProofs := merkle.ProofsFromByteSlices(roots)
+
+	return dataRoot, func(start, end int) types.ShareProof {
+		r := start / width
+		nmtProof, err := rows[r].ProveRange(start-r*width, end-r*width)
+		require.NoError(t, err)
+		namespace := shares[start][:consts.NamespaceSize]
+		return types.ShareProof{
+			Data: shares[start:end],
+			ShareProofs: []*cmtproto.NMTProof{{
+				Start: int32(nmtProof.Start()),
+				End:   int32(nmtProof.End()),
+				Nodes: nmtProof.Nodes(),
+			}},
+			NamespaceID:      namespace[1:],
+			NamespaceVersion: uint32(namespace[0]),
+			RowProof: types.RowProof{
+				RowRoots: []cmtbytes.HexBytes{roots[r]},
+				Proofs:   []*merkle.Proof{rowProofs[r]},
+				StartRow: uint32(r),
+				EndRow:   uint32(r),
+			},
+		}
+	}
+}
@@ -95,6 +95,89 @@ func (c *LRUTxCache) Has(txKey types.TxKey) bool {
 	return ok
 }
 
+// LRURejectionCache maintains a thread-safe LRU cache of the code and log of
+// the CheckTx responses that rejected transactions, so that their status can
+// be reported after they have left the mempool.
+type LRURejectionCache struct {
+	staticSize int
+
+	mtx      tmsync.Mutex
+	cacheMap map[types.TxKey]*list.Element
+	list     *list.List
+}
+
+type rejection struct {
+	key  types.TxKey
+	code uint32
+	log  string
+}
+
+func NewLRURejectionCache(cacheSize int) *LRURejectionCache {
+	return &LRURejectionCache{
+		staticSize: cacheSize,
+		cacheMap:   make(map[types.TxKey]*list.Element, cacheSize),
+		list:       list.New(),
+	}
+}
+
+func (c *LRURejectionCache) Reset() {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	c.cacheMap = make(map[types.TxKey]*list.Element, c.staticSize)
+	c.list.Init()
+}
+
+// Push records the code and log of the response that rejected the
+// transaction, replacing any earlier rejection.
+func (c *LRURejectionCache) Push(txKey types.TxKey, code uint32, log string) {
+	if c.staticSize == 0 {
+		return
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.cacheMap[txKey]; ok {
+		e.Value = rejection{key: txKey, code: code, log: log}
+		c.list.MoveToBack(e)
+		return
+	}
+
+	if c.list.Len() >= c.staticSize {
+		front := c.list.Front()
+		if front != nil {
+			delete(c.cacheMap, front.Value.(rejection).key)
+			c.list.Remove(front)
+		}
+	}
+
+	c.cacheMap[txKey] = c.list.PushBack(rejection{key: txKey, code: code, log: log})
+}
+
+func (c *LRURejectionCache) Remove(txKey types.TxKey) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if e, ok := c.cacheMap[txKey]; ok {
+		delete(c.cacheMap, txKey)
+		c.list.Remove(e)
+	}
+}
+
+// Get returns the code and log of the response that rejected the transaction.
+func (c *LRURejectionCache) Get(txKey types.TxKey) (code uint32, log string, ok bool) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	e, ok := c.cacheMap[txKey]
+	if !ok {
+		return 0, "", false
+	}
+	r := e.Value.(rejection)
+	return r.code, r.log, true
+}
+
 // SeenTxSet records transactions that have been
 // seen by other peers but not yet by us
 type SeenTxSet struct {
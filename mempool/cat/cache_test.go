@@ -76,6 +76,39 @@ func TestLRUTxCacheSize(t *testing.T) {
 	}
 }
 
+func TestLRURejectionCache(t *testing.T) {
+	const size = 10
+	cache := NewLRURejectionCache(size)
+
+	for i := 0; i < size*2; i++ {
+		tx := types.Tx([]byte(fmt.Sprintf("tx%d", i)))
+		cache.Push(tx.Key(), uint32(i), fmt.Sprintf("log%d", i))
+		require.Less(t, cache.list.Len(), size+1)
+	}
+	// the oldest rejections were dropped.
+	_, _, ok := cache.Get(types.Tx("tx0").Key())
+	require.False(t, ok)
+
+	key := types.Tx(fmt.Sprintf("tx%d", size)).Key()
+	code, log, ok := cache.Get(key)
+	require.True(t, ok)
+	require.EqualValues(t, size, code)
+	require.Equal(t, fmt.Sprintf("log%d", size), log)
+
+	// a later rejection replaces the earlier one.
+	cache.Push(key, 1, "other")
+	code, log, ok = cache.Get(key)
+	require.True(t, ok)
+	require.EqualValues(t, 1, code)
+	require.Equal(t, "other", log)
+	require.Equal(t, size, cache.list.Len())
+
+	cache.Remove(key)
+	_, _, ok = cache.Get(key)
+	require.False(t, ok)
+	require.Equal(t, size-1, cache.list.Len())
+}
+
 func TestSeenTxSetConcurrency(t *testing.T) {
 	seenSet := NewSeenTxSet()
 
@@ -3,6 +3,7 @@ package cat
 import (
 	"errors"
 	"fmt"
+	"math"
 	"runtime"
 	"sort"
 	"sync"
@@ -22,8 +23,9 @@ import (
 var _ mempool.Mempool = (*TxPool)(nil)
 
 var (
-	ErrTxInMempool       = errors.New("tx already exists in mempool")
-	ErrTxAlreadyRejected = errors.New("tx was previously rejected")
+	ErrTxInMempool            = errors.New("tx already exists in mempool")
+	ErrTxAlreadyRejected      = errors.New("tx was previously rejected")
+	ErrReplacementUnderpriced = errors.New("replacement tx priority is too low")
 )
 
 // TxPoolOption sets an optional parameter on the TxPool.
@@ -38,6 +40,12 @@ type TxPoolOption func(*TxPool)
 // the order to which transactions are entered. There is no guarantee when CheckTx
 // passes that a transaction has been successfully broadcast to any of its peers.
 //
+// The application can also assign a sender and a sequence to transactions in
+// the CheckTx response. The transactions of a sender are always reaped in
+// sequence order, and a transaction with the same sender and sequence as one
+// already in the mempool replaces it if its priority is higher by at least the
+// configured percentage.
+//
 // A TTL can be set to remove transactions after a period of time or a number
 // of heights.
 //
@@ -66,12 +74,21 @@ type TxPool struct {
 	rejectedTxCache *LRUTxCache
 	// Thread-safe cache of evicted transactions for quick look-up
 	evictedTxCache *LRUTxCache
+	// Thread-safe cache of the responses that rejected transactions, for the
+	// TxStatus RPC endpoint
+	rejectionCache *LRURejectionCache
+	// Thread-safe cache of the transactions watched by a broadcast_tx_watch
+	// subscriber, which are reported when committed even if not in the store
+	watchedTxCache *LRUTxCache
 	// Thread-safe list of transactions peers have seen that we have not yet seen
 	seenByPeersSet *SeenTxSet
 
 	// Store of wrapped transactions
 	store *store
 
+	// eventBus publishes the life cycle events of transactions
+	eventBus types.TxStatusEventPublisher
+
 	// broadcastCh is an unbuffered channel of new transactions that need to
 	// be broadcasted to peers. Only populated if `broadcast` in the config is enabled
 	broadcastCh      chan *wrappedTx
@@ -95,11 +112,14 @@ func NewTxPool(
 		metrics:          mempool.NopMetrics(),
 		rejectedTxCache:  NewLRUTxCache(cfg.CacheSize),
 		evictedTxCache:   NewLRUTxCache(cfg.CacheSize / 5),
+		rejectionCache:   NewLRURejectionCache(cfg.CacheSize / 5),
+		watchedTxCache:   NewLRUTxCache(cfg.CacheSize / 5),
 		seenByPeersSet:   NewSeenTxSet(),
 		height:           height,
 		preCheckFn:       func(_ types.Tx) error { return nil },
 		postCheckFn:      func(_ types.Tx, _ *abci.ResponseCheckTx) error { return nil },
 		store:            newStore(),
+		eventBus:         types.NopEventBus{},
 		broadcastCh:      make(chan *wrappedTx),
 		txsToBeBroadcast: make([]types.TxKey, 0),
 	}
@@ -130,6 +150,12 @@ func WithMetrics(metrics *mempool.Metrics) TxPoolOption {
 	return func(txmp *TxPool) { txmp.metrics = metrics }
 }
 
+// WithEventBus sets the event bus the life cycle events of transactions are
+// published to.
+func WithEventBus(eventBus types.TxStatusEventPublisher) TxPoolOption {
+	return func(txmp *TxPool) { txmp.eventBus = eventBus }
+}
+
 // Lock is a noop as ABCI calls are serialized
 func (txmp *TxPool) Lock() {}
 
@@ -202,6 +228,13 @@ func (txmp *TxPool) IsRejectedTx(txKey types.TxKey) bool {
 	return txmp.rejectedTxCache.Has(txKey)
 }
 
+// WasRecentlyRejected returns the code and log of the response that rejected
+// the transaction, either when it was first checked or during a recheck, if
+// it is still within the rejection cache.
+func (txmp *TxPool) WasRecentlyRejected(txKey types.TxKey) (uint32, string, bool) {
+	return txmp.rejectionCache.Get(txKey)
+}
+
 // CheckToPurgeExpiredTxs checks if there has been adequate time since the last time
 // the txpool looped through all transactions and if so, performs a purge of any transaction
 // that has expired according to the TTLDuration. This is thread safe.
@@ -216,6 +249,7 @@ func (txmp *TxPool) CheckToPurgeExpiredTxs() {
 		// Add the purged transactions to the evicted cache
 		for _, tx := range purgedTxs {
 			txmp.evictedTxCache.Push(tx.key)
+			txmp.publishTxStatus(tx.key, types.EventDataTxStatus{Status: types.TxStatusEvicted, Reason: types.TxEvictedTTL})
 		}
 		txmp.metrics.EvictedTxs.Add(float64(numExpired))
 		txmp.lastPurgeTime = time.Now()
@@ -324,6 +358,7 @@ func (txmp *TxPool) TryAddNewTx(tx types.Tx, key types.TxKey, txInfo mempool.TxI
 
 	// If a precheck hook is defined, call it before invoking the application.
 	if err := txmp.preCheck(tx); err != nil {
+		txmp.rejectTx(key, mempool.CodeTypeRejected, err.Error())
 		txmp.metrics.FailedTxs.Add(1)
 		return nil, mempool.ErrPreCheck{Reason: err}
 	}
@@ -342,13 +377,14 @@ func (txmp *TxPool) TryAddNewTx(tx types.Tx, key types.TxKey, txInfo mempool.TxI
 		if txmp.config.KeepInvalidTxsInCache {
 			txmp.rejectedTxCache.Push(key)
 		}
+		txmp.rejectTx(key, rsp.Code, rsp.Log)
 		txmp.metrics.FailedTxs.Add(1)
 		return rsp, fmt.Errorf("application rejected transaction with code %d (Log: %s)", rsp.Code, rsp.Log)
 	}
 
 	// Create wrapped tx
 	wtx := newWrappedTx(
-		tx, key, txmp.Height(), rsp.GasWanted, rsp.Priority, rsp.Sender,
+		tx, key, txmp.Height(), rsp.GasWanted, rsp.Priority, rsp.Sender, rsp.Sequence,
 	)
 
 	// Perform the post check
@@ -357,6 +393,7 @@ func (txmp *TxPool) TryAddNewTx(tx types.Tx, key types.TxKey, txInfo mempool.TxI
 		if txmp.config.KeepInvalidTxsInCache {
 			txmp.rejectedTxCache.Push(key)
 		}
+		txmp.rejectTx(key, rsp.Code, err.Error())
 		txmp.metrics.FailedTxs.Add(1)
 		return rsp, fmt.Errorf("rejected bad transaction after post check: %w", err)
 	}
@@ -393,6 +430,7 @@ func (txmp *TxPool) Flush() {
 	txmp.seenByPeersSet.Reset()
 	txmp.rejectedTxCache.Reset()
 	txmp.evictedTxCache.Reset()
+	txmp.rejectionCache.Reset()
 	txmp.metrics.EvictedTxs.Add(float64(size))
 	txmp.broadcastMtx.Lock()
 	defer txmp.broadcastMtx.Unlock()
@@ -407,7 +445,9 @@ func (txmp *TxPool) PeerHasTx(peer uint16, txKey types.TxKey) {
 
 // allEntriesSorted returns a slice of all the transactions currently in the
 // mempool, sorted in nonincreasing order by priority with ties broken by
-// increasing order of arrival time.
+// increasing order of arrival time. The transactions of a sender are then
+// reordered among the positions they occupy so that they are in sequence
+// order.
 func (txmp *TxPool) allEntriesSorted() []*wrappedTx {
 	txs := txmp.store.getAllTxs()
 	sort.Slice(txs, func(i, j int) bool {
@@ -416,13 +456,37 @@ func (txmp *TxPool) allEntriesSorted() []*wrappedTx {
 		}
 		return txs[i].priority > txs[j].priority // N.B. higher priorities first
 	})
+
+	positions := make(map[string][]int)
+	for i, tx := range txs {
+		if tx.hasSequence() {
+			positions[tx.sender] = append(positions[tx.sender], i)
+		}
+	}
+	for _, indexes := range positions {
+		if len(indexes) == 1 {
+			continue
+		}
+		senderTxs := make([]*wrappedTx, len(indexes))
+		for i, idx := range indexes {
+			senderTxs[i] = txs[idx]
+		}
+		sort.Slice(senderTxs, func(i, j int) bool {
+			return senderTxs[i].sequence < senderTxs[j].sequence
+		})
+		for i, idx := range indexes {
+			txs[idx] = senderTxs[i]
+		}
+	}
 	return txs
 }
 
 // ReapMaxBytesMaxGas returns a slice of valid transactions that fit within the
 // size and gas constraints. The results are ordered by nonincreasing priority,
-// with ties broken by increasing order of arrival. Reaping transactions does
-// not remove them from the mempool
+// with ties broken by increasing order of arrival, except that the
+// transactions of a sender are in sequence order. Once a transaction of a
+// sender doesn't fit, none of its later transactions are reaped. Reaping
+// transactions does not remove them from the mempool
 //
 // If maxBytes < 0, no limit is set on the total size in bytes.
 // If maxGas < 0, no limit is set on the total gas cost.
@@ -433,12 +497,20 @@ func (txmp *TxPool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 	var totalGas, totalBytes int64
 
 	var keep []types.Tx //nolint:prealloc
+	skippedSenders := make(map[string]struct{})
 	for _, w := range txmp.allEntriesSorted() {
+		if _, skipped := skippedSenders[w.sender]; skipped && w.hasSequence() {
+			continue
+		}
 		// N.B. When computing byte size, we need to include the overhead for
 		// encoding as protobuf to send to the application. This actually overestimates it
 		// as we add the proto overhead to each transaction
 		txBytes := types.ComputeProtoSizeForTxs([]types.Tx{w.tx})
 		if (maxGas >= 0 && totalGas+w.gasWanted > maxGas) || (maxBytes >= 0 && totalBytes+txBytes > maxBytes) {
+			// later transactions of the sender would be out of sequence
+			if w.hasSequence() {
+				skippedSenders[w.sender] = struct{}{}
+			}
 			continue
 		}
 		totalBytes += txBytes
@@ -450,7 +522,8 @@ func (txmp *TxPool) ReapMaxBytesMaxGas(maxBytes, maxGas int64) types.Txs {
 
 // ReapMaxTxs returns up to max transactions from the mempool. The results are
 // ordered by nonincreasing priority with ties broken by increasing order of
-// arrival. Reaping transactions does not remove them from the mempool.
+// arrival, except that the transactions of a sender are in sequence order.
+// Reaping transactions does not remove them from the mempool.
 //
 // If max < 0, all transactions in the mempool are reaped.
 //
@@ -507,9 +580,22 @@ func (txmp *TxPool) Update(
 	txmp.updateMtx.Unlock()
 
 	txmp.metrics.SuccessfulTxs.Add(float64(len(blockTxs)))
-	for _, tx := range blockTxs {
+	for i, tx := range blockTxs {
 		// Regardless of success, remove the transaction from the mempool.
-		txmp.removeTxByKey(tx.Key())
+		key := tx.Key()
+		// Only report the txs this node knows about: most of the txs of a
+		// block may never have entered this mempool.
+		known := txmp.store.has(key) || txmp.watchedTxCache.Has(key)
+		txmp.removeTxByKey(key)
+		if known {
+			txmp.publishTxStatus(key, types.EventDataTxStatus{
+				Status: types.TxStatusCommitted,
+				Code:   deliverTxResponses[i].Code,
+				Log:    deliverTxResponses[i].Log,
+				Height: blockHeight,
+				Index:  uint32(i),
+			})
+		}
 	}
 
 	txmp.purgeExpiredTxs(blockHeight)
@@ -537,6 +623,10 @@ func (txmp *TxPool) Update(
 // If either the application rejected the transaction or a post-check hook is
 // defined and rejects the transaction, it is discarded.
 //
+// If a transaction of the same sender and sequence is in the mempool, the new
+// transaction replaces it if its priority is high enough, and is discarded
+// otherwise.
+//
 // Otherwise, if the mempool is full, check for lower-priority transactions
 // that can be evicted to make room for the new one. If no such transactions
 // exist, this transaction is logged and dropped; otherwise the selected
@@ -544,20 +634,50 @@ func (txmp *TxPool) Update(
 //
 // Finally, the new transaction is added and size stats updated.
 func (txmp *TxPool) addNewTransaction(wtx *wrappedTx, checkTxRes *abci.ResponseCheckTx) error {
+	// The same transaction may have been added since it was checked, and
+	// must not be taken for a replacement of itself.
+	if txmp.store.has(wtx.key) {
+		return ErrTxInMempool
+	}
+
+	// The transaction to replace, if any, makes room for the new one.
+	size := wtx.size()
+	replaced := txmp.store.getBySequence(wtx.sender, wtx.sequence)
+	if replaced != nil {
+		if minPriority := txmp.minReplacementPriority(replaced.priority); wtx.priority < minPriority {
+			checkTxRes.MempoolError = fmt.Sprintf("rejected valid incoming transaction; priority %d is below the %d needed to replace %X",
+				wtx.priority, minPriority, replaced.key)
+			txmp.rejectTx(wtx.key, mempool.CodeTypeRejected, checkTxRes.MempoolError)
+			return fmt.Errorf("%w: priority %d is below the %d needed to replace %X",
+				ErrReplacementUnderpriced, wtx.priority, minPriority, replaced.key)
+		}
+		size -= replaced.size()
+	}
+
 	// At this point the application has ruled the transaction valid, but the
 	// mempool might be full. If so, find the lowest-priority items with lower
 	// priority than the application assigned to this new one, and evict as many
 	// of them as necessary to make room for tx. If no such items exist, we
 	// discard tx.
-	if !txmp.canAddTx(wtx.size()) {
+	if !txmp.canAddTx(size) {
 		victims, victimBytes := txmp.store.getTxsBelowPriority(wtx.priority)
+		if replaced != nil {
+			for i, victim := range victims {
+				if victim == replaced {
+					victims = append(victims[:i], victims[i+1:]...)
+					victimBytes -= replaced.size()
+					break
+				}
+			}
+		}
 
 		// If there are no suitable eviction candidates, or the total size of
 		// those candidates is not enough to make room for the new transaction,
 		// drop the new one.
-		if len(victims) == 0 || victimBytes < wtx.size() {
+		if len(victims) == 0 || victimBytes < size {
 			txmp.metrics.EvictedTxs.Add(1)
 			txmp.evictedTxCache.Push(wtx.key)
+			txmp.publishTxStatus(wtx.key, types.EventDataTxStatus{Status: types.TxStatusEvicted, Reason: types.TxEvictedFull})
 			checkTxRes.MempoolError = fmt.Sprintf("rejected valid incoming transaction; mempool is full (%X)",
 				wtx.key)
 			return fmt.Errorf("rejected valid incoming transaction; mempool is full (%X). Size: (%d:%d)",
@@ -588,13 +708,45 @@ func (txmp *TxPool) addNewTransaction(wtx *wrappedTx, checkTxRes *abci.ResponseC
 			// We may not need to evict all the eligible transactions.  Bail out
 			// early if we have made enough room.
 			availableBytes += tx.size()
-			if availableBytes >= wtx.size() {
+			if availableBytes >= size {
 				break
 			}
 		}
 	}
 
-	txmp.store.set(wtx)
+	if replaced != nil {
+		if !txmp.store.replace(replaced, wtx) {
+			if txmp.store.has(wtx.key) {
+				return ErrTxInMempool
+			}
+			return fmt.Errorf("rejected valid incoming transaction; %X was removed before it could be replaced",
+				replaced.key)
+		}
+		// The replaced transaction can't be included anymore, so peers
+		// announcing it are ignored.
+		txmp.rejectedTxCache.Push(replaced.key)
+		txmp.seenByPeersSet.RemoveKey(replaced.key)
+		txmp.evictedTxCache.Push(replaced.key)
+		txmp.metrics.ReplacedTxs.Add(1)
+		txmp.publishTxStatus(replaced.key, types.EventDataTxStatus{Status: types.TxStatusEvicted, Reason: types.TxEvictedReplaced})
+		txmp.logger.Debug(
+			"replaced transaction of the same sender and sequence",
+			"old_tx", fmt.Sprintf("%X", replaced.key),
+			"old_priority", replaced.priority,
+			"new_tx", fmt.Sprintf("%X", wtx.key),
+			"new_priority", wtx.priority,
+		)
+	} else if !txmp.store.set(wtx) {
+		if txmp.store.has(wtx.key) {
+			return ErrTxInMempool
+		}
+		checkTxRes.MempoolError = fmt.Sprintf("rejected valid incoming transaction; a transaction of sender %q with sequence %d is already in the mempool",
+			wtx.sender, wtx.sequence)
+		txmp.rejectTx(wtx.key, mempool.CodeTypeRejected, checkTxRes.MempoolError)
+		return errors.New(checkTxRes.MempoolError)
+	}
+	txmp.rejectionCache.Remove(wtx.key)
+	txmp.publishTxStatus(wtx.key, types.EventDataTxStatus{Status: types.TxStatusAccepted})
 
 	txmp.metrics.TxSizeBytes.Observe(float64(wtx.size()))
 	txmp.metrics.Size.Set(float64(txmp.Size()))
@@ -610,10 +762,26 @@ func (txmp *TxPool) addNewTransaction(wtx *wrappedTx, checkTxRes *abci.ResponseC
 	return nil
 }
 
+// minReplacementPriority returns the minimum priority a transaction must have
+// to replace a transaction of the given priority. It saturates at
+// math.MaxInt64 rather than overflowing.
+func (txmp *TxPool) minReplacementPriority(priority int64) int64 {
+	bump := priority / 100 * txmp.config.MinReplacementPriorityBump
+	bump += priority % 100 * txmp.config.MinReplacementPriorityBump / 100
+	if bump < 1 {
+		bump = 1
+	}
+	if priority > 0 && bump > math.MaxInt64-priority {
+		return math.MaxInt64
+	}
+	return priority + bump
+}
+
 func (txmp *TxPool) evictTx(wtx *wrappedTx) {
 	txmp.store.remove(wtx.key)
 	txmp.evictedTxCache.Push(wtx.key)
 	txmp.metrics.EvictedTxs.Add(1)
+	txmp.publishTxStatus(wtx.key, types.EventDataTxStatus{Status: types.TxStatusEvicted, Reason: types.TxEvictedFull})
 	txmp.logger.Debug(
 		"evicted valid existing transaction; mempool full",
 		"old_tx", fmt.Sprintf("%X", wtx.key),
@@ -650,6 +818,18 @@ func (txmp *TxPool) handleRecheckResult(wtx *wrappedTx, checkTxRes *abci.Respons
 	if txmp.config.KeepInvalidTxsInCache {
 		txmp.rejectedTxCache.Push(wtx.key)
 	}
+	log := checkTxRes.Log
+	if err != nil {
+		log = err.Error()
+	}
+	txmp.evictedTxCache.Push(wtx.key)
+	txmp.rejectionCache.Push(wtx.key, checkTxRes.Code, log)
+	txmp.publishTxStatus(wtx.key, types.EventDataTxStatus{
+		Status: types.TxStatusEvicted,
+		Reason: types.TxEvictedRecheck,
+		Code:   checkTxRes.Code,
+		Log:    log,
+	})
 	txmp.metrics.FailedTxs.Add(1)
 	txmp.metrics.Size.Set(float64(txmp.Size()))
 	txmp.metrics.SizeBytes.Set(float64(txmp.SizeBytes()))
@@ -746,6 +926,7 @@ func (txmp *TxPool) purgeExpiredTxs(blockHeight int64) {
 	// Add the purged transactions to the evicted cache
 	for _, tx := range purgedTxs {
 		txmp.evictedTxCache.Push(tx.key)
+		txmp.publishTxStatus(tx.key, types.EventDataTxStatus{Status: types.TxStatusEvicted, Reason: types.TxEvictedTTL})
 	}
 	txmp.metrics.EvictedTxs.Add(float64(numExpired))
 
@@ -757,6 +938,34 @@ func (txmp *TxPool) purgeExpiredTxs(blockHeight int64) {
 	txmp.seenByPeersSet.Prune(expirationAge)
 }
 
+// rejectTx records the code and log of the response that rejected the
+// transaction and reports it.
+func (txmp *TxPool) rejectTx(key types.TxKey, code uint32, log string) {
+	txmp.rejectionCache.Push(key, code, log)
+	txmp.publishTxStatus(key, types.EventDataTxStatus{Status: types.TxStatusRejected, Code: code, Log: log})
+}
+
+// WatchTx marks the transaction with the given key as watched, so that its
+// commit is reported even if the transaction never enters the mempool, e.g.
+// because it is already in the cache. The transaction is no longer watched
+// once its status is final.
+func (txmp *TxPool) WatchTx(key types.TxKey) {
+	txmp.watchedTxCache.Push(key)
+}
+
+// publishTxStatus publishes a transition in the life cycle of the transaction
+// with the given key.
+func (txmp *TxPool) publishTxStatus(key types.TxKey, data types.EventDataTxStatus) {
+	switch data.Status {
+	case types.TxStatusCommitted, types.TxStatusRejected, types.TxStatusEvicted:
+		txmp.watchedTxCache.Remove(key)
+	}
+	data.Hash = key[:]
+	if err := txmp.eventBus.PublishEventTxStatus(data); err != nil {
+		txmp.logger.Error("failed publishing tx status", "tx", fmt.Sprintf("%X", key), "status", data.Status, "err", err)
+	}
+}
+
 func (txmp *TxPool) notifyTxsAvailable() {
 	if txmp.Size() == 0 {
 		return // nothing to do
@@ -5,10 +5,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"os"
 	"sort"
 	"strconv"
+	"strings"
 	"sync"
 	"testing"
 	"time"
@@ -29,7 +31,8 @@ import (
 )
 
 // application extends the KV store application by overriding CheckTx to provide
-// transaction priority based on the value in the key/value pair.
+// transaction priority based on the value in the key/value pair, and an
+// optional sequence following the value.
 type application struct {
 	*kvstore.Application
 }
@@ -47,15 +50,21 @@ func newDefaultTx(msg string) types.Tx {
 	return types.Tx(newTx(0, 0, []byte(msg), 1))
 }
 
+func newSequencedTx(sender string, sequence uint64, msg string, priority int64) types.Tx {
+	return types.Tx(fmt.Sprintf("%s=%s=%d=%d", sender, msg, priority, sequence))
+}
+
 func (app *application) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	var (
 		priority int64
 		sender   string
+		sequence uint64
 	)
 
-	// infer the priority from the raw transaction value (sender=key=value)
+	// infer the priority from the raw transaction value (sender=key=value) and
+	// the sequence from the optional suffix (sender=key=value=sequence)
 	parts := bytes.Split(req.Tx, []byte("="))
-	if len(parts) == 3 {
+	if len(parts) == 3 || len(parts) == 4 {
 		v, err := strconv.ParseInt(string(parts[2]), 10, 64)
 		if err != nil {
 			return abci.ResponseCheckTx{
@@ -64,6 +73,16 @@ func (app *application) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 				GasWanted: 1,
 			}
 		}
+		if len(parts) == 4 {
+			sequence, err = strconv.ParseUint(string(parts[3]), 10, 64)
+			if err != nil {
+				return abci.ResponseCheckTx{
+					Priority:  priority,
+					Code:      102,
+					GasWanted: 1,
+				}
+			}
+		}
 
 		priority = v
 		sender = string(parts[0])
@@ -78,6 +97,7 @@ func (app *application) CheckTx(req abci.RequestCheckTx) abci.ResponseCheckTx {
 	return abci.ResponseCheckTx{
 		Priority:  priority,
 		Sender:    sender,
+		Sequence:  sequence,
 		Code:      code.CodeTypeOK,
 		GasWanted: 1,
 	}
@@ -383,6 +403,85 @@ func TestTxPool_ReapMaxBytesMaxGas(t *testing.T) {
 	require.Len(t, reapedTxs, 25)
 }
 
+func TestTxPool_ReplaceSameSequence(t *testing.T) {
+	txmp := setup(t, 100)
+
+	original := newSequencedTx("alice", 1, "original", 100)
+	require.NoError(t, txmp.CheckTx(original, nil, mempool.TxInfo{}))
+
+	// the default bump is 10%
+	underpriced := newSequencedTx("alice", 1, "underpriced", 109)
+	err := txmp.CheckTx(underpriced, nil, mempool.TxInfo{})
+	require.ErrorIs(t, err, ErrReplacementUnderpriced)
+	require.True(t, txmp.Has(original.Key()))
+	require.False(t, txmp.Has(underpriced.Key()))
+	code, _, ok := txmp.WasRecentlyRejected(underpriced.Key())
+	require.True(t, ok)
+	require.Equal(t, mempool.CodeTypeRejected, code)
+
+	// transactions of other sequences or senders are not replacements
+	require.NoError(t, txmp.CheckTx(newSequencedTx("alice", 2, "next", 1), nil, mempool.TxInfo{}))
+	require.NoError(t, txmp.CheckTx(newSequencedTx("bob", 1, "other", 1), nil, mempool.TxInfo{}))
+	require.Equal(t, 3, txmp.Size())
+
+	replacement := newSequencedTx("alice", 1, "replacement", 110)
+	require.NoError(t, txmp.CheckTx(replacement, nil, mempool.TxInfo{}))
+	require.Equal(t, 3, txmp.Size())
+	require.True(t, txmp.Has(replacement.Key()))
+	require.False(t, txmp.Has(original.Key()))
+	require.Equal(t, int64(len(replacement)+len("alice=next=1=2")+len("bob=other=1=1")), txmp.SizeBytes())
+
+	// the replaced transaction is not accepted again
+	require.True(t, txmp.WasRecentlyEvicted(original.Key()))
+	require.True(t, txmp.IsRejectedTx(original.Key()))
+	require.ErrorIs(t, txmp.CheckTx(original, nil, mempool.TxInfo{}), ErrTxAlreadyRejected)
+
+	// once the replacement is removed, its sequence is free again
+	require.NoError(t, txmp.RemoveTxByKey(replacement.Key()))
+	require.NoError(t, txmp.CheckTx(underpriced, nil, mempool.TxInfo{}))
+}
+
+func TestTxPool_ReplaceMaxPriority(t *testing.T) {
+	txmp := setup(t, 100)
+
+	original := newSequencedTx("alice", 1, "original", math.MaxInt64)
+	require.NoError(t, txmp.CheckTx(original, nil, mempool.TxInfo{}))
+
+	// the bump saturates instead of wrapping to a negative priority
+	require.Equal(t, int64(math.MaxInt64), txmp.minReplacementPriority(math.MaxInt64))
+	underpriced := newSequencedTx("alice", 1, "underpriced", 1)
+	require.ErrorIs(t, txmp.CheckTx(underpriced, nil, mempool.TxInfo{}), ErrReplacementUnderpriced)
+	require.True(t, txmp.Has(original.Key()))
+	require.False(t, txmp.Has(underpriced.Key()))
+
+	// a transaction of the same priority can still replace it
+	replacement := newSequencedTx("alice", 1, "replacement", math.MaxInt64)
+	require.NoError(t, txmp.CheckTx(replacement, nil, mempool.TxInfo{}))
+	require.True(t, txmp.Has(replacement.Key()))
+	require.False(t, txmp.Has(original.Key()))
+}
+
+func TestTxPool_ReapSequenceOrder(t *testing.T) {
+	txmp := setup(t, 100)
+
+	alice1 := newSequencedTx("alice", 1, strings.Repeat("a", 100), 1)
+	alice2 := newSequencedTx("alice", 2, "b", 100)
+	alice3 := newSequencedTx("alice", 3, "c", 50)
+	bob := newSequencedTx("bob", 0, "d", 75)
+	for _, tx := range []types.Tx{alice3, bob, alice2, alice1} {
+		require.NoError(t, txmp.CheckTx(tx, nil, mempool.TxInfo{}))
+	}
+
+	// alice's transactions take the positions of her priorities, 100, 50 and 1,
+	// in sequence order.
+	require.Equal(t, types.Txs{alice1, bob, alice2, alice3}, txmp.ReapMaxTxs(-1))
+	require.Equal(t, types.Txs{alice1, bob}, txmp.ReapMaxBytesMaxGas(-1, 2))
+
+	// if alice's first transaction doesn't fit, neither do the others.
+	maxBytes := types.ComputeProtoSizeForTxs(types.Txs{bob, alice2, alice3})
+	require.Equal(t, types.Txs{bob}, txmp.ReapMaxBytesMaxGas(maxBytes, -1))
+}
+
 func TestTxMempoolTxLargerThanMaxBytes(t *testing.T) {
 	rng := rand.New(rand.NewSource(time.Now().UnixNano()))
 	txmp := setup(t, 0)
@@ -750,6 +849,22 @@ func TestTxPool_ConcurrentlyAddingTx(t *testing.T) {
 	require.Equal(t, numTxs-1, errCount)
 }
 
+func TestTxPool_AddTxAlreadyInStore(t *testing.T) {
+	txmp := setup(t, 100)
+	for _, tx := range []types.Tx{newDefaultTx("unsequenced"), newSequencedTx("alice", 1, "sequenced", 1)} {
+		rsp, err := txmp.TryAddNewTx(tx, tx.Key(), mempool.TxInfo{})
+		require.NoError(t, err)
+
+		// the same transaction, added concurrently after both were checked,
+		// is already in the mempool rather than rejected.
+		wtx := newWrappedTx(tx, tx.Key(), txmp.Height(), rsp.GasWanted, rsp.Priority, rsp.Sender, rsp.Sequence)
+		require.ErrorIs(t, txmp.addNewTransaction(wtx, rsp), ErrTxInMempool)
+		require.True(t, txmp.Has(tx.Key()))
+		_, _, rejected := txmp.WasRecentlyRejected(tx.Key())
+		require.False(t, rejected)
+	}
+}
+
 func TestTxPool_BroadcastQueue(t *testing.T) {
 	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
@@ -778,3 +893,96 @@ func TestTxPool_BroadcastQueue(t *testing.T) {
 
 	wg.Wait()
 }
+
+// txStatusRecorder records the life cycle events published by the mempool.
+type txStatusRecorder struct {
+	mtx    sync.Mutex
+	events []types.EventDataTxStatus
+}
+
+func (r *txStatusRecorder) PublishEventTxStatus(data types.EventDataTxStatus) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.events = append(r.events, data)
+	return nil
+}
+
+// eventsFor returns the events published for the transaction.
+func (r *txStatusRecorder) eventsFor(tx types.Tx) []types.EventDataTxStatus {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	var events []types.EventDataTxStatus
+	for _, event := range r.events {
+		if bytes.Equal(event.Hash, tx.Hash()) {
+			events = append(events, event)
+		}
+	}
+	return events
+}
+
+func TestTxPool_TxStatusEvents(t *testing.T) {
+	recorder := &txStatusRecorder{}
+	txmp := setup(t, 100, WithEventBus(recorder))
+	txmp.config.TTLNumBlocks = 2
+
+	committed := types.Tx("sender-1=key=5")
+	rechecked := types.Tx("sender-2=key=5")
+	expired := types.Tx("sender-3=key=5")
+	mustCheckTx(t, txmp, string(committed))
+	mustCheckTx(t, txmp, string(rechecked))
+
+	// a tx the application rejects is reported with the CheckTx result.
+	invalid := types.Tx("invalid")
+	require.Error(t, txmp.CheckTx(invalid, nil, mempool.TxInfo{}))
+	require.Equal(t, []types.EventDataTxStatus{
+		{Hash: invalid.Hash(), Status: types.TxStatusRejected, Code: 101},
+	}, recorder.eventsFor(invalid))
+	code, _, ok := txmp.WasRecentlyRejected(invalid.Key())
+	require.True(t, ok)
+	require.Equal(t, uint32(101), code)
+
+	// committed txs are reported with their height and index, while txs that
+	// no longer pass the post check are evicted by the recheck.
+	recheckErr := errors.New("no longer valid")
+	postCheck := func(tx types.Tx, _ *abci.ResponseCheckTx) error {
+		if bytes.Equal(tx, rechecked) {
+			return recheckErr
+		}
+		return nil
+	}
+	require.NoError(t, txmp.Update(2, types.Txs{committed}, abciResponses(1, abci.CodeTypeOK), nil, postCheck))
+	require.Equal(t, []types.EventDataTxStatus{
+		{Hash: committed.Hash(), Status: types.TxStatusAccepted},
+		{Hash: committed.Hash(), Status: types.TxStatusCommitted, Height: 2, Index: 0},
+	}, recorder.eventsFor(committed))
+	require.Eventually(t, func() bool { return len(recorder.eventsFor(rechecked)) == 2 }, time.Second, 10*time.Millisecond)
+	require.Equal(t, types.EventDataTxStatus{
+		Hash:   rechecked.Hash(),
+		Status: types.TxStatusEvicted,
+		Reason: types.TxEvictedRecheck,
+		Log:    recheckErr.Error(),
+	}, recorder.eventsFor(rechecked)[1])
+	require.True(t, txmp.WasRecentlyEvicted(rechecked.Key()))
+	_, log, ok := txmp.WasRecentlyRejected(rechecked.Key())
+	require.True(t, ok)
+	require.Equal(t, recheckErr.Error(), log)
+
+	// only the committed txs that were in the mempool or are watched are
+	// reported.
+	unknown := types.Tx("sender-4=key=5")
+	watched := types.Tx("sender-5=key=5")
+	txmp.WatchTx(watched.Key())
+	require.NoError(t, txmp.Update(3, types.Txs{unknown, watched}, abciResponses(2, abci.CodeTypeOK), nil, nil))
+	require.Empty(t, recorder.eventsFor(unknown))
+	require.Equal(t, []types.EventDataTxStatus{
+		{Hash: watched.Hash(), Status: types.TxStatusCommitted, Height: 3, Index: 1},
+	}, recorder.eventsFor(watched))
+
+	// txs that outlive the TTL are evicted.
+	mustCheckTx(t, txmp, string(expired))
+	require.NoError(t, txmp.Update(6, types.Txs{}, abciResponses(0, abci.CodeTypeOK), nil, nil))
+	require.Equal(t, []types.EventDataTxStatus{
+		{Hash: expired.Hash(), Status: types.TxStatusAccepted},
+		{Hash: expired.Hash(), Status: types.TxStatusEvicted, Reason: types.TxEvictedTTL},
+	}, recorder.eventsFor(expired))
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/cometbft/cometbft/behaviour"
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/tmhash"
 	"github.com/cometbft/cometbft/libs/log"
@@ -42,6 +43,7 @@ type Reactor struct {
 	ids         *mempoolIDs
 	requests    *requestScheduler
 	traceClient trace.Tracer
+	reporter    behaviour.Reporter
 }
 
 type ReactorOptions struct {
@@ -104,6 +106,7 @@ func (memR *Reactor) SetLogger(l log.Logger) {
 
 // OnStart implements Service.
 func (memR *Reactor) OnStart() error {
+	memR.reporter = behaviour.NewSwitchReporter(memR.Switch)
 	if !memR.opts.ListenOnly {
 		go func() {
 			for {
@@ -243,7 +246,8 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			key := ntx.Key()
 			schema.WriteMempoolTx(memR.traceClient, string(e.Src.ID()), key[:], schema.Download)
 			// If we requested the transaction we mark it as received.
-			if memR.requests.Has(peerID, key) {
+			requested := memR.requests.Has(peerID, key)
+			if requested {
 				memR.requests.MarkReceived(peerID, key)
 				memR.Logger.Debug("received a response for a requested transaction", "peerID", peerID, "txKey", key)
 			} else {
@@ -257,6 +261,9 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 				memR.Logger.Debug("Could not add tx", "txKey", key, "err", err)
 				return
 			}
+			if requested && err == nil {
+				_ = memR.reporter.Report(behaviour.GoodMessage(e.Src.ID(), "sent a requested tx"))
+			}
 			if !memR.opts.ListenOnly {
 				// We broadcast only transactions that we deem valid and actually have in our mempool.
 				memR.broadcastSeenTx(key)
@@ -274,7 +281,7 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		txKey, err := types.TxKeyFromBytes(msg.TxKey)
 		if err != nil {
 			memR.Logger.Error("peer sent SeenTx with incorrect tx key", "err", err)
-			memR.Switch.StopPeerForError(e.Src, err)
+			_ = memR.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 			return
 		}
 		schema.WriteMempoolPeerState(
@@ -308,7 +315,7 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 		txKey, err := types.TxKeyFromBytes(msg.TxKey)
 		if err != nil {
 			memR.Logger.Error("peer sent WantTx with incorrect tx key", "err", err)
-			memR.Switch.StopPeerForError(e.Src, err)
+			_ = memR.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 			return
 		}
 		schema.WriteMempoolPeerState(
@@ -338,7 +345,7 @@ func (memR *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 
 	default:
 		memR.Logger.Error("unknown message type", "src", e.Src, "chId", e.ChannelID, "msg", fmt.Sprintf("%T", msg))
-		memR.Switch.StopPeerForError(e.Src, fmt.Errorf("mempool cannot handle message of type: %T", msg))
+		_ = memR.reporter.Report(behaviour.BadMessage(e.Src.ID(), fmt.Sprintf("mempool cannot handle message of type: %T", msg)))
 		return
 	}
 }
@@ -397,6 +404,7 @@ func (memR *Reactor) broadcastNewTx(wtx *wrappedTx) {
 		},
 	}
 
+	sent := false
 	for id, peer := range memR.ids.GetAll() {
 		if p, ok := peer.Get(types.PeerStateKey).(PeerState); ok {
 			// make sure peer isn't too far behind. This can happen
@@ -412,10 +420,16 @@ func (memR *Reactor) broadcastNewTx(wtx *wrappedTx) {
 			continue
 		}
 
-		p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
+		if p2p.SendEnvelopeShim(peer, p2p.Envelope{ //nolint: staticcheck
 			ChannelID: mempool.MempoolChannel,
 			Message:   msg,
-		}, memR.Logger)
+		}, memR.Logger) {
+			sent = true
+		}
+	}
+
+	if sent {
+		memR.mempool.publishTxStatus(wtx.key, types.EventDataTxStatus{Status: types.TxStatusGossiped})
 	}
 }
 
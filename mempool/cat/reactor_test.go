@@ -60,6 +60,27 @@ func TestReactorBroadcastTxsMessage(t *testing.T) {
 	waitForTxsOnReactors(t, transactions, reactors)
 }
 
+// Replace a transaction on the first reactor and wait for the replacement to
+// take its place in the others.
+func TestReactorPropagatesReplacementTx(t *testing.T) {
+	config := cfg.TestConfig()
+	const N = 3
+	reactors := makeAndConnectReactors(t, config, N)
+
+	original := newSequencedTx("alice", 1, "original", 100)
+	require.NoError(t, reactors[0].mempool.CheckTx(original, nil, mempool.TxInfo{}))
+	waitForTxsOnReactors(t, types.Txs{original}, reactors)
+
+	replacement := newSequencedTx("alice", 1, "replacement", 200)
+	require.NoError(t, reactors[0].mempool.CheckTx(replacement, nil, mempool.TxInfo{}))
+	for _, r := range reactors {
+		require.Eventually(t, func() bool {
+			return r.mempool.Has(replacement.Key()) && !r.mempool.Has(original.Key())
+		}, timeout, 100*time.Millisecond)
+		require.Equal(t, 1, r.mempool.Size())
+	}
+}
+
 func TestReactorSendWantTxAfterReceiveingSeenTx(t *testing.T) {
 	reactor, _ := setupReactor(t)
 
@@ -13,6 +13,9 @@ type store struct {
 	bytes       int64
 	txs         map[types.TxKey]*wrappedTx
 	reservedTxs map[types.TxKey]struct{}
+	// transactions with a sequence indexed by sender and sequence. There is at
+	// most one transaction per sender and sequence.
+	senders map[string]map[uint64]*wrappedTx
 }
 
 func newStore() *store {
@@ -20,21 +23,88 @@ func newStore() *store {
 		bytes:       0,
 		txs:         make(map[types.TxKey]*wrappedTx),
 		reservedTxs: make(map[types.TxKey]struct{}),
+		senders:     make(map[string]map[uint64]*wrappedTx),
 	}
 }
 
+// set adds the transaction to the store unless a transaction with the same
+// key, or with the same sender and sequence, is already present.
 func (s *store) set(wtx *wrappedTx) bool {
 	if wtx == nil {
 		return false
 	}
 	s.mtx.Lock()
 	defer s.mtx.Unlock()
-	if _, exists := s.txs[wtx.key]; !exists {
-		s.txs[wtx.key] = wtx
-		s.bytes += wtx.size()
-		return true
+	if _, exists := s.txs[wtx.key]; exists {
+		return false
+	}
+	if wtx.hasSequence() && s.bySequence(wtx.sender, wtx.sequence) != nil {
+		return false
+	}
+	s.add(wtx)
+	return true
+}
+
+// replace atomically removes the old transaction and adds the new one, of the
+// same sender and sequence, in its place. It returns false, leaving the store
+// untouched, if the old transaction is no longer in the store.
+func (s *store) replace(old, wtx *wrappedTx) bool {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	if _, exists := s.txs[old.key]; !exists {
+		return false
+	}
+	if _, exists := s.txs[wtx.key]; exists {
+		return false
+	}
+	s.delete(old)
+	s.add(wtx)
+	return true
+}
+
+// getBySequence returns the transaction of the sender with the given
+// sequence, or nil if there is none.
+func (s *store) getBySequence(sender string, sequence uint64) *wrappedTx {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+	return s.bySequence(sender, sequence)
+}
+
+func (s *store) bySequence(sender string, sequence uint64) *wrappedTx {
+	return s.senders[sender][sequence]
+}
+
+// add inserts the transaction and indexes it by sender and sequence. The
+// caller must hold the lock.
+func (s *store) add(wtx *wrappedTx) {
+	s.txs[wtx.key] = wtx
+	s.bytes += wtx.size()
+	if !wtx.hasSequence() {
+		return
+	}
+	sequences, ok := s.senders[wtx.sender]
+	if !ok {
+		sequences = make(map[uint64]*wrappedTx)
+		s.senders[wtx.sender] = sequences
+	}
+	sequences[wtx.sequence] = wtx
+}
+
+// delete removes the transaction and its sender index entry. The caller must
+// hold the lock.
+func (s *store) delete(wtx *wrappedTx) {
+	s.bytes -= wtx.size()
+	delete(s.txs, wtx.key)
+	if !wtx.hasSequence() {
+		return
+	}
+	sequences := s.senders[wtx.sender]
+	if sequences[wtx.sequence] == wtx {
+		delete(sequences, wtx.sequence)
+		if len(sequences) == 0 {
+			delete(s.senders, wtx.sender)
+		}
 	}
-	return false
 }
 
 func (s *store) get(txKey types.TxKey) *wrappedTx {
@@ -57,8 +127,7 @@ func (s *store) remove(txKey types.TxKey) bool {
 	if !exists {
 		return false
 	}
-	s.bytes -= tx.size()
-	delete(s.txs, txKey)
+	s.delete(tx)
 	return true
 }
 
@@ -149,10 +218,9 @@ func (s *store) purgeExpiredTxs(expirationHeight int64, expirationAge time.Time)
 	var purgedTxs []*wrappedTx
 	counter := 0
 
-	for key, tx := range s.txs {
+	for _, tx := range s.txs {
 		if tx.height < expirationHeight || tx.timestamp.Before(expirationAge) {
-			s.bytes -= tx.size()
-			delete(s.txs, key)
+			s.delete(tx)
 			purgedTxs = append(purgedTxs, tx)
 			counter++
 		}
@@ -165,4 +233,5 @@ func (s *store) reset() {
 	defer s.mtx.Unlock()
 	s.bytes = 0
 	s.txs = make(map[types.TxKey]*wrappedTx)
+	s.senders = make(map[string]map[uint64]*wrappedTx)
 }
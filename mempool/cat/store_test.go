@@ -16,7 +16,7 @@ func TestStoreSimple(t *testing.T) {
 
 	tx := types.Tx("tx1")
 	key := tx.Key()
-	wtx := newWrappedTx(tx, key, 1, 1, 1, "")
+	wtx := newWrappedTx(tx, key, 1, 1, 1, "", 0)
 
 	// asset zero state
 	require.Nil(t, store.get(key))
@@ -42,12 +42,49 @@ func TestStoreSimple(t *testing.T) {
 	require.Zero(t, store.totalBytes())
 }
 
+func TestStoreSequences(t *testing.T) {
+	store := newStore()
+
+	tx1, tx2 := types.Tx("tx1"), types.Tx("tx2")
+	wtx1 := newWrappedTx(tx1, tx1.Key(), 1, 1, 1, "alice", 1)
+	wtx2 := newWrappedTx(tx2, tx2.Key(), 1, 1, 2, "alice", 1)
+
+	require.True(t, store.set(wtx1))
+	require.Equal(t, wtx1, store.getBySequence("alice", 1))
+	require.Nil(t, store.getBySequence("alice", 2))
+	require.Nil(t, store.getBySequence("bob", 1))
+
+	// only one tx per sender and sequence
+	require.False(t, store.set(wtx2))
+	require.False(t, store.has(tx2.Key()))
+
+	require.True(t, store.replace(wtx1, wtx2))
+	require.False(t, store.has(tx1.Key()))
+	require.Equal(t, wtx2, store.getBySequence("alice", 1))
+	require.Equal(t, 1, store.size())
+	require.Equal(t, wtx2.size(), store.totalBytes())
+
+	// the replaced tx is no longer in the store
+	require.False(t, store.replace(wtx1, wtx2))
+
+	require.True(t, store.remove(tx2.Key()))
+	require.Nil(t, store.getBySequence("alice", 1))
+	require.Empty(t, store.senders)
+
+	// txs without a sequence are not indexed
+	wtx3 := newWrappedTx(tx1, tx1.Key(), 1, 1, 1, "alice", 0)
+	wtx4 := newWrappedTx(tx2, tx2.Key(), 1, 1, 1, "alice", 0)
+	require.True(t, store.set(wtx3))
+	require.True(t, store.set(wtx4))
+	require.Nil(t, store.getBySequence("alice", 0))
+}
+
 func TestStoreReservingTxs(t *testing.T) {
 	store := newStore()
 
 	tx := types.Tx("tx1")
 	key := tx.Key()
-	wtx := newWrappedTx(tx, key, 1, 1, 1, "")
+	wtx := newWrappedTx(tx, key, 1, 1, 1, "", 0)
 
 	// asset zero state
 	store.release(key)
@@ -105,7 +142,7 @@ func TestStoreConcurrentAccess(t *testing.T) {
 			for range ticker.C {
 				tx := types.Tx(fmt.Sprintf("tx%d", i%(numTxs/10)))
 				key := tx.Key()
-				wtx := newWrappedTx(tx, key, 1, 1, 1, "")
+				wtx := newWrappedTx(tx, key, 1, 1, 1, "", 0)
 				existingTx := store.get(key)
 				if existingTx != nil && bytes.Equal(existingTx.tx, tx) {
 					// tx has already been added
@@ -138,7 +175,7 @@ func TestStoreGetTxs(t *testing.T) {
 	for i := 0; i < numTxs; i++ {
 		tx := types.Tx(fmt.Sprintf("tx%d", i))
 		key := tx.Key()
-		wtx := newWrappedTx(tx, key, 1, 1, int64(i), "")
+		wtx := newWrappedTx(tx, key, 1, 1, int64(i), "", 0)
 		store.set(wtx)
 	}
 
@@ -168,7 +205,7 @@ func TestStoreExpiredTxs(t *testing.T) {
 	for i := 0; i < numTxs; i++ {
 		tx := types.Tx(fmt.Sprintf("tx%d", i))
 		key := tx.Key()
-		wtx := newWrappedTx(tx, key, int64(i), 1, 1, "")
+		wtx := newWrappedTx(tx, key, int64(i), 1, 1, "", 0)
 		store.set(wtx)
 	}
 
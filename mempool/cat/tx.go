@@ -18,9 +18,10 @@ type wrappedTx struct {
 	gasWanted int64       // app: gas required to execute this transaction
 	priority  int64       // app: priority value for this transaction
 	sender    string      // app: assigned sender label
+	sequence  uint64      // app: position in the sequence of transactions of the sender
 }
 
-func newWrappedTx(tx types.Tx, key types.TxKey, height, gasWanted, priority int64, sender string) *wrappedTx {
+func newWrappedTx(tx types.Tx, key types.TxKey, height, gasWanted, priority int64, sender string, sequence uint64) *wrappedTx {
 	return &wrappedTx{
 		tx:        tx,
 		key:       key,
@@ -29,8 +30,14 @@ func newWrappedTx(tx types.Tx, key types.TxKey, height, gasWanted, priority int6
 		gasWanted: gasWanted,
 		priority:  priority,
 		sender:    sender,
+		sequence:  sequence,
 	}
 }
 
 // Size reports the size of the raw transaction in bytes.
 func (w *wrappedTx) size() int64 { return int64(len(w.tx)) }
+
+// hasSequence reports whether the application assigned the transaction a
+// sender and a sequence, making it replaceable and ordered among the
+// transactions of its sender.
+func (w *wrappedTx) hasSequence() bool { return w.sender != "" && w.sequence != 0 }
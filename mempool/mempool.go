@@ -100,6 +100,12 @@ type Mempool interface {
 	// Used in the RPC endpoint: TxStatus.
 	WasRecentlyEvicted(key types.TxKey) bool
 
+	// WasRecentlyRejected returns the code and log of the CheckTx response
+	// that rejected the tx, or that invalidated it during a recheck, if it
+	// exists in the rejected cache.
+	// Used in the RPC endpoint: TxStatus.
+	WasRecentlyRejected(key types.TxKey) (code uint32, log string, ok bool)
+
 	// Size returns the number of transactions in the mempool.
 	Size() int
 
@@ -107,6 +113,11 @@ type Mempool interface {
 	SizeBytes() int64
 }
 
+// CodeTypeRejected is the code reported for the transactions rejected by the
+// mempool itself rather than by the CheckTx of the application, such as the
+// ones failing the pre-check or too low in priority to replace another.
+const CodeTypeRejected uint32 = 1<<32 - 1
+
 // PreCheckFunc is an optional filter executed before CheckTx and rejects
 // transaction if false is returned. An example would be to ensure that a
 // transaction doesn't exceeded the block size.
@@ -65,6 +65,10 @@ type Metrics struct {
 	// RerequestedTxs defines the number of times that a requested tx
 	// never received a response in time and a new request was made.
 	RerequestedTxs metrics.Counter
+
+	// ReplacedTxs defines the number of transactions that were replaced by a
+	// transaction of the same sender and sequence with a higher priority.
+	ReplacedTxs metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -147,6 +151,13 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "rerequested_txs",
 			Help:      "Number of times a transaction was requested again after a previous request timed out",
 		}, labels).With(labelsAndValues...),
+
+		ReplacedTxs: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "replaced_txs",
+			Help:      "Number of transactions replaced by a transaction of the same sender and sequence with a higher priority",
+		}, labels).With(labelsAndValues...),
 	}
 }
 
@@ -163,5 +174,6 @@ func NopMetrics() *Metrics {
 		AlreadySeenTxs: discard.NewCounter(),
 		RequestedTxs:   discard.NewCounter(),
 		RerequestedTxs: discard.NewCounter(),
+		ReplacedTxs:    discard.NewCounter(),
 	}
 }
@@ -40,5 +40,7 @@ func (m Mempool) WasRecentlyEvicted(types.TxKey) bool     { return false }
 func (Mempool) TxsFront() *clist.CElement                 { return nil }
 func (Mempool) TxsWaitChan() <-chan struct{}              { return nil }
 
+func (m Mempool) WasRecentlyRejected(types.TxKey) (uint32, string, bool) { return 0, "", false }
+
 func (Mempool) InitWAL() error { return nil }
 func (Mempool) CloseWAL()      {}
@@ -198,6 +198,12 @@ func (mem *CListMempool) WasRecentlyEvicted(key types.TxKey) bool {
 	return false
 }
 
+// WasRecentlyRejected returns false consistently as this implementation does
+// not keep the responses of rejected transactions.
+func (mem *CListMempool) WasRecentlyRejected(key types.TxKey) (uint32, string, bool) {
+	return 0, "", false
+}
+
 // TxsWaitChan returns a channel to wait on transactions. It will be closed
 // once the mempool is not empty (ie. the internal `mem.txs` has at least one
 // element)
@@ -279,6 +279,12 @@ func (txmp *TxMempool) WasRecentlyEvicted(txKey types.TxKey) bool {
 	return txmp.evictedTxs.HasKey(txKey)
 }
 
+// WasRecentlyRejected returns false consistently as this implementation does
+// not keep the responses of rejected transactions.
+func (txmp *TxMempool) WasRecentlyRejected(txKey types.TxKey) (uint32, string, bool) {
+	return 0, "", false
+}
+
 // removeTxByKey removes the specified transaction key from the mempool.
 // The caller must hold txmp.mtx excluxively.
 func (txmp *TxMempool) removeTxByKey(key types.TxKey) error {
@@ -38,6 +38,7 @@ import (
 	mempoolv1 "github.com/cometbft/cometbft/mempool/v1"
 	"github.com/cometbft/cometbft/p2p"
 	"github.com/cometbft/cometbft/p2p/pex"
+	"github.com/cometbft/cometbft/p2p/trust"
 	"github.com/cometbft/cometbft/privval"
 	"github.com/cometbft/cometbft/proxy"
 	rpccore "github.com/cometbft/cometbft/rpc/core"
@@ -75,6 +76,10 @@ type DBProvider func(*DBContext) (dbm.DB, error)
 
 const readHeaderTimeout = 10 * time.Second
 
+// haltIndexerTimeout is how long a halting node waits for the indexer to
+// index the last committed block.
+const haltIndexerTimeout = 10 * time.Second
+
 // DefaultDBProvider returns a database using the DBBackend and DBDir
 // specified in the ctx.Config.
 func DefaultDBProvider(ctx *DBContext) (dbm.DB, error) {
@@ -213,6 +218,8 @@ type Node struct {
 	nodeKey     *p2p.NodeKey // our node privkey
 	isListening bool
 
+	trustHistoryDB dbm.DB // trust metrics of the peers, saved by the switch
+
 	// services
 	eventBus          *types.EventBus // pub/sub for services
 	stateStore        sm.Store
@@ -233,6 +240,8 @@ type Node struct {
 	txIndexer         txindex.TxIndexer
 	blockIndexer      indexer.BlockIndexer
 	indexerService    *txindex.IndexerService
+	coldTierService   *store.ColdTierService
+	pruner            *sm.Pruner
 	prometheusSrv     *http.Server
 	tracer            trace.Tracer
 	pyroscopeProfiler *pyroscope.Profiler
@@ -245,7 +254,15 @@ func initDBs(config *cfg.Config, dbProvider DBProvider) (blockStore *store.Block
 	if err != nil {
 		return
 	}
-	blockStore = store.NewBlockStore(blockStoreDB)
+	coldTier, err := store.NewSegmentStore(config.ColdStorageDir())
+	if err != nil {
+		return
+	}
+	options := []store.BlockStoreOption{store.WithColdTier(coldTier)}
+	if config.Storage.RetainTxInfo > 0 {
+		options = append(options, store.WithTxInfoPrunedSeparately())
+	}
+	blockStore = store.NewBlockStore(blockStoreDB, options...)
 
 	stateDB, err = dbProvider(&DBContext{"state", config})
 	if err != nil {
@@ -375,6 +392,7 @@ func createMempoolAndMempoolReactor(
 	config *cfg.Config,
 	proxyApp proxy.AppConns,
 	state sm.State,
+	eventBus *types.EventBus,
 	memplMetrics *mempl.Metrics,
 	logger log.Logger,
 	traceClient trace.Tracer,
@@ -389,6 +407,7 @@ func createMempoolAndMempoolReactor(
 			mempoolv2.WithMetrics(memplMetrics),
 			mempoolv2.WithPreCheck(sm.TxPreCheck(state)),
 			mempoolv2.WithPostCheck(sm.TxPostCheck(state)),
+			mempoolv2.WithEventBus(eventBus),
 		)
 
 		reactor, err := mempoolv2.NewReactor(
@@ -530,12 +549,15 @@ func createConsensusReactor(config *cfg.Config,
 	if privValidator != nil {
 		consensusState.SetPrivValidator(privValidator)
 	}
-	consensusReactor := cs.NewReactor(
-		consensusState,
-		waitSync,
+	options := []cs.ReactorOption{
 		cs.ReactorMetrics(csMetrics),
 		cs.ReactorTracing(traceClient),
-	)
+	}
+	if config.Consensus.CompactBlocks {
+		// the validation of the config ensures the mempool is the CAT mempool
+		options = append(options, cs.ReactorCompactBlocks(mempool.(*mempoolv2.TxPool)))
+	}
+	consensusReactor := cs.NewReactor(consensusState, waitSync, options...)
 	consensusReactor.SetLogger(consensusLogger)
 	// services which will be publishing and/or subscribing for messages (events)
 	// consensusReactor will set it on consensusState and blockExecutor
@@ -624,6 +646,7 @@ func createSwitch(config *cfg.Config,
 	evidenceReactor *evidence.Reactor,
 	nodeInfo p2p.NodeInfo,
 	nodeKey *p2p.NodeKey,
+	trustMetricStore *trust.MetricStore,
 	p2pLogger log.Logger,
 	tracer trace.Tracer,
 ) *p2p.Switch {
@@ -633,6 +656,7 @@ func createSwitch(config *cfg.Config,
 		p2p.WithMetrics(p2pMetrics),
 		p2p.SwitchPeerFilters(peerFilters...),
 		p2p.WithTracer(tracer),
+		p2p.WithTrustMetricStore(trustMetricStore),
 	)
 	sw.SetLogger(p2pLogger)
 	sw.AddReactor("MEMPOOL", mempoolReactor)
@@ -774,7 +798,8 @@ func NewNode(config *cfg.Config,
 	}
 
 	stateStore := sm.NewStore(stateDB, sm.StoreOptions{
-		DiscardABCIResponses: config.Storage.DiscardABCIResponses,
+		DiscardABCIResponses:         config.Storage.DiscardABCIResponses,
+		PruneABCIResponsesSeparately: config.Storage.RetainABCIResponses > 0,
 	})
 
 	state, genDoc, err := LoadStateFromDBOrGenesisDocProvider(stateDB, genesisDocProvider)
@@ -782,6 +807,13 @@ func NewNode(config *cfg.Config,
 		return nil, err
 	}
 
+	var coldTierService *store.ColdTierService
+	if config.Storage.ColdStorageDepth > 0 {
+		coldTierService = store.NewColdTierService(blockStore,
+			config.Storage.ColdStorageDepth, config.Storage.ColdStorageSegmentSize)
+		coldTierService.SetLogger(logger.With("module", "store"))
+	}
+
 	// Create the proxyApp and establish connections to the ABCI app (consensus, mempool, query).
 	proxyApp, err := createAndStartProxyAppConns(clientCreator, logger)
 	if err != nil {
@@ -870,7 +902,7 @@ func NewNode(config *cfg.Config,
 	}
 
 	// Make MempoolReactor
-	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, memplMetrics, logger, tracer)
+	mempool, mempoolReactor := createMempoolAndMempoolReactor(config, proxyApp, state, eventBus, memplMetrics, logger, tracer)
 
 	// Make Evidence Reactor
 	evidenceReactor, evidencePool, err := createEvidenceReactor(config, dbProvider, stateDB, blockStore, logger)
@@ -889,6 +921,17 @@ func NewNode(config *cfg.Config,
 		sm.WithBlockStore(blockStore),
 	)
 
+	var pruner *sm.Pruner
+	if config.Storage.PruningEnabled() {
+		pruner = sm.NewPruner(stateStore, blockStore, sm.Retention{
+			Blocks:        config.Storage.RetainBlocks,
+			States:        config.Storage.RetainStates,
+			ABCIResponses: config.Storage.RetainABCIResponses,
+			TxInfo:        config.Storage.RetainTxInfo,
+		}, config.Storage.PruningInterval, sm.PrunerWithMetrics(smMetrics))
+		pruner.SetLogger(logger.With("module", "pruner"))
+	}
+
 	// Make BlockchainReactor. Don't start fast sync if we're doing a state sync first.
 	bcReactor, err := createBlockchainReactor(config, state, blockExec, blockStore, fastSync && !stateSync, logger)
 	if err != nil {
@@ -929,9 +972,16 @@ func NewNode(config *cfg.Config,
 
 	// Setup Switch.
 	p2pLogger := logger.With("module", "p2p")
+	trustHistoryDB, err := dbProvider(&DBContext{"trusthistory", config})
+	if err != nil {
+		return nil, err
+	}
+	trustMetricStore := trust.NewTrustMetricStore(trustHistoryDB, trust.DefaultConfig())
+	trustMetricStore.SetLogger(p2pLogger)
 	sw := createSwitch(
 		config, transport, p2pMetrics, peerFilters, mempoolReactor, bcReactor,
-		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey, p2pLogger, tracer,
+		stateSyncReactor, consensusReactor, evidenceReactor, nodeInfo, nodeKey,
+		trustMetricStore, p2pLogger, tracer,
 	)
 
 	err = sw.AddPersistentPeers(splitAndTrimEmpty(config.P2P.PersistentPeers, ",", " "))
@@ -985,6 +1035,8 @@ func NewNode(config *cfg.Config,
 		nodeInfo:  nodeInfo,
 		nodeKey:   nodeKey,
 
+		trustHistoryDB: trustHistoryDB,
+
 		stateStore:       stateStore,
 		blockStore:       blockStore,
 		bcReactor:        bcReactor,
@@ -1000,6 +1052,8 @@ func NewNode(config *cfg.Config,
 		proxyApp:         proxyApp,
 		txIndexer:        txIndexer,
 		indexerService:   indexerService,
+		coldTierService:  coldTierService,
+		pruner:           pruner,
 		blockIndexer:     blockIndexer,
 		eventBus:         eventBus,
 		tracer:           tracer,
@@ -1088,9 +1142,61 @@ func (n *Node) OnStart() error {
 		}
 	}
 
+	if n.coldTierService != nil {
+		if err := n.coldTierService.Start(); err != nil {
+			return err
+		}
+	}
+	if n.pruner != nil {
+		if err := n.pruner.Start(); err != nil {
+			return err
+		}
+	}
+
+	go n.stopOnHalt(n.blockStore.Height())
+
 	return nil
 }
 
+// stopOnHalt stops the node once consensus halted at the halt height or time
+// of the config, after the indexer handled the blocks committed since the node
+// started at startHeight.
+func (n *Node) stopOnHalt(startHeight int64) {
+	select {
+	case <-n.consensusState.Halted():
+	case <-n.Quit():
+		return
+	}
+
+	height := n.blockStore.Height()
+	n.Logger.Info("Consensus halted, stopping the node", "height", height)
+	if height > startHeight && !n.waitForIndexer(height) {
+		n.Logger.Error("Timed out waiting for the indexer", "height", height,
+			"indexed_height", n.indexerService.IndexedHeight())
+	}
+
+	if err := n.Stop(); err != nil {
+		n.Logger.Error("Failed to stop the node", "err", err)
+	}
+}
+
+// waitForIndexer waits until the indexer handled the block at height. It
+// returns false if it timed out.
+func (n *Node) waitForIndexer(height int64) bool {
+	timeout := time.NewTimer(haltIndexerTimeout)
+	defer timeout.Stop()
+	ticker := time.NewTicker(10 * time.Millisecond)
+	defer ticker.Stop()
+	for n.indexerService.IndexedHeight() < height {
+		select {
+		case <-ticker.C:
+		case <-timeout.C:
+			return false
+		}
+	}
+	return true
+}
+
 // OnStop stops the Node. It implements service.Service.
 func (n *Node) OnStop() {
 	n.BaseService.OnStop()
@@ -1137,6 +1243,17 @@ func (n *Node) OnStop() {
 		}
 	}
 
+	if n.coldTierService != nil {
+		if err := n.coldTierService.Stop(); err != nil {
+			n.Logger.Error("Error closing coldTierService", "err", err)
+		}
+	}
+	if n.pruner != nil {
+		if err := n.pruner.Stop(); err != nil {
+			n.Logger.Error("Error closing pruner", "err", err)
+		}
+	}
+
 	if n.blockStore != nil {
 		if err := n.blockStore.Close(); err != nil {
 			n.Logger.Error("problem closing blockstore", "err", err)
@@ -1149,6 +1266,12 @@ func (n *Node) OnStop() {
 		}
 	}
 
+	if n.trustHistoryDB != nil {
+		if err := n.trustHistoryDB.Close(); err != nil {
+			n.Logger.Error("problem closing trust history db", "err", err)
+		}
+	}
+
 	if n.tracer != nil {
 		n.tracer.Stop()
 	}
@@ -1191,6 +1314,7 @@ func (n *Node) ConfigureRPC() error {
 		ConsensusReactor: n.consensusReactor,
 		EventBus:         n.eventBus,
 		Mempool:          n.mempool,
+		Tracer:           n.tracer,
 
 		Logger: n.Logger.With("module", "rpc"),
 
@@ -1288,7 +1412,7 @@ func (n *Node) startRPC() ([]net.Listener, error) {
 		listeners[i] = listener
 	}
 
-	// we expose a simplified api over grpc for convenience to app devs
+	// we expose broadcast_tx_commit and a read api over grpc for convenience to app devs
 	grpcListenAddr := n.config.RPC.GRPCListenAddress
 	if grpcListenAddr != "" {
 		config := rpcserver.DefaultConfig()
@@ -1479,6 +1603,14 @@ func makeNodeInfo(
 		nodeInfo.Channels = append(nodeInfo.Channels, mempoolv2.MempoolStateChannel)
 	}
 
+	if config.Consensus.ErasureCodedBlockParts {
+		nodeInfo.Channels = append(nodeInfo.Channels, cs.ParityPartChannel)
+	}
+
+	if config.Consensus.CompactBlocks {
+		nodeInfo.Channels = append(nodeInfo.Channels, cs.CompactBlockChannel)
+	}
+
 	lAddr := config.P2P.ExternalAddress
 
 	if lAddr == "" {
@@ -3,6 +3,7 @@ package pex
 import (
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -495,7 +496,10 @@ func (r *Reactor) ensurePeers() {
 	// Try maxAttempts times to pick numToDial addresses to dial
 	maxAttempts := numToDial * 3
 
-	for i := 0; i < maxAttempts && len(toDial) < numToDial; i++ {
+	// peers with a lower trust score are only dialed if there aren't enough
+	// candidates with a higher score.
+	var candidates []*p2p.NetAddress
+	for i := 0; i < maxAttempts; i++ {
 		try := r.book.PickAddress(newBias)
 		if try == nil {
 			continue
@@ -503,13 +507,26 @@ func (r *Reactor) ensurePeers() {
 		if _, selected := toDial[try.ID]; selected {
 			continue
 		}
-		if r.Switch.IsDialingOrExistingAddress(try) {
+		if r.Switch.IsDialingOrExistingAddress(try) || r.Switch.IsPeerBanned(try.ID) {
 			continue
 		}
 		// TODO: consider moving some checks from toDial into here
 		// so we don't even consider dialing peers that we want to wait
 		// before dialling again, or have dialed too many times already
 		toDial[try.ID] = try
+		candidates = append(candidates, try)
+	}
+	if len(candidates) > numToDial {
+		scores := make(map[p2p.ID]int, len(candidates))
+		for _, addr := range candidates {
+			scores[addr.ID] = r.Switch.PeerTrustScore(addr.ID)
+		}
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return scores[candidates[i].ID] > scores[candidates[j].ID]
+		})
+		for _, addr := range candidates[numToDial:] {
+			delete(toDial, addr.ID)
+		}
 	}
 
 	// Dial picked addresses
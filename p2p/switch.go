@@ -10,7 +10,9 @@ import (
 	"github.com/cometbft/cometbft/libs/cmap"
 	"github.com/cometbft/cometbft/libs/rand"
 	"github.com/cometbft/cometbft/libs/service"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/cometbft/cometbft/p2p/trust"
 	"github.com/cometbft/cometbft/pkg/trace"
 	"github.com/cometbft/cometbft/pkg/trace/schema"
 	"github.com/gogo/protobuf/proto"
@@ -53,6 +55,7 @@ type AddrBook interface {
 	AddOurAddress(*NetAddress)
 	OurAddress(*NetAddress) bool
 	MarkGood(ID)
+	MarkBad(*NetAddress, time.Duration)
 	RemoveAddress(*NetAddress)
 	HasAddress(*NetAddress) bool
 	Save()
@@ -93,6 +96,12 @@ type Switch struct {
 
 	rng *rand.Rand // seed for randomizing dial times and orders
 
+	// trust metrics of the peers, used to ban misbehaving peers
+	trustStore *trust.MetricStore
+	// peers banned for misbehaving, mapped to the end of their ban
+	bannedPeers    map[ID]time.Time
+	bannedPeersMtx cmtsync.Mutex
+
 	metrics     *Metrics
 	mlc         *metricsLabelCache
 	traceClient trace.Tracer
@@ -128,6 +137,7 @@ func NewSwitch(
 		filterTimeout:        defaultFilterTimeout,
 		persistentPeersAddrs: make([]*NetAddress, 0),
 		unconditionalPeerIDs: make(map[ID]struct{}),
+		bannedPeers:          make(map[ID]time.Time),
 		mlc:                  newMetricsLabelCache(),
 		traceClient:          trace.NoOpTracer(),
 	}
@@ -163,6 +173,13 @@ func WithTracer(tracer trace.Tracer) SwitchOption {
 	return func(sw *Switch) { sw.traceClient = tracer }
 }
 
+// WithTrustMetricStore sets the store of the trust metrics used to score
+// peers. The store is started and stopped with the switch. Without it, peers
+// are never banned.
+func WithTrustMetricStore(store *trust.MetricStore) SwitchOption {
+	return func(sw *Switch) { sw.trustStore = store }
+}
+
 //---------------------------------------------------------------------
 // Switch setup
 
@@ -237,6 +254,12 @@ func (sw *Switch) SetNodeKey(nodeKey *NodeKey) {
 
 // OnStart implements BaseService. It starts all the reactors and peers.
 func (sw *Switch) OnStart() error {
+	if sw.trustStore != nil {
+		if err := sw.trustStore.Start(); err != nil {
+			return fmt.Errorf("failed to start trust metric store: %w", err)
+		}
+	}
+
 	// Start reactors
 	for _, reactor := range sw.reactors {
 		err := reactor.Start()
@@ -265,6 +288,12 @@ func (sw *Switch) OnStop() {
 			sw.Logger.Error("error while stopped reactor", "reactor", reactor, "error", err)
 		}
 	}
+
+	if sw.trustStore != nil {
+		if err := sw.trustStore.Stop(); err != nil {
+			sw.Logger.Error("error while stopping trust metric store", "error", err)
+		}
+	}
 }
 
 //---------------------------------------------------------------------
@@ -415,6 +444,10 @@ func (sw *Switch) stopAndRemovePeer(peer Peer, reason interface{}) {
 		reactor.RemovePeer(peer, reason)
 	}
 
+	if sw.trustStore != nil {
+		sw.trustStore.PeerDisconnected(string(peer.ID()))
+	}
+
 	// Removing a peer should go last to avoid a situation where a peer
 	// reconnect to our node and the switch calls InitPeer before
 	// RemovePeer is finished.
@@ -493,9 +526,94 @@ func (sw *Switch) SetAddrBook(addrBook AddrBook) {
 // MarkPeerAsGood marks the given peer as good when it did something useful
 // like contributed to consensus.
 func (sw *Switch) MarkPeerAsGood(peer Peer) {
+	sw.MarkPeerIDAsGood(peer.ID())
+}
+
+// MarkPeerIDAsGood marks the peer of the given ID as good, as MarkPeerAsGood,
+// even if it is no longer connected.
+func (sw *Switch) MarkPeerIDAsGood(id ID) {
+	if sw.addrBook != nil {
+		sw.addrBook.MarkGood(id)
+	}
+	if sw.trustStore != nil {
+		sw.trustStore.GetPeerTrustMetric(string(id)).GoodEvents(1)
+	}
+}
+
+// MarkPeerAsBad lowers the trust score of the peer of the given ID when it
+// misbehaved, like sending an invalid message, even if it is no longer
+// connected. If config.MinPeerTrustScore is set and the score falls below it,
+// the node ID of the peer is banned for config.PeerBanDuration and the peer is
+// disconnected, unless it is persistent or unconditional.
+func (sw *Switch) MarkPeerAsBad(id ID, reason interface{}) {
+	if sw.trustStore == nil {
+		return
+	}
+	tm := sw.trustStore.GetPeerTrustMetric(string(id))
+	tm.BadEvents(1)
+
+	score := tm.TrustScore()
+	if score >= sw.config.MinPeerTrustScore || sw.isPersistentPeerID(id) || sw.IsPeerUnconditional(id) {
+		return
+	}
+
+	sw.Logger.Info("Banning peer with low trust score", "peer", id, "score", score,
+		"duration", sw.config.PeerBanDuration, "reason", reason)
+	sw.bannedPeersMtx.Lock()
+	sw.bannedPeers[id] = time.Now().Add(sw.config.PeerBanDuration)
+	sw.bannedPeersMtx.Unlock()
 	if sw.addrBook != nil {
-		sw.addrBook.MarkGood(peer.ID())
+		// the address book finds the advertised address of the peer by its
+		// ID, unlike the socket address of an inbound peer, whose port is
+		// ephemeral.
+		sw.addrBook.MarkBad(&NetAddress{ID: id}, sw.config.PeerBanDuration)
+	}
+	if peer := sw.peers.Get(id); peer != nil {
+		sw.StopPeerForError(peer, reason)
+	}
+}
+
+// isPersistentPeerID returns true if the peer of the given ID is one of the
+// persistent peers, connected or not.
+func (sw *Switch) isPersistentPeerID(id ID) bool {
+	if peer := sw.peers.Get(id); peer != nil && peer.IsPersistent() {
+		return true
+	}
+	for _, pa := range sw.persistentPeersAddrs {
+		if pa.ID == id {
+			return true
+		}
+	}
+	return false
+}
+
+// IsPeerBanned returns true if the peer is currently banned for misbehaving.
+func (sw *Switch) IsPeerBanned(id ID) bool {
+	sw.bannedPeersMtx.Lock()
+	defer sw.bannedPeersMtx.Unlock()
+
+	until, ok := sw.bannedPeers[id]
+	if !ok {
+		return false
+	}
+	if time.Now().After(until) {
+		delete(sw.bannedPeers, id)
+		return false
 	}
+	return true
+}
+
+// PeerTrustScore returns the trust score of the peer, between 0 and 100.
+// Peers without history have the maximum score.
+func (sw *Switch) PeerTrustScore(id ID) int {
+	if sw.trustStore == nil {
+		return 100
+	}
+	score, ok := sw.trustStore.PeerTrustScore(string(id))
+	if !ok {
+		return 100
+	}
+	return score
 }
 
 //---------------------------------------------------------------------
@@ -825,6 +943,10 @@ func (sw *Switch) filterPeer(p Peer) error {
 		return ErrRejected{id: p.ID(), isDuplicate: true}
 	}
 
+	if sw.IsPeerBanned(p.ID()) {
+		return ErrRejected{id: p.ID(), isBanned: true}
+	}
+
 	errc := make(chan error, len(sw.peerFilters))
 
 	for _, f := range sw.peerFilters {
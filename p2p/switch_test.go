@@ -19,11 +19,14 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
+	dbm "github.com/cometbft/cometbft-db"
+
 	"github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto/ed25519"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p/conn"
+	"github.com/cometbft/cometbft/p2p/trust"
 	p2pproto "github.com/cometbft/cometbft/proto/tendermint/p2p"
 )
 
@@ -487,6 +490,69 @@ func TestSwitchStopPeerForError(t *testing.T) {
 	assert.EqualValues(t, 0, peersMetricValue())
 }
 
+func TestSwitchMarkPeerAsBad(t *testing.T) {
+	const minScore = 20
+	// make two connected switches scoring their peers, only sw1 banning them.
+	sw1, sw2 := MakeSwitchPair(t, func(i int, sw *Switch) *Switch {
+		opt := WithTrustMetricStore(trust.NewTrustMetricStore(dbm.NewMemDB(), trust.DefaultConfig()))
+		opt(sw)
+		if i == 0 {
+			p2pCfg := *sw.config
+			p2pCfg.MinPeerTrustScore = minScore
+			sw.config = &p2pCfg
+		}
+		return initSwitchFunc(i, sw)
+	})
+	t.Cleanup(func() {
+		if err := sw1.Stop(); err != nil {
+			t.Error(err)
+		}
+		if err := sw2.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	require.Len(t, sw1.Peers().List(), 1)
+	p := sw1.Peers().List()[0]
+	assert.Equal(t, 100, sw1.PeerTrustScore(p.ID()))
+
+	// banning is disabled by default.
+	require.Len(t, sw2.Peers().List(), 1)
+	p2 := sw2.Peers().List()[0]
+	for i := 0; i < 3; i++ {
+		sw2.MarkPeerAsBad(p2.ID(), "bad message")
+	}
+	assert.Less(t, sw2.PeerTrustScore(p2.ID()), minScore)
+	assert.False(t, sw2.IsPeerBanned(p2.ID()))
+
+	// a single bad message after a good one lowers the score, but not below
+	// the minimum.
+	sw1.MarkPeerAsGood(p)
+	sw1.MarkPeerAsBad(p.ID(), "bad message")
+	assert.Less(t, sw1.PeerTrustScore(p.ID()), 100)
+	assert.False(t, sw1.IsPeerBanned(p.ID()))
+	assert.Len(t, sw1.Peers().List(), 1)
+
+	// another one gets the peer disconnected and its node ID banned.
+	sw1.MarkPeerAsBad(p.ID(), "bad message")
+	assert.Less(t, sw1.PeerTrustScore(p.ID()), minScore)
+	assert.True(t, sw1.IsPeerBanned(p.ID()))
+	assert.Empty(t, sw1.Peers().List())
+
+	// the banned peer is rejected if it connects again.
+	err := sw1.filterPeer(p)
+	if err, ok := err.(ErrRejected); ok {
+		assert.True(t, err.IsBanned())
+	} else {
+		t.Errorf("expected ErrRejected")
+	}
+
+	// the score of the disconnected peer is still updated.
+	score := sw1.PeerTrustScore(p.ID())
+	sw1.MarkPeerIDAsGood(p.ID())
+	assert.Greater(t, sw1.PeerTrustScore(p.ID()), score)
+}
+
 func TestSwitchReconnectsToOutboundPersistentPeer(t *testing.T) {
 	sw := MakeSwitch(cfg, 1, "testing", "123.123.123", initSwitchFunc)
 	err := sw.Start()
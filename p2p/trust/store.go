@@ -116,6 +116,19 @@ func (tms *MetricStore) GetPeerTrustMetric(key string) *Metric {
 	return tm
 }
 
+// PeerTrustScore returns the trust score of the peer identified by the key, without
+// creating a trust metric for peers that don't have one yet
+func (tms *MetricStore) PeerTrustScore(key string) (score int, ok bool) {
+	tms.mtx.Lock()
+	tm, ok := tms.peerMetrics[key]
+	tms.mtx.Unlock()
+
+	if !ok {
+		return 0, false
+	}
+	return tm.TrustScore(), true
+}
+
 // PeerDisconnected pauses the trust metric associated with the peer identified by the key
 func (tms *MetricStore) PeerDisconnected(key string) {
 	tms.mtx.Lock()
@@ -158,6 +158,16 @@ func TestTrustMetricStorePeerScore(t *testing.T) {
 	// We will remember our experiences with this peer
 	tm = store.GetPeerTrustMetric(key)
 	assert.NotEqual(t, 100, tm.TrustScore())
+
+	score, ok := store.PeerTrustScore(key)
+	assert.True(t, ok)
+	assert.Equal(t, tm.TrustScore(), score)
+
+	// Looking up the score of an unknown peer doesn't create a metric
+	_, ok = store.PeerTrustScore("UnknownKey")
+	assert.False(t, ok)
+	assert.Equal(t, 1, store.Size())
+
 	err = store.Stop()
 	require.NoError(t, err)
 }
@@ -30,6 +30,41 @@ const (
 
 	// NamespaceSize is the size of a namespace in bytes.
 	NamespaceSize = NamespaceIDSize + NamespaceVersionSize
+
+	// ShareSize is the size of a share in bytes.
+	ShareSize = 512
+
+	// ShareInfoBytes is the number of bytes used to store the share version
+	// and the sequence start indicator of a share.
+	ShareInfoBytes = 1
+
+	// SequenceLenBytes is the number of bytes used to store the length of a
+	// sequence in the first share of the sequence.
+	SequenceLenBytes = 4
+
+	// CompactShareReservedBytes is the number of bytes used by compact shares
+	// to store the index of the first unit that starts in the share.
+	CompactShareReservedBytes = 4
+
+	// FirstSparseShareContentSize is the number of bytes of blob data that fit
+	// in the first share of a blob of share version zero.
+	FirstSparseShareContentSize = ShareSize - NamespaceSize - ShareInfoBytes - SequenceLenBytes
+
+	// ContinuationSparseShareContentSize is the number of bytes of blob data
+	// that fit in every share of a blob but the first.
+	ContinuationSparseShareContentSize = ShareSize - NamespaceSize - ShareInfoBytes
+
+	// ShareVersionZero is the share version of blobs that only store their
+	// data.
+	ShareVersionZero = 0
+
+	// ShareVersionOne is the share version of blobs that also store the
+	// address of their signer in their first share, after the sequence length.
+	ShareVersionOne = 1
+
+	// SignerSize is the size of the signer address stored in the first share
+	// of a blob of share version one.
+	SignerSize = 20
 )
 
 var (
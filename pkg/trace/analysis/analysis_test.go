@@ -0,0 +1,170 @@
+package analysis
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/pkg/trace"
+	"github.com/cometbft/cometbft/pkg/trace/schema"
+)
+
+var start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func at(ms int) time.Time {
+	return start.Add(time.Duration(ms) * time.Millisecond)
+}
+
+// writeTable writes events to the table file of the node in dir.
+func writeTable[T trace.Entry](t *testing.T, dir, node string, times []time.Time, msgs []T) {
+	t.Helper()
+	nodeDir := filepath.Join(dir, "chain", node)
+	require.NoError(t, os.MkdirAll(nodeDir, 0o755))
+	f, err := os.OpenFile(
+		filepath.Join(nodeDir, msgs[0].Table()+".jsonl"),
+		os.O_APPEND|os.O_CREATE|os.O_WRONLY,
+		0o644,
+	)
+	require.NoError(t, err)
+	defer f.Close()
+	enc := json.NewEncoder(f)
+	for i, msg := range msgs {
+		ev := trace.NewEvent("chain", node, msg.Table(), msg)
+		ev.Timestamp = times[i]
+		require.NoError(t, enc.Encode(ev))
+	}
+}
+
+func testDataset(t *testing.T) string {
+	dir := t.TempDir()
+
+	// node a proposes height 1 with two parts, b receives them at 10ms and
+	// 30ms, c receives only one of them.
+	writeTable(t, dir, "a", []time.Time{at(0), at(1)}, []schema.BlockPart{
+		{Height: 1, Index: 0, TransferType: schema.Upload},
+		{Height: 1, Index: 1, TransferType: schema.Upload},
+	})
+	writeTable(t, dir, "b", []time.Time{at(10), at(30), at(40)}, []schema.BlockPart{
+		{Height: 1, Index: 0, TransferType: schema.Download},
+		{Height: 1, Index: 1, TransferType: schema.Download},
+		{Height: 1, Index: 1, TransferType: schema.Download},
+	})
+	writeTable(t, dir, "c", []time.Time{at(20)}, []schema.BlockPart{
+		{Height: 1, Index: 0, TransferType: schema.Download},
+	})
+
+	// votes signed at 0ms received after 5 and 15ms.
+	writeTable(t, dir, "b", []time.Time{at(5), at(15)}, []schema.Vote{
+		{VoteHeight: 1, VoteType: "Prevote", VoteMillisecondTimestamp: start.UnixMilli(), TransferType: schema.Download},
+		{VoteHeight: 1, VoteType: "Prevote", VoteMillisecondTimestamp: start.UnixMilli(), TransferType: schema.Download},
+	})
+
+	// tx first seen by c at 50ms, committed by a at 250ms and by b at 300ms.
+	writeTable(t, dir, "b", []time.Time{at(60)}, []schema.MempoolTx{{TxHash: "abcd", Peer: "a"}})
+	writeTable(t, dir, "c", []time.Time{at(50)}, []schema.MempoolTx{{TxHash: "ABCD", Peer: "b"}})
+	writeTable(t, dir, "a", []time.Time{at(250)}, []schema.CommittedTxs{{Height: 2, TxHashes: []string{"ABCD", "FFFF"}}})
+	writeTable(t, dir, "b", []time.Time{at(300)}, []schema.CommittedTxs{{Height: 2, TxHashes: []string{"ABCD"}}})
+
+	writeTable(t, dir, "a", []time.Time{at(0), at(1)}, []schema.ReceivedBytes{
+		{PeerID: "b", Channel: 0x20, Bytes: 100},
+		{PeerID: "b", Channel: 0x21, Bytes: 50},
+	})
+	writeTable(t, dir, "a", []time.Time{at(0), at(1)}, []schema.PendingBytes{
+		{PeerID: "b", Bytes: map[byte]int{0x20: 10, 0x21: 20}},
+		{PeerID: "b", Bytes: map[byte]int{0x20: 10}},
+	})
+	return dir
+}
+
+func TestAnalyze(t *testing.T) {
+	ds, err := Load(testDataset(t))
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, ds.Nodes())
+
+	report := Analyze(ds)
+
+	require.Equal(t, []BlockPartLatency{{
+		Height:    1,
+		Parts:     2,
+		Nodes:     1,
+		FirstSeen: at(0),
+		P50Ms:     30,
+		P90Ms:     30,
+		MaxMs:     30,
+	}}, report.BlockPartLatency)
+
+	require.Equal(t, []VoteArrival{{
+		Height:   1,
+		VoteType: "Prevote",
+		Count:    2,
+		MinMs:    5,
+		P50Ms:    5,
+		P90Ms:    15,
+		MaxMs:    15,
+	}}, report.VoteArrival)
+
+	require.Len(t, report.TxLatency, 1)
+	require.Equal(t, TxLatency{
+		TxHash:        "ABCD",
+		FirstSeenNode: "c",
+		FirstSeen:     at(50),
+		Height:        2,
+		CommittedAt:   at(250),
+		LatencyMs:     200,
+	}, report.TxLatency[0])
+
+	require.Equal(t, []PeerBytes{{
+		NodeID:           "a",
+		PeerID:           "b",
+		ReceivedBytes:    150,
+		ReceivedMsgs:     2,
+		MaxPendingBytes:  30,
+		MeanPendingBytes: 20,
+	}}, report.PeerBytes)
+
+	summary := report.Summarize()
+	require.Equal(t, 3, summary.Nodes)
+	require.Equal(t, 1, summary.Heights)
+	require.Equal(t, float64(200), summary.TxLatency.MaxMs)
+	require.EqualValues(t, 150, summary.ReceivedBytes)
+}
+
+func TestReportWriteCSVAndJSON(t *testing.T) {
+	ds, err := Load(testDataset(t))
+	require.NoError(t, err)
+	report := Analyze(ds)
+
+	out := t.TempDir()
+	require.NoError(t, report.WriteCSV(out))
+	require.NoError(t, report.WriteJSON(out))
+
+	f, err := os.Open(filepath.Join(out, TxLatencyReport+".csv"))
+	require.NoError(t, err)
+	defer f.Close()
+	records, err := csv.NewReader(f).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 2)
+	require.Equal(t, txLatencyHeader, records[0])
+	require.Equal(t, "ABCD", records[1][0])
+	require.Equal(t, "200.000", records[1][5])
+
+	bz, err := os.ReadFile(filepath.Join(out, VoteArrivalReport+".json"))
+	require.NoError(t, err)
+	var votes []VoteArrival
+	require.NoError(t, json.Unmarshal(bz, &votes))
+	require.Equal(t, report.VoteArrival, votes)
+}
+
+func TestPercentile(t *testing.T) {
+	require.Equal(t, float64(0), percentile(nil, 50))
+	values := []float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	require.Equal(t, float64(1), percentile(values, 0))
+	require.Equal(t, float64(5), percentile(values, 50))
+	require.Equal(t, float64(9), percentile(values, 90))
+	require.Equal(t, float64(10), percentile(values, 100))
+}
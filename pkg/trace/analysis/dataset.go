@@ -0,0 +1,114 @@
+// Package analysis decodes trace tables collected from many nodes and joins
+// them to measure block part propagation, vote arrival, transaction latency and
+// peer traffic.
+package analysis
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/cometbft/cometbft/pkg/trace"
+	"github.com/cometbft/cometbft/pkg/trace/schema"
+)
+
+// Dataset holds the rows of the tables used for analysis, collected from any
+// number of nodes. The node that collected each row is identified by the
+// NodeID of its event.
+type Dataset struct {
+	BlockParts    []trace.Event[schema.BlockPart]
+	Votes         []trace.Event[schema.Vote]
+	MempoolTxs    []trace.Event[schema.MempoolTx]
+	CommittedTxs  []trace.Event[schema.CommittedTxs]
+	ReceivedBytes []trace.Event[schema.ReceivedBytes]
+	PendingBytes  []trace.Event[schema.PendingBytes]
+}
+
+// Nodes returns the sorted IDs of every node that has rows in the dataset.
+func (d *Dataset) Nodes() []string {
+	set := make(map[string]struct{})
+	add := func(nodeID string) { set[nodeID] = struct{}{} }
+	for _, e := range d.BlockParts {
+		add(e.NodeID)
+	}
+	for _, e := range d.Votes {
+		add(e.NodeID)
+	}
+	for _, e := range d.MempoolTxs {
+		add(e.NodeID)
+	}
+	for _, e := range d.CommittedTxs {
+		add(e.NodeID)
+	}
+	for _, e := range d.ReceivedBytes {
+		add(e.NodeID)
+	}
+	for _, e := range d.PendingBytes {
+		add(e.NodeID)
+	}
+
+	nodes := make([]string, 0, len(set))
+	for node := range set {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	return nodes
+}
+
+// Load walks dir and decodes every table used for analysis, along with its
+// segments, from each directory it finds them in. This supports the layouts
+// produced by both trace.GetTable, when tables of each node are saved to
+// their own directory, and trace.S3Download.
+func Load(dir string) (*Dataset, error) {
+	ds := &Dataset{}
+	err := filepath.WalkDir(dir, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		return ds.loadDir(p)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ds, nil
+}
+
+// loadDir decodes the tables stored in dir, skipping those that are missing.
+func (d *Dataset) loadDir(dir string) error {
+	var err error
+	if d.BlockParts, err = appendTable(d.BlockParts, dir, schema.BlockPartsTable); err != nil {
+		return err
+	}
+	if d.Votes, err = appendTable(d.Votes, dir, schema.VoteTable); err != nil {
+		return err
+	}
+	if d.MempoolTxs, err = appendTable(d.MempoolTxs, dir, schema.MempoolTxTable); err != nil {
+		return err
+	}
+	if d.CommittedTxs, err = appendTable(d.CommittedTxs, dir, schema.CommittedTxsTable); err != nil {
+		return err
+	}
+	if d.ReceivedBytes, err = appendTable(d.ReceivedBytes, dir, schema.ReceivedBytesTable); err != nil {
+		return err
+	}
+	d.PendingBytes, err = appendTable(d.PendingBytes, dir, schema.PendingBytesTable)
+	return err
+}
+
+// appendTable decodes the table stored in dir and appends its rows to events.
+// Missing tables are skipped.
+func appendTable[T any](events []trace.Event[T], dir, table string) ([]trace.Event[T], error) {
+	decoded, err := trace.DecodeTable[T](dir, table)
+	switch {
+	case os.IsNotExist(err):
+		return events, nil
+	case err != nil:
+		return nil, fmt.Errorf("failed to decode table %s in %s: %w", table, dir, err)
+	}
+	return append(events, decoded...), nil
+}
@@ -0,0 +1,220 @@
+package analysis
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"time"
+)
+
+const (
+	BlockPartLatencyReport = "block_part_latency"
+	VoteArrivalReport      = "vote_arrival"
+	TxLatencyReport        = "tx_latency"
+	PeerBytesReport        = "peer_bytes"
+)
+
+// Report holds every analysis computed from a dataset.
+type Report struct {
+	Nodes            []string           `json:"nodes"`
+	BlockPartLatency []BlockPartLatency `json:"block_part_latency"`
+	VoteArrival      []VoteArrival      `json:"vote_arrival"`
+	TxLatency        []TxLatency        `json:"tx_latency"`
+	PeerBytes        []PeerBytes        `json:"peer_bytes"`
+}
+
+// Analyze computes every analysis over the dataset.
+func Analyze(ds *Dataset) *Report {
+	return &Report{
+		Nodes:            ds.Nodes(),
+		BlockPartLatency: BlockPartLatencies(ds),
+		VoteArrival:      VoteArrivals(ds),
+		TxLatency:        TxLatencies(ds),
+		PeerBytes:        PeerBytesSummary(ds),
+	}
+}
+
+// Stats summarizes a set of latencies in milliseconds.
+type Stats struct {
+	Count  int     `json:"count"`
+	MeanMs float64 `json:"mean_ms"`
+	P50Ms  float64 `json:"p50_ms"`
+	P90Ms  float64 `json:"p90_ms"`
+	MaxMs  float64 `json:"max_ms"`
+}
+
+func newStats(values []float64) Stats {
+	if len(values) == 0 {
+		return Stats{}
+	}
+	sorted := append([]float64(nil), values...)
+	sort.Float64s(sorted)
+	sum := 0.0
+	for _, v := range sorted {
+		sum += v
+	}
+	return Stats{
+		Count:  len(sorted),
+		MeanMs: sum / float64(len(sorted)),
+		P50Ms:  percentile(sorted, 50),
+		P90Ms:  percentile(sorted, 90),
+		MaxMs:  percentile(sorted, 100),
+	}
+}
+
+// Summary aggregates a report across heights, transactions and peers.
+type Summary struct {
+	Nodes   int `json:"nodes"`
+	Heights int `json:"heights"`
+	// BlockPartLatency summarizes the time it took for every node to receive
+	// each block.
+	BlockPartLatency Stats `json:"block_part_latency"`
+	// VoteArrival summarizes the median vote arrival delay of each height,
+	// round and vote type.
+	VoteArrival Stats `json:"vote_arrival"`
+	// TxLatency summarizes the first-seen-to-commit latency of transactions.
+	TxLatency     Stats `json:"tx_latency"`
+	ReceivedBytes int64 `json:"received_bytes"`
+}
+
+// Summarize aggregates the report.
+func (r *Report) Summarize() Summary {
+	var (
+		blocks = make([]float64, 0, len(r.BlockPartLatency))
+		votes  = make([]float64, 0, len(r.VoteArrival))
+		txs    = make([]float64, 0, len(r.TxLatency))
+		bytes  int64
+	)
+	for _, b := range r.BlockPartLatency {
+		if b.Nodes > 0 {
+			blocks = append(blocks, b.MaxMs)
+		}
+	}
+	for _, v := range r.VoteArrival {
+		votes = append(votes, v.P50Ms)
+	}
+	for _, tx := range r.TxLatency {
+		txs = append(txs, tx.LatencyMs)
+	}
+	for _, pb := range r.PeerBytes {
+		bytes += pb.ReceivedBytes
+	}
+	return Summary{
+		Nodes:            len(r.Nodes),
+		Heights:          len(r.BlockPartLatency),
+		BlockPartLatency: newStats(blocks),
+		VoteArrival:      newStats(votes),
+		TxLatency:        newStats(txs),
+		ReceivedBytes:    bytes,
+	}
+}
+
+// WriteJSON writes each analysis of the report to its own json file in dir.
+func (r *Report) WriteJSON(dir string) error {
+	reports := map[string]any{
+		BlockPartLatencyReport: r.BlockPartLatency,
+		VoteArrivalReport:      r.VoteArrival,
+		TxLatencyReport:        r.TxLatency,
+		PeerBytesReport:        r.PeerBytes,
+	}
+	return writeFiles(dir, ".json", reports, func(w io.Writer, v any) error {
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+		return enc.Encode(v)
+	})
+}
+
+// WriteCSV writes each analysis of the report to its own csv file in dir.
+func (r *Report) WriteCSV(dir string) error {
+	reports := map[string]any{
+		BlockPartLatencyReport: toRecords(blockPartLatencyHeader, r.BlockPartLatency, BlockPartLatency.record),
+		VoteArrivalReport:      toRecords(voteArrivalHeader, r.VoteArrival, VoteArrival.record),
+		TxLatencyReport:        toRecords(txLatencyHeader, r.TxLatency, TxLatency.record),
+		PeerBytesReport:        toRecords(peerBytesHeader, r.PeerBytes, PeerBytes.record),
+	}
+	return writeFiles(dir, ".csv", reports, func(w io.Writer, v any) error {
+		cw := csv.NewWriter(w)
+		if err := cw.WriteAll(v.([][]string)); err != nil {
+			return err
+		}
+		return cw.Error()
+	})
+}
+
+func writeFiles(dir, ext string, reports map[string]any, write func(io.Writer, any) error) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	for name, v := range reports {
+		p := filepath.Join(dir, name+ext)
+		f, err := os.Create(p)
+		if err != nil {
+			return err
+		}
+		err = write(f, v)
+		if cerr := f.Close(); err == nil {
+			err = cerr
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write %s: %w", p, err)
+		}
+	}
+	return nil
+}
+
+func toRecords[T any](header []string, rows []T, record func(T) []string) [][]string {
+	out := make([][]string, 0, len(rows)+1)
+	out = append(out, header)
+	for _, row := range rows {
+		out = append(out, record(row))
+	}
+	return out
+}
+
+var (
+	blockPartLatencyHeader = []string{"height", "round", "parts", "nodes", "first_seen", "p50_ms", "p90_ms", "max_ms"}
+	voteArrivalHeader      = []string{"height", "round", "vote_type", "count", "min_ms", "p50_ms", "p90_ms", "max_ms"}
+	txLatencyHeader        = []string{"tx_hash", "first_seen_node", "first_seen", "height", "committed_at", "latency_ms"}
+	peerBytesHeader        = []string{
+		"node_id", "peer_id", "received_bytes", "received_msgs", "max_pending_bytes", "mean_pending_bytes",
+	}
+)
+
+func (b BlockPartLatency) record() []string {
+	return []string{
+		itoa(b.Height), itoa(int64(b.Round)), itoa(int64(b.Parts)), itoa(int64(b.Nodes)),
+		formatTime(b.FirstSeen), ftoa(b.P50Ms), ftoa(b.P90Ms), ftoa(b.MaxMs),
+	}
+}
+
+func (v VoteArrival) record() []string {
+	return []string{
+		itoa(v.Height), itoa(int64(v.Round)), v.VoteType, itoa(int64(v.Count)),
+		ftoa(v.MinMs), ftoa(v.P50Ms), ftoa(v.P90Ms), ftoa(v.MaxMs),
+	}
+}
+
+func (tx TxLatency) record() []string {
+	return []string{
+		tx.TxHash, tx.FirstSeenNode, formatTime(tx.FirstSeen), itoa(tx.Height),
+		formatTime(tx.CommittedAt), ftoa(tx.LatencyMs),
+	}
+}
+
+func (pb PeerBytes) record() []string {
+	return []string{
+		pb.NodeID, pb.PeerID, itoa(pb.ReceivedBytes), itoa(int64(pb.ReceivedMsgs)),
+		itoa(int64(pb.MaxPendingBytes)), ftoa(pb.MeanPendingBytes),
+	}
+}
+
+func itoa(i int64) string { return strconv.FormatInt(i, 10) }
+
+func ftoa(f float64) string { return strconv.FormatFloat(f, 'f', 3, 64) }
+
+func formatTime(t time.Time) string { return t.UTC().Format(time.RFC3339Nano) }
@@ -0,0 +1,324 @@
+package analysis
+
+import (
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cometbft/cometbft/pkg/trace/schema"
+)
+
+// BlockPartLatency describes how long it took for the block parts of the
+// latest round of a height to propagate to every node. Latencies are measured
+// from the first time any node sent or received a part of the block.
+type BlockPartLatency struct {
+	Height int64 `json:"height"`
+	Round  int32 `json:"round"`
+	// Parts is the number of distinct parts observed.
+	Parts int `json:"parts"`
+	// Nodes is the number of nodes that received every part.
+	Nodes     int       `json:"nodes"`
+	FirstSeen time.Time `json:"first_seen"`
+	P50Ms     float64   `json:"p50_ms"`
+	P90Ms     float64   `json:"p90_ms"`
+	MaxMs     float64   `json:"max_ms"`
+}
+
+// VoteArrival describes the distribution of the delay between when votes were
+// signed and when they were received by the nodes.
+type VoteArrival struct {
+	Height   int64   `json:"height"`
+	Round    int32   `json:"round"`
+	VoteType string  `json:"vote_type"`
+	Count    int     `json:"count"`
+	MinMs    float64 `json:"min_ms"`
+	P50Ms    float64 `json:"p50_ms"`
+	P90Ms    float64 `json:"p90_ms"`
+	MaxMs    float64 `json:"max_ms"`
+}
+
+// TxLatency describes the time between a transaction first being seen by any
+// node and it first being committed by any node.
+type TxLatency struct {
+	TxHash        string    `json:"tx_hash"`
+	FirstSeenNode string    `json:"first_seen_node"`
+	FirstSeen     time.Time `json:"first_seen"`
+	Height        int64     `json:"height"`
+	CommittedAt   time.Time `json:"committed_at"`
+	LatencyMs     float64   `json:"latency_ms"`
+}
+
+// PeerBytes describes the traffic between a node and one of its peers.
+type PeerBytes struct {
+	NodeID string `json:"node_id"`
+	PeerID string `json:"peer_id"`
+	// ReceivedBytes and ReceivedMsgs are the total number of bytes and
+	// messages received by the node from the peer.
+	ReceivedBytes int64 `json:"received_bytes"`
+	ReceivedMsgs  int   `json:"received_msgs"`
+	// MaxPendingBytes and MeanPendingBytes describe the number of bytes
+	// queued to be sent by the node to the peer, across all channels.
+	MaxPendingBytes  int     `json:"max_pending_bytes"`
+	MeanPendingBytes float64 `json:"mean_pending_bytes"`
+}
+
+// heightRound identifies a round of consensus.
+type heightRound struct {
+	height int64
+	round  int32
+}
+
+// BlockPartLatencies computes the propagation latency of block parts for each
+// height, using the latest round observed for the height.
+func BlockPartLatencies(ds *Dataset) []BlockPartLatency {
+	type nodeParts map[int32]time.Time // part index -> first received
+	var (
+		latestRound = make(map[int64]int32)
+		firstSeen   = make(map[heightRound]time.Time)
+		received    = make(map[heightRound]map[string]nodeParts)
+	)
+	for _, e := range ds.BlockParts {
+		if r, has := latestRound[e.Msg.Height]; !has || e.Msg.Round > r {
+			latestRound[e.Msg.Height] = e.Msg.Round
+		}
+		hr := heightRound{e.Msg.Height, e.Msg.Round}
+		if t, has := firstSeen[hr]; !has || e.Timestamp.Before(t) {
+			firstSeen[hr] = e.Timestamp
+		}
+		if e.Msg.TransferType != schema.Download {
+			continue
+		}
+		nodes, has := received[hr]
+		if !has {
+			nodes = make(map[string]nodeParts)
+			received[hr] = nodes
+		}
+		parts, has := nodes[e.NodeID]
+		if !has {
+			parts = make(nodeParts)
+			nodes[e.NodeID] = parts
+		}
+		if t, has := parts[e.Msg.Index]; !has || e.Timestamp.Before(t) {
+			parts[e.Msg.Index] = e.Timestamp
+		}
+	}
+
+	out := make([]BlockPartLatency, 0, len(latestRound))
+	for height, round := range latestRound {
+		hr := heightRound{height, round}
+		nodes := received[hr]
+
+		indexes := make(map[int32]struct{})
+		for _, parts := range nodes {
+			for index := range parts {
+				indexes[index] = struct{}{}
+			}
+		}
+
+		var latencies []float64
+		for _, parts := range nodes {
+			if len(parts) != len(indexes) {
+				continue
+			}
+			var complete time.Time
+			for _, t := range parts {
+				if t.After(complete) {
+					complete = t
+				}
+			}
+			latencies = append(latencies, millis(complete.Sub(firstSeen[hr])))
+		}
+		sort.Float64s(latencies)
+
+		out = append(out, BlockPartLatency{
+			Height:    height,
+			Round:     round,
+			Parts:     len(indexes),
+			Nodes:     len(latencies),
+			FirstSeen: firstSeen[hr],
+			P50Ms:     percentile(latencies, 50),
+			P90Ms:     percentile(latencies, 90),
+			MaxMs:     percentile(latencies, 100),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Height < out[j].Height })
+	return out
+}
+
+// VoteArrivals computes the distribution of vote arrival delays per height,
+// round and vote type. Only received votes are considered.
+func VoteArrivals(ds *Dataset) []VoteArrival {
+	type key struct {
+		heightRound
+		voteType string
+	}
+	delays := make(map[key][]float64)
+	for _, e := range ds.Votes {
+		if e.Msg.TransferType != schema.Download {
+			continue
+		}
+		k := key{heightRound{e.Msg.VoteHeight, e.Msg.VoteRound}, e.Msg.VoteType}
+		signed := time.UnixMilli(e.Msg.VoteMillisecondTimestamp)
+		delays[k] = append(delays[k], millis(e.Timestamp.Sub(signed)))
+	}
+
+	out := make([]VoteArrival, 0, len(delays))
+	for k, d := range delays {
+		sort.Float64s(d)
+		out = append(out, VoteArrival{
+			Height:   k.height,
+			Round:    k.round,
+			VoteType: k.voteType,
+			Count:    len(d),
+			MinMs:    percentile(d, 0),
+			P50Ms:    percentile(d, 50),
+			P90Ms:    percentile(d, 90),
+			MaxMs:    percentile(d, 100),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Height != out[j].Height {
+			return out[i].Height < out[j].Height
+		}
+		if out[i].Round != out[j].Round {
+			return out[i].Round < out[j].Round
+		}
+		return out[i].VoteType < out[j].VoteType
+	})
+	return out
+}
+
+// TxLatencies computes the time between each transaction being first seen in
+// the mempool of any node and first being committed by any node. Transactions
+// that were not both seen and committed are omitted.
+func TxLatencies(ds *Dataset) []TxLatency {
+	type seen struct {
+		node string
+		at   time.Time
+	}
+	type committed struct {
+		height int64
+		at     time.Time
+	}
+	var (
+		firstSeen      = make(map[string]seen)
+		firstCommitted = make(map[string]committed)
+	)
+	for _, e := range ds.MempoolTxs {
+		hash := strings.ToUpper(e.Msg.TxHash)
+		if s, has := firstSeen[hash]; !has || e.Timestamp.Before(s.at) {
+			firstSeen[hash] = seen{e.NodeID, e.Timestamp}
+		}
+	}
+	for _, e := range ds.CommittedTxs {
+		for _, hash := range e.Msg.TxHashes {
+			hash = strings.ToUpper(hash)
+			if c, has := firstCommitted[hash]; !has || e.Timestamp.Before(c.at) {
+				firstCommitted[hash] = committed{e.Msg.Height, e.Timestamp}
+			}
+		}
+	}
+
+	out := make([]TxLatency, 0, len(firstCommitted))
+	for hash, c := range firstCommitted {
+		s, has := firstSeen[hash]
+		if !has {
+			continue
+		}
+		out = append(out, TxLatency{
+			TxHash:        hash,
+			FirstSeenNode: s.node,
+			FirstSeen:     s.at,
+			Height:        c.height,
+			CommittedAt:   c.at,
+			LatencyMs:     millis(c.at.Sub(s.at)),
+		})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Height != out[j].Height {
+			return out[i].Height < out[j].Height
+		}
+		return out[i].TxHash < out[j].TxHash
+	})
+	return out
+}
+
+// PeerBytesSummary computes the traffic between each node and its peers from
+// the received and pending bytes tables.
+func PeerBytesSummary(ds *Dataset) []PeerBytes {
+	type key struct{ node, peer string }
+	type pending struct {
+		max, total, samples int
+	}
+	var (
+		received = make(map[key]*PeerBytes)
+		queued   = make(map[key]*pending)
+	)
+	get := func(k key) *PeerBytes {
+		pb, has := received[k]
+		if !has {
+			pb = &PeerBytes{NodeID: k.node, PeerID: k.peer}
+			received[k] = pb
+		}
+		return pb
+	}
+
+	for _, e := range ds.ReceivedBytes {
+		pb := get(key{e.NodeID, e.Msg.PeerID})
+		pb.ReceivedBytes += int64(e.Msg.Bytes)
+		pb.ReceivedMsgs++
+	}
+	for _, e := range ds.PendingBytes {
+		k := key{e.NodeID, e.Msg.PeerID}
+		get(k)
+		p, has := queued[k]
+		if !has {
+			p = &pending{}
+			queued[k] = p
+		}
+		sum := 0
+		for _, b := range e.Msg.Bytes {
+			sum += b
+		}
+		if sum > p.max {
+			p.max = sum
+		}
+		p.total += sum
+		p.samples++
+	}
+
+	out := make([]PeerBytes, 0, len(received))
+	for k, pb := range received {
+		if p, has := queued[k]; has {
+			pb.MaxPendingBytes = p.max
+			pb.MeanPendingBytes = float64(p.total) / float64(p.samples)
+		}
+		out = append(out, *pb)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].NodeID != out[j].NodeID {
+			return out[i].NodeID < out[j].NodeID
+		}
+		return out[i].PeerID < out[j].PeerID
+	})
+	return out
+}
+
+// percentile returns the p-th percentile of the sorted values using the
+// nearest rank method. It returns 0 if there are no values.
+func percentile(sorted []float64, p float64) float64 {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := int(math.Ceil(p / 100 * float64(len(sorted))))
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// millis converts a duration to fractional milliseconds.
+func millis(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
@@ -7,8 +7,12 @@ import (
 	"os"
 	"sync"
 	"sync/atomic"
+	"time"
 )
 
+// errFileBusy is returned when a file is already being read from.
+var errFileBusy = errors.New("file is currently being read from")
+
 // bufferedFile is a file that is being written to and read from. It is thread
 // safe, however, when reading from the file, writes will be ignored.
 type bufferedFile struct {
@@ -25,16 +29,36 @@ type bufferedFile struct {
 
 	// writer is the buffered writer that is writing to the file.
 	wr *bufio.Writer
+
+	// size is the number of bytes in the file, including buffered bytes.
+	size int64
+
+	// opened is when the file was started, used for time based rotation.
+	opened time.Time
+
+	// start is the offset of the first row stored in the file. It is only
+	// changed when rotating.
+	start uint64
+
+	// rows is the number of rows written to the table, including those in
+	// its segments. It is used as the offset of the next row when streaming
+	// and is only accessed by the goroutine draining the canal.
+	rows uint64
 }
 
 // newbufferedFile creates a new buffered file that writes to the given file.
 func newbufferedFile(file *os.File) *bufferedFile {
-	return &bufferedFile{
+	bf := &bufferedFile{
 		file:    file,
 		wr:      bufio.NewWriter(file),
 		reading: atomic.Bool{},
 		mut:     &sync.Mutex{},
+		opened:  time.Now(),
 	}
+	if info, err := file.Stat(); err == nil {
+		bf.size = info.Size()
+	}
+	return bf
 }
 
 // Write writes the given bytes to the file. If the file is currently being read
@@ -45,7 +69,64 @@ func (f *bufferedFile) Write(b []byte) (int, error) {
 	}
 	f.mut.Lock()
 	defer f.mut.Unlock()
-	return f.wr.Write(b)
+	n, err := f.wr.Write(b)
+	f.size += int64(n)
+	return n, err
+}
+
+// shouldRotate returns true if the file has grown beyond maxSize or has been
+// open for longer than maxAge. Zero values disable the respective check.
+func (f *bufferedFile) shouldRotate(maxSize int64, maxAge time.Duration) bool {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	if f.size == 0 {
+		return false
+	}
+	return (maxSize > 0 && f.size >= maxSize) ||
+		(maxAge > 0 && time.Since(f.opened) >= maxAge)
+}
+
+// rotate flushes and renames the file to sealedName, then starts a new empty
+// file in its place whose first row has the offset start. Files that are being
+// read from are not rotated and errFileBusy is returned.
+func (f *bufferedFile) rotate(sealedName string, start uint64) error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	if f.reading.Load() {
+		return errFileBusy
+	}
+
+	if err := f.wr.Flush(); err != nil {
+		return err
+	}
+	name := f.file.Name()
+	if err := f.file.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(name, sealedName); err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(name, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		// stop accepting writes as there is no file to write to.
+		f.reading.Store(true)
+		return err
+	}
+	f.file = file
+	f.wr.Reset(file)
+	f.size = 0
+	f.opened = time.Now()
+	f.start = start
+	return nil
+}
+
+// flush writes any buffered data to the underlying file so that it can be
+// read by a separate file descriptor.
+func (f *bufferedFile) flush() error {
+	f.mut.Lock()
+	defer f.mut.Unlock()
+	return f.wr.Flush()
 }
 
 func (f *bufferedFile) startReading() error {
@@ -84,7 +165,7 @@ func (f *bufferedFile) stopReading() error {
 // was being written to.
 func (f *bufferedFile) File() (*os.File, func() error, error) {
 	if f.reading.Load() {
-		return nil, func() error { return nil }, errors.New("file is currently being read from")
+		return nil, func() error { return nil }, errFileBusy
 	}
 	err := f.startReading()
 	if err != nil {
@@ -93,11 +174,15 @@ func (f *bufferedFile) File() (*os.File, func() error, error) {
 	return f.file, f.stopReading, nil
 }
 
-// Close closes the file.
+// Close flushes any buffered data and closes the file.
 func (f *bufferedFile) Close() error {
 	// set reading to true to prevent writes while closing the file.
 	f.mut.Lock()
 	defer f.mut.Unlock()
 	f.reading.Store(true)
+	if err := f.wr.Flush(); err != nil {
+		f.file.Close()
+		return err
+	}
 	return f.file.Close()
 }
@@ -4,14 +4,13 @@ import (
 	"bufio"
 	"encoding/json"
 	"io"
-	"os"
 )
 
 // DecodeFile reads a file and decodes it into a slice of events via
 // scanning. The table parameter is used to determine the type of the events.
 // The file should be a jsonl file. The generic here are passed to the event
 // type.
-func DecodeFile[T any](f *os.File) ([]Event[T], error) {
+func DecodeFile[T any](f io.Reader) ([]Event[T], error) {
 	var out []Event[T]
 	r := bufio.NewReader(f)
 	for {
@@ -92,6 +92,9 @@ func pump(table string, br *bufio.Reader) (*io.PipeReader, *multipart.Writer) {
 func (lt *LocalTracer) servePullData() {
 	mux := http.NewServeMux()
 	mux.HandleFunc("/get_table", lt.getTableHandler())
+	mux.HandleFunc(streamPath, lt.streamHandler())
+	mux.HandleFunc(tablesPath, lt.tablesHandler())
+	mux.HandleFunc(flushPath, lt.flushHandler())
 	err := http.ListenAndServe(lt.cfg.Instrumentation.TracePullAddress, mux) //nolint:gosec
 	if err != nil {
 		lt.logger.Error("trace pull server failure", "err", err)
@@ -235,20 +238,19 @@ func (lt *LocalTracer) pushLoop() {
 	}
 }
 
+// PushAll pushes every table to S3. Sealed segments are only pushed once,
+// while the file that is being written to is pushed every time.
 func (lt *LocalTracer) PushAll() error {
-	for table := range lt.fileMap {
-		f, done, err := lt.readTable(table)
-		if err != nil {
+	for table, bf := range lt.files() {
+		if err := lt.pushSegments(table); err != nil {
 			return err
 		}
-		for i := 0; i < 3; i++ {
-			err = PushS3(lt.chainID, lt.nodeID, lt.s3Config, f)
-			if err == nil {
-				break
-			}
-			lt.logger.Error("failed to push table", "table", table, "error", err)
-			time.Sleep(time.Second * time.Duration(rand.Intn(3))) //nolint:gosec
+
+		f, done, err := bf.File()
+		if err != nil {
+			return err
 		}
+		lt.pushFile(table, f)
 		err = done()
 		if err != nil {
 			return err
@@ -257,10 +259,60 @@ func (lt *LocalTracer) PushAll() error {
 	return nil
 }
 
+// pushSegments pushes the sealed segments of the table that have not been
+// pushed yet. When compression is enabled, segments are only pushed once they
+// are compressed.
+func (lt *LocalTracer) pushSegments(table string) error {
+	lt.pushMtx.Lock()
+	defer lt.pushMtx.Unlock()
+	lt.segMtx.RLock()
+	defer lt.segMtx.RUnlock()
+
+	segs, err := listSegments(lt.dir, table)
+	if err != nil {
+		return err
+	}
+	for _, seg := range segs {
+		if _, has := lt.pushed[seg.path]; has {
+			continue
+		}
+		if lt.cfg.Instrumentation.TraceCompressSegments && !seg.compressed {
+			continue
+		}
+		f, err := os.Open(seg.path)
+		if err != nil {
+			return err
+		}
+		if lt.pushFile(table, f) {
+			lt.pushed[seg.path] = struct{}{}
+		}
+		f.Close()
+	}
+	return nil
+}
+
+// pushFile pushes the file to S3, retrying a few times. It returns true if the
+// file was pushed.
+func (lt *LocalTracer) pushFile(table string, f *os.File) bool {
+	for i := 0; i < 3; i++ {
+		err := PushS3(lt.chainID, lt.nodeID, lt.s3Config, f)
+		if err == nil {
+			return true
+		}
+		lt.logger.Error("failed to push table", "table", table, "file", f.Name(), "error", err)
+		time.Sleep(time.Second * time.Duration(rand.Intn(3))) //nolint:gosec
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return false
+		}
+	}
+	return false
+}
+
 // S3Download downloads files that match some prefix from an S3 bucket to a
 // local directory dst.
 // fileNames is a list of traced jsonl file names to download. If it is empty, all traces are downloaded.
-// fileNames should not have .jsonl suffix.
+// fileNames should not have .jsonl suffix. The segments of each table are
+// downloaded as well, use OpenTable or DecodeTable to read them.
 func S3Download(dst, prefix string, fileNames []string, cfg S3Config) error {
 	// Ensure local directory structure exists
 	err := os.MkdirAll(dst, os.ModePerm)
@@ -298,9 +350,8 @@ func S3Download(dst, prefix string, fileNames []string, cfg S3Config) error {
 			}
 
 			for _, filename := range fileNames {
-				// Add .jsonl suffix to the fileNames
-				fullFilename := filename + ".jsonl"
-				if strings.HasSuffix(key, fullFilename) {
+				// match both the table file and its segments
+				if isTableFile(path.Base(key), filename) {
 					localFilePath := filepath.Join(dst, prefix, strings.TrimPrefix(key, prefix))
 					fmt.Printf("Downloading %s to %s\n", key, localFilePath)
 
@@ -3,10 +3,13 @@ package trace
 import (
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"path"
 	"strconv"
 	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/cometbft/cometbft/config"
@@ -54,10 +57,37 @@ type LocalTracer struct {
 	cfg             *config.Config
 	s3Config        S3Config
 
-	// fileMap maps tables to their open files files are threadsafe, but the map
-	// is not. Therefore don't create new files after initialization to remain
-	// threadsafe.
-	fileMap map[string]*bufferedFile
+	// dir is the directory where tables are stored.
+	dir string
+	// segMtx protects the set of sealed segments of all tables. Readers hold
+	// it for reading so that segments are not rotated, compressed or pruned
+	// while they are being read.
+	segMtx sync.RWMutex
+	// sealMtx protects sealPending.
+	sealMtx sync.Mutex
+	// sealPending is the set of tables that have been rotated and have
+	// segments to compress and prune.
+	sealPending map[string]struct{}
+	// sealSignal notifies the seal loop that sealPending is not empty.
+	sealSignal chan struct{}
+	// pushMtx protects pushed.
+	pushMtx sync.Mutex
+	// pushed is the set of segments that have already been pushed to S3.
+	pushed map[string]struct{}
+	// quit stops the background goroutines.
+	quit chan struct{}
+
+	// fileMap maps tables to their open files. Files are threadsafe, and the
+	// map is copied when a table is opened so that it can be read without
+	// locking. Files are never closed before the tracer is stopped.
+	fileMap atomic.Pointer[map[string]*bufferedFile]
+	// filesMtx serializes opening new tables.
+	filesMtx sync.Mutex
+	// collecting is the set of tables that are being collected. It is a
+	// subset of the tables in fileMap.
+	collecting *tableSet
+	// hub fans out newly written rows to streaming clients.
+	hub *streamHub
 	// canal is a channel for all events that are being written. It acts as an
 	// extra buffer to avoid blocking the caller when writing to files.
 	canal chan Event[Entry]
@@ -72,29 +102,35 @@ type LocalTracer struct {
 func NewLocalTracer(cfg *config.Config, logger log.Logger, chainID, nodeID string) (*LocalTracer, error) {
 	fm := make(map[string]*bufferedFile)
 	p := path.Join(cfg.RootDir, "data", "traces")
-	for _, table := range splitAndTrimEmpty(cfg.Instrumentation.TracingTables, ",", " ") {
-		fileName := fmt.Sprintf("%s/%s.jsonl", p, table)
-		err := os.MkdirAll(p, 0700)
-		if err != nil {
-			return nil, fmt.Errorf("failed to create directory %s: %w", p, err)
-		}
-		file, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
+	tables := splitAndTrimEmpty(cfg.Instrumentation.TracingTables, ",", " ")
+	for _, table := range tables {
+		bf, err := openTable(p, table)
 		if err != nil {
-			return nil, fmt.Errorf("failed to open or create file %s: %w", fileName, err)
+			return nil, err
 		}
-		fm[table] = newbufferedFile(file)
+		fm[table] = bf
 	}
 
 	lt := &LocalTracer{
-		fileMap: fm,
-		cfg:     cfg,
-		canal:   make(chan Event[Entry], cfg.Instrumentation.TraceBufferSize),
-		chainID: chainID,
-		nodeID:  nodeID,
-		logger:  logger,
+		collecting:  newTableSet(tables),
+		hub:         newStreamHub(),
+		dir:         p,
+		sealPending: make(map[string]struct{}),
+		sealSignal:  make(chan struct{}, 1),
+		pushed:      make(map[string]struct{}),
+		quit:        make(chan struct{}),
+		cfg:         cfg,
+		canal:       make(chan Event[Entry], cfg.Instrumentation.TraceBufferSize),
+		chainID:     chainID,
+		nodeID:      nodeID,
+		logger:      logger,
 	}
+	lt.fileMap.Store(&fm)
 
 	go lt.drainCanal()
+	go lt.sealLoop()
+	// seal any segments left over from a previous run.
+	lt.queueSeal(tables...)
 	if cfg.Instrumentation.TracePullAddress != "" {
 		go lt.servePullData()
 	}
@@ -144,29 +180,156 @@ func (lt *LocalTracer) Write(e Entry) {
 	lt.canal <- NewEvent(lt.chainID, lt.nodeID, e.Table(), e)
 }
 
-// ReadTable returns a file for the given table. If the table is not being
-// collected, an error is returned. The caller should not close the file.
-func (lt *LocalTracer) readTable(table string) (*os.File, func() error, error) {
+// ReadTable returns a reader of every row of the given table, including all
+// of its retained segments. If the table is not being collected, an error is
+// returned. The caller must call the returned function when they are done
+// reading. Writes to the table are ignored and its segments are not rotated
+// or pruned until then.
+func (lt *LocalTracer) readTable(table string) (io.Reader, func() error, error) {
+	noop := func() error { return nil }
 	bf, has := lt.getFile(table)
 	if !has {
-		return nil, func() error { return nil }, fmt.Errorf("table %s not found", table)
+		return nil, noop, fmt.Errorf("table %s not found", table)
+	}
+
+	lt.segMtx.RLock()
+	segs, err := listSegments(lt.dir, table)
+	if err != nil {
+		lt.segMtx.RUnlock()
+		return nil, noop, err
 	}
 
-	return bf.File()
+	rcs := make([]io.ReadCloser, 0, len(segs))
+	for _, seg := range segs {
+		rc, err := seg.open()
+		if err != nil {
+			newMultiReadCloser(rcs).Close()
+			lt.segMtx.RUnlock()
+			return nil, noop, err
+		}
+		rcs = append(rcs, rc)
+	}
+	segReader := newMultiReadCloser(rcs)
+
+	f, stopReading, err := bf.File()
+	if err != nil {
+		segReader.Close()
+		lt.segMtx.RUnlock()
+		return nil, noop, err
+	}
+
+	done := func() error {
+		defer lt.segMtx.RUnlock()
+		err := stopReading()
+		if cerr := segReader.Close(); err == nil {
+			err = cerr
+		}
+		return err
+	}
+	return io.MultiReader(segReader, f), done, nil
 }
 
 func (lt *LocalTracer) IsCollecting(table string) bool {
-	_, has := lt.getFile(table)
-	return has
+	return lt.collecting.has(table)
+}
+
+// CollectingTables returns the sorted list of tables being collected.
+func (lt *LocalTracer) CollectingTables() []string {
+	return lt.collecting.list()
+}
+
+// EnableTables starts collecting the given tables, opening their files if
+// needed.
+func (lt *LocalTracer) EnableTables(tables ...string) error {
+	lt.filesMtx.Lock()
+	defer lt.filesMtx.Unlock()
+
+	old := lt.files()
+	fm := make(map[string]*bufferedFile, len(old)+len(tables))
+	for table, bf := range old {
+		fm[table] = bf
+	}
+	for _, table := range tables {
+		if _, has := fm[table]; has {
+			continue
+		}
+		if strings.ContainsAny(table, `/\.`) || table == "" {
+			return fmt.Errorf("invalid table name %q", table)
+		}
+		bf, err := openTable(lt.dir, table)
+		if err != nil {
+			return err
+		}
+		fm[table] = bf
+	}
+	lt.fileMap.Store(&fm)
+
+	lt.collecting.add(tables...)
+	lt.logger.Info("enabled trace tables", "tables", tables)
+	return nil
+}
+
+// DisableTables stops collecting the given tables. Their files are kept open
+// so that the rows already collected can still be read.
+func (lt *LocalTracer) DisableTables(tables ...string) error {
+	lt.collecting.remove(tables...)
+	lt.logger.Info("disabled trace tables", "tables", tables)
+	return nil
+}
+
+// Flush writes the rows written so far, including the ones still in the
+// canal, to the files of their tables.
+func (lt *LocalTracer) Flush() error {
+	lt.drainWritten()
+	for table, bf := range lt.files() {
+		if err := bf.flush(); err != nil {
+			return fmt.Errorf("failed to flush table %s: %w", table, err)
+		}
+	}
+	return nil
 }
 
-// getFile gets a file for the given type. This method is purposely
-// not thread-safe to avoid the overhead of locking with each event save.
+// files returns the open files of all tables. The returned map must not be
+// modified.
+func (lt *LocalTracer) files() map[string]*bufferedFile {
+	return *lt.fileMap.Load()
+}
+
+// getFile gets a file for the given table, whether or not it is still being
+// collected.
 func (lt *LocalTracer) getFile(table string) (*bufferedFile, bool) {
-	f, has := lt.fileMap[table]
+	f, has := lt.files()[table]
 	return f, has
 }
 
+// openTable opens, or creates, the file of the table stored in dir and counts
+// the rows already stored in the table and its segments.
+func openTable(dir, table string) (*bufferedFile, error) {
+	fileName := tablePath(dir, table)
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create directory %s: %w", dir, err)
+	}
+	file, err := os.OpenFile(fileName, os.O_APPEND|os.O_CREATE|os.O_RDWR, 0644)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open or create file %s: %w", fileName, err)
+	}
+	start, err := tableStart(dir, table)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to read segments of table %s: %w", table, err)
+	}
+	count, err := countRows(file)
+	if err != nil {
+		file.Close()
+		return nil, fmt.Errorf("failed to count rows in file %s: %w", fileName, err)
+	}
+	bf := newbufferedFile(file)
+	bf.start = start
+	bf.rows = start + count
+	return bf, nil
+}
+
 // saveEventToFile marshals an Event into JSON and appends it to a file named after the event's Type.
 func (lt *LocalTracer) saveEventToFile(event Event[Entry]) error {
 	file, has := lt.getFile(event.Table)
@@ -179,26 +342,61 @@ func (lt *LocalTracer) saveEventToFile(event Event[Entry]) error {
 		return fmt.Errorf("failed to marshal event: %v", err)
 	}
 
-	if _, err := file.Write(append(eventJSON, '\n')); err != nil {
+	n, err := file.Write(append(eventJSON, '\n'))
+	if err != nil {
 		return fmt.Errorf("failed to write event to file: %v", err)
 	}
 
+	// writes are ignored while the file is being read, only rows that made it
+	// to the file are given an offset and streamed.
+	if n == 0 {
+		return nil
+	}
+	offset := file.rows
+	file.rows++
+	lt.hub.publish(StreamEvent{Table: event.Table, Offset: offset, Event: eventJSON})
+	lt.maybeRotate(event.Table, file)
+
 	return nil
 }
 
+// canalMarker is sent through the canal after the events written so far, and
+// done is closed once they are saved.
+type canalMarker struct {
+	done chan struct{}
+}
+
+func (canalMarker) Table() string { return "" }
+
+// drainWritten waits until the events written before the call are saved.
+func (lt *LocalTracer) drainWritten() {
+	marker := canalMarker{done: make(chan struct{})}
+	lt.canal <- Event[Entry]{Msg: marker}
+	<-marker.done
+}
+
 // draincanal takes a variadic number of channels of Event pointers and drains them into files.
 func (lt *LocalTracer) drainCanal() {
 	// purposefully do not lock, and rely on the channel to provide sync
 	// actions, to avoid overhead of locking with each event save.
 	for ev := range lt.canal {
+		if marker, ok := ev.Msg.(canalMarker); ok {
+			close(marker.done)
+			continue
+		}
 		if err := lt.saveEventToFile(ev); err != nil {
 			lt.logger.Error("failed to save event to file", "error", err)
 		}
 	}
 }
 
-// Stop optionally uploads and closes all open files.
+// Stop saves the rows still in the canal, optionally uploads and closes all
+// open files.
 func (lt *LocalTracer) Stop() {
+	lt.drainWritten()
+	lt.hub.closeAll()
+	close(lt.quit)
+
 	if lt.s3Config.SecretKey != "" {
 		lt.logger.Info("pushing all tables before stopping")
 		err := lt.PushAll()
@@ -207,7 +405,7 @@ func (lt *LocalTracer) Stop() {
 		}
 	}
 
-	for _, file := range lt.fileMap {
+	for _, file := range lt.files() {
 		err := file.Close()
 		if err != nil {
 			lt.logger.Error("failed to close file", "error", err)
@@ -137,12 +137,29 @@ func TestLocalTracerServerPull(t *testing.T) {
 }
 
 // TestReadPushConfigFromConfigFile tests reading the push config from the environment variables.
+// TestLocalTracerStopSavesCanal tests that the rows still in the canal are
+// saved when the tracer is stopped.
+func TestLocalTracerStopSavesCanal(t *testing.T) {
+	port, err := getFreePort()
+	require.NoError(t, err)
+	client := setupLocalTracer(t, port)
+
+	for i := 0; i < 50; i++ {
+		client.Write(testEvent{"Annecy", i})
+	}
+	client.Stop()
+
+	events, err := DecodeTable[testEvent](client.dir, testEventTable)
+	require.NoError(t, err)
+	require.Len(t, events, 50)
+}
+
 func TestReadPushConfigFromEnvVars(t *testing.T) {
-	os.Setenv(PushBucketName, "bucket")
-	os.Setenv(PushRegion, "region")
-	os.Setenv(PushAccessKey, "access")
-	os.Setenv(PushKey, "secret")
-	os.Setenv(PushDelay, "10")
+	t.Setenv(PushBucketName, "bucket")
+	t.Setenv(PushRegion, "region")
+	t.Setenv(PushAccessKey, "access")
+	t.Setenv(PushKey, "secret")
+	t.Setenv(PushDelay, "10")
 
 	lt := setupLocalTracer(t, 0)
 	require.Equal(t, "bucket", lt.s3Config.BucketName)
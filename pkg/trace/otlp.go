@@ -0,0 +1,145 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+)
+
+// The types below mirror the subset of the OTLP logs data model that is used
+// to export traces, using the protobuf JSON encoding accepted by OTLP/HTTP
+// collectors on the /v1/logs endpoint. See
+// https://github.com/open-telemetry/opentelemetry-proto for the definitions.
+
+type otlpLogsRequest struct {
+	ResourceLogs []otlpResourceLogs `json:"resourceLogs"`
+}
+
+type otlpResourceLogs struct {
+	Resource  otlpResource    `json:"resource"`
+	ScopeLogs []otlpScopeLogs `json:"scopeLogs"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeLogs struct {
+	Scope      otlpScope       `json:"scope"`
+	LogRecords []otlpLogRecord `json:"logRecords"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpLogRecord struct {
+	// TimeUnixNano and ObservedTimeUnixNano are 64 bit integers, which are
+	// encoded as strings.
+	TimeUnixNano         string       `json:"timeUnixNano"`
+	ObservedTimeUnixNano string       `json:"observedTimeUnixNano"`
+	SeverityNumber       int          `json:"severityNumber"`
+	SeverityText         string       `json:"severityText"`
+	Body                 otlpAnyValue `json:"body"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+// otlpAnyValue holds exactly one of its fields.
+type otlpAnyValue struct {
+	StringValue *string          `json:"stringValue,omitempty"`
+	BoolValue   *bool            `json:"boolValue,omitempty"`
+	IntValue    *string          `json:"intValue,omitempty"`
+	DoubleValue *float64         `json:"doubleValue,omitempty"`
+	ArrayValue  *otlpArrayValue  `json:"arrayValue,omitempty"`
+	KvlistValue *otlpKvlistValue `json:"kvlistValue,omitempty"`
+}
+
+type otlpArrayValue struct {
+	Values []otlpAnyValue `json:"values"`
+}
+
+type otlpKvlistValue struct {
+	Values []otlpKeyValue `json:"values"`
+}
+
+// otlpSeverityInfo is the INFO severity number of the OTLP logs data model.
+const otlpSeverityInfo = 9
+
+func otlpString(s string) otlpAnyValue {
+	return otlpAnyValue{StringValue: &s}
+}
+
+// toOTLPValue converts an entry to an OTLP value by encoding it to json and
+// mapping each json value to its OTLP counterpart. Objects are converted to
+// key value lists, keeping the json field names.
+func toOTLPValue(e Entry) (otlpAnyValue, error) {
+	bz, err := json.Marshal(e)
+	if err != nil {
+		return otlpAnyValue{}, err
+	}
+	dec := json.NewDecoder(bytes.NewReader(bz))
+	dec.UseNumber()
+	var v any
+	if err := dec.Decode(&v); err != nil {
+		return otlpAnyValue{}, err
+	}
+	return jsonToOTLPValue(v)
+}
+
+func jsonToOTLPValue(v any) (otlpAnyValue, error) {
+	switch v := v.(type) {
+	case nil:
+		return otlpAnyValue{}, nil
+	case string:
+		return otlpString(v), nil
+	case bool:
+		return otlpAnyValue{BoolValue: &v}, nil
+	case json.Number:
+		if i, err := strconv.ParseInt(v.String(), 10, 64); err == nil {
+			s := strconv.FormatInt(i, 10)
+			return otlpAnyValue{IntValue: &s}, nil
+		}
+		f, err := v.Float64()
+		if err != nil {
+			return otlpAnyValue{}, err
+		}
+		return otlpAnyValue{DoubleValue: &f}, nil
+	case []any:
+		arr := &otlpArrayValue{Values: make([]otlpAnyValue, 0, len(v))}
+		for _, elem := range v {
+			av, err := jsonToOTLPValue(elem)
+			if err != nil {
+				return otlpAnyValue{}, err
+			}
+			arr.Values = append(arr.Values, av)
+		}
+		return otlpAnyValue{ArrayValue: arr}, nil
+	case map[string]any:
+		kvs := &otlpKvlistValue{Values: make([]otlpKeyValue, 0, len(v))}
+		for _, key := range sortedKeys(v) {
+			av, err := jsonToOTLPValue(v[key])
+			if err != nil {
+				return otlpAnyValue{}, err
+			}
+			kvs.Values = append(kvs.Values, otlpKeyValue{Key: key, Value: av})
+		}
+		return otlpAnyValue{KvlistValue: kvs}, nil
+	default:
+		return otlpAnyValue{}, fmt.Errorf("unsupported json value %T", v)
+	}
+}
+
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,272 @@
+package trace
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+const (
+	// otlpLogsPath is the path of the OTLP/HTTP logs endpoint of a collector.
+	otlpLogsPath = "/v1/logs"
+
+	// otlpServiceName is used as the service.name resource attribute.
+	otlpServiceName = "celestia"
+)
+
+// OTLPTracer exports traces to an OpenTelemetry collector using OTLP/HTTP.
+// Each entry is exported as a log record whose body holds the fields of the
+// entry. Records are grouped by table, which is used as the instrumentation
+// scope, and the chain ID and node ID are set as resource attributes. Entries
+// are exported in batches of TraceBufferSize, or after TraceOTLPFlushInterval,
+// whichever comes first.
+type OTLPTracer struct {
+	chainID, nodeID string
+	logger          log.Logger
+	endpoint        string
+	client          *http.Client
+
+	batchSize     int
+	flushInterval time.Duration
+
+	// collecting is the set of tables being collected.
+	collecting *tableSet
+
+	// canal is a channel for all entries that are being exported. It acts as
+	// an extra buffer to avoid blocking the caller while exporting. Entries
+	// written while it is full are dropped and counted in dropped.
+	canal   chan Event[Entry]
+	dropped atomic.Uint64
+	// flushes receives requests to export the current batch. The result of
+	// the export is sent on the provided channel.
+	flushes chan chan error
+	quit    chan struct{}
+	done    chan struct{}
+}
+
+// NewOTLPTracer creates a tracer that exports the tables configured in
+// TracingTables to the OTLP/HTTP collector at TraceOTLPEndpoint. Goroutine to
+// batch and export entries is started in this function.
+func NewOTLPTracer(cfg *config.Config, logger log.Logger, chainID, nodeID string) (*OTLPTracer, error) {
+	if cfg.Instrumentation.TraceOTLPEndpoint == "" {
+		return nil, fmt.Errorf("trace_otlp_endpoint must be set when using the otlp tracer")
+	}
+
+	batchSize := cfg.Instrumentation.TraceBufferSize
+	if batchSize < 1 {
+		batchSize = 1
+	}
+	flushInterval := cfg.Instrumentation.TraceOTLPFlushInterval
+	if flushInterval <= 0 {
+		flushInterval = config.DefaultInstrumentationConfig().TraceOTLPFlushInterval
+	}
+
+	ot := &OTLPTracer{
+		chainID:       chainID,
+		nodeID:        nodeID,
+		logger:        logger,
+		endpoint:      strings.TrimSuffix(cfg.Instrumentation.TraceOTLPEndpoint, "/") + otlpLogsPath,
+		client:        &http.Client{Timeout: 15 * time.Second},
+		batchSize:     batchSize,
+		flushInterval: flushInterval,
+		collecting:    newTableSet(splitAndTrimEmpty(cfg.Instrumentation.TracingTables, ",", " ")),
+		canal:         make(chan Event[Entry], batchSize),
+		flushes:       make(chan chan error),
+		quit:          make(chan struct{}),
+		done:          make(chan struct{}),
+	}
+
+	go ot.exportLoop()
+
+	return ot, nil
+}
+
+// Write queues the entry for export. It never blocks: if the collector is too
+// slow and the buffer is full, the entry is dropped.
+func (ot *OTLPTracer) Write(e Entry) {
+	if !ot.IsCollecting(e.Table()) {
+		return
+	}
+	select {
+	case ot.canal <- NewEvent(ot.chainID, ot.nodeID, e.Table(), e):
+	default:
+		ot.dropped.Add(1)
+	}
+}
+
+// Dropped returns the number of entries dropped because the buffer was full.
+func (ot *OTLPTracer) Dropped() uint64 {
+	return ot.dropped.Load()
+}
+
+func (ot *OTLPTracer) IsCollecting(table string) bool {
+	return ot.collecting.has(table)
+}
+
+// CollectingTables returns the sorted list of tables being collected.
+func (ot *OTLPTracer) CollectingTables() []string {
+	return ot.collecting.list()
+}
+
+// EnableTables starts collecting the given tables.
+func (ot *OTLPTracer) EnableTables(tables ...string) error {
+	ot.collecting.add(tables...)
+	ot.logger.Info("enabled trace tables", "tables", tables)
+	return nil
+}
+
+// DisableTables stops collecting the given tables.
+func (ot *OTLPTracer) DisableTables(tables ...string) error {
+	ot.collecting.remove(tables...)
+	ot.logger.Info("disabled trace tables", "tables", tables)
+	return nil
+}
+
+// Flush exports the entries that have been written so far without waiting
+// for the batch to be full.
+func (ot *OTLPTracer) Flush() error {
+	result := make(chan error, 1)
+	select {
+	case ot.flushes <- result:
+	case <-ot.done:
+		return fmt.Errorf("tracer is stopped")
+	}
+	return <-result
+}
+
+// Stop exports any batched entries and stops the tracer.
+func (ot *OTLPTracer) Stop() {
+	close(ot.quit)
+	<-ot.done
+}
+
+// exportLoop batches entries from the canal and exports them when the batch
+// is full or the flush interval has elapsed.
+func (ot *OTLPTracer) exportLoop() {
+	defer close(ot.done)
+
+	ticker := time.NewTicker(ot.flushInterval)
+	defer ticker.Stop()
+
+	batch := make([]Event[Entry], 0, ot.batchSize)
+	var reportedDropped uint64
+	flush := func() error {
+		if dropped := ot.dropped.Load(); dropped > reportedDropped {
+			ot.logger.Error("dropped trace entries, the collector is too slow",
+				"entries", dropped-reportedDropped, "total", dropped)
+			reportedDropped = dropped
+		}
+		if len(batch) == 0 {
+			return nil
+		}
+		err := ot.export(batch)
+		if err != nil {
+			ot.logger.Error("failed to export traces", "entries", len(batch), "err", err)
+		}
+		batch = batch[:0]
+		return err
+	}
+
+	for {
+		select {
+		case ev := <-ot.canal:
+			batch = append(batch, ev)
+			if len(batch) >= ot.batchSize {
+				flush()
+			}
+		case <-ticker.C:
+			flush()
+		case result := <-ot.flushes:
+			// include the entries that are already in the canal.
+			for len(ot.canal) > 0 {
+				batch = append(batch, <-ot.canal)
+			}
+			result <- flush()
+		case <-ot.quit:
+			// drain whatever is left in the canal before stopping.
+			for {
+				select {
+				case ev := <-ot.canal:
+					batch = append(batch, ev)
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// export sends the batch to the collector.
+func (ot *OTLPTracer) export(batch []Event[Entry]) error {
+	req, err := ot.buildRequest(batch)
+	if err != nil {
+		return err
+	}
+	bz, err := json.Marshal(req)
+	if err != nil {
+		return err
+	}
+
+	resp, err := ot.client.Post(ot.endpoint, "application/json", bytes.NewReader(bz))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("unexpected status code: %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+	return nil
+}
+
+// buildRequest maps the batch to an OTLP logs request, grouping log records by
+// table.
+func (ot *OTLPTracer) buildRequest(batch []Event[Entry]) (*otlpLogsRequest, error) {
+	var (
+		scopes   []otlpScopeLogs
+		byTable  = make(map[string]int)
+		observed = strconv.FormatInt(time.Now().UnixNano(), 10)
+	)
+	for _, ev := range batch {
+		body, err := toOTLPValue(ev.Msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to convert %s entry: %w", ev.Table, err)
+		}
+
+		i, has := byTable[ev.Table]
+		if !has {
+			i = len(scopes)
+			byTable[ev.Table] = i
+			scopes = append(scopes, otlpScopeLogs{Scope: otlpScope{Name: ev.Table}})
+		}
+		scopes[i].LogRecords = append(scopes[i].LogRecords, otlpLogRecord{
+			TimeUnixNano:         strconv.FormatInt(ev.Timestamp.UnixNano(), 10),
+			ObservedTimeUnixNano: observed,
+			SeverityNumber:       otlpSeverityInfo,
+			SeverityText:         "INFO",
+			Body:                 body,
+		})
+	}
+
+	return &otlpLogsRequest{
+		ResourceLogs: []otlpResourceLogs{{
+			Resource: otlpResource{Attributes: []otlpKeyValue{
+				{Key: "service.name", Value: otlpString(otlpServiceName)},
+				{Key: "chain_id", Value: otlpString(ot.chainID)},
+				{Key: "node_id", Value: otlpString(ot.nodeID)},
+			}},
+			ScopeLogs: scopes,
+		}},
+	}, nil
+}
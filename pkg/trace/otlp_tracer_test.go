@@ -0,0 +1,178 @@
+package trace
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+)
+
+// testCollector is a stand-in for an OTLP/HTTP collector that records every
+// logs request it receives as generic json, so that the wire encoding is
+// validated independently of the types used to encode it.
+type testCollector struct {
+	mtx      sync.Mutex
+	requests []map[string]any
+}
+
+func (c *testCollector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != otlpLogsPath || r.Header.Get("Content-Type") != "application/json" {
+		http.Error(w, "unexpected request", http.StatusBadRequest)
+		return
+	}
+	var req map[string]any
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	c.mtx.Lock()
+	c.requests = append(c.requests, req)
+	c.mtx.Unlock()
+	w.WriteHeader(http.StatusOK)
+}
+
+func (c *testCollector) received() []map[string]any {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return append([]map[string]any(nil), c.requests...)
+}
+
+type otherEvent struct {
+	Peers []string `json:"peers"`
+	Ratio float64  `json:"ratio"`
+	Ok    bool     `json:"ok"`
+}
+
+func (otherEvent) Table() string { return "otherEvent" }
+
+type canalEvent struct{}
+
+func (canalEvent) Table() string { return "canal" }
+
+func setupOTLPTracer(t *testing.T, url string, batchSize int, tables string) *OTLPTracer {
+	cfg := config.DefaultConfig()
+	cfg.Instrumentation.TraceType = "otlp"
+	cfg.Instrumentation.TraceOTLPEndpoint = url
+	cfg.Instrumentation.TraceBufferSize = batchSize
+	cfg.Instrumentation.TracingTables = tables
+	cfg.Instrumentation.TraceOTLPFlushInterval = time.Hour
+
+	tracer, err := NewTracer(cfg, log.NewNopLogger(), "test_chain", "test_node")
+	require.NoError(t, err)
+	ot, ok := tracer.(*OTLPTracer)
+	require.True(t, ok)
+	return ot
+}
+
+func TestOTLPTracerExport(t *testing.T) {
+	collector := &testCollector{}
+	srv := httptest.NewServer(collector)
+	defer srv.Close()
+
+	ot := setupOTLPTracer(t, srv.URL+"/", 3, testEventTable+",otherEvent")
+	require.True(t, ot.IsCollecting(testEventTable))
+	require.False(t, ot.IsCollecting("canal"))
+
+	ot.Write(testEvent{"Annecy", 420})
+	ot.Write(otherEvent{Peers: []string{"a", "b"}, Ratio: 0.5, Ok: true})
+	ot.Write(testEvent{"Paris", 7})
+
+	// the batch is full, so it is exported without waiting for the interval.
+	require.Eventually(t, func() bool { return len(collector.received()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	req := collector.received()[0]
+	resourceLogs := req["resourceLogs"].([]any)
+	require.Len(t, resourceLogs, 1)
+	rl := resourceLogs[0].(map[string]any)
+
+	attrs := map[string]string{}
+	for _, a := range rl["resource"].(map[string]any)["attributes"].([]any) {
+		kv := a.(map[string]any)
+		attrs[kv["key"].(string)] = kv["value"].(map[string]any)["stringValue"].(string)
+	}
+	require.Equal(t, "test_chain", attrs["chain_id"])
+	require.Equal(t, "test_node", attrs["node_id"])
+
+	scopeLogs := rl["scopeLogs"].([]any)
+	require.Len(t, scopeLogs, 2)
+
+	testScope := scopeLogs[0].(map[string]any)
+	require.Equal(t, testEventTable, testScope["scope"].(map[string]any)["name"])
+	records := testScope["logRecords"].([]any)
+	require.Len(t, records, 2)
+	first := records[0].(map[string]any)
+	ts, ok := first["timeUnixNano"].(string)
+	require.True(t, ok, "timestamps are encoded as strings")
+	require.NotEmpty(t, ts)
+	require.Equal(t, map[string]any{"kvlistValue": map[string]any{"values": []any{
+		map[string]any{"key": "city", "value": map[string]any{"stringValue": "Annecy"}},
+		map[string]any{"key": "length", "value": map[string]any{"intValue": "420"}},
+	}}}, first["body"])
+
+	otherScope := scopeLogs[1].(map[string]any)
+	require.Equal(t, "otherEvent", otherScope["scope"].(map[string]any)["name"])
+	other := otherScope["logRecords"].([]any)[0].(map[string]any)
+	require.Equal(t, map[string]any{"kvlistValue": map[string]any{"values": []any{
+		map[string]any{"key": "ok", "value": map[string]any{"boolValue": true}},
+		map[string]any{"key": "peers", "value": map[string]any{"arrayValue": map[string]any{"values": []any{
+			map[string]any{"stringValue": "a"},
+			map[string]any{"stringValue": "b"},
+		}}}},
+		map[string]any{"key": "ratio", "value": map[string]any{"doubleValue": 0.5}},
+	}}}, other["body"])
+
+	// entries of tables that are not collected are dropped, and the remaining
+	// entries are exported when stopping.
+	ot.Write(testEvent{"Lyon", 1})
+	ot.Write(canalEvent{})
+	ot.Write(testEvent{"Pontivy", 1})
+	ot.Stop()
+	received := collector.received()
+	require.Len(t, received, 2)
+	scope := received[1]["resourceLogs"].([]any)[0].(map[string]any)["scopeLogs"].([]any)
+	require.Len(t, scope, 1)
+	require.Len(t, scope[0].(map[string]any)["logRecords"].([]any), 2)
+}
+
+func TestOTLPTracerDropsWhenFull(t *testing.T) {
+	// the collector doesn't answer until released, so that the export loop
+	// is stuck exporting the first batch.
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	ot := setupOTLPTracer(t, srv.URL, 1, testEventTable)
+	written := make(chan struct{})
+	go func() {
+		for i := 0; i < 10; i++ {
+			ot.Write(testEvent{"Annecy", i})
+		}
+		close(written)
+	}()
+	select {
+	case <-written:
+	case <-time.After(5 * time.Second):
+		t.Fatal("Write blocked on a slow collector")
+	}
+	require.NotZero(t, ot.Dropped())
+
+	close(release)
+	ot.Stop()
+}
+
+func TestOTLPTracerRequiresEndpoint(t *testing.T) {
+	cfg := config.DefaultConfig()
+	cfg.Instrumentation.TraceType = "otlp"
+	_, err := NewTracer(cfg, log.NewNopLogger(), "test_chain", "test_node")
+	require.Error(t, err)
+}
@@ -1,6 +1,7 @@
 package schema
 
 import (
+	"github.com/cometbft/cometbft/libs/bytes"
 	"github.com/cometbft/cometbft/pkg/trace"
 	"github.com/cometbft/cometbft/types"
 )
@@ -15,6 +16,7 @@ func ConsensusTables() []string {
 		VoteTable,
 		ConsensusStateTable,
 		ProposalTable,
+		CommittedTxsTable,
 	}
 }
 
@@ -169,6 +171,38 @@ func WriteBlockSummary(client trace.Tracer, block *types.Block, size int) {
 	})
 }
 
+const (
+	// CommittedTxsTable is the name of the table that stores the hashes of
+	// the transactions included in each committed block.
+	CommittedTxsTable = "consensus_committed_txs"
+)
+
+// CommittedTxs describes schema for the "consensus_committed_txs" table.
+type CommittedTxs struct {
+	Height   int64    `json:"height"`
+	TxHashes []string `json:"tx_hashes"`
+}
+
+// Table returns the table name for the CommittedTxs struct.
+func (c CommittedTxs) Table() string {
+	return CommittedTxsTable
+}
+
+// WriteCommittedTxs writes a tracing point for the transactions of a committed
+// block using the predetermined schema for consensus tracing.
+func WriteCommittedTxs(client trace.Tracer, height int64, txs types.Txs) {
+	// this check is redundant to what is checked during client.Write, although it
+	// is an optimization to avoid hashing every transaction.
+	if !client.IsCollecting(CommittedTxsTable) {
+		return
+	}
+	hashes := make([]string, len(txs))
+	for i, tx := range txs {
+		hashes[i] = bytes.HexBytes(tx.Hash()).String()
+	}
+	client.Write(CommittedTxs{Height: height, TxHashes: hashes})
+}
+
 const (
 	ConsensusStateTable = "consensus_state"
 )
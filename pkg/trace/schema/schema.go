@@ -4,10 +4,12 @@ import (
 	"strings"
 
 	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/pkg/trace"
 )
 
 func init() {
 	config.DefaultTracingTables = strings.Join(AllTables(), ",")
+	trace.RegisterTables(AllTables()...)
 }
 
 func AllTables() []string {
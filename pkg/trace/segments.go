@@ -0,0 +1,390 @@
+package trace
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	// tableExt is the extension of the files that store tables.
+	tableExt = ".jsonl"
+	// compressedExt is appended to the name of compressed segments.
+	compressedExt = ".gz"
+	// tmpExt is appended to the name of segments that are being compressed.
+	tmpExt = ".tmp"
+)
+
+// segment is a sealed part of a table. Tables are stored as zero or more
+// sealed segments followed by the file that is currently being written to.
+// Segments are named "table.offset.jsonl", where offset is the offset of the
+// first row stored in the segment, and have a ".gz" suffix when compressed.
+type segment struct {
+	path       string
+	offset     uint64
+	compressed bool
+}
+
+// segmentPath returns the path of the uncompressed segment of the table
+// starting at offset.
+func segmentPath(dir, table string, offset uint64) string {
+	return filepath.Join(dir, fmt.Sprintf("%s.%020d%s", table, offset, tableExt))
+}
+
+// tablePath returns the path of the file of the table that is being written
+// to.
+func tablePath(dir, table string) string {
+	return filepath.Join(dir, table+tableExt)
+}
+
+// parseSegmentName returns the offset of the segment of the table with the
+// given file name, and whether it is compressed. ok is false if the name is
+// not a segment of the table.
+func parseSegmentName(name, table string) (offset uint64, compressed bool, ok bool) {
+	rest, found := strings.CutPrefix(name, table+".")
+	if !found {
+		return 0, false, false
+	}
+	rest, compressed = strings.CutSuffix(rest, compressedExt)
+	rest, found = strings.CutSuffix(rest, tableExt)
+	if !found {
+		return 0, false, false
+	}
+	offset, err := strconv.ParseUint(rest, 10, 64)
+	if err != nil {
+		return 0, false, false
+	}
+	return offset, compressed, true
+}
+
+// isTableFile returns true if the file name stores rows of the table, either
+// as a segment or as the file that is being written to.
+func isTableFile(name, table string) bool {
+	if name == table+tableExt {
+		return true
+	}
+	_, _, ok := parseSegmentName(name, table)
+	return ok
+}
+
+// listSegments returns the sealed segments of the table stored in dir ordered
+// by offset. If a segment is present both compressed and uncompressed, which
+// happens while it is being compressed, the uncompressed one is returned.
+func listSegments(dir, table string) ([]segment, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	byOffset := make(map[uint64]segment)
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+		offset, compressed, ok := parseSegmentName(entry.Name(), table)
+		if !ok {
+			continue
+		}
+		if existing, has := byOffset[offset]; has && !existing.compressed {
+			continue
+		}
+		byOffset[offset] = segment{
+			path:       filepath.Join(dir, entry.Name()),
+			offset:     offset,
+			compressed: compressed,
+		}
+	}
+
+	segs := make([]segment, 0, len(byOffset))
+	for _, seg := range byOffset {
+		segs = append(segs, seg)
+	}
+	sort.Slice(segs, func(i, j int) bool { return segs[i].offset < segs[j].offset })
+	return segs, nil
+}
+
+// open returns a reader of the rows stored in the segment, decompressing them
+// if needed.
+func (s segment) open() (io.ReadCloser, error) {
+	f, err := os.Open(s.path)
+	if err != nil {
+		return nil, err
+	}
+	if !s.compressed {
+		return f, nil
+	}
+	gr, err := gzip.NewReader(f)
+	if err != nil {
+		f.Close()
+		return nil, err
+	}
+	return &gzipFile{Reader: gr, file: f}, nil
+}
+
+// gzipFile closes both the gzip reader and the underlying file.
+type gzipFile struct {
+	*gzip.Reader
+	file *os.File
+}
+
+func (g *gzipFile) Close() error {
+	err := g.Reader.Close()
+	if ferr := g.file.Close(); err == nil {
+		err = ferr
+	}
+	return err
+}
+
+// compressSegment writes a compressed copy of the segment next to it and
+// returns its path. The copy is written to a temporary file first, the caller
+// is responsible for renaming it to the returned path.
+func compressSegment(seg segment) (tmpPath, path string, err error) {
+	path = seg.path + compressedExt
+	tmpPath = path + tmpExt
+
+	in, err := os.Open(seg.path)
+	if err != nil {
+		return "", "", err
+	}
+	defer in.Close()
+
+	out, err := os.Create(tmpPath)
+	if err != nil {
+		return "", "", err
+	}
+
+	gw := gzip.NewWriter(out)
+	if _, err = io.Copy(gw, in); err == nil {
+		err = gw.Close()
+	}
+	if err == nil {
+		err = out.Sync()
+	}
+	if cerr := out.Close(); err == nil {
+		err = cerr
+	}
+	if err != nil {
+		os.Remove(tmpPath)
+		return "", "", err
+	}
+	return tmpPath, path, nil
+}
+
+// multiReadCloser reads from multiple readers sequentially and closes all of
+// them.
+type multiReadCloser struct {
+	io.Reader
+	closers []io.Closer
+}
+
+func newMultiReadCloser(rcs []io.ReadCloser) *multiReadCloser {
+	readers := make([]io.Reader, len(rcs))
+	closers := make([]io.Closer, len(rcs))
+	for i, rc := range rcs {
+		readers[i] = rc
+		closers[i] = rc
+	}
+	return &multiReadCloser{Reader: io.MultiReader(readers...), closers: closers}
+}
+
+func (m *multiReadCloser) Close() error {
+	var err error
+	for _, c := range m.closers {
+		if cerr := c.Close(); err == nil {
+			err = cerr
+		}
+	}
+	return err
+}
+
+// OpenTable returns a reader of every row of the table stored in dir, reading
+// all of its segments in order followed by the file that was being written
+// to. This is useful to read tables downloaded using GetTable or S3Download.
+func OpenTable(dir, table string) (io.ReadCloser, error) {
+	segs, err := listSegments(dir, table)
+	if err != nil {
+		return nil, err
+	}
+
+	rcs := make([]io.ReadCloser, 0, len(segs)+1)
+	closeAll := func() {
+		for _, rc := range rcs {
+			rc.Close()
+		}
+	}
+	for _, seg := range segs {
+		rc, err := seg.open()
+		if err != nil {
+			closeAll()
+			return nil, err
+		}
+		rcs = append(rcs, rc)
+	}
+
+	f, err := os.Open(tablePath(dir, table))
+	switch {
+	case err == nil:
+		rcs = append(rcs, f)
+	case !os.IsNotExist(err) || len(segs) == 0:
+		closeAll()
+		return nil, err
+	}
+
+	return newMultiReadCloser(rcs), nil
+}
+
+// DecodeTable reads every row of the table stored in dir, including all of its
+// segments, and decodes them into a slice of events.
+func DecodeTable[T any](dir, table string) ([]Event[T], error) {
+	rc, err := OpenTable(dir, table)
+	if err != nil {
+		return nil, err
+	}
+	defer rc.Close()
+	return DecodeFile[T](rc)
+}
+
+// maybeRotate seals the file of the table into a segment if it is due for
+// rotation. Rotation is skipped, and retried on the next write, while the
+// table is being read from.
+func (lt *LocalTracer) maybeRotate(table string, bf *bufferedFile) {
+	if !bf.shouldRotate(lt.cfg.Instrumentation.TraceRotateSize, lt.cfg.Instrumentation.TraceRotateInterval) {
+		return
+	}
+	if !lt.segMtx.TryLock() {
+		return
+	}
+	defer lt.segMtx.Unlock()
+
+	err := bf.rotate(segmentPath(lt.dir, table, bf.start), bf.rows)
+	switch {
+	case err == errFileBusy:
+		return
+	case err != nil:
+		lt.logger.Error("failed to rotate table", "table", table, "err", err)
+		return
+	}
+
+	lt.queueSeal(table)
+}
+
+// queueSeal queues the tables to have their segments compressed and pruned.
+func (lt *LocalTracer) queueSeal(tables ...string) {
+	lt.sealMtx.Lock()
+	for _, table := range tables {
+		lt.sealPending[table] = struct{}{}
+	}
+	lt.sealMtx.Unlock()
+
+	select {
+	case lt.sealSignal <- struct{}{}:
+	default:
+		// the seal loop has already been notified.
+	}
+}
+
+// sealLoop compresses and prunes the segments of tables after they are
+// rotated.
+func (lt *LocalTracer) sealLoop() {
+	for {
+		select {
+		case <-lt.quit:
+			return
+		case <-lt.sealSignal:
+			lt.sealMtx.Lock()
+			pending := lt.sealPending
+			lt.sealPending = make(map[string]struct{})
+			lt.sealMtx.Unlock()
+
+			for table := range pending {
+				if err := lt.sealSegments(table); err != nil {
+					lt.logger.Error("failed to seal segments", "table", table, "err", err)
+				}
+			}
+		}
+	}
+}
+
+// sealSegments compresses the uncompressed segments of the table if
+// compression is enabled, and deletes the oldest segments beyond the number
+// that are retained.
+func (lt *LocalTracer) sealSegments(table string) error {
+	if lt.cfg.Instrumentation.TraceCompressSegments {
+		lt.segMtx.RLock()
+		segs, err := listSegments(lt.dir, table)
+		lt.segMtx.RUnlock()
+		if err != nil {
+			return err
+		}
+
+		for _, seg := range segs {
+			if seg.compressed {
+				continue
+			}
+			tmpPath, path, err := compressSegment(seg)
+			if err != nil {
+				return err
+			}
+			lt.segMtx.Lock()
+			err = os.Rename(tmpPath, path)
+			if err == nil {
+				err = os.Remove(seg.path)
+			}
+			lt.segMtx.Unlock()
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	retain := lt.cfg.Instrumentation.TraceRetainSegments
+	if retain == 0 {
+		return nil
+	}
+
+	lt.segMtx.Lock()
+	defer lt.segMtx.Unlock()
+	segs, err := listSegments(lt.dir, table)
+	if err != nil {
+		return err
+	}
+	for i := 0; i < len(segs)-retain; i++ {
+		// remove both versions in case the segment is being compressed.
+		for _, p := range []string{
+			strings.TrimSuffix(segs[i].path, compressedExt),
+			strings.TrimSuffix(segs[i].path, compressedExt) + compressedExt,
+		} {
+			if err := os.Remove(p); err != nil && !os.IsNotExist(err) {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// tableStart returns the offset of the first row that will be stored in the
+// file of the table that is being written to, based on its sealed segments.
+func tableStart(dir, table string) (uint64, error) {
+	segs, err := listSegments(dir, table)
+	if err != nil || len(segs) == 0 {
+		return 0, err
+	}
+
+	last := segs[len(segs)-1]
+	rc, err := last.open()
+	if err != nil {
+		return 0, err
+	}
+	defer rc.Close()
+
+	count, err := countRows(rc)
+	if err != nil {
+		return 0, err
+	}
+	return last.offset + count, nil
+}
@@ -0,0 +1,151 @@
+package trace
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"testing"
+	"time"
+
+	"github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/stretchr/testify/require"
+)
+
+// TestLocalTracerRotation tests that tables are rotated into compressed
+// segments, that old segments are pruned and that reading a table includes
+// every retained segment.
+func TestLocalTracerRotation(t *testing.T) {
+	port, err := getFreePort()
+	require.NoError(t, err)
+	client := setupRotatingTracer(t, port, 3)
+
+	// each row is a little over 100 bytes, so each segment holds two rows.
+	for i := 0; i < 10; i++ {
+		client.Write(testEvent{"Annecy", i})
+	}
+
+	require.Eventually(t, func() bool {
+		segs, err := listSegments(client.dir, testEventTable)
+		require.NoError(t, err)
+		if len(segs) != 3 {
+			return false
+		}
+		for _, seg := range segs {
+			if !seg.compressed {
+				return false
+			}
+		}
+		return true
+	}, 5*time.Second, 50*time.Millisecond)
+
+	// the oldest segments have been pruned, the retained segments hold rows 4
+	// to 9 and the file being written to is empty.
+	segs, err := listSegments(client.dir, testEventTable)
+	require.NoError(t, err)
+	require.EqualValues(t, 4, segs[0].offset)
+	require.EqualValues(t, 6, segs[1].offset)
+	require.EqualValues(t, 8, segs[2].offset)
+
+	r, done, err := client.readTable(testEventTable)
+	require.NoError(t, err)
+	events, err := DecodeFile[testEvent](r)
+	require.NoError(t, err)
+	require.NoError(t, done())
+	require.Len(t, events, 6)
+	for i, e := range events {
+		require.Equal(t, i+4, e.Msg.Length)
+	}
+
+	// downloading the table includes every retained segment.
+	newDir := t.TempDir()
+	url := fmt.Sprintf("http://localhost:%d", port)
+	err = GetTable(url, testEventTable, newDir)
+	require.NoError(t, err)
+	downloaded, err := DecodeTable[testEvent](newDir, testEventTable)
+	require.NoError(t, err)
+	require.Equal(t, events, downloaded)
+
+	// streaming from an offset in a pruned segment starts at the oldest
+	// retained row, and streaming from the middle of a segment skips the rows
+	// before the offset.
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	for start, expected := range map[uint64]uint64{0: 4, 7: 7} {
+		err = StreamTables(ctx, url, []string{testEventTable}, Cursor{testEventTable: start}, time.Time{},
+			func(se StreamEvent) error {
+				require.Equal(t, expected, se.Offset)
+				return errStopStream
+			})
+		require.ErrorIs(t, err, errStopStream)
+	}
+}
+
+// TestLocalTracerRotationRestart tests that the offsets of a rotated table
+// continue from its segments after a restart.
+func TestLocalTracerRotationRestart(t *testing.T) {
+	client := setupRotatingTracer(t, 0, 0)
+	for i := 0; i < 5; i++ {
+		client.Write(testEvent{"Annecy", i})
+	}
+	require.Eventually(t, func() bool {
+		segs, err := listSegments(client.dir, testEventTable)
+		require.NoError(t, err)
+		return len(segs) == 2 && segs[1].compressed
+	}, 5*time.Second, 50*time.Millisecond)
+	client.Stop()
+
+	restarted, err := NewLocalTracer(client.cfg, client.logger, "test_chain", "test_node")
+	require.NoError(t, err)
+	defer restarted.Stop()
+	require.EqualValues(t, 5, restarted.files()[testEventTable].rows)
+	require.EqualValues(t, 4, restarted.files()[testEventTable].start)
+
+	events, err := DecodeTable[testEvent](restarted.dir, testEventTable)
+	require.NoError(t, err)
+	require.Len(t, events, 5)
+}
+
+func TestParseSegmentName(t *testing.T) {
+	dir := t.TempDir()
+	name := path.Base(segmentPath(dir, "mempool_tx", 42))
+
+	offset, compressed, ok := parseSegmentName(name, "mempool_tx")
+	require.True(t, ok)
+	require.False(t, compressed)
+	require.EqualValues(t, 42, offset)
+
+	offset, compressed, ok = parseSegmentName(name+compressedExt, "mempool_tx")
+	require.True(t, ok)
+	require.True(t, compressed)
+	require.EqualValues(t, 42, offset)
+
+	_, _, ok = parseSegmentName(name, "mempool")
+	require.False(t, ok)
+	_, _, ok = parseSegmentName(name+compressedExt+tmpExt, "mempool_tx")
+	require.False(t, ok)
+	require.True(t, isTableFile("mempool_tx.jsonl", "mempool_tx"))
+	require.False(t, isTableFile("mempool_tx.jsonl", "mempool"))
+}
+
+func TestOpenTableMissing(t *testing.T) {
+	_, err := OpenTable(t.TempDir(), testEventTable)
+	require.True(t, os.IsNotExist(err))
+}
+
+func setupRotatingTracer(t *testing.T, port int, retain int) *LocalTracer {
+	logger := log.NewNopLogger()
+	cfg := config.DefaultConfig()
+	cfg.SetRoot(t.TempDir())
+	cfg.Instrumentation.TraceBufferSize = 100
+	cfg.Instrumentation.TracingTables = testEventTable
+	cfg.Instrumentation.TracePullAddress = fmt.Sprintf(":%d", port)
+	cfg.Instrumentation.TraceRotateSize = 200
+	cfg.Instrumentation.TraceRetainSegments = retain
+	cfg.Instrumentation.TraceCompressSegments = true
+
+	client, err := NewLocalTracer(cfg, logger, "test_chain", "test_node")
+	require.NoError(t, err)
+	return client
+}
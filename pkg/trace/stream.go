@@ -0,0 +1,429 @@
+package trace
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// streamPath is the path of the pull server endpoint used to tail tables.
+	streamPath = "/stream"
+
+	// streamSubscriberBuffer is the number of rows that can be queued for a
+	// streaming client before it is considered too slow and is disconnected.
+	streamSubscriberBuffer = 1000
+)
+
+// StreamEvent is a single row sent to a streaming client. Offset is the
+// position of the row in its table, starting at zero, and can be used to
+// resume the stream after a disconnect. Event is the raw json encoded
+// Event[T] as it is stored in the table.
+type StreamEvent struct {
+	Table  string          `json:"table"`
+	Offset uint64          `json:"offset"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// DecodeStreamEvent decodes the raw event carried by a StreamEvent. The
+// generic is passed to the event type.
+func DecodeStreamEvent[T any](se StreamEvent) (Event[T], error) {
+	var e Event[T]
+	err := json.Unmarshal(se.Event, &e)
+	return e, err
+}
+
+// Cursor maps each table to the offset of the next row that should be
+// received. It is updated by StreamTables as rows are handled, so passing the
+// same cursor to a new call resumes the stream where it was left off.
+type Cursor map[string]uint64
+
+// streamSubscriber is a single streaming client.
+type streamSubscriber struct {
+	tables map[string]struct{}
+	out    chan StreamEvent
+}
+
+// streamHub fans out rows written by the LocalTracer to all of the streaming
+// clients interested in their table. Subscribers that do not keep up are
+// dropped instead of blocking the tracer.
+type streamHub struct {
+	mtx  sync.Mutex
+	subs map[*streamSubscriber]struct{}
+}
+
+func newStreamHub() *streamHub {
+	return &streamHub{subs: make(map[*streamSubscriber]struct{})}
+}
+
+// subscribe registers a new subscriber for the given tables.
+func (h *streamHub) subscribe(tables []string) *streamSubscriber {
+	sub := &streamSubscriber{
+		tables: make(map[string]struct{}, len(tables)),
+		out:    make(chan StreamEvent, streamSubscriberBuffer),
+	}
+	for _, table := range tables {
+		sub.tables[table] = struct{}{}
+	}
+
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	h.subs[sub] = struct{}{}
+	return sub
+}
+
+// unsubscribe removes the subscriber and closes its channel if it has not
+// already been dropped.
+func (h *streamHub) unsubscribe(sub *streamSubscriber) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if _, has := h.subs[sub]; has {
+		delete(h.subs, sub)
+		close(sub.out)
+	}
+}
+
+// publish sends the row to every subscriber of its table. Subscribers with a
+// full buffer are dropped and their channel closed.
+func (h *streamHub) publish(se StreamEvent) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	for sub := range h.subs {
+		if _, has := sub.tables[se.Table]; !has {
+			continue
+		}
+		select {
+		case sub.out <- se:
+		default:
+			delete(h.subs, sub)
+			close(sub.out)
+		}
+	}
+}
+
+// closeAll drops every subscriber.
+func (h *streamHub) closeAll() {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	for sub := range h.subs {
+		delete(h.subs, sub)
+		close(sub.out)
+	}
+}
+
+// streamHandler tails one or more tables. The request specifies the tables
+// using one or more "table" values, and optionally where to start using
+// "offset" values in the form of "table:offset" and a "since" RFC3339
+// timestamp. Rows that are already stored are sent first, table by table,
+// followed by new rows as they are written. The response is newline
+// delimited json, one StreamEvent per line.
+func (lt *LocalTracer) streamHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "Failed to parse form", http.StatusBadRequest)
+			return
+		}
+
+		tables := r.Form["table"]
+		if len(tables) == 0 {
+			http.Error(w, "No tables provided", http.StatusBadRequest)
+			return
+		}
+		for _, table := range tables {
+			// tables that are no longer collected can still be streamed to
+			// read the rows that were stored.
+			if _, has := lt.getFile(table); !has {
+				http.Error(w, fmt.Sprintf("table %s not found", table), http.StatusBadRequest)
+				return
+			}
+		}
+
+		cursor, err := parseOffsets(r.Form["offset"])
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		var since time.Time
+		if s := r.FormValue("since"); s != "" {
+			since, err = time.Parse(time.RFC3339Nano, s)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid since: %v", err), http.StatusBadRequest)
+				return
+			}
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "Streaming not supported", http.StatusInternalServerError)
+			return
+		}
+
+		// subscribe before reading the stored rows so that no row is missed
+		// between the two. Rows that are both stored and received live are
+		// deduplicated using their offset.
+		sub := lt.hub.subscribe(tables)
+		defer lt.hub.unsubscribe(sub)
+
+		w.Header().Set("Content-Type", "application/x-ndjson")
+		w.WriteHeader(http.StatusOK)
+
+		enc := json.NewEncoder(w)
+		for _, table := range tables {
+			next, err := lt.replayTable(table, cursor[table], since, enc.Encode)
+			if err != nil {
+				lt.logger.Error("failed to replay table", "table", table, "err", err)
+				return
+			}
+			cursor[table] = next
+		}
+		flusher.Flush()
+
+		for {
+			select {
+			case <-r.Context().Done():
+				return
+			case se, ok := <-sub.out:
+				if !ok {
+					// the client is too slow or the tracer is stopping, the
+					// client is expected to reconnect using its cursor.
+					return
+				}
+				if se.Offset < cursor[se.Table] {
+					continue
+				}
+				if err := enc.Encode(se); err != nil {
+					return
+				}
+				cursor[se.Table] = se.Offset + 1
+				flusher.Flush()
+			}
+		}
+	}
+}
+
+// replayTable passes each stored row of the table, including its retained
+// segments, starting at offset and written at or after since to send. It
+// returns the offset after the last row read.
+func (lt *LocalTracer) replayTable(
+	table string,
+	offset uint64,
+	since time.Time,
+	send func(any) error,
+) (uint64, error) {
+	bf, has := lt.getFile(table)
+	if !has {
+		return offset, fmt.Errorf("table %s not found", table)
+	}
+
+	// prevent segments from being rotated or pruned while replaying.
+	lt.segMtx.RLock()
+	defer lt.segMtx.RUnlock()
+
+	segs, err := listSegments(lt.dir, table)
+	if err != nil {
+		return offset, err
+	}
+	for i, seg := range segs {
+		// skip segments that only contain rows before offset.
+		if i+1 < len(segs) && segs[i+1].offset <= offset {
+			continue
+		}
+		if i+1 == len(segs) && bf.start <= offset {
+			continue
+		}
+		rc, err := seg.open()
+		if err != nil {
+			return offset, err
+		}
+		_, err = replayRows(table, rc, seg.offset, offset, since, send)
+		rc.Close()
+		if err != nil {
+			return offset, err
+		}
+	}
+
+	if err := bf.flush(); err != nil {
+		return offset, err
+	}
+
+	// open a separate file descriptor so that the tracer can keep writing.
+	f, err := os.Open(bf.file.Name())
+	if err != nil {
+		return offset, err
+	}
+	defer f.Close()
+
+	next, err := replayRows(table, f, bf.start, offset, since, send)
+	if err != nil {
+		return offset, err
+	}
+	if next < offset {
+		return offset, nil
+	}
+	return next, nil
+}
+
+// replayRows passes each row read from r, the first of which has the offset
+// first, to send if it is at or after offset and was written at or after
+// since. It returns the offset after the last row read.
+func replayRows(
+	table string,
+	r io.Reader,
+	first, offset uint64,
+	since time.Time,
+	send func(any) error,
+) (uint64, error) {
+	var (
+		br   = bufio.NewReader(r)
+		next = first
+	)
+	for {
+		line, err := br.ReadBytes('\n')
+		if err == io.EOF {
+			// an incomplete last line is being written and will be received
+			// live.
+			return next, nil
+		} else if err != nil {
+			return next, err
+		}
+		row := next
+		next++
+		if row < offset {
+			continue
+		}
+
+		line = bytes.TrimSuffix(line, []byte{'\n'})
+		if !since.IsZero() {
+			var ts struct {
+				Timestamp time.Time `json:"timestamp"`
+			}
+			if err := json.Unmarshal(line, &ts); err != nil {
+				return next, err
+			}
+			if ts.Timestamp.Before(since) {
+				continue
+			}
+		}
+
+		if err := send(StreamEvent{Table: table, Offset: row, Event: line}); err != nil {
+			return next, err
+		}
+	}
+}
+
+// parseOffsets parses offsets in the form of "table:offset".
+func parseOffsets(values []string) (Cursor, error) {
+	cursor := make(Cursor, len(values))
+	for _, v := range values {
+		table, off, found := strings.Cut(v, ":")
+		if !found || table == "" {
+			return nil, fmt.Errorf("invalid offset %q: expected table:offset", v)
+		}
+		n, err := strconv.ParseUint(off, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid offset %q: %w", v, err)
+		}
+		cursor[table] = n
+	}
+	return cursor, nil
+}
+
+// StreamTables tails the given tables from the pull server at serverURL,
+// calling handle for every row received. Streaming starts at the offsets in
+// cursor, and rows written before since are skipped when catching up. The
+// cursor is updated after each handled row, so calling StreamTables again with
+// the same cursor after an error resumes the stream without gaps or
+// duplicates. It blocks until ctx is done, the server closes the stream or
+// handle returns an error.
+func StreamTables(
+	ctx context.Context,
+	serverURL string,
+	tables []string,
+	cursor Cursor,
+	since time.Time,
+	handle func(StreamEvent) error,
+) error {
+	if cursor == nil {
+		return errors.New("cursor must not be nil")
+	}
+
+	data := url.Values{}
+	for _, table := range tables {
+		data.Add("table", table)
+		if off, has := cursor[table]; has {
+			data.Add("offset", fmt.Sprintf("%s:%d", table, off))
+		}
+	}
+	if !since.IsZero() {
+		data.Set("since", since.Format(time.RFC3339Nano))
+	}
+
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodPost,
+		serverURL+streamPath,
+		strings.NewReader(data.Encode()),
+	)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		msg, _ := io.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected status code: %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+	}
+
+	dec := json.NewDecoder(resp.Body)
+	for {
+		var se StreamEvent
+		if err := dec.Decode(&se); err != nil {
+			if err == io.EOF {
+				return nil
+			}
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		if err := handle(se); err != nil {
+			return err
+		}
+		cursor[se.Table] = se.Offset + 1
+	}
+}
+
+// countRows counts the number of complete rows read from r.
+func countRows(r io.Reader) (uint64, error) {
+	var (
+		count uint64
+		buf   = make([]byte, 32*1024)
+	)
+	for {
+		n, err := r.Read(buf)
+		count += uint64(bytes.Count(buf[:n], []byte{'\n'}))
+		if err == io.EOF {
+			return count, nil
+		} else if err != nil {
+			return 0, err
+		}
+	}
+}
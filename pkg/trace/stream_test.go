@@ -0,0 +1,106 @@
+package trace
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+var errStopStream = errors.New("stop stream")
+
+// TestLocalTracerStream tests that streaming a table first sends the stored
+// rows, then new rows as they are written, and that a stream can be resumed
+// using the cursor.
+func TestLocalTracerStream(t *testing.T) {
+	port, err := getFreePort()
+	require.NoError(t, err)
+	client := setupLocalTracer(t, port)
+
+	for i := 0; i < 3; i++ {
+		client.Write(testEvent{"Annecy", i})
+	}
+
+	// Wait for the server to start
+	time.Sleep(100 * time.Millisecond)
+
+	url := fmt.Sprintf("http://localhost:%d", port)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// streaming a table that is not being collected fails.
+	err = StreamTables(ctx, url, []string{"canal"}, Cursor{}, time.Time{}, func(StreamEvent) error { return nil })
+	require.Error(t, err)
+
+	// receive the three stored rows and two live rows, then disconnect.
+	cursor := Cursor{}
+	var received []testEvent
+	go func() {
+		time.Sleep(200 * time.Millisecond)
+		client.Write(testEvent{"Paris", 3})
+		client.Write(testEvent{"Paris", 4})
+	}()
+	err = StreamTables(ctx, url, []string{testEventTable}, cursor, time.Time{}, func(se StreamEvent) error {
+		require.Equal(t, testEventTable, se.Table)
+		require.EqualValues(t, len(received), se.Offset)
+		e, err := DecodeStreamEvent[testEvent](se)
+		require.NoError(t, err)
+		received = append(received, e.Msg)
+		if len(received) == 5 {
+			return errStopStream
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStopStream)
+	require.Equal(t, uint64(4), cursor[testEventTable])
+	for i, e := range received {
+		require.Equal(t, i, e.Length)
+	}
+
+	// the last handled row returned an error, so it is received again when
+	// resuming along with rows written while disconnected.
+	client.Write(testEvent{"Pontivy", 5})
+	time.Sleep(100 * time.Millisecond)
+
+	var resumed []StreamEvent
+	err = StreamTables(ctx, url, []string{testEventTable}, cursor, time.Time{}, func(se StreamEvent) error {
+		resumed = append(resumed, se)
+		if len(resumed) == 2 {
+			return errStopStream
+		}
+		return nil
+	})
+	require.ErrorIs(t, err, errStopStream)
+	require.EqualValues(t, 4, resumed[0].Offset)
+	require.EqualValues(t, 5, resumed[1].Offset)
+	require.Equal(t, uint64(5), cursor[testEventTable])
+
+	// rows written before since are skipped when catching up.
+	since := time.Now()
+	client.Write(testEvent{"Lyon", 6})
+	time.Sleep(100 * time.Millisecond)
+	err = StreamTables(ctx, url, []string{testEventTable}, Cursor{}, since, func(se StreamEvent) error {
+		require.EqualValues(t, 6, se.Offset)
+		return errStopStream
+	})
+	require.ErrorIs(t, err, errStopStream)
+}
+
+// TestLocalTracerStreamOffsetsAfterRestart tests that offsets continue from
+// the rows already stored when the tracer is restarted.
+func TestLocalTracerStreamOffsetsAfterRestart(t *testing.T) {
+	client := setupLocalTracer(t, 0)
+	for i := 0; i < 3; i++ {
+		client.Write(testEvent{"Annecy", i})
+	}
+	time.Sleep(100 * time.Millisecond)
+	client.Stop()
+
+	restarted, err := NewLocalTracer(client.cfg, client.logger, "test_chain", "test_node")
+	require.NoError(t, err)
+	defer restarted.Stop()
+	require.Equal(t, uint64(3), restarted.files()[testEventTable].rows)
+}
@@ -0,0 +1,218 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+const (
+	// tablesPath is the path of the pull server endpoint used to list, enable
+	// and disable tables.
+	tablesPath = "/tables"
+	// flushPath is the path of the pull server endpoint used to flush
+	// buffered rows.
+	flushPath = "/flush"
+)
+
+// TableController is implemented by tracers that support changing which
+// tables are collected at runtime.
+type TableController interface {
+	// CollectingTables returns the sorted list of tables being collected.
+	CollectingTables() []string
+	// EnableTables starts collecting the given tables.
+	EnableTables(tables ...string) error
+	// DisableTables stops collecting the given tables. Rows that were already
+	// collected are kept.
+	DisableTables(tables ...string) error
+	// Flush writes or exports any buffered rows.
+	Flush() error
+}
+
+// tableSet is a set of tables that can be read without locking. Changes are
+// made to a copy of the set that is then atomically swapped in, so that
+// IsCollecting, which is called for every trace, stays cheap.
+type tableSet struct {
+	mtx    sync.Mutex
+	tables atomic.Pointer[map[string]struct{}]
+}
+
+func newTableSet(tables []string) *tableSet {
+	ts := &tableSet{}
+	m := make(map[string]struct{}, len(tables))
+	for _, table := range tables {
+		m[table] = struct{}{}
+	}
+	ts.tables.Store(&m)
+	return ts
+}
+
+func (ts *tableSet) has(table string) bool {
+	_, has := (*ts.tables.Load())[table]
+	return has
+}
+
+// list returns the sorted tables in the set.
+func (ts *tableSet) list() []string {
+	m := *ts.tables.Load()
+	tables := make([]string, 0, len(m))
+	for table := range m {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	return tables
+}
+
+// update applies fn to a copy of the set and swaps it in.
+func (ts *tableSet) update(fn func(map[string]struct{})) {
+	ts.mtx.Lock()
+	defer ts.mtx.Unlock()
+	old := *ts.tables.Load()
+	m := make(map[string]struct{}, len(old))
+	for table := range old {
+		m[table] = struct{}{}
+	}
+	fn(m)
+	ts.tables.Store(&m)
+}
+
+func (ts *tableSet) add(tables ...string) {
+	ts.update(func(m map[string]struct{}) {
+		for _, table := range tables {
+			m[table] = struct{}{}
+		}
+	})
+}
+
+func (ts *tableSet) remove(tables ...string) {
+	ts.update(func(m map[string]struct{}) {
+		for _, table := range tables {
+			delete(m, table)
+		}
+	})
+}
+
+// registeredTables are the tables defined by the trace schema, which
+// registers them with RegisterTables.
+var registeredTables = newTableSet(nil)
+
+// RegisterTables registers tables of the trace schema, the only ones that can
+// be enabled through the pull server besides the tables already open.
+func RegisterTables(tables ...string) {
+	registeredTables.add(tables...)
+}
+
+// checkTables returns an error if a table is neither registered nor open, so
+// that callers of the pull server can't create arbitrary files.
+func (lt *LocalTracer) checkTables(tables []string) error {
+	for _, table := range tables {
+		if _, open := lt.getFile(table); !open && !registeredTables.has(table) {
+			return fmt.Errorf("unknown table %q", table)
+		}
+	}
+	return nil
+}
+
+// TablesResponse is returned by the tables endpoint of the pull server.
+type TablesResponse struct {
+	Tables []string `json:"tables"`
+}
+
+// tablesHandler lists the tables being collected. When "enable" or "disable"
+// values are provided, the tables are enabled or disabled first. Only
+// registered or open tables are accepted.
+func (lt *LocalTracer) tablesHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			http.Error(w, "Failed to parse form", http.StatusBadRequest)
+			return
+		}
+		if err := lt.checkTables(append(r.Form["enable"], r.Form["disable"]...)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		if enable := r.Form["enable"]; len(enable) > 0 {
+			if err := lt.EnableTables(enable...); err != nil {
+				http.Error(w, fmt.Sprintf("failed to enable tables: %v", err), http.StatusInternalServerError)
+				return
+			}
+		}
+		if disable := r.Form["disable"]; len(disable) > 0 {
+			if err := lt.DisableTables(disable...); err != nil {
+				http.Error(w, fmt.Sprintf("failed to disable tables: %v", err), http.StatusInternalServerError)
+				return
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(TablesResponse{Tables: lt.CollectingTables()}); err != nil {
+			http.Error(w, "Failed to send data", http.StatusInternalServerError)
+		}
+	}
+}
+
+// flushHandler flushes the buffered rows of every table.
+func (lt *LocalTracer) flushHandler() http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if err := lt.Flush(); err != nil {
+			http.Error(w, fmt.Sprintf("failed to flush tables: %v", err), http.StatusInternalServerError)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}
+}
+
+// UpdateTables enables and disables tables using the pull server at serverURL
+// and returns the tables being collected afterwards. Passing no tables only
+// lists the tables being collected.
+func UpdateTables(serverURL string, enable, disable []string) ([]string, error) {
+	data := url.Values{}
+	for _, table := range enable {
+		data.Add("enable", table)
+	}
+	for _, table := range disable {
+		data.Add("disable", table)
+	}
+
+	resp, err := http.PostForm(serverURL+tablesPath, data) //nolint:gosec
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if err := checkResponse(resp); err != nil {
+		return nil, err
+	}
+
+	var tr TablesResponse
+	if err := json.NewDecoder(resp.Body).Decode(&tr); err != nil {
+		return nil, err
+	}
+	return tr.Tables, nil
+}
+
+// FlushTables flushes the buffered rows of every table using the pull server
+// at serverURL.
+func FlushTables(serverURL string) error {
+	resp, err := http.PostForm(serverURL+flushPath, url.Values{}) //nolint:gosec
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	return checkResponse(resp)
+}
+
+// checkResponse returns an error including the body of the response if its
+// status code is not 200.
+func checkResponse(resp *http.Response) error {
+	if resp.StatusCode == http.StatusOK {
+		return nil
+	}
+	msg, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+	return fmt.Errorf("unexpected status code: %d: %s", resp.StatusCode, strings.TrimSpace(string(msg)))
+}
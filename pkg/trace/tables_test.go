@@ -0,0 +1,89 @@
+package trace
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// TestLocalTracerTables tests enabling and disabling tables at runtime using
+// the pull server.
+func TestLocalTracerTables(t *testing.T) {
+	port, err := getFreePort()
+	require.NoError(t, err)
+	client := setupLocalTracer(t, port)
+	defer client.Stop()
+	RegisterTables("otherEvent")
+
+	// Wait for the server to start
+	time.Sleep(100 * time.Millisecond)
+	url := fmt.Sprintf("http://localhost:%d", port)
+
+	tables, err := UpdateTables(url, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, []string{testEventTable}, tables)
+
+	// rows of tables that are not collected are ignored.
+	client.Write(otherEvent{Ratio: 1})
+	require.False(t, client.IsCollecting("otherEvent"))
+
+	tables, err = UpdateTables(url, []string{"otherEvent"}, []string{testEventTable})
+	require.NoError(t, err)
+	require.Equal(t, []string{"otherEvent"}, tables)
+	require.True(t, client.IsCollecting("otherEvent"))
+	require.False(t, client.IsCollecting(testEventTable))
+
+	// flushing saves the rows still in the canal.
+	client.Write(otherEvent{Ratio: 2})
+	client.Write(testEvent{"Annecy", 0})
+	require.NoError(t, FlushTables(url))
+
+	events, err := DecodeTable[otherEvent](client.dir, "otherEvent")
+	require.NoError(t, err)
+	require.Len(t, events, 1)
+	require.Equal(t, 2.0, events[0].Msg.Ratio)
+
+	// the disabled table can still be downloaded, but no longer receives rows.
+	newDir := t.TempDir()
+	require.NoError(t, GetTable(url, testEventTable, newDir))
+	disabled, err := DecodeTable[testEvent](newDir, testEventTable)
+	require.NoError(t, err)
+	require.Empty(t, disabled)
+
+	// tables that are neither registered nor open are rejected, so that
+	// no file can be created out of the schema or the trace directory.
+	_, err = UpdateTables(url, []string{"unknown"}, nil)
+	require.Error(t, err)
+	require.NoFileExists(t, tablePath(client.dir, "unknown"))
+	_, err = UpdateTables(url, []string{"../escape"}, nil)
+	require.Error(t, err)
+}
+
+// TestOTLPTracerTables tests enabling tables at runtime and flushing the
+// current batch of the OTLP tracer.
+func TestOTLPTracerTables(t *testing.T) {
+	collector := &testCollector{}
+	srv := httptest.NewServer(collector)
+	defer srv.Close()
+
+	ot := setupOTLPTracer(t, srv.URL, 100, testEventTable)
+	require.NoError(t, ot.EnableTables("otherEvent"))
+	require.Equal(t, []string{"otherEvent", testEventTable}, ot.CollectingTables())
+
+	ot.Write(otherEvent{Ratio: 1})
+	require.NoError(t, ot.DisableTables(testEventTable))
+	ot.Write(testEvent{"Annecy", 0})
+
+	// the batch is not full, so nothing is exported until flushed.
+	require.Empty(t, collector.received())
+	require.NoError(t, ot.Flush())
+	received := collector.received()
+	require.Len(t, received, 1)
+
+	ot.Stop()
+	require.Error(t, ot.Flush())
+	require.Len(t, collector.received(), 1)
+}
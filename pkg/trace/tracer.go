@@ -29,6 +29,8 @@ func NewTracer(cfg *config.Config, logger log.Logger, chainID, nodeID string) (T
 	switch cfg.Instrumentation.TraceType {
 	case "local":
 		return NewLocalTracer(cfg, logger, chainID, nodeID)
+	case "otlp":
+		return NewOTLPTracer(cfg, logger, chainID, nodeID)
 	case "noop":
 		return NoOpTracer(), nil
 	default:
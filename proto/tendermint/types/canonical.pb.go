@@ -133,13 +133,14 @@ func (m *CanonicalPartSetHeader) GetHash() []byte {
 }
 
 type CanonicalProposal struct {
-	Type      SignedMsgType     `protobuf:"varint,1,opt,name=type,proto3,enum=tendermint.types.SignedMsgType" json:"type,omitempty"`
-	Height    int64             `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
-	Round     int64             `protobuf:"fixed64,3,opt,name=round,proto3" json:"round,omitempty"`
-	POLRound  int64             `protobuf:"varint,4,opt,name=pol_round,json=polRound,proto3" json:"pol_round,omitempty"`
-	BlockID   *CanonicalBlockID `protobuf:"bytes,5,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
-	Timestamp time.Time         `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
-	ChainID   string            `protobuf:"bytes,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Type                  SignedMsgType          `protobuf:"varint,1,opt,name=type,proto3,enum=tendermint.types.SignedMsgType" json:"type,omitempty"`
+	Height                int64                  `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round                 int64                  `protobuf:"fixed64,3,opt,name=round,proto3" json:"round,omitempty"`
+	POLRound              int64                  `protobuf:"varint,4,opt,name=pol_round,json=polRound,proto3" json:"pol_round,omitempty"`
+	BlockID               *CanonicalBlockID      `protobuf:"bytes,5,opt,name=block_id,json=blockId,proto3" json:"block_id,omitempty"`
+	Timestamp             time.Time              `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	ChainID               string                 `protobuf:"bytes,7,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	ExtendedPartSetHeader *ExtendedPartSetHeader `protobuf:"bytes,8,opt,name=extended_part_set_header,json=extendedPartSetHeader,proto3" json:"extended_part_set_header,omitempty"`
 }
 
 func (m *CanonicalProposal) Reset()         { *m = CanonicalProposal{} }
@@ -224,6 +225,13 @@ func (m *CanonicalProposal) GetChainID() string {
 	return ""
 }
 
+func (m *CanonicalProposal) GetExtendedPartSetHeader() *ExtendedPartSetHeader {
+	if m != nil {
+		return m.ExtendedPartSetHeader
+	}
+	return nil
+}
+
 type CanonicalVote struct {
 	Type      SignedMsgType     `protobuf:"varint,1,opt,name=type,proto3,enum=tendermint.types.SignedMsgType" json:"type,omitempty"`
 	Height    int64             `protobuf:"fixed64,2,opt,name=height,proto3" json:"height,omitempty"`
@@ -318,38 +326,40 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/canonical.proto", fileDescriptor_8d1a1a84ff7267ed) }
 
 var fileDescriptor_8d1a1a84ff7267ed = []byte{
-	// 490 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0x41, 0x6e, 0x9b, 0x40,
-	0x14, 0xf5, 0x38, 0xc4, 0xc6, 0x93, 0xb8, 0x75, 0x47, 0x51, 0x84, 0xac, 0x0a, 0x10, 0x8b, 0x8a,
-	0x6e, 0x40, 0x8a, 0x6f, 0x40, 0xba, 0xa8, 0xab, 0x46, 0x8d, 0x26, 0x51, 0x16, 0xdd, 0x58, 0x03,
-	0x4c, 0x00, 0x15, 0x98, 0x11, 0x8c, 0x17, 0xd9, 0xf4, 0x0c, 0x39, 0x47, 0x4f, 0x92, 0x65, 0x96,
-	0xed, 0xc6, 0xad, 0xf0, 0x45, 0xaa, 0x19, 0x6c, 0x13, 0x25, 0x6d, 0x37, 0xad, 0xba, 0x41, 0xff,
-	0xbf, 0xff, 0x78, 0xff, 0xe9, 0x7d, 0x0d, 0xb4, 0x05, 0x2d, 0x63, 0x5a, 0x15, 0x59, 0x29, 0x7c,
-	0x71, 0xc3, 0x69, 0xed, 0x47, 0xa4, 0x64, 0x65, 0x16, 0x91, 0xdc, 0xe3, 0x15, 0x13, 0x0c, 0x4d,
-	0x3a, 0x86, 0xa7, 0x18, 0xd3, 0xa3, 0x84, 0x25, 0x4c, 0x0d, 0x7d, 0x59, 0xb5, 0xbc, 0xe9, 0xcb,
-	0x27, 0x4a, 0xea, 0xbb, 0x99, 0x5a, 0x09, 0x63, 0x49, 0x4e, 0x7d, 0xd5, 0x85, 0xcb, 0x6b, 0x5f,
-	0x64, 0x05, 0xad, 0x05, 0x29, 0x78, 0x4b, 0x70, 0x3e, 0xc3, 0xc9, 0xe9, 0x76, 0x73, 0x90, 0xb3,
-	0xe8, 0xd3, 0xfc, 0x0d, 0x42, 0x50, 0x4b, 0x49, 0x9d, 0x1a, 0xc0, 0x06, 0xee, 0x21, 0x56, 0x35,
-	0xba, 0x82, 0xcf, 0x39, 0xa9, 0xc4, 0xa2, 0xa6, 0x62, 0x91, 0x52, 0x12, 0xd3, 0xca, 0xe8, 0xdb,
-	0xc0, 0x3d, 0x38, 0x71, 0xbd, 0xc7, 0x46, 0xbd, 0x9d, 0xe0, 0x39, 0xa9, 0xc4, 0x05, 0x15, 0x6f,
-	0x15, 0x3f, 0xd0, 0xee, 0x56, 0x56, 0x0f, 0x8f, 0xf9, 0x43, 0xd0, 0x09, 0xe0, 0xf1, 0xaf, 0xe9,
-	0xe8, 0x08, 0xee, 0x0b, 0x26, 0x48, 0xae, 0x6c, 0x8c, 0x71, 0xdb, 0xec, 0xbc, 0xf5, 0x3b, 0x6f,
-	0xce, 0xb7, 0x3e, 0x7c, 0xd1, 0x89, 0x54, 0x8c, 0xb3, 0x9a, 0xe4, 0x68, 0x06, 0x35, 0x69, 0x47,
-	0xfd, 0xfe, 0xec, 0xc4, 0x7a, 0x6a, 0xf3, 0x22, 0x4b, 0x4a, 0x1a, 0x9f, 0xd5, 0xc9, 0xe5, 0x0d,
-	0xa7, 0x58, 0x91, 0xd1, 0x31, 0x1c, 0xa4, 0x34, 0x4b, 0x52, 0xa1, 0x16, 0x4c, 0xf0, 0xa6, 0x93,
-	0x66, 0x2a, 0xb6, 0x2c, 0x63, 0x63, 0x4f, 0xc1, 0x6d, 0x83, 0x5e, 0xc3, 0x11, 0x67, 0xf9, 0xa2,
-	0x9d, 0x68, 0x36, 0x70, 0xf7, 0x82, 0xc3, 0x66, 0x65, 0xe9, 0xe7, 0x1f, 0xde, 0x63, 0x89, 0x61,
-	0x9d, 0xb3, 0x5c, 0x55, 0xe8, 0x1d, 0xd4, 0x43, 0x19, 0xef, 0x22, 0x8b, 0x8d, 0x7d, 0x15, 0x9c,
-	0xf3, 0x87, 0xe0, 0x36, 0x97, 0x08, 0x0e, 0x9a, 0x95, 0x35, 0xdc, 0x34, 0x78, 0xa8, 0x04, 0xe6,
-	0x31, 0x0a, 0xe0, 0x68, 0x77, 0x46, 0x63, 0xa0, 0xc4, 0xa6, 0x5e, 0x7b, 0x68, 0x6f, 0x7b, 0x68,
-	0xef, 0x72, 0xcb, 0x08, 0x74, 0x99, 0xfb, 0xed, 0x77, 0x0b, 0xe0, 0xee, 0x37, 0xf4, 0x0a, 0xea,
-	0x51, 0x4a, 0xb2, 0x52, 0xfa, 0x19, 0xda, 0xc0, 0x1d, 0xb5, 0xbb, 0x4e, 0x25, 0x26, 0x77, 0xa9,
-	0xe1, 0x3c, 0x76, 0xbe, 0xf4, 0xe1, 0x78, 0x67, 0xeb, 0x8a, 0x09, 0xfa, 0x3f, 0x72, 0x7d, 0x18,
-	0x96, 0xf6, 0x2f, 0xc3, 0xda, 0xff, 0xfb, 0xb0, 0x06, 0xbf, 0x0f, 0x2b, 0x38, 0xbb, 0x6b, 0x4c,
-	0x70, 0xdf, 0x98, 0xe0, 0x47, 0x63, 0x82, 0xdb, 0xb5, 0xd9, 0xbb, 0x5f, 0x9b, 0xbd, 0xaf, 0x6b,
-	0xb3, 0xf7, 0x71, 0x96, 0x64, 0x22, 0x5d, 0x86, 0x5e, 0xc4, 0x0a, 0x3f, 0x62, 0x05, 0x15, 0xe1,
-	0xb5, 0xe8, 0x8a, 0xf6, 0x51, 0x3f, 0x7e, 0xc8, 0xe1, 0x40, 0xe1, 0xb3, 0x9f, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xc4, 0x4e, 0xf7, 0xa8, 0x2d, 0x04, 0x00, 0x00,
+	// 517 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x41, 0x6e, 0x9b, 0x40,
+	0x14, 0x35, 0x36, 0xb6, 0xf1, 0x24, 0x6e, 0xdd, 0x51, 0x1a, 0x21, 0xab, 0x02, 0xc4, 0xa2, 0xa5,
+	0x1b, 0x90, 0xe2, 0x1b, 0x90, 0x56, 0xaa, 0xab, 0x46, 0x8d, 0x26, 0x51, 0x16, 0xdd, 0xd0, 0x01,
+	0x26, 0x80, 0x0a, 0x0c, 0x82, 0xb1, 0xd4, 0x6c, 0x7a, 0x86, 0x9c, 0xa3, 0x57, 0xe8, 0x05, 0xb2,
+	0xcc, 0xb2, 0x2b, 0xb7, 0xc2, 0x17, 0xa9, 0x18, 0xb0, 0x49, 0xed, 0xb4, 0x9b, 0x56, 0xd9, 0xa0,
+	0xff, 0xdf, 0x7f, 0xf3, 0xff, 0x9b, 0xff, 0xd0, 0x00, 0x8d, 0x91, 0xd4, 0x27, 0x79, 0x12, 0xa5,
+	0xcc, 0x62, 0x57, 0x19, 0x29, 0x2c, 0x0f, 0xa7, 0x34, 0x8d, 0x3c, 0x1c, 0x9b, 0x59, 0x4e, 0x19,
+	0x85, 0x93, 0x96, 0x61, 0x72, 0xc6, 0xf4, 0x20, 0xa0, 0x01, 0xe5, 0x45, 0xab, 0x8a, 0x6a, 0xde,
+	0xf4, 0xd9, 0x4e, 0x27, 0xfe, 0x6d, 0xaa, 0x6a, 0x40, 0x69, 0x10, 0x13, 0x8b, 0x67, 0xee, 0xe2,
+	0xd2, 0x62, 0x51, 0x42, 0x0a, 0x86, 0x93, 0xac, 0x26, 0xe8, 0x5f, 0xc0, 0xe4, 0x78, 0x3d, 0xd9,
+	0x8e, 0xa9, 0xf7, 0x69, 0xfe, 0x0a, 0x42, 0x20, 0x86, 0xb8, 0x08, 0x65, 0x41, 0x13, 0x8c, 0x7d,
+	0xc4, 0x63, 0x78, 0x01, 0x1e, 0x67, 0x38, 0x67, 0x4e, 0x41, 0x98, 0x13, 0x12, 0xec, 0x93, 0x5c,
+	0xee, 0x6a, 0x82, 0xb1, 0x77, 0x64, 0x98, 0xdb, 0x42, 0xcd, 0x4d, 0xc3, 0x53, 0x9c, 0xb3, 0x33,
+	0xc2, 0xde, 0x70, 0xbe, 0x2d, 0xde, 0x2c, 0xd5, 0x0e, 0x1a, 0x67, 0x77, 0x41, 0xdd, 0x06, 0x87,
+	0xf7, 0xd3, 0xe1, 0x01, 0xe8, 0x33, 0xca, 0x70, 0xcc, 0x65, 0x8c, 0x51, 0x9d, 0x6c, 0xb4, 0x75,
+	0x5b, 0x6d, 0xfa, 0xb7, 0x1e, 0x78, 0xd2, 0x36, 0xc9, 0x69, 0x46, 0x0b, 0x1c, 0xc3, 0x19, 0x10,
+	0x2b, 0x39, 0xfc, 0xf8, 0xa3, 0x23, 0x75, 0x57, 0xe6, 0x59, 0x14, 0xa4, 0xc4, 0x3f, 0x29, 0x82,
+	0xf3, 0xab, 0x8c, 0x20, 0x4e, 0x86, 0x87, 0x60, 0x10, 0x92, 0x28, 0x08, 0x19, 0x1f, 0x30, 0x41,
+	0x4d, 0x56, 0x89, 0xc9, 0xe9, 0x22, 0xf5, 0xe5, 0x1e, 0x87, 0xeb, 0x04, 0xbe, 0x04, 0xa3, 0x8c,
+	0xc6, 0x4e, 0x5d, 0x11, 0x35, 0xc1, 0xe8, 0xd9, 0xfb, 0xe5, 0x52, 0x95, 0x4e, 0xdf, 0xbf, 0x43,
+	0x15, 0x86, 0xa4, 0x8c, 0xc6, 0x3c, 0x82, 0x6f, 0x81, 0xe4, 0x56, 0xeb, 0x75, 0x22, 0x5f, 0xee,
+	0xf3, 0xc5, 0xe9, 0x7f, 0x59, 0x5c, 0xe3, 0x84, 0xbd, 0x57, 0x2e, 0xd5, 0x61, 0x93, 0xa0, 0x21,
+	0x6f, 0x30, 0xf7, 0xa1, 0x0d, 0x46, 0x1b, 0x1b, 0xe5, 0x01, 0x6f, 0x36, 0x35, 0x6b, 0xa3, 0xcd,
+	0xb5, 0xd1, 0xe6, 0xf9, 0x9a, 0x61, 0x4b, 0xd5, 0xde, 0xaf, 0x7f, 0xa8, 0x02, 0x6a, 0x8f, 0xc1,
+	0xe7, 0x40, 0xf2, 0x42, 0x1c, 0xa5, 0x95, 0x9e, 0xa1, 0x26, 0x18, 0xa3, 0x7a, 0xd6, 0x71, 0x85,
+	0x55, 0xb3, 0x78, 0x71, 0xee, 0xc3, 0x8f, 0x40, 0x26, 0x9f, 0xb9, 0x50, 0xdf, 0xd9, 0xfe, 0x01,
+	0x24, 0x3e, 0xfa, 0xc5, 0xee, 0x3d, 0x5e, 0x37, 0x27, 0x7e, 0x33, 0x14, 0x3d, 0x25, 0xf7, 0xc1,
+	0xfa, 0xd7, 0x2e, 0x18, 0x6f, 0x2e, 0x7e, 0x41, 0x19, 0x79, 0x08, 0xe7, 0xee, 0xda, 0x21, 0xfe,
+	0x4f, 0x3b, 0xfa, 0xff, 0x6e, 0xc7, 0xe0, 0xcf, 0x76, 0xd8, 0x27, 0x37, 0xa5, 0x22, 0xdc, 0x96,
+	0x8a, 0xf0, 0xb3, 0x54, 0x84, 0xeb, 0x95, 0xd2, 0xb9, 0x5d, 0x29, 0x9d, 0xef, 0x2b, 0xa5, 0xf3,
+	0x61, 0x16, 0x44, 0x2c, 0x5c, 0xb8, 0xa6, 0x47, 0x13, 0xcb, 0xa3, 0x09, 0x61, 0xee, 0x25, 0x6b,
+	0x83, 0xfa, 0xd9, 0xd8, 0x7e, 0x2a, 0xdc, 0x01, 0xc7, 0x67, 0xbf, 0x06, 0x00, 0x16, 0x4c, 0x24,
+	0x3d, 0x8f, 0x04, 0x00, 0x00,
 }
 
 func (m *CanonicalBlockID) Marshal() (dAtA []byte, err error) {
@@ -447,6 +457,18 @@ func (m *CanonicalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExtendedPartSetHeader != nil {
+		{
+			size, err := m.ExtendedPartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintCanonical(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.ChainID) > 0 {
 		i -= len(m.ChainID)
 		copy(dAtA[i:], m.ChainID)
@@ -454,12 +476,12 @@ func (m *CanonicalProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err2 != nil {
-		return 0, err2
+	n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err3 != nil {
+		return 0, err3
 	}
-	i -= n2
-	i = encodeVarintCanonical(dAtA, i, uint64(n2))
+	i -= n3
+	i = encodeVarintCanonical(dAtA, i, uint64(n3))
 	i--
 	dAtA[i] = 0x32
 	if m.BlockID != nil {
@@ -526,12 +548,12 @@ func (m *CanonicalVote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintCanonical(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintCanonical(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x2a
 	if m.BlockID != nil {
@@ -636,6 +658,10 @@ func (m *CanonicalProposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovCanonical(uint64(l))
 	}
+	if m.ExtendedPartSetHeader != nil {
+		l = m.ExtendedPartSetHeader.Size()
+		n += 1 + l + sovCanonical(uint64(l))
+	}
 	return n
 }
 
@@ -1081,6 +1107,42 @@ func (m *CanonicalProposal) Unmarshal(dAtA []byte) error {
 			}
 			m.ChainID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPartSetHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowCanonical
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthCanonical
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtendedPartSetHeader == nil {
+				m.ExtendedPartSetHeader = &ExtendedPartSetHeader{}
+			}
+			if err := m.ExtendedPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipCanonical(dAtA[iNdEx:])
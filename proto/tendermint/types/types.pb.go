@@ -148,6 +148,77 @@ func (m *PartSetHeader) GetHash() []byte {
 	return nil
 }
 
+// ExtendedPartSetHeader is the header of a PartSet extended with Reed-Solomon
+// parity parts, from which the parts can be recovered.
+type ExtendedPartSetHeader struct {
+	PartSetHeader PartSetHeader `protobuf:"bytes,1,opt,name=part_set_header,json=partSetHeader,proto3" json:"part_set_header"`
+	ParityTotal   uint32        `protobuf:"varint,2,opt,name=parity_total,json=parityTotal,proto3" json:"parity_total,omitempty"`
+	ParityHash    []byte        `protobuf:"bytes,3,opt,name=parity_hash,json=parityHash,proto3" json:"parity_hash,omitempty"`
+	// the parity parts are computed over parts padded to the same size.
+	LastPartSize uint32 `protobuf:"varint,4,opt,name=last_part_size,json=lastPartSize,proto3" json:"last_part_size,omitempty"`
+}
+
+func (m *ExtendedPartSetHeader) Reset()         { *m = ExtendedPartSetHeader{} }
+func (m *ExtendedPartSetHeader) String() string { return proto.CompactTextString(m) }
+func (*ExtendedPartSetHeader) ProtoMessage()    {}
+func (*ExtendedPartSetHeader) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d3a6e55e2345de56, []int{1}
+}
+func (m *ExtendedPartSetHeader) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ExtendedPartSetHeader) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ExtendedPartSetHeader.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ExtendedPartSetHeader) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExtendedPartSetHeader.Merge(m, src)
+}
+func (m *ExtendedPartSetHeader) XXX_Size() int {
+	return m.Size()
+}
+func (m *ExtendedPartSetHeader) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExtendedPartSetHeader.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExtendedPartSetHeader proto.InternalMessageInfo
+
+func (m *ExtendedPartSetHeader) GetPartSetHeader() PartSetHeader {
+	if m != nil {
+		return m.PartSetHeader
+	}
+	return PartSetHeader{}
+}
+
+func (m *ExtendedPartSetHeader) GetParityTotal() uint32 {
+	if m != nil {
+		return m.ParityTotal
+	}
+	return 0
+}
+
+func (m *ExtendedPartSetHeader) GetParityHash() []byte {
+	if m != nil {
+		return m.ParityHash
+	}
+	return nil
+}
+
+func (m *ExtendedPartSetHeader) GetLastPartSize() uint32 {
+	if m != nil {
+		return m.LastPartSize
+	}
+	return 0
+}
+
 type Part struct {
 	Index uint32       `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
 	Bytes []byte       `protobuf:"bytes,2,opt,name=bytes,proto3" json:"bytes,omitempty"`
@@ -158,7 +229,7 @@ func (m *Part) Reset()         { *m = Part{} }
 func (m *Part) String() string { return proto.CompactTextString(m) }
 func (*Part) ProtoMessage()    {}
 func (*Part) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{1}
+	return fileDescriptor_d3a6e55e2345de56, []int{2}
 }
 func (m *Part) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -218,7 +289,7 @@ func (m *BlockID) Reset()         { *m = BlockID{} }
 func (m *BlockID) String() string { return proto.CompactTextString(m) }
 func (*BlockID) ProtoMessage()    {}
 func (*BlockID) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{2}
+	return fileDescriptor_d3a6e55e2345de56, []int{3}
 }
 func (m *BlockID) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -288,7 +359,7 @@ func (m *Header) Reset()         { *m = Header{} }
 func (m *Header) String() string { return proto.CompactTextString(m) }
 func (*Header) ProtoMessage()    {}
 func (*Header) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{3}
+	return fileDescriptor_d3a6e55e2345de56, []int{4}
 }
 func (m *Header) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -434,7 +505,7 @@ func (m *Data) Reset()         { *m = Data{} }
 func (m *Data) String() string { return proto.CompactTextString(m) }
 func (*Data) ProtoMessage()    {}
 func (*Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{4}
+	return fileDescriptor_d3a6e55e2345de56, []int{5}
 }
 func (m *Data) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -499,7 +570,7 @@ func (m *Blob) Reset()         { *m = Blob{} }
 func (m *Blob) String() string { return proto.CompactTextString(m) }
 func (*Blob) ProtoMessage()    {}
 func (*Blob) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{5}
+	return fileDescriptor_d3a6e55e2345de56, []int{6}
 }
 func (m *Blob) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -573,7 +644,7 @@ func (m *Vote) Reset()         { *m = Vote{} }
 func (m *Vote) String() string { return proto.CompactTextString(m) }
 func (*Vote) ProtoMessage()    {}
 func (*Vote) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{6}
+	return fileDescriptor_d3a6e55e2345de56, []int{7}
 }
 func (m *Vote) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -670,7 +741,7 @@ func (m *Commit) Reset()         { *m = Commit{} }
 func (m *Commit) String() string { return proto.CompactTextString(m) }
 func (*Commit) ProtoMessage()    {}
 func (*Commit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{7}
+	return fileDescriptor_d3a6e55e2345de56, []int{8}
 }
 func (m *Commit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +810,7 @@ func (m *CommitSig) Reset()         { *m = CommitSig{} }
 func (m *CommitSig) String() string { return proto.CompactTextString(m) }
 func (*CommitSig) ProtoMessage()    {}
 func (*CommitSig) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{8}
+	return fileDescriptor_d3a6e55e2345de56, []int{9}
 }
 func (m *CommitSig) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -797,20 +868,21 @@ func (m *CommitSig) GetSignature() []byte {
 }
 
 type Proposal struct {
-	Type      SignedMsgType `protobuf:"varint,1,opt,name=type,proto3,enum=tendermint.types.SignedMsgType" json:"type,omitempty"`
-	Height    int64         `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
-	Round     int32         `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
-	PolRound  int32         `protobuf:"varint,4,opt,name=pol_round,json=polRound,proto3" json:"pol_round,omitempty"`
-	BlockID   BlockID       `protobuf:"bytes,5,opt,name=block_id,json=blockId,proto3" json:"block_id"`
-	Timestamp time.Time     `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
-	Signature []byte        `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	Type                  SignedMsgType          `protobuf:"varint,1,opt,name=type,proto3,enum=tendermint.types.SignedMsgType" json:"type,omitempty"`
+	Height                int64                  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Round                 int32                  `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	PolRound              int32                  `protobuf:"varint,4,opt,name=pol_round,json=polRound,proto3" json:"pol_round,omitempty"`
+	BlockID               BlockID                `protobuf:"bytes,5,opt,name=block_id,json=blockId,proto3" json:"block_id"`
+	Timestamp             time.Time              `protobuf:"bytes,6,opt,name=timestamp,proto3,stdtime" json:"timestamp"`
+	Signature             []byte                 `protobuf:"bytes,7,opt,name=signature,proto3" json:"signature,omitempty"`
+	ExtendedPartSetHeader *ExtendedPartSetHeader `protobuf:"bytes,8,opt,name=extended_part_set_header,json=extendedPartSetHeader,proto3" json:"extended_part_set_header,omitempty"`
 }
 
 func (m *Proposal) Reset()         { *m = Proposal{} }
 func (m *Proposal) String() string { return proto.CompactTextString(m) }
 func (*Proposal) ProtoMessage()    {}
 func (*Proposal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{9}
+	return fileDescriptor_d3a6e55e2345de56, []int{10}
 }
 func (m *Proposal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -888,6 +960,13 @@ func (m *Proposal) GetSignature() []byte {
 	return nil
 }
 
+func (m *Proposal) GetExtendedPartSetHeader() *ExtendedPartSetHeader {
+	if m != nil {
+		return m.ExtendedPartSetHeader
+	}
+	return nil
+}
+
 type SignedHeader struct {
 	Header *Header `protobuf:"bytes,1,opt,name=header,proto3" json:"header,omitempty"`
 	Commit *Commit `protobuf:"bytes,2,opt,name=commit,proto3" json:"commit,omitempty"`
@@ -897,7 +976,7 @@ func (m *SignedHeader) Reset()         { *m = SignedHeader{} }
 func (m *SignedHeader) String() string { return proto.CompactTextString(m) }
 func (*SignedHeader) ProtoMessage()    {}
 func (*SignedHeader) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{10}
+	return fileDescriptor_d3a6e55e2345de56, []int{11}
 }
 func (m *SignedHeader) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -949,7 +1028,7 @@ func (m *LightBlock) Reset()         { *m = LightBlock{} }
 func (m *LightBlock) String() string { return proto.CompactTextString(m) }
 func (*LightBlock) ProtoMessage()    {}
 func (*LightBlock) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{11}
+	return fileDescriptor_d3a6e55e2345de56, []int{12}
 }
 func (m *LightBlock) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1003,7 +1082,7 @@ func (m *BlockMeta) Reset()         { *m = BlockMeta{} }
 func (m *BlockMeta) String() string { return proto.CompactTextString(m) }
 func (*BlockMeta) ProtoMessage()    {}
 func (*BlockMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{12}
+	return fileDescriptor_d3a6e55e2345de56, []int{13}
 }
 func (m *BlockMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1081,7 +1160,7 @@ func (m *TxProof) Reset()         { *m = TxProof{} }
 func (m *TxProof) String() string { return proto.CompactTextString(m) }
 func (*TxProof) ProtoMessage()    {}
 func (*TxProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{13}
+	return fileDescriptor_d3a6e55e2345de56, []int{14}
 }
 func (m *TxProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1143,7 +1222,7 @@ func (m *IndexWrapper) Reset()         { *m = IndexWrapper{} }
 func (m *IndexWrapper) String() string { return proto.CompactTextString(m) }
 func (*IndexWrapper) ProtoMessage()    {}
 func (*IndexWrapper) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{14}
+	return fileDescriptor_d3a6e55e2345de56, []int{15}
 }
 func (m *IndexWrapper) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1206,7 +1285,7 @@ func (m *BlobTx) Reset()         { *m = BlobTx{} }
 func (m *BlobTx) String() string { return proto.CompactTextString(m) }
 func (*BlobTx) ProtoMessage()    {}
 func (*BlobTx) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{15}
+	return fileDescriptor_d3a6e55e2345de56, []int{16}
 }
 func (m *BlobTx) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1270,7 +1349,7 @@ func (m *ShareProof) Reset()         { *m = ShareProof{} }
 func (m *ShareProof) String() string { return proto.CompactTextString(m) }
 func (*ShareProof) ProtoMessage()    {}
 func (*ShareProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{16}
+	return fileDescriptor_d3a6e55e2345de56, []int{17}
 }
 func (m *ShareProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1348,7 +1427,7 @@ func (m *RowProof) Reset()         { *m = RowProof{} }
 func (m *RowProof) String() string { return proto.CompactTextString(m) }
 func (*RowProof) ProtoMessage()    {}
 func (*RowProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{17}
+	return fileDescriptor_d3a6e55e2345de56, []int{18}
 }
 func (m *RowProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1438,7 +1517,7 @@ func (m *NMTProof) Reset()         { *m = NMTProof{} }
 func (m *NMTProof) String() string { return proto.CompactTextString(m) }
 func (*NMTProof) ProtoMessage()    {}
 func (*NMTProof) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d3a6e55e2345de56, []int{18}
+	return fileDescriptor_d3a6e55e2345de56, []int{19}
 }
 func (m *NMTProof) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1499,6 +1578,7 @@ func init() {
 	proto.RegisterEnum("tendermint.types.BlockIDFlag", BlockIDFlag_name, BlockIDFlag_value)
 	proto.RegisterEnum("tendermint.types.SignedMsgType", SignedMsgType_name, SignedMsgType_value)
 	proto.RegisterType((*PartSetHeader)(nil), "tendermint.types.PartSetHeader")
+	proto.RegisterType((*ExtendedPartSetHeader)(nil), "tendermint.types.ExtendedPartSetHeader")
 	proto.RegisterType((*Part)(nil), "tendermint.types.Part")
 	proto.RegisterType((*BlockID)(nil), "tendermint.types.BlockID")
 	proto.RegisterType((*Header)(nil), "tendermint.types.Header")
@@ -1522,111 +1602,116 @@ func init() {
 func init() { proto.RegisterFile("tendermint/types/types.proto", fileDescriptor_d3a6e55e2345de56) }
 
 var fileDescriptor_d3a6e55e2345de56 = []byte{
-	// 1663 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0xe3, 0xc6,
-	0x15, 0x37, 0x25, 0x4a, 0xa2, 0x9e, 0x24, 0x5b, 0x26, 0x9c, 0x8d, 0x56, 0x9b, 0x95, 0x55, 0x15,
-	0x6d, 0x9d, 0x34, 0x90, 0xb7, 0xde, 0xa2, 0x7f, 0x0e, 0x39, 0x58, 0xb6, 0xb3, 0xd1, 0xc6, 0xff,
-	0x40, 0x29, 0x1b, 0xb4, 0x28, 0x40, 0x50, 0xe2, 0x58, 0x62, 0x43, 0x71, 0x58, 0xce, 0xc8, 0xf6,
-	0xe6, 0x13, 0x14, 0xbe, 0x34, 0xa7, 0xde, 0x7c, 0x4a, 0x0f, 0xbd, 0xf7, 0x0b, 0x14, 0x3d, 0xe5,
-	0x98, 0x5b, 0x7b, 0x69, 0x5a, 0x78, 0x81, 0x22, 0x1f, 0xa3, 0x98, 0x37, 0x43, 0x8a, 0xb2, 0xa4,
-	0x36, 0x58, 0x04, 0xb9, 0x18, 0x9c, 0xf7, 0x7e, 0xef, 0xcd, 0xfb, 0x3b, 0xef, 0xc9, 0xf0, 0x16,
-	0x27, 0x81, 0x4b, 0xa2, 0x89, 0x17, 0xf0, 0x5d, 0xfe, 0x32, 0x24, 0x4c, 0xfe, 0x6d, 0x87, 0x11,
-	0xe5, 0xd4, 0xac, 0xce, 0xb8, 0x6d, 0xa4, 0xd7, 0xb7, 0x46, 0x74, 0x44, 0x91, 0xb9, 0x2b, 0xbe,
-	0x24, 0xae, 0xbe, 0x3d, 0xa2, 0x74, 0xe4, 0x93, 0x5d, 0x3c, 0x0d, 0xa6, 0x17, 0xbb, 0xdc, 0x9b,
-	0x10, 0xc6, 0x9d, 0x49, 0xa8, 0x00, 0x8f, 0x53, 0xd7, 0x0c, 0xa3, 0x97, 0x21, 0xa7, 0x02, 0x4b,
-	0x2f, 0x14, 0xbb, 0x91, 0x62, 0x5f, 0x92, 0x88, 0x79, 0x34, 0x48, 0xdb, 0x51, 0x6f, 0x2e, 0x58,
-	0x79, 0xe9, 0xf8, 0x9e, 0xeb, 0x70, 0x1a, 0x49, 0x44, 0xeb, 0x97, 0x50, 0x39, 0x77, 0x22, 0xde,
-	0x23, 0xfc, 0x03, 0xe2, 0xb8, 0x24, 0x32, 0xb7, 0x20, 0xc7, 0x29, 0x77, 0xfc, 0x9a, 0xd6, 0xd4,
-	0x76, 0x2a, 0x96, 0x3c, 0x98, 0x26, 0xe8, 0x63, 0x87, 0x8d, 0x6b, 0x99, 0xa6, 0xb6, 0x53, 0xb6,
-	0xf0, 0xbb, 0x35, 0x06, 0x5d, 0x88, 0x0a, 0x09, 0x2f, 0x70, 0xc9, 0x75, 0x2c, 0x81, 0x07, 0x41,
-	0x1d, 0xbc, 0xe4, 0x84, 0x29, 0x11, 0x79, 0x30, 0x7f, 0x0a, 0x39, 0xb4, 0xbf, 0x96, 0x6d, 0x6a,
-	0x3b, 0xa5, 0xbd, 0x5a, 0x3b, 0x15, 0x28, 0xe9, 0x5f, 0xfb, 0x5c, 0xf0, 0x3b, 0xfa, 0x17, 0x5f,
-	0x6d, 0xaf, 0x59, 0x12, 0xdc, 0xf2, 0xa1, 0xd0, 0xf1, 0xe9, 0xf0, 0x93, 0xee, 0x61, 0x62, 0x88,
-	0x36, 0x33, 0xc4, 0x3c, 0x81, 0x8d, 0xd0, 0x89, 0xb8, 0xcd, 0x08, 0xb7, 0xc7, 0xe8, 0x05, 0x5e,
-	0x5a, 0xda, 0xdb, 0x6e, 0xdf, 0xcf, 0x43, 0x7b, 0xce, 0x59, 0x75, 0x4b, 0x25, 0x4c, 0x13, 0x5b,
-	0xff, 0xd1, 0x21, 0xaf, 0x82, 0xf1, 0x1e, 0x14, 0x54, 0x58, 0xf1, 0xc2, 0xd2, 0xde, 0xe3, 0xb4,
-	0x46, 0xc5, 0x6a, 0x1f, 0xd0, 0x80, 0x91, 0x80, 0x4d, 0x99, 0xd2, 0x17, 0xcb, 0x98, 0x3f, 0x04,
-	0x63, 0x38, 0x76, 0xbc, 0xc0, 0xf6, 0x5c, 0xb4, 0xa8, 0xd8, 0x29, 0xdd, 0x7d, 0xb5, 0x5d, 0x38,
-	0x10, 0xb4, 0xee, 0xa1, 0x55, 0x40, 0x66, 0xd7, 0x35, 0x1f, 0x40, 0x7e, 0x4c, 0xbc, 0xd1, 0x98,
-	0x63, 0x58, 0xb2, 0x96, 0x3a, 0x99, 0xbf, 0x00, 0x5d, 0x14, 0x44, 0x4d, 0xc7, 0xbb, 0xeb, 0x6d,
-	0x59, 0x2d, 0xed, 0xb8, 0x5a, 0xda, 0xfd, 0xb8, 0x5a, 0x3a, 0x86, 0xb8, 0xf8, 0xb3, 0x7f, 0x6d,
-	0x6b, 0x16, 0x4a, 0x98, 0x07, 0x50, 0xf1, 0x1d, 0xc6, 0xed, 0x81, 0x08, 0x9b, 0xb8, 0x3e, 0x87,
-	0x2a, 0x1e, 0x2e, 0x06, 0x44, 0x05, 0x56, 0x99, 0x5e, 0x12, 0x52, 0x92, 0xe4, 0x9a, 0x3b, 0x50,
-	0x45, 0x25, 0x43, 0x3a, 0x99, 0x78, 0xdc, 0xc6, 0xb8, 0xe7, 0x31, 0xee, 0xeb, 0x82, 0x7e, 0x80,
-	0xe4, 0x0f, 0x44, 0x06, 0x1e, 0x41, 0xd1, 0x75, 0xb8, 0x23, 0x21, 0x05, 0x84, 0x18, 0x82, 0x80,
-	0xcc, 0x1f, 0xc1, 0x46, 0x52, 0x75, 0x4c, 0x42, 0x0c, 0xa9, 0x65, 0x46, 0x46, 0xe0, 0x13, 0xd8,
-	0x0a, 0xc8, 0x35, 0xb7, 0xef, 0xa3, 0x8b, 0x88, 0x36, 0x05, 0xef, 0xc5, 0xbc, 0xc4, 0x0f, 0x60,
-	0x7d, 0x18, 0x07, 0x5f, 0x62, 0x01, 0xb1, 0x95, 0x84, 0x8a, 0xb0, 0x87, 0x60, 0x38, 0x61, 0x28,
-	0x01, 0x25, 0x04, 0x14, 0x9c, 0x30, 0x44, 0xd6, 0x3b, 0xb0, 0x89, 0x3e, 0x46, 0x84, 0x4d, 0x7d,
-	0xae, 0x94, 0x94, 0x11, 0xb3, 0x21, 0x18, 0x96, 0xa4, 0x23, 0xf6, 0xfb, 0x50, 0x21, 0x97, 0x9e,
-	0x4b, 0x82, 0x21, 0x91, 0xb8, 0x0a, 0xe2, 0xca, 0x31, 0x11, 0x41, 0x6f, 0x43, 0x35, 0x8c, 0x68,
-	0x48, 0x19, 0x89, 0x6c, 0xc7, 0x75, 0x23, 0xc2, 0x58, 0x6d, 0x5d, 0xea, 0x8b, 0xe9, 0xfb, 0x92,
-	0xdc, 0xb2, 0x41, 0x3f, 0x74, 0xb8, 0x63, 0x56, 0x21, 0xcb, 0xaf, 0x59, 0x4d, 0x6b, 0x66, 0x77,
-	0xca, 0x96, 0xf8, 0x34, 0xb7, 0xa1, 0xc4, 0x7e, 0x37, 0x75, 0x22, 0x62, 0x33, 0xef, 0x53, 0x82,
-	0xc9, 0xd3, 0x2d, 0x90, 0xa4, 0x9e, 0xf7, 0x29, 0x49, 0xda, 0x20, 0x3f, 0x6b, 0x83, 0xe7, 0xba,
-	0x91, 0xa9, 0x66, 0x9f, 0xeb, 0x46, 0xb6, 0xaa, 0x3f, 0xd7, 0x0d, 0xbd, 0x9a, 0x6b, 0xfd, 0x41,
-	0x03, 0xbd, 0xe3, 0xd3, 0x81, 0xf9, 0x3d, 0x28, 0x07, 0xce, 0x84, 0xb0, 0xd0, 0x19, 0x12, 0x51,
-	0x0d, 0xb2, 0x7b, 0x4a, 0x09, 0xad, 0xeb, 0x0a, 0x8d, 0x22, 0x63, 0x71, 0x87, 0x8b, 0x6f, 0xe1,
-	0x30, 0x1b, 0x0b, 0x2b, 0xe2, 0x26, 0xc8, 0x62, 0x87, 0x97, 0x91, 0xf8, 0x42, 0x15, 0xf9, 0x8f,
-	0x61, 0x73, 0xa6, 0x3b, 0x06, 0xea, 0x08, 0xac, 0x26, 0x0c, 0x05, 0x6e, 0x7d, 0x9d, 0x01, 0xfd,
-	0x05, 0xe5, 0xc4, 0x7c, 0x0a, 0xba, 0xa8, 0x3f, 0xb4, 0x64, 0x7d, 0x59, 0xa3, 0xf6, 0xbc, 0x51,
-	0x40, 0xdc, 0x13, 0x36, 0xea, 0xbf, 0x0c, 0x89, 0x85, 0xe0, 0x54, 0x9f, 0x64, 0xe6, 0xfa, 0x64,
-	0x0b, 0x72, 0x11, 0x9d, 0x06, 0x2e, 0xda, 0x97, 0xb3, 0xe4, 0xc1, 0x3c, 0x02, 0x23, 0x29, 0x7f,
-	0xfd, 0xff, 0x95, 0xff, 0x86, 0x28, 0x7f, 0xd1, 0x9c, 0x8a, 0x60, 0x15, 0x06, 0xaa, 0x0b, 0x3a,
-	0x50, 0x4c, 0x5e, 0x65, 0xd5, 0x46, 0xdf, 0xac, 0x13, 0x67, 0x62, 0x22, 0x46, 0x49, 0x51, 0x27,
-	0x55, 0x21, 0x73, 0x57, 0x4d, 0x18, 0xaa, 0x2c, 0xe6, 0xfa, 0xc5, 0x96, 0x2f, 0x6b, 0x01, 0xfd,
-	0x9a, 0xf5, 0x4b, 0x17, 0x9f, 0xd8, 0xb7, 0xa0, 0xc8, 0xbc, 0x51, 0xe0, 0xf0, 0x69, 0x44, 0x54,
-	0x4b, 0xcd, 0x08, 0xad, 0xbf, 0x6a, 0x90, 0x97, 0x2d, 0x9a, 0x8a, 0x9b, 0xb6, 0x3c, 0x6e, 0x99,
-	0x55, 0x71, 0xcb, 0xbe, 0x7e, 0xdc, 0xf6, 0x01, 0x12, 0x63, 0x58, 0x4d, 0x6f, 0x66, 0x77, 0x4a,
-	0x7b, 0x8f, 0x16, 0x15, 0x49, 0x13, 0x7b, 0xde, 0x48, 0xbd, 0x40, 0x29, 0xa1, 0xd6, 0x3f, 0x35,
-	0x28, 0x26, 0x7c, 0x73, 0x1f, 0x2a, 0xb1, 0x5d, 0xf6, 0x85, 0xef, 0x8c, 0x54, 0xed, 0x3c, 0x5e,
-	0x69, 0xdc, 0xfb, 0xbe, 0x33, 0xb2, 0x4a, 0xca, 0x1e, 0x71, 0x58, 0x9e, 0x87, 0xcc, 0x8a, 0x3c,
-	0xcc, 0x25, 0x3e, 0xfb, 0x7a, 0x89, 0x9f, 0x4b, 0x91, 0x7e, 0x3f, 0x45, 0x7f, 0xc9, 0x80, 0x71,
-	0x8e, 0x8f, 0x82, 0xe3, 0x7f, 0x17, 0x1d, 0xf1, 0x08, 0x8a, 0x21, 0xf5, 0x6d, 0xc9, 0xd1, 0x91,
-	0x63, 0x84, 0xd4, 0xb7, 0x16, 0xd2, 0x9e, 0xfb, 0x96, 0xda, 0x25, 0xff, 0x2d, 0x44, 0xad, 0x70,
-	0x3f, 0x6a, 0x11, 0x94, 0x65, 0x28, 0xd4, 0x90, 0x7e, 0x22, 0x62, 0x80, 0x53, 0x5f, 0x5b, 0x5c,
-	0x2a, 0xa4, 0xd9, 0x12, 0x69, 0x29, 0x9c, 0x90, 0x90, 0x33, 0x4d, 0xed, 0x09, 0xb5, 0x55, 0x65,
-	0x69, 0x29, 0x5c, 0xeb, 0x8f, 0x1a, 0xc0, 0xb1, 0x88, 0x2c, 0xfa, 0x2b, 0xc6, 0x2b, 0x43, 0x13,
-	0xec, 0xb9, 0x9b, 0x1b, 0xab, 0x92, 0xa6, 0xee, 0x2f, 0xb3, 0xb4, 0xdd, 0x07, 0x50, 0x99, 0x15,
-	0x23, 0x23, 0xb1, 0x31, 0x4b, 0x94, 0x24, 0x53, 0xaf, 0x47, 0xb8, 0x55, 0xbe, 0x4c, 0x9d, 0x5a,
-	0x7f, 0xd3, 0xa0, 0x88, 0x36, 0x9d, 0x10, 0xee, 0xcc, 0xe5, 0x50, 0x7b, 0xfd, 0x1c, 0x3e, 0x06,
-	0x90, 0x6a, 0x70, 0xfa, 0xc8, 0xca, 0x2a, 0x22, 0x05, 0x87, 0xcf, 0xcf, 0x92, 0x80, 0x67, 0xff,
-	0x77, 0xc0, 0x55, 0x4b, 0xc7, 0x61, 0x7f, 0x13, 0x0a, 0xc1, 0x74, 0x62, 0x8b, 0x59, 0xa7, 0xcb,
-	0x6a, 0x0d, 0xa6, 0x93, 0xfe, 0x35, 0x6b, 0xfd, 0x16, 0x0a, 0xfd, 0x6b, 0xdc, 0xfb, 0x44, 0x89,
-	0x46, 0x94, 0xaa, 0x65, 0x43, 0x8e, 0x29, 0x43, 0x10, 0x70, 0xb6, 0x2e, 0x9b, 0x51, 0xed, 0x6f,
-	0xb8, 0x51, 0xc6, 0xbb, 0xe4, 0x6f, 0xa0, 0x8c, 0xaf, 0xe7, 0xc7, 0x91, 0x13, 0x86, 0x24, 0x32,
-	0xd7, 0x21, 0xc3, 0xaf, 0xd5, 0x4d, 0x19, 0x7e, 0x3d, 0x9b, 0x79, 0xf8, 0xf2, 0xe2, 0xfe, 0x9a,
-	0x4d, 0x66, 0x5e, 0x57, 0xd2, 0x84, 0x27, 0xc2, 0xcf, 0xf8, 0x85, 0x2c, 0x5a, 0x79, 0x71, 0xec,
-	0xba, 0x2d, 0x1b, 0xf2, 0x62, 0xe0, 0xf6, 0xaf, 0x17, 0xf4, 0xbe, 0x0b, 0xb9, 0x81, 0x4f, 0x07,
-	0x52, 0x5f, 0x69, 0xef, 0xc1, 0xd2, 0xbc, 0x0c, 0x2c, 0x09, 0x5a, 0x7d, 0xc1, 0xd7, 0x1a, 0x40,
-	0x4f, 0x98, 0x22, 0xc3, 0x15, 0x47, 0x44, 0xee, 0x0e, 0x32, 0x22, 0xef, 0x81, 0x34, 0xd6, 0x46,
-	0x87, 0xe3, 0x0b, 0xeb, 0x8b, 0x17, 0x9e, 0x9e, 0xf4, 0x65, 0x68, 0x4a, 0x2c, 0xd1, 0xc8, 0x16,
-	0x76, 0x85, 0xec, 0xe2, 0xae, 0xf0, 0x73, 0x91, 0xa4, 0x2b, 0xa9, 0x3f, 0x59, 0x4e, 0x17, 0xd4,
-	0x5b, 0xf4, 0x4a, 0xaa, 0x37, 0x22, 0xf5, 0xb5, 0x7c, 0x57, 0xc8, 0xad, 0xd8, 0x15, 0x3e, 0xd7,
-	0xc0, 0x88, 0x75, 0xc8, 0xba, 0xb8, 0xb2, 0x45, 0x29, 0xc4, 0x9b, 0x92, 0x50, 0x6b, 0x89, 0xb3,
-	0xe8, 0xe7, 0x39, 0x5f, 0x57, 0x17, 0x81, 0xc2, 0x89, 0xb8, 0x09, 0x55, 0xca, 0x39, 0xfc, 0x16,
-	0x57, 0x30, 0x2e, 0x7e, 0x47, 0x44, 0xf4, 0x4a, 0x2d, 0x30, 0x06, 0x12, 0x2c, 0x7a, 0x25, 0x12,
-	0x42, 0x02, 0x17, 0x59, 0xd2, 0xde, 0x3c, 0x09, 0x5c, 0x8b, 0x5e, 0xb5, 0x08, 0x18, 0x71, 0x1c,
-	0xc5, 0xab, 0x8b, 0x02, 0x98, 0xf6, 0x9c, 0x25, 0x0f, 0x62, 0xbd, 0x23, 0xc9, 0x8c, 0x15, 0x9f,
-	0x02, 0x17, 0x50, 0x97, 0xb0, 0x5a, 0x16, 0x1d, 0x91, 0x07, 0x71, 0xbf, 0x4f, 0x9c, 0x0b, 0x59,
-	0xfa, 0x72, 0x56, 0x18, 0x82, 0x20, 0x4a, 0xff, 0x9d, 0xbf, 0x6b, 0x50, 0x4a, 0x8d, 0x35, 0xf3,
-	0x27, 0xf0, 0x46, 0xe7, 0xf8, 0xec, 0xe0, 0x43, 0xbb, 0x7b, 0x68, 0xbf, 0x7f, 0xbc, 0xff, 0xcc,
-	0xfe, 0xe8, 0xf4, 0xc3, 0xd3, 0xb3, 0x8f, 0x4f, 0xab, 0x6b, 0xf5, 0x07, 0x37, 0xb7, 0x4d, 0x33,
-	0x85, 0xfd, 0x28, 0xf8, 0x24, 0xa0, 0x57, 0x81, 0xb9, 0x0b, 0x5b, 0xf3, 0x22, 0xfb, 0x9d, 0xde,
-	0xd1, 0x69, 0xbf, 0xaa, 0xd5, 0xdf, 0xb8, 0xb9, 0x6d, 0x6e, 0xa6, 0x24, 0xf6, 0x07, 0x8c, 0x04,
-	0x7c, 0x51, 0xe0, 0xe0, 0xec, 0xe4, 0xa4, 0xdb, 0xaf, 0x66, 0x16, 0x04, 0xd4, 0x9e, 0xf1, 0x36,
-	0x6c, 0xce, 0x0b, 0x9c, 0x76, 0x8f, 0xab, 0xd9, 0xba, 0x79, 0x73, 0xdb, 0x5c, 0x4f, 0xa1, 0x4f,
-	0x3d, 0xbf, 0x6e, 0xfc, 0xfe, 0xf3, 0xc6, 0xda, 0x9f, 0xff, 0xd4, 0xd0, 0x84, 0x67, 0x95, 0xb9,
-	0xd1, 0x66, 0xbe, 0x0b, 0x6f, 0xf6, 0xba, 0xcf, 0x4e, 0x8f, 0x0e, 0xed, 0x93, 0xde, 0x33, 0xbb,
-	0xff, 0xab, 0xf3, 0xa3, 0x94, 0x77, 0x1b, 0x37, 0xb7, 0xcd, 0x92, 0x72, 0x69, 0x15, 0xfa, 0xdc,
-	0x3a, 0x7a, 0x71, 0xd6, 0x3f, 0xaa, 0x6a, 0x12, 0x7d, 0x1e, 0x91, 0x4b, 0xca, 0x09, 0xa2, 0x9f,
-	0xc0, 0xc3, 0x25, 0xe8, 0xc4, 0xb1, 0xcd, 0x9b, 0xdb, 0x66, 0xe5, 0x3c, 0x22, 0xf2, 0xd9, 0x47,
-	0x89, 0x36, 0xd4, 0x16, 0x25, 0xce, 0xce, 0xcf, 0x7a, 0xfb, 0xc7, 0xd5, 0x66, 0xbd, 0x7a, 0x73,
-	0xdb, 0x2c, 0xc7, 0x33, 0x5c, 0xe0, 0x67, 0x9e, 0x75, 0x4e, 0xbe, 0xb8, 0x6b, 0x68, 0x5f, 0xde,
-	0x35, 0xb4, 0x7f, 0xdf, 0x35, 0xb4, 0xcf, 0x5e, 0x35, 0xd6, 0xbe, 0x7c, 0xd5, 0x58, 0xfb, 0xc7,
-	0xab, 0xc6, 0xda, 0xaf, 0x9f, 0x8e, 0x3c, 0x3e, 0x9e, 0x0e, 0xda, 0x43, 0x3a, 0xd9, 0x1d, 0xd2,
-	0x09, 0xe1, 0x83, 0x0b, 0x3e, 0xfb, 0x90, 0xff, 0x26, 0xb8, 0xff, 0xd3, 0x7d, 0x90, 0x47, 0xfa,
-	0xd3, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x5c, 0xda, 0x7c, 0x03, 0x7b, 0x10, 0x00, 0x00,
+	// 1741 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4b, 0x6f, 0x23, 0xc7,
+	0x11, 0xd6, 0x90, 0x43, 0x72, 0x58, 0x24, 0x25, 0x6a, 0xa0, 0x5d, 0x73, 0xb9, 0x5e, 0x8a, 0x61,
+	0x1e, 0x96, 0x1d, 0x83, 0xda, 0x68, 0x83, 0x3c, 0x0e, 0x3e, 0x88, 0x92, 0xbc, 0xe6, 0x5a, 0x2f,
+	0x0c, 0xe9, 0x35, 0x12, 0x04, 0x98, 0x0c, 0x39, 0x2d, 0x72, 0x62, 0x72, 0x7a, 0x32, 0xdd, 0x94,
+	0x28, 0xff, 0x82, 0x40, 0x97, 0xf8, 0x92, 0xdc, 0x74, 0x72, 0x0e, 0xf9, 0x19, 0x41, 0x4e, 0x3e,
+	0xee, 0x2d, 0xb9, 0xc4, 0x09, 0x76, 0x81, 0xc0, 0x3f, 0x23, 0xe8, 0xea, 0x9e, 0xe1, 0x50, 0x24,
+	0xf3, 0x58, 0x2c, 0x72, 0x21, 0xa6, 0xab, 0xbf, 0xaa, 0xae, 0xfe, 0xaa, 0xaa, 0xab, 0x40, 0x78,
+	0x9b, 0x13, 0xdf, 0x25, 0xe1, 0xd8, 0xf3, 0xf9, 0x2e, 0xbf, 0x0e, 0x08, 0x93, 0xbf, 0xcd, 0x20,
+	0xa4, 0x9c, 0x9a, 0xe5, 0xd9, 0x6e, 0x13, 0xe5, 0xd5, 0xad, 0x01, 0x1d, 0x50, 0xdc, 0xdc, 0x15,
+	0x5f, 0x12, 0x57, 0xdd, 0x1e, 0x50, 0x3a, 0x18, 0x91, 0x5d, 0x5c, 0xf5, 0x26, 0x17, 0xbb, 0xdc,
+	0x1b, 0x13, 0xc6, 0x9d, 0x71, 0xa0, 0x00, 0x8f, 0x12, 0xc7, 0xf4, 0xc3, 0xeb, 0x80, 0x53, 0x81,
+	0xa5, 0x17, 0x6a, 0xbb, 0x96, 0xd8, 0xbe, 0x24, 0x21, 0xf3, 0xa8, 0x9f, 0xf4, 0xa3, 0x5a, 0x5f,
+	0xf0, 0xf2, 0xd2, 0x19, 0x79, 0xae, 0xc3, 0x69, 0x28, 0x11, 0x8d, 0x9f, 0x42, 0xe9, 0xdc, 0x09,
+	0x79, 0x87, 0xf0, 0x8f, 0x88, 0xe3, 0x92, 0xd0, 0xdc, 0x82, 0x0c, 0xa7, 0xdc, 0x19, 0x55, 0xb4,
+	0xba, 0xb6, 0x53, 0xb2, 0xe4, 0xc2, 0x34, 0x41, 0x1f, 0x3a, 0x6c, 0x58, 0x49, 0xd5, 0xb5, 0x9d,
+	0xa2, 0x85, 0xdf, 0x8d, 0x17, 0x1a, 0xdc, 0x3b, 0x9a, 0xe2, 0x09, 0xee, 0xbc, 0x8d, 0x13, 0xd8,
+	0x08, 0x9c, 0x90, 0xdb, 0x8c, 0x70, 0x7b, 0x88, 0x22, 0xb4, 0x56, 0xd8, 0xdb, 0x6e, 0xde, 0x25,
+	0xa6, 0x39, 0xa7, 0xd9, 0xd2, 0xbf, 0xfa, 0x7a, 0x7b, 0xcd, 0x2a, 0x05, 0x73, 0xe6, 0xbe, 0x05,
+	0xc5, 0xc0, 0x09, 0x3d, 0x7e, 0x6d, 0x4b, 0xcf, 0x52, 0xe8, 0x59, 0x41, 0xca, 0xba, 0xe8, 0xdf,
+	0x36, 0xa8, 0xa5, 0x8d, 0x6e, 0xa6, 0xd1, 0x4d, 0x90, 0xa2, 0x8f, 0x1c, 0x36, 0x34, 0xbf, 0x03,
+	0xeb, 0x23, 0x87, 0x71, 0x5b, 0xfa, 0xe5, 0x7d, 0x4e, 0x2a, 0x3a, 0x5a, 0x29, 0x0a, 0x29, 0xfa,
+	0xe0, 0x7d, 0x4e, 0x1a, 0x43, 0xd0, 0xc5, 0xb7, 0x20, 0xc1, 0xf3, 0x5d, 0x32, 0x8d, 0x48, 0xc0,
+	0x85, 0x90, 0xf6, 0xae, 0x39, 0x61, 0x8a, 0x05, 0xb9, 0x30, 0x7f, 0x08, 0x19, 0x0c, 0x09, 0x1e,
+	0x5a, 0xd8, 0xab, 0x24, 0xaf, 0x28, 0x43, 0xd6, 0x3c, 0x17, 0xfb, 0xea, 0x6e, 0x12, 0xdc, 0x18,
+	0x41, 0xae, 0x35, 0xa2, 0xfd, 0xcf, 0xda, 0x87, 0x31, 0xb7, 0xda, 0x8c, 0xdb, 0x65, 0x0c, 0xa6,
+	0x5e, 0x9f, 0xc1, 0xc6, 0x3f, 0x75, 0xc8, 0xca, 0x4f, 0xf3, 0x03, 0xc8, 0xa9, 0x4c, 0x51, 0x31,
+	0x79, 0x94, 0xb4, 0xa8, 0xb6, 0x9a, 0x07, 0xd4, 0x67, 0xc4, 0x67, 0x13, 0xa6, 0xec, 0x45, 0x3a,
+	0xe6, 0xf7, 0xc0, 0xe8, 0x0f, 0x1d, 0xcf, 0xb7, 0x3d, 0x17, 0x3d, 0xca, 0xb7, 0x0a, 0x2f, 0xbf,
+	0xde, 0xce, 0x1d, 0x08, 0x59, 0xfb, 0xd0, 0xca, 0xe1, 0x66, 0xdb, 0x35, 0xef, 0x43, 0x76, 0x48,
+	0xbc, 0xc1, 0x90, 0x23, 0x2d, 0x69, 0x4b, 0xad, 0xcc, 0x9f, 0x80, 0x2e, 0x72, 0x1c, 0xd9, 0x2f,
+	0xec, 0x55, 0x9b, 0xb2, 0x00, 0x9a, 0x51, 0x01, 0x34, 0xbb, 0x51, 0x01, 0xb4, 0x0c, 0x71, 0xf0,
+	0x17, 0x7f, 0xdf, 0xd6, 0x2c, 0xd4, 0x30, 0x0f, 0xa0, 0x84, 0x11, 0xec, 0x09, 0xda, 0xc4, 0xf1,
+	0x19, 0x34, 0xf1, 0x60, 0x91, 0x10, 0x45, 0xac, 0x72, 0xbd, 0x20, 0xb4, 0xa4, 0xc8, 0x35, 0x77,
+	0xa0, 0x8c, 0x46, 0xfa, 0x74, 0x3c, 0xf6, 0xb8, 0x4c, 0x96, 0x2c, 0xf2, 0x8e, 0xe9, 0x71, 0x80,
+	0x62, 0x4c, 0x98, 0x87, 0x90, 0x77, 0x1d, 0xee, 0x48, 0x48, 0x0e, 0x21, 0x86, 0x10, 0xe0, 0xe6,
+	0x3b, 0xb0, 0x11, 0x17, 0x12, 0x93, 0x10, 0x43, 0x5a, 0x99, 0x89, 0x11, 0xf8, 0x18, 0xb6, 0x7c,
+	0x32, 0xe5, 0xf6, 0x5d, 0x74, 0x1e, 0xd1, 0xa6, 0xd8, 0x7b, 0x3e, 0xaf, 0xf1, 0x5d, 0x58, 0xef,
+	0x47, 0xe4, 0x4b, 0x2c, 0x20, 0xb6, 0x14, 0x4b, 0x11, 0xf6, 0x00, 0x0c, 0x27, 0x08, 0x24, 0xa0,
+	0x80, 0x80, 0x9c, 0x13, 0x04, 0xb8, 0xf5, 0x1e, 0x6c, 0xe2, 0x1d, 0x43, 0xc2, 0x26, 0x23, 0xae,
+	0x8c, 0x14, 0x11, 0xb3, 0x21, 0x36, 0x2c, 0x29, 0x47, 0xec, 0xb7, 0xa1, 0x44, 0x2e, 0x3d, 0x97,
+	0xf8, 0x7d, 0x22, 0x71, 0x25, 0xc4, 0x15, 0x23, 0x21, 0x82, 0xde, 0x85, 0x72, 0x10, 0xd2, 0x80,
+	0x32, 0x12, 0xda, 0x8e, 0xeb, 0x86, 0x84, 0xb1, 0xca, 0xba, 0xb4, 0x17, 0xc9, 0xf7, 0xa5, 0xb8,
+	0x61, 0x83, 0x7e, 0xe8, 0x70, 0xc7, 0x2c, 0x43, 0x9a, 0x4f, 0x59, 0x45, 0xab, 0xa7, 0x77, 0x8a,
+	0x96, 0xf8, 0x14, 0x15, 0xca, 0x7e, 0x3d, 0x71, 0x42, 0x22, 0xab, 0x4f, 0x04, 0x4f, 0xb7, 0x40,
+	0x8a, 0x44, 0xed, 0xc5, 0x65, 0x90, 0x9d, 0x95, 0xc1, 0x33, 0xdd, 0x48, 0x95, 0xd3, 0xcf, 0x74,
+	0x23, 0x5d, 0xd6, 0x9f, 0xe9, 0x86, 0x5e, 0xce, 0x34, 0x7e, 0xab, 0x81, 0xde, 0x1a, 0xd1, 0x9e,
+	0x78, 0x14, 0x7c, 0x67, 0x4c, 0x58, 0xe0, 0xf4, 0x89, 0xc8, 0x06, 0x59, 0x3d, 0x85, 0x58, 0xd6,
+	0x76, 0x85, 0x45, 0x11, 0xb1, 0xe8, 0xd1, 0x12, 0xdf, 0xe2, 0xc2, 0x6c, 0x28, 0xbc, 0x88, 0x8a,
+	0x20, 0x2d, 0x9f, 0x01, 0x14, 0x3e, 0x97, 0x32, 0xf3, 0xfb, 0xb0, 0x39, 0xb3, 0x1d, 0x01, 0xe5,
+	0x7b, 0x51, 0x8e, 0x37, 0x14, 0xb8, 0xf1, 0x4d, 0x0a, 0xf4, 0xe7, 0x94, 0x13, 0xf3, 0x09, 0xe8,
+	0x22, 0xff, 0xd0, 0x93, 0xf5, 0x65, 0x85, 0xda, 0xf1, 0x06, 0x3e, 0x71, 0x4f, 0xd8, 0xa0, 0x7b,
+	0x1d, 0x10, 0x0b, 0xc1, 0x89, 0x3a, 0x49, 0xcd, 0xd5, 0xc9, 0x16, 0x64, 0x42, 0x3a, 0xf1, 0x5d,
+	0xf4, 0x2f, 0x63, 0xc9, 0x85, 0x79, 0x04, 0x46, 0x9c, 0xfe, 0xfa, 0x7f, 0x4a, 0xff, 0x0d, 0x91,
+	0xfe, 0xa2, 0x38, 0x95, 0xc0, 0xca, 0xf5, 0x54, 0x15, 0xb4, 0x20, 0x1f, 0x37, 0x9a, 0x4a, 0xe6,
+	0x7f, 0xa8, 0xc4, 0x99, 0x9a, 0xe0, 0x28, 0x4e, 0xea, 0x38, 0x2b, 0x64, 0xec, 0xca, 0xf1, 0x86,
+	0x4a, 0x8b, 0xb9, 0x7a, 0xb1, 0xe5, 0xcb, 0x9a, 0xc3, 0x7b, 0xcd, 0xea, 0xa5, 0x2d, 0xa4, 0xe6,
+	0xdb, 0x90, 0x67, 0xde, 0xc0, 0x77, 0xf8, 0x24, 0x24, 0xaa, 0xa4, 0x66, 0x82, 0xc6, 0x9f, 0x34,
+	0xc8, 0xca, 0x12, 0x4d, 0xf0, 0xa6, 0x2d, 0xe7, 0x2d, 0xb5, 0x8a, 0xb7, 0xf4, 0xeb, 0xf3, 0xb6,
+	0x0f, 0x10, 0x3b, 0xc3, 0x2a, 0x7a, 0x3d, 0xbd, 0x53, 0xd8, 0x7b, 0xb8, 0x68, 0x48, 0xba, 0xd8,
+	0xf1, 0x06, 0xea, 0x05, 0x4a, 0x28, 0x35, 0xfe, 0xa6, 0x41, 0x3e, 0xde, 0x37, 0xf7, 0xa1, 0x14,
+	0xf9, 0x65, 0x5f, 0x8c, 0x9c, 0x81, 0xca, 0x9d, 0x47, 0x2b, 0x9d, 0xfb, 0x70, 0xe4, 0x0c, 0xac,
+	0x82, 0xf2, 0x47, 0x2c, 0x96, 0xc7, 0x21, 0xb5, 0x22, 0x0e, 0x73, 0x81, 0x4f, 0xbf, 0x5e, 0xe0,
+	0xe7, 0x42, 0xa4, 0xdf, 0x0d, 0xd1, 0xef, 0xd2, 0x60, 0x9c, 0xe3, 0xa3, 0xe0, 0x8c, 0xfe, 0x1f,
+	0x15, 0xf1, 0x10, 0xf2, 0x01, 0x1d, 0xd9, 0x72, 0x47, 0xc7, 0x1d, 0x23, 0xa0, 0x23, 0x6b, 0x21,
+	0xec, 0x99, 0x37, 0x54, 0x2e, 0xd9, 0x37, 0xc0, 0x5a, 0xee, 0x0e, 0x6b, 0xe6, 0x2f, 0xa1, 0x42,
+	0xd4, 0x24, 0x65, 0xdf, 0xed, 0xfb, 0x06, 0x1e, 0xf8, 0xce, 0xa2, 0xe3, 0x4b, 0x67, 0x2f, 0xeb,
+	0x1e, 0x59, 0x26, 0x6e, 0x84, 0x50, 0x94, 0x64, 0xcb, 0xb5, 0xf9, 0x58, 0xb0, 0x9c, 0x98, 0xcc,
+	0x2a, 0x8b, 0xf6, 0x95, 0xc1, 0xec, 0x30, 0xd6, 0x90, 0x5d, 0xb3, 0x92, 0x5a, 0xa5, 0x21, 0x13,
+	0xdb, 0x52, 0xb8, 0xc6, 0xef, 0x35, 0x80, 0x63, 0x11, 0x3b, 0x64, 0x54, 0x34, 0x70, 0x86, 0x2e,
+	0xcc, 0xcf, 0x84, 0xb5, 0x55, 0x69, 0xa1, 0xce, 0x2f, 0xb2, 0xa4, 0xdf, 0x07, 0x50, 0x9a, 0xa5,
+	0x3b, 0x23, 0x91, 0x33, 0x4b, 0x8c, 0xc4, 0x7d, 0xb5, 0x43, 0xb8, 0x55, 0xbc, 0x4c, 0xac, 0x1a,
+	0x7f, 0xd6, 0x20, 0x8f, 0x3e, 0x9d, 0x10, 0xee, 0xcc, 0x65, 0x89, 0xf6, 0xfa, 0x59, 0xf2, 0x08,
+	0x40, 0x9a, 0xc1, 0xfe, 0x26, 0x73, 0x37, 0x8f, 0x12, 0x6c, 0x6f, 0x3f, 0x8a, 0x09, 0x4f, 0xff,
+	0x7b, 0xc2, 0xd5, 0xa3, 0x11, 0xd1, 0xfe, 0x16, 0xe4, 0xfc, 0xc9, 0xd8, 0x16, 0xdd, 0x54, 0x97,
+	0xf5, 0xe0, 0x4f, 0xc6, 0xdd, 0x29, 0x6b, 0xfc, 0x0a, 0x72, 0xdd, 0x29, 0x4e, 0x96, 0xa2, 0x08,
+	0x42, 0x4a, 0xd5, 0x38, 0x23, 0x1b, 0xa1, 0x21, 0x04, 0xd8, 0xbd, 0x97, 0x75, 0xc1, 0xe6, 0x7f,
+	0x39, 0xb3, 0x46, 0xd3, 0xea, 0x2f, 0xa0, 0x88, 0xef, 0xf3, 0xa7, 0xa1, 0x13, 0x04, 0x24, 0x34,
+	0xd7, 0x21, 0xc5, 0xa7, 0xea, 0xa4, 0x14, 0x9f, 0xce, 0xba, 0x2a, 0xbe, 0xed, 0x38, 0x21, 0xa7,
+	0xe3, 0xae, 0xda, 0x96, 0x32, 0x71, 0x13, 0x71, 0xcf, 0xe8, 0x0d, 0xce, 0x5b, 0x59, 0xb1, 0x6c,
+	0xbb, 0x0d, 0x1b, 0xb2, 0xa2, 0xa5, 0x77, 0xa7, 0x0b, 0x76, 0xdf, 0x87, 0x4c, 0x6f, 0x44, 0x7b,
+	0xd2, 0x5e, 0x61, 0xef, 0xfe, 0xd2, 0xb8, 0xf4, 0x2c, 0x09, 0x5a, 0x7d, 0xc0, 0x37, 0x1a, 0x40,
+	0x47, 0xb8, 0x22, 0xe9, 0x8a, 0x18, 0x91, 0xd3, 0x09, 0x7e, 0x9b, 0x1f, 0x80, 0x74, 0xd6, 0xc6,
+	0x0b, 0x47, 0x07, 0x56, 0x17, 0x0f, 0x3c, 0x3d, 0xe9, 0x4a, 0x6a, 0x0a, 0x2c, 0xb6, 0xc8, 0x16,
+	0xa6, 0x91, 0xf4, 0xe2, 0x34, 0xf2, 0x63, 0x11, 0xa4, 0x2b, 0x69, 0x3f, 0x1e, 0x7f, 0x17, 0xcc,
+	0x5b, 0xf4, 0x4a, 0x9a, 0x37, 0x42, 0xf5, 0xb5, 0x7c, 0x1a, 0xc9, 0xac, 0x98, 0x46, 0xbe, 0xd4,
+	0xc0, 0x88, 0x6c, 0xc8, 0xbc, 0xb8, 0xb2, 0x45, 0x2a, 0x44, 0xb3, 0x98, 0x30, 0x6b, 0x89, 0xb5,
+	0xa8, 0xe7, 0xb9, 0xbb, 0xae, 0x4e, 0x02, 0x85, 0x13, 0xbc, 0x09, 0x53, 0xea, 0x72, 0xf8, 0x2d,
+	0x8e, 0x60, 0x5c, 0xbc, 0x58, 0x21, 0xbd, 0x52, 0x23, 0x92, 0x81, 0x02, 0x8b, 0x5e, 0x89, 0x80,
+	0x10, 0xdf, 0xc5, 0x2d, 0xe9, 0x6f, 0x96, 0xf8, 0xae, 0x45, 0xaf, 0x1a, 0x04, 0x8c, 0x88, 0x47,
+	0xf1, 0xae, 0xa3, 0x02, 0x86, 0x3d, 0x63, 0xc9, 0x85, 0x18, 0x20, 0x49, 0xdc, 0xc5, 0xc5, 0xa7,
+	0xc0, 0xf9, 0xd4, 0x25, 0xac, 0x92, 0xc6, 0x8b, 0xc8, 0x85, 0x38, 0x7f, 0x44, 0x9c, 0x0b, 0x99,
+	0xfa, 0xb2, 0x1b, 0x19, 0x42, 0x20, 0x52, 0xff, 0xbd, 0xbf, 0x68, 0x50, 0x48, 0x34, 0x4e, 0xf3,
+	0x07, 0x70, 0xaf, 0x75, 0x7c, 0x76, 0xf0, 0xb1, 0xdd, 0x3e, 0xb4, 0x3f, 0x3c, 0xde, 0x7f, 0x6a,
+	0x7f, 0x72, 0xfa, 0xf1, 0xe9, 0xd9, 0xa7, 0xa7, 0xe5, 0xb5, 0xea, 0xfd, 0x9b, 0xdb, 0xba, 0x99,
+	0xc0, 0x7e, 0xe2, 0x7f, 0xe6, 0xd3, 0x2b, 0xdf, 0xdc, 0x85, 0xad, 0x79, 0x95, 0xfd, 0x56, 0xe7,
+	0xe8, 0xb4, 0x5b, 0xd6, 0xaa, 0xf7, 0x6e, 0x6e, 0xeb, 0x9b, 0x09, 0x8d, 0xfd, 0x1e, 0x23, 0x3e,
+	0x5f, 0x54, 0x38, 0x38, 0x3b, 0x39, 0x69, 0x77, 0xcb, 0xa9, 0x05, 0x05, 0x35, 0xc9, 0xbc, 0x0b,
+	0x9b, 0xf3, 0x0a, 0xa7, 0xed, 0xe3, 0x72, 0xba, 0x6a, 0xde, 0xdc, 0xd6, 0xd7, 0x13, 0xe8, 0x53,
+	0x6f, 0x54, 0x35, 0x7e, 0xf3, 0x65, 0x6d, 0xed, 0x8f, 0x7f, 0xa8, 0x69, 0xe2, 0x66, 0xa5, 0xb9,
+	0xe6, 0x69, 0xbe, 0x0f, 0x6f, 0x75, 0xda, 0x4f, 0x4f, 0x8f, 0x0e, 0xed, 0x93, 0xce, 0x53, 0xbb,
+	0xfb, 0xb3, 0xf3, 0xa3, 0xc4, 0xed, 0x36, 0x6e, 0x6e, 0xeb, 0x05, 0x75, 0xa5, 0x55, 0xe8, 0x73,
+	0xeb, 0xe8, 0xf9, 0x59, 0xf7, 0xa8, 0xac, 0x49, 0xf4, 0x79, 0x48, 0x2e, 0x29, 0x27, 0x88, 0x7e,
+	0x0c, 0x0f, 0x96, 0xa0, 0xe3, 0x8b, 0x6d, 0xde, 0xdc, 0xd6, 0x4b, 0xe7, 0x21, 0x91, 0xcf, 0x3e,
+	0x6a, 0x34, 0xa1, 0xb2, 0xa8, 0x71, 0x76, 0x7e, 0xd6, 0xd9, 0x3f, 0x2e, 0xd7, 0xab, 0xe5, 0x9b,
+	0xdb, 0x7a, 0x31, 0x9a, 0x12, 0x04, 0x7e, 0x76, 0xb3, 0xd6, 0xc9, 0x57, 0x2f, 0x6b, 0xda, 0x8b,
+	0x97, 0x35, 0xed, 0x1f, 0x2f, 0x6b, 0xda, 0x17, 0xaf, 0x6a, 0x6b, 0x2f, 0x5e, 0xd5, 0xd6, 0xfe,
+	0xfa, 0xaa, 0xb6, 0xf6, 0xf3, 0x27, 0x03, 0x8f, 0x0f, 0x27, 0xbd, 0x66, 0x9f, 0x8e, 0x77, 0xfb,
+	0x74, 0x4c, 0x78, 0xef, 0x82, 0xcf, 0x3e, 0xe4, 0x7f, 0x2b, 0x77, 0xff, 0xef, 0xe8, 0x65, 0x51,
+	0xfe, 0xe4, 0x5f, 0x03, 0x00, 0x7a, 0x50, 0xa5, 0x26, 0xb0, 0x11, 0x00, 0x00,
 }
 
 func (m *PartSetHeader) Marshal() (dAtA []byte, err error) {
@@ -1664,6 +1749,56 @@ func (m *PartSetHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ExtendedPartSetHeader) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExtendedPartSetHeader) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExtendedPartSetHeader) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastPartSize != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.LastPartSize))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ParityHash) > 0 {
+		i -= len(m.ParityHash)
+		copy(dAtA[i:], m.ParityHash)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ParityHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ParityTotal != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.ParityTotal))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.PartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Part) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1842,12 +1977,12 @@ func (m *Header) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	}
 	i--
 	dAtA[i] = 0x2a
-	n4, err4 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
-	if err4 != nil {
-		return 0, err4
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
 	}
-	i -= n4
-	i = encodeVarintTypes(dAtA, i, uint64(n4))
+	i -= n5
+	i = encodeVarintTypes(dAtA, i, uint64(n5))
 	i--
 	dAtA[i] = 0x22
 	if m.Height != 0 {
@@ -2005,12 +2140,12 @@ func (m *Vote) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x32
 	}
-	n6, err6 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err6 != nil {
-		return 0, err6
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err7 != nil {
+		return 0, err7
 	}
-	i -= n6
-	i = encodeVarintTypes(dAtA, i, uint64(n6))
+	i -= n7
+	i = encodeVarintTypes(dAtA, i, uint64(n7))
 	i--
 	dAtA[i] = 0x2a
 	{
@@ -2125,12 +2260,12 @@ func (m *CommitSig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	n9, err9 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err9 != nil {
-		return 0, err9
+	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n9
-	i = encodeVarintTypes(dAtA, i, uint64(n9))
+	i -= n10
+	i = encodeVarintTypes(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0x1a
 	if len(m.ValidatorAddress) > 0 {
@@ -2168,6 +2303,18 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExtendedPartSetHeader != nil {
+		{
+			size, err := m.ExtendedPartSetHeader.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Signature) > 0 {
 		i -= len(m.Signature)
 		copy(dAtA[i:], m.Signature)
@@ -2175,12 +2322,12 @@ func (m *Proposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	n10, err10 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
-	if err10 != nil {
-		return 0, err10
+	n12, err12 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Timestamp, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Timestamp):])
+	if err12 != nil {
+		return 0, err12
 	}
-	i -= n10
-	i = encodeVarintTypes(dAtA, i, uint64(n10))
+	i -= n12
+	i = encodeVarintTypes(dAtA, i, uint64(n12))
 	i--
 	dAtA[i] = 0x32
 	{
@@ -2440,20 +2587,20 @@ func (m *IndexWrapper) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		dAtA[i] = 0x1a
 	}
 	if len(m.ShareIndexes) > 0 {
-		dAtA20 := make([]byte, len(m.ShareIndexes)*10)
-		var j19 int
+		dAtA22 := make([]byte, len(m.ShareIndexes)*10)
+		var j21 int
 		for _, num := range m.ShareIndexes {
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA22[j21] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j21++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA22[j21] = uint8(num)
+			j21++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintTypes(dAtA, i, uint64(j19))
+		i -= j21
+		copy(dAtA[i:], dAtA22[:j21])
+		i = encodeVarintTypes(dAtA, i, uint64(j21))
 		i--
 		dAtA[i] = 0x12
 	}
@@ -2727,6 +2874,27 @@ func (m *PartSetHeader) Size() (n int) {
 	return n
 }
 
+func (m *ExtendedPartSetHeader) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PartSetHeader.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.ParityTotal != 0 {
+		n += 1 + sovTypes(uint64(m.ParityTotal))
+	}
+	l = len(m.ParityHash)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.LastPartSize != 0 {
+		n += 1 + sovTypes(uint64(m.LastPartSize))
+	}
+	return n
+}
+
 func (m *Part) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2967,6 +3135,10 @@ func (m *Proposal) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.ExtendedPartSetHeader != nil {
+		l = m.ExtendedPartSetHeader.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
 	return n
 }
 
@@ -3288,6 +3460,161 @@ func (m *PartSetHeader) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ExtendedPartSetHeader) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExtendedPartSetHeader: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExtendedPartSetHeader: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PartSetHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParityTotal", wireType)
+			}
+			m.ParityTotal = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParityTotal |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParityHash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ParityHash = append(m.ParityHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ParityHash == nil {
+				m.ParityHash = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPartSize", wireType)
+			}
+			m.LastPartSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastPartSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *Part) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -5128,6 +5455,42 @@ func (m *Proposal) Unmarshal(dAtA []byte) error {
 				m.Signature = []byte{}
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtendedPartSetHeader", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExtendedPartSetHeader == nil {
+				m.ExtendedPartSetHeader = &ExtendedPartSetHeader{}
+			}
+			if err := m.ExtendedPartSetHeader.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -98,6 +98,60 @@ func TestBlockEvents(t *testing.T) {
 	}
 }
 
+// subscribe to new blocks from the first height, then resume from a cursor
+func TestSubscribeFromEvents(t *testing.T) {
+	c := getHTTPClient()
+	require.NoError(t, c.Start())
+	t.Cleanup(func() {
+		if err := c.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	const subscriber = "TestSubscribeFromEvents"
+	query := types.QueryForEvent(types.EventNewBlock).String()
+
+	// wait for a few blocks to replay
+	status, err := c.Status(context.Background())
+	require.NoError(t, err)
+	err = client.WaitForHeight(c, status.SyncInfo.LatestBlockHeight+2, nil)
+	require.NoError(t, err)
+
+	receive := func(eventCh <-chan ctypes.ResultEvent, height int64) {
+		select {
+		case event := <-eventCh:
+			blockEvent, ok := event.Data.(types.EventDataNewBlock)
+			require.True(t, ok)
+			require.Equal(t, height, blockEvent.Block.Height)
+			require.Equal(t, fmt.Sprintf("%d/0", height), event.Cursor)
+		case <-time.After(waitForEventTimeout):
+			t.Fatalf("timed out waiting for the block at height %d", height)
+		}
+	}
+
+	// the stored blocks are replayed, followed by the new ones
+	eventCh, err := c.SubscribeFrom(context.Background(), subscriber, query, 1, "", 100)
+	require.NoError(t, err)
+	latest := status.SyncInfo.LatestBlockHeight + 2
+	for height := int64(1); height <= latest+1; height++ {
+		receive(eventCh, height)
+	}
+	require.NoError(t, c.UnsubscribeAll(context.Background(), subscriber))
+
+	// a new client resumes after the event with the given cursor
+	resumed := getHTTPClient()
+	require.NoError(t, resumed.Start())
+	t.Cleanup(func() {
+		if err := resumed.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	eventCh, err = resumed.SubscribeFrom(context.Background(), subscriber, query, 0, "2/0", 100)
+	require.NoError(t, err)
+	receive(eventCh, 3)
+	receive(eventCh, 4)
+}
+
 func TestTxEventsSentWithBroadcastTxAsync(t *testing.T) { testTxEventsSent(t, "async") }
 func TestTxEventsSentWithBroadcastTxSync(t *testing.T)  { testTxEventsSent(t, "sync") }
 
@@ -0,0 +1,307 @@
+package grpc
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	"github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/p2p"
+	rpcclient "github.com/cometbft/cometbft/rpc/client"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	coregrpc "github.com/cometbft/cometbft/rpc/grpc"
+	"github.com/cometbft/cometbft/types"
+)
+
+/*
+Client is a client of the BlockAPI and TxAPI gRPC services of a CometBFT node.
+It returns the same results as the JSON RPC clients, decoded from the
+protobuf responses, and streams new blocks and headers.
+
+Request parameters follow the JSON RPC clients, a nil height requests the
+latest height.
+
+	c, err := grpc.New("tcp://127.0.0.1:9090")
+	if err != nil {
+		// handle error
+	}
+	defer c.Close()
+
+	res, err := c.Block(ctx, nil)
+*/
+type Client struct {
+	conn  *grpc.ClientConn
+	block coregrpc.BlockAPIClient
+	tx    coregrpc.TxAPIClient
+}
+
+var _ rpcclient.StatusClient = (*Client)(nil)
+
+// New returns a client connected to the gRPC server listening on protoAddr,
+// which is the grpc_laddr of the node.
+func New(protoAddr string) (*Client, error) {
+	conn, err := coregrpc.Dial(protoAddr)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{
+		conn:  conn,
+		block: coregrpc.NewBlockAPIClient(conn),
+		tx:    coregrpc.NewTxAPIClient(conn),
+	}, nil
+}
+
+// Close closes the connection to the server.
+func (c *Client) Close() error {
+	return c.conn.Close()
+}
+
+func (c *Client) Block(ctx context.Context, height *int64) (*ctypes.ResultBlock, error) {
+	res, err := c.block.BlockByHeight(ctx, &coregrpc.RequestBlockByHeight{Height: heightValue(height)})
+	if err != nil {
+		return nil, err
+	}
+	return resultBlock(res)
+}
+
+func (c *Client) BlockByHash(ctx context.Context, hash []byte) (*ctypes.ResultBlock, error) {
+	res, err := c.block.BlockByHash(ctx, &coregrpc.RequestBlockByHash{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return resultBlock(res)
+}
+
+func (c *Client) Header(ctx context.Context, height *int64) (*ctypes.ResultHeader, error) {
+	res, err := c.block.HeaderByHeight(ctx, &coregrpc.RequestHeaderByHeight{Height: heightValue(height)})
+	if err != nil {
+		return nil, err
+	}
+	return resultHeader(res)
+}
+
+func (c *Client) HeaderByHash(ctx context.Context, hash bytes.HexBytes) (*ctypes.ResultHeader, error) {
+	res, err := c.block.HeaderByHash(ctx, &coregrpc.RequestHeaderByHash{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return resultHeader(res)
+}
+
+func (c *Client) Commit(ctx context.Context, height *int64) (*ctypes.ResultCommit, error) {
+	res, err := c.block.Commit(ctx, &coregrpc.RequestCommit{Height: heightValue(height)})
+	if err != nil {
+		return nil, err
+	}
+	signedHeader, err := types.SignedHeaderFromProto(res.SignedHeader)
+	if err != nil {
+		return nil, err
+	}
+	return ctypes.NewResultCommit(signedHeader.Header, signedHeader.Commit, res.Canonical), nil
+}
+
+func (c *Client) BlockResults(ctx context.Context, height *int64) (*ctypes.ResultBlockResults, error) {
+	res, err := c.block.BlockResults(ctx, &coregrpc.RequestBlockResults{Height: heightValue(height)})
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultBlockResults{
+		Height:                res.Height,
+		TxsResults:            res.TxsResults,
+		BeginBlockEvents:      res.BeginBlockEvents,
+		EndBlockEvents:        res.EndBlockEvents,
+		ValidatorUpdates:      res.ValidatorUpdates,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+	}, nil
+}
+
+func (c *Client) Validators(ctx context.Context, height *int64, page, perPage *int) (*ctypes.ResultValidators, error) {
+	req := &coregrpc.RequestValidators{Height: heightValue(height)}
+	if page != nil {
+		req.Page = int32(*page)
+	}
+	if perPage != nil {
+		req.PerPage = int32(*perPage)
+	}
+	res, err := c.block.Validators(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	validators := make([]*types.Validator, len(res.Validators))
+	for i, val := range res.Validators {
+		if validators[i], err = types.ValidatorFromProto(val); err != nil {
+			return nil, err
+		}
+	}
+	return &ctypes.ResultValidators{
+		BlockHeight: res.BlockHeight,
+		Validators:  validators,
+		Count:       int(res.Count),
+		Total:       int(res.Total),
+	}, nil
+}
+
+func (c *Client) Status(ctx context.Context) (*ctypes.ResultStatus, error) {
+	res, err := c.block.Status(ctx, &coregrpc.RequestStatus{})
+	if err != nil {
+		return nil, err
+	}
+	result := &ctypes.ResultStatus{}
+	if res.NodeInfo != nil {
+		if result.NodeInfo, err = p2p.DefaultNodeInfoFromToProto(res.NodeInfo); err != nil {
+			return nil, err
+		}
+	}
+	if syncInfo := res.SyncInfo; syncInfo != nil {
+		result.SyncInfo = ctypes.SyncInfo{
+			LatestBlockHash:     syncInfo.LatestBlockHash,
+			LatestAppHash:       syncInfo.LatestAppHash,
+			LatestBlockHeight:   syncInfo.LatestBlockHeight,
+			LatestBlockTime:     syncInfo.LatestBlockTime,
+			EarliestBlockHash:   syncInfo.EarliestBlockHash,
+			EarliestAppHash:     syncInfo.EarliestAppHash,
+			EarliestBlockHeight: syncInfo.EarliestBlockHeight,
+			EarliestBlockTime:   syncInfo.EarliestBlockTime,
+			CatchingUp:          syncInfo.CatchingUp,
+		}
+	}
+	if validatorInfo := res.ValidatorInfo; validatorInfo != nil {
+		result.ValidatorInfo = ctypes.ValidatorInfo{
+			Address:     validatorInfo.Address,
+			VotingPower: validatorInfo.VotingPower,
+		}
+		if validatorInfo.PubKey != nil {
+			if result.ValidatorInfo.PubKey, err = cryptoenc.PubKeyFromProto(*validatorInfo.PubKey); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return result, nil
+}
+
+func (c *Client) Tx(ctx context.Context, hash []byte, prove bool) (*ctypes.ResultTx, error) {
+	res, err := c.tx.Tx(ctx, &coregrpc.RequestTx{Hash: hash, Prove: prove})
+	if err != nil {
+		return nil, err
+	}
+	result := &ctypes.ResultTx{
+		Hash:     res.Hash,
+		Height:   res.Height,
+		Index:    res.Index,
+		TxResult: res.TxResult,
+		Tx:       res.Tx,
+	}
+	if res.Proof != nil {
+		if result.Proof, err = types.ShareProofFromProto(*res.Proof); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func (c *Client) TxStatus(ctx context.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
+	res, err := c.tx.TxStatus(ctx, &coregrpc.RequestTxStatus{Hash: hash})
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultTxStatus{
+		Height:        res.Height,
+		Index:         res.Index,
+		ExecutionCode: res.ExecutionCode,
+		Status:        res.Status,
+		CheckTxCode:   res.CheckTxCode,
+		CheckTxLog:    res.CheckTxLog,
+	}, nil
+}
+
+// SubscribeNewBlocks streams the blocks committed after the call. The channel
+// is closed once ctx is cancelled or the stream fails, in which case the
+// caller can subscribe again and fetch the missed blocks with Block.
+func (c *Client) SubscribeNewBlocks(ctx context.Context) (<-chan *ctypes.ResultBlock, error) {
+	stream, err := c.block.SubscribeNewBlocks(ctx, &coregrpc.RequestSubscribeNewBlocks{})
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan *ctypes.ResultBlock)
+	go func() {
+		defer close(out)
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			block, err := resultBlock(res)
+			if err != nil {
+				return
+			}
+			select {
+			case out <- block:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+// SubscribeNewHeaders streams the headers of the blocks committed after the
+// call. The channel is closed once ctx is cancelled or the stream fails.
+func (c *Client) SubscribeNewHeaders(ctx context.Context) (<-chan *types.Header, error) {
+	stream, err := c.block.SubscribeNewHeaders(ctx, &coregrpc.RequestSubscribeNewHeaders{})
+	if err != nil {
+		return nil, err
+	}
+	out := make(chan *types.Header)
+	go func() {
+		defer close(out)
+		for {
+			res, err := stream.Recv()
+			if err != nil {
+				return
+			}
+			header, err := resultHeader(res)
+			if err != nil {
+				return
+			}
+			select {
+			case out <- header.Header:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out, nil
+}
+
+func resultBlock(res *coregrpc.ResponseBlock) (*ctypes.ResultBlock, error) {
+	blockID, err := types.BlockIDFromProto(&res.BlockID)
+	if err != nil {
+		return nil, err
+	}
+	result := &ctypes.ResultBlock{BlockID: *blockID}
+	if res.Block != nil {
+		if result.Block, err = types.BlockFromProto(res.Block); err != nil {
+			return nil, err
+		}
+	}
+	return result, nil
+}
+
+func resultHeader(res *coregrpc.ResponseHeader) (*ctypes.ResultHeader, error) {
+	if res.Header == nil {
+		return &ctypes.ResultHeader{}, nil
+	}
+	header, err := types.HeaderFromProto(res.Header)
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultHeader{Header: &header}, nil
+}
+
+func heightValue(height *int64) int64 {
+	if height == nil {
+		return 0
+	}
+	return *height
+}
@@ -0,0 +1,99 @@
+package client_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	rpcgrpc "github.com/cometbft/cometbft/rpc/client/grpc"
+	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
+)
+
+func getGRPCClient(t *testing.T) *rpcgrpc.Client {
+	c, err := rpcgrpc.New(rpctest.GetConfig().RPC.GRPCListenAddress)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+// TestGRPCClient checks that the gRPC client returns the same results as the
+// JSON RPC client.
+func TestGRPCClient(t *testing.T) {
+	c, httpClient := getGRPCClient(t), getHTTPClient()
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	tx := []byte("grpc-client=value")
+	bres, err := httpClient.BroadcastTxCommit(ctx, tx)
+	require.NoError(t, err)
+	require.True(t, bres.DeliverTx.IsOK())
+	height := bres.Height
+
+	status, err := c.Status(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, rpctest.GetConfig().Moniker, status.NodeInfo.Moniker)
+	assert.GreaterOrEqual(t, status.SyncInfo.LatestBlockHeight, height)
+	assert.NotNil(t, status.ValidatorInfo.PubKey)
+
+	block, err := c.Block(ctx, &height)
+	require.NoError(t, err)
+	expBlock, err := httpClient.Block(ctx, &height)
+	require.NoError(t, err)
+	assert.Equal(t, expBlock.BlockID, block.BlockID)
+	assert.Equal(t, expBlock.Block.Hash(), block.Block.Hash())
+
+	blockByHash, err := c.BlockByHash(ctx, block.BlockID.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, block.BlockID, blockByHash.BlockID)
+
+	header, err := c.Header(ctx, &height)
+	require.NoError(t, err)
+	assert.Equal(t, block.Block.Header, *header.Header)
+	header, err = c.HeaderByHash(ctx, block.BlockID.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, block.Block.Header, *header.Header)
+
+	commit, err := c.Commit(ctx, &height)
+	require.NoError(t, err)
+	expCommit, err := httpClient.Commit(ctx, &height)
+	require.NoError(t, err)
+	assert.Equal(t, expCommit.Commit.Hash(), commit.Commit.Hash())
+	assert.Equal(t, expCommit.CanonicalCommit, commit.CanonicalCommit)
+
+	results, err := c.BlockResults(ctx, &height)
+	require.NoError(t, err)
+	require.Len(t, results.TxsResults, 1)
+	assert.Equal(t, bres.DeliverTx.Data, results.TxsResults[0].Data)
+
+	validators, err := c.Validators(ctx, &height, nil, nil)
+	require.NoError(t, err)
+	expValidators, err := httpClient.Validators(ctx, &height, nil, nil)
+	require.NoError(t, err)
+	assert.Equal(t, expValidators.Total, validators.Total)
+	require.Len(t, validators.Validators, len(expValidators.Validators))
+	assert.Equal(t, expValidators.Validators[0].Address, validators.Validators[0].Address)
+
+	txRes, err := c.Tx(ctx, bres.Hash, false)
+	require.NoError(t, err)
+	assert.Equal(t, types.Tx(tx), txRes.Tx)
+	assert.Equal(t, height, txRes.Height)
+
+	txStatus, err := c.TxStatus(ctx, bres.Hash)
+	require.NoError(t, err)
+	assert.Equal(t, height, txStatus.Height)
+
+	headers, err := c.SubscribeNewHeaders(ctx)
+	require.NoError(t, err)
+	blocks, err := c.SubscribeNewBlocks(ctx)
+	require.NoError(t, err)
+	newHeader := <-headers
+	require.NotNil(t, newHeader)
+	assert.Greater(t, newHeader.Height, height)
+	newBlock := <-blocks
+	require.NotNil(t, newBlock)
+	assert.Greater(t, newBlock.Block.Height, height)
+}
@@ -587,6 +587,23 @@ func (c *baseRPCClient) ProveShares(
 	return result, nil
 }
 
+func (c *baseRPCClient) BlobsByNamespace(
+	ctx context.Context,
+	height uint64,
+	namespace []byte,
+) (*ctypes.ResultBlobsByNamespace, error) {
+	result := new(ctypes.ResultBlobsByNamespace)
+	params := map[string]interface{}{
+		"height":    height,
+		"namespace": namespace,
+	}
+	_, err := c.caller.Call(ctx, "blobs_by_namespace", params, result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
 func (c *baseRPCClient) TxSearch(
 	ctx context.Context,
 	query string,
@@ -696,6 +713,15 @@ type WSEvents struct {
 
 	mtx           cmtsync.RWMutex
 	subscriptions map[string]chan ctypes.ResultEvent // query -> chan
+	resumable     map[string]*resumePoint            // query -> where to resume
+}
+
+// resumePoint is where a subscription made with SubscribeFrom resumes after
+// reconnecting: the event following cursor or, if no event was received yet,
+// the events since height.
+type resumePoint struct {
+	height int64
+	cursor string
 }
 
 func newWSEvents(remote, endpoint string) (*WSEvents, error) {
@@ -703,6 +729,7 @@ func newWSEvents(remote, endpoint string) (*WSEvents, error) {
 		endpoint:      endpoint,
 		remote:        remote,
 		subscriptions: make(map[string]chan ctypes.ResultEvent),
+		resumable:     make(map[string]*resumePoint),
 	}
 	w.BaseService = *service.NewBaseService(nil, "WSEvents", w)
 
@@ -770,6 +797,39 @@ func (w *WSEvents) Subscribe(ctx context.Context, subscriber, query string,
 	return outc, nil
 }
 
+// SubscribeFrom is like Subscribe, but first receives the NewBlock and Tx
+// events matching the query since the given height, or following the event
+// with the given cursor if it is not empty. After reconnecting, the
+// subscription resumes from the last event received, so that no events are
+// missed. Events are never dropped, so the channel must be drained to keep
+// receiving the events of other subscriptions.
+//
+// It returns an error if WSEvents is not running.
+func (w *WSEvents) SubscribeFrom(ctx context.Context, subscriber, query string, height int64, cursor string,
+	outCapacity ...int) (out <-chan ctypes.ResultEvent, err error) {
+
+	if !w.IsRunning() {
+		return nil, errNotRunning
+	}
+
+	if err := w.ws.SubscribeFrom(ctx, query, height, cursor); err != nil {
+		return nil, err
+	}
+
+	outCap := 1
+	if len(outCapacity) > 0 {
+		outCap = outCapacity[0]
+	}
+
+	outc := make(chan ctypes.ResultEvent, outCap)
+	w.mtx.Lock()
+	w.subscriptions[query] = outc
+	w.resumable[query] = &resumePoint{height: height, cursor: cursor}
+	w.mtx.Unlock()
+
+	return outc, nil
+}
+
 // Unsubscribe implements EventsClient by using WSClient to unsubscribe given
 // subscriber from query.
 //
@@ -788,6 +848,7 @@ func (w *WSEvents) Unsubscribe(ctx context.Context, subscriber, query string) er
 	if ok {
 		delete(w.subscriptions, query)
 	}
+	delete(w.resumable, query)
 	w.mtx.Unlock()
 
 	return nil
@@ -808,6 +869,7 @@ func (w *WSEvents) UnsubscribeAll(ctx context.Context, subscriber string) error
 
 	w.mtx.Lock()
 	w.subscriptions = make(map[string]chan ctypes.ResultEvent)
+	w.resumable = make(map[string]*resumePoint)
 	w.mtx.Unlock()
 
 	return nil
@@ -821,7 +883,12 @@ func (w *WSEvents) redoSubscriptionsAfter(d time.Duration) {
 	w.mtx.RLock()
 	defer w.mtx.RUnlock()
 	for q := range w.subscriptions {
-		err := w.ws.Subscribe(context.Background(), q)
+		var err error
+		if p, ok := w.resumable[q]; ok {
+			err = w.ws.SubscribeFrom(context.Background(), q, p.height, p.cursor)
+		} else {
+			err = w.ws.Subscribe(context.Background(), q)
+		}
 		if err != nil {
 			w.Logger.Error("Failed to resubscribe", "err", err)
 		}
@@ -861,9 +928,20 @@ func (w *WSEvents) eventListener() {
 				continue
 			}
 
+			if result.Cursor != "" {
+				w.mtx.Lock()
+				if p, ok := w.resumable[result.Query]; ok {
+					p.cursor = result.Cursor
+				}
+				w.mtx.Unlock()
+			}
+
 			w.mtx.RLock()
 			if out, ok := w.subscriptions[result.Query]; ok {
-				if cap(out) == 0 {
+				// events of resumable subscriptions are never dropped, as the
+				// cursor would move past them.
+				_, resumable := w.resumable[result.Query]
+				if cap(out) == 0 || resumable {
 					out <- *result
 				} else {
 					select {
@@ -219,6 +219,14 @@ func (c *Local) ProveShares(
 	return core.ProveShares(c.ctx, int64(height), startShare, endShare)
 }
 
+func (c *Local) BlobsByNamespace(
+	ctx context.Context,
+	height uint64,
+	namespace []byte,
+) (*ctypes.ResultBlobsByNamespace, error) {
+	return core.BlobsByNamespace(c.ctx, int64(height), namespace)
+}
+
 func (c *Local) TxSearch(
 	_ context.Context,
 	query string,
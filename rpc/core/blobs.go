@@ -0,0 +1,160 @@
+package core
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cometbft/cometbft/pkg/consts"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+// BlobsByNamespace returns every blob of the given namespace included in the
+// block at the given height, in the order they appear in the block, along with
+// the range of shares they occupy and an NMT proof of those shares to the data
+// root of the block. The namespace is the namespace version followed by the
+// namespace ID.
+func BlobsByNamespace(
+	_ *rpctypes.Context,
+	height int64,
+	namespace []byte,
+) (*ctypes.ResultBlobsByNamespace, error) {
+	if len(namespace) != consts.NamespaceSize {
+		return nil, fmt.Errorf("namespace must be %d bytes, got %d", consts.NamespaceSize, len(namespace))
+	}
+
+	env := GetEnvironment()
+	height, err := getHeight(env.BlockStore.Height(), &height)
+	if err != nil {
+		return nil, err
+	}
+	block := env.BlockStore.LoadBlock(height)
+	if block == nil {
+		return nil, fmt.Errorf("no block found for height %d", height)
+	}
+
+	var (
+		rawBlock []byte
+		blobs    = []ctypes.NamespacedBlob{}
+	)
+	for txIndex, tx := range block.Txs {
+		bTx, isBlob := types.UnmarshalBlobTx(tx)
+		if !isBlob {
+			continue
+		}
+		matching := blobsInNamespace(bTx.Blobs, namespace)
+		if len(matching) == 0 {
+			continue
+		}
+
+		// the raw block is only needed by the application if the namespace
+		// has blobs in the block.
+		if rawBlock == nil {
+			rawBlock, err = loadRawBlock(env.BlockStore, height)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		startIndexes, err := blobShareIndexes(rawBlock, uint32(txIndex), bTx)
+		if err != nil {
+			return nil, fmt.Errorf("failed to find the shares of the blobs of tx %d: %w", txIndex, err)
+		}
+		for _, i := range matching {
+			blob := bTx.Blobs[i]
+			shares, err := types.SparseSharesNeeded(len(blob.Data), blob.ShareVersion)
+			if err != nil {
+				return nil, fmt.Errorf("blob %d of tx %d: %w", i, txIndex, err)
+			}
+			start := uint64(startIndexes[i])
+			end := start + shares
+
+			proof, err := queryShareProof(rawBlock, fmt.Sprintf(consts.ShareInclusionProofQueryPath, start, end))
+			if err != nil {
+				return nil, err
+			}
+			if err := checkBlobProof(proof, block.DataHash, namespace, start, end); err != nil {
+				return nil, fmt.Errorf("invalid proof for blob %d of tx %d: %w", i, txIndex, err)
+			}
+
+			blobs = append(blobs, ctypes.NamespacedBlob{
+				TxIndex:      uint32(txIndex),
+				Data:         blob.Data,
+				ShareVersion: blob.ShareVersion,
+				StartShare:   start,
+				EndShare:     end,
+				Proof:        proof,
+			})
+		}
+	}
+
+	return &ctypes.ResultBlobsByNamespace{
+		Height:    height,
+		Namespace: namespace,
+		Blobs:     blobs,
+	}, nil
+}
+
+// checkBlobProof checks that the proof of the application is valid for the
+// data root, and that it proves the shares [start, end) of the namespace.
+// VerifyProof alone only shows that the proven shares are in the data root,
+// whichever shares they are.
+func checkBlobProof(proof types.ShareProof, dataRoot, namespace []byte, start, end uint64) error {
+	if !proof.VerifyProof(dataRoot) {
+		return fmt.Errorf("the shares are not included in the data root")
+	}
+	proofStart, proofEnd, err := proof.ShareRange()
+	if err != nil {
+		return err
+	}
+	if proofStart != start || proofEnd != end {
+		return fmt.Errorf("expected shares [%d, %d), got [%d, %d)", start, end, proofStart, proofEnd)
+	}
+	proofNamespace, err := proof.Namespace()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(proofNamespace, namespace) {
+		return fmt.Errorf("expected shares of namespace %X, got %X", namespace, proofNamespace)
+	}
+	return nil
+}
+
+// blobsInNamespace returns the indexes of the blobs in the namespace.
+func blobsInNamespace(blobs []*cmtproto.Blob, namespace []byte) []int {
+	var indexes []int
+	for i, blob := range blobs {
+		if blob.NamespaceVersion == uint32(namespace[0]) && bytes.Equal(blob.NamespaceId, namespace[1:]) {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// blobShareIndexes returns the index of the first share of each blob of the
+// blob tx. The square layout is only known to the application, so the shares
+// of the PayForBlobs transaction are requested using the tx inclusion proof,
+// and the share indexes are read from the IndexWrapper stored in them.
+func blobShareIndexes(rawBlock []byte, txIndex uint32, bTx cmtproto.BlobTx) ([]uint32, error) {
+	pfbProof, err := queryShareProof(rawBlock, fmt.Sprintf(consts.TxInclusionProofQueryPath, txIndex))
+	if err != nil {
+		return nil, err
+	}
+	units, err := types.ParseCompactShares(pfbProof.Data)
+	if err != nil {
+		return nil, err
+	}
+	for _, unit := range units {
+		indexWrapper, isIndexWrapper := types.UnmarshalIndexWrapper(unit)
+		if !isIndexWrapper || !bytes.Equal(indexWrapper.Tx, bTx.Tx) {
+			continue
+		}
+		if len(indexWrapper.ShareIndexes) != len(bTx.Blobs) {
+			return nil, fmt.Errorf("expected %d share indexes, got %d", len(bTx.Blobs), len(indexWrapper.ShareIndexes))
+		}
+		return indexWrapper.ShareIndexes, nil
+	}
+	return nil, fmt.Errorf("PayForBlobs transaction not found in its shares")
+}
@@ -0,0 +1,265 @@
+package core
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/celestiaorg/nmt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+	"github.com/cometbft/cometbft/pkg/consts"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
+)
+
+const testSquareWidth = 4
+
+var (
+	pfbNamespace     = testNamespace(0, 4)
+	namespaceA       = testNamespace(0, 0xA)
+	namespaceB       = testNamespace(0, 0xB)
+	namespaceC       = testNamespace(0, 0xC)
+	tailPadNamespace = append([]byte{255}, bytes.Repeat([]byte{0xFF}, consts.NamespaceIDSize-1)...)
+)
+
+func TestBlobsByNamespace(t *testing.T) {
+	blobA1 := &cmtproto.Blob{NamespaceId: namespaceA[1:], Data: bytes.Repeat([]byte{1}, 600)}
+	// blobB fits in a share of version 0, but not of version 1, which also
+	// stores its signer.
+	blobB := &cmtproto.Blob{
+		NamespaceId:  namespaceB[1:],
+		Data:         bytes.Repeat([]byte{2}, consts.FirstSparseShareContentSize-5),
+		ShareVersion: consts.ShareVersionOne,
+	}
+	blobA2 := &cmtproto.Blob{NamespaceId: namespaceA[1:], Data: bytes.Repeat([]byte{3}, 100)}
+
+	blobTx1, err := types.MarshalBlobTx([]byte("pfb-1"), blobA1, blobB)
+	require.NoError(t, err)
+	blobTx2, err := types.MarshalBlobTx([]byte("pfb-2"), blobA2)
+	require.NoError(t, err)
+	wrapper1, err := types.MarshalIndexWrapper([]byte("pfb-1"), 4, 8)
+	require.NoError(t, err)
+	wrapper2, err := types.MarshalIndexWrapper([]byte("pfb-2"), 6)
+	require.NoError(t, err)
+
+	// layout of the square, each row having four shares:
+	// 0: PayForBlobs, 1-3: padding, 4-5: blobA1, 6: blobA2, 7: padding,
+	// 8-9: blobB, 10-15: tail padding.
+	var shares [][]byte
+	shares = append(shares, compactShare(pfbNamespace, wrapper1, wrapper2))
+	shares = append(shares, paddingShares(pfbNamespace, 3)...)
+	shares = append(shares, sparseShares(namespaceA, blobA1.Data, 0)...)
+	shares = append(shares, sparseShares(namespaceA, blobA2.Data, 0)...)
+	shares = append(shares, paddingShares(namespaceA, 1)...)
+	shares = append(shares, sparseShares(namespaceB, blobB.Data, consts.ShareVersionOne)...)
+	shares = append(shares, paddingShares(tailPadNamespace, 6)...)
+	app := newSquareApp(t, shares)
+
+	block := types.MakeBlock(1, []types.Tx{[]byte("tx"), blobTx1, blobTx2}, nil, nil)
+	block.DataHash = app.dataRoot
+	SetEnvironment(&Environment{
+		BlockStore:    partsBlockStore{mockBlockStore{height: 1, blocks: []*types.Block{nil, block}}},
+		ProxyAppQuery: app,
+	})
+
+	res, err := BlobsByNamespace(&rpctypes.Context{}, 1, namespaceA)
+	require.NoError(t, err)
+	require.Len(t, res.Blobs, 2)
+	assert.EqualValues(t, 1, res.Blobs[0].TxIndex)
+	assert.Equal(t, blobA1.Data, res.Blobs[0].Data)
+	assert.EqualValues(t, 4, res.Blobs[0].StartShare)
+	assert.EqualValues(t, 6, res.Blobs[0].EndShare)
+	assert.EqualValues(t, 2, res.Blobs[1].TxIndex)
+	assert.EqualValues(t, 6, res.Blobs[1].StartShare)
+	assert.EqualValues(t, 7, res.Blobs[1].EndShare)
+	for _, blob := range res.Blobs {
+		assert.True(t, blob.Proof.VerifyProof(block.DataHash))
+	}
+
+	res, err = BlobsByNamespace(&rpctypes.Context{}, 1, namespaceB)
+	require.NoError(t, err)
+	require.Len(t, res.Blobs, 1)
+	assert.EqualValues(t, 8, res.Blobs[0].StartShare)
+	assert.EqualValues(t, 10, res.Blobs[0].EndShare)
+	assert.True(t, res.Blobs[0].Proof.VerifyProof(block.DataHash))
+
+	// namespaces without blobs return an empty list.
+	res, err = BlobsByNamespace(&rpctypes.Context{}, 1, namespaceC)
+	require.NoError(t, err)
+	assert.Empty(t, res.Blobs)
+
+	_, err = BlobsByNamespace(&rpctypes.Context{}, 1, namespaceA[1:])
+	assert.Error(t, err)
+	_, err = BlobsByNamespace(&rpctypes.Context{}, 2, namespaceA)
+	assert.Error(t, err)
+
+	// a valid proof of other shares than the blob's is rejected.
+	app.shift = 1
+	_, err = BlobsByNamespace(&rpctypes.Context{}, 1, namespaceA)
+	assert.Error(t, err)
+}
+
+// partsBlockStore is a mockBlockStore that also returns the parts of its
+// blocks.
+type partsBlockStore struct {
+	mockBlockStore
+}
+
+func (store partsBlockStore) LoadBlockPart(height int64, index int) *types.Part {
+	return store.LoadBlock(height).MakePartSet(types.BlockPartSizeBytes).GetPart(index)
+}
+
+// squareApp answers share proof queries for a square of shares that isn't
+// extended. The data root is the merkle root of the row roots followed by
+// stand-ins for the roots of the parity rows and of the columns, as the data
+// root of an extended square.
+type squareApp struct {
+	t         *testing.T
+	shares    [][]byte
+	rows      []*nmt.NamespacedMerkleTree
+	rowRoots  [][]byte
+	rowProofs []*merkle.Proof
+	dataRoot  []byte
+	// shift moves the range of the proven shares of the share proofs.
+	shift int
+}
+
+func newSquareApp(t *testing.T, shares [][]byte) *squareApp {
+	require.Equal(t, testSquareWidth*testSquareWidth, len(shares))
+	app := &squareApp{t: t, shares: shares}
+	for r := 0; r < testSquareWidth; r++ {
+		tree := nmt.New(sha256.New(), nmt.NamespaceIDSize(consts.NamespaceSize), nmt.IgnoreMaxNamespace(true))
+		for _, share := range shares[r*testSquareWidth : (r+1)*testSquareWidth] {
+			require.NoError(t, tree.Push(append(append([]byte{}, share[:consts.NamespaceSize]...), share...)))
+		}
+		root, err := tree.Root()
+		require.NoError(t, err)
+		app.rows = append(app.rows, tree)
+		app.rowRoots = append(app.rowRoots, root)
+	}
+	roots := append([][]byte{}, app.rowRoots...)
+	for i := testSquareWidth; i < 4*testSquareWidth; i++ {
+		roots = append(roots, []byte(fmt.Sprintf("root %d", i)))
+	}
+	app.dataRoot, app.rowProofs = merkle.ProofsFromByteSlices(roots)
+	return app
+}
+
+func (app *squareApp) Error() error { return nil }
+
+func (app *squareApp) EchoSync(string) (*abci.ResponseEcho, error) { return nil, nil }
+
+func (app *squareApp) InfoSync(abci.RequestInfo) (*abci.ResponseInfo, error) { return nil, nil }
+
+// QuerySync proves the first share for tx inclusion proofs, as all the
+// PayForBlobs transactions are stored in it.
+func (app *squareApp) QuerySync(req abci.RequestQuery) (*abci.ResponseQuery, error) {
+	var start, end int
+	switch {
+	case strings.HasPrefix(req.Path, "custom/txInclusionProof/"):
+		start, end = 0, 1
+	default:
+		_, err := fmt.Sscanf(req.Path, consts.ShareInclusionProofQueryPath, &start, &end)
+		require.NoError(app.t, err)
+		start, end = start+app.shift, end+app.shift
+	}
+
+	namespace := app.shares[start][:consts.NamespaceSize]
+	proof := types.ShareProof{
+		Data:             app.shares[start:end],
+		NamespaceID:      namespace[1:],
+		NamespaceVersion: uint32(namespace[0]),
+		RowProof: types.RowProof{
+			StartRow: uint32(start / testSquareWidth),
+			EndRow:   uint32((end - 1) / testSquareWidth),
+		},
+	}
+	for r := start / testSquareWidth; r <= (end-1)/testSquareWidth; r++ {
+		rowStart := max(start, r*testSquareWidth) - r*testSquareWidth
+		rowEnd := min(end, (r+1)*testSquareWidth) - r*testSquareWidth
+		nmtProof, err := app.rows[r].ProveRange(rowStart, rowEnd)
+		require.NoError(app.t, err)
+		proof.ShareProofs = append(proof.ShareProofs, &cmtproto.NMTProof{
+			Start: int32(nmtProof.Start()),
+			End:   int32(nmtProof.End()),
+			Nodes: nmtProof.Nodes(),
+		})
+		proof.RowProof.RowRoots = append(proof.RowProof.RowRoots, cmtbytes.HexBytes(app.rowRoots[r]))
+		proof.RowProof.Proofs = append(proof.RowProof.Proofs, app.rowProofs[r])
+	}
+
+	pb := proof.ToProto()
+	bz, err := pb.Marshal()
+	require.NoError(app.t, err)
+	return &abci.ResponseQuery{Value: bz}, nil
+}
+
+func testNamespace(version byte, id byte) []byte {
+	namespace := make([]byte, consts.NamespaceSize)
+	namespace[0] = version
+	namespace[consts.NamespaceSize-1] = id
+	return namespace
+}
+
+// compactShare returns a single compact share storing the units.
+func compactShare(namespace []byte, units ...[]byte) []byte {
+	var data []byte
+	for _, unit := range units {
+		data = binary.AppendUvarint(data, uint64(len(unit)))
+		data = append(data, unit...)
+	}
+	share := append([]byte{}, namespace...)
+	share = append(share, 1)
+	share = binary.BigEndian.AppendUint32(share, uint32(len(data)))
+	share = binary.BigEndian.AppendUint32(share, uint32(len(share)+consts.CompactShareReservedBytes))
+	share = append(share, data...)
+	return append(share, make([]byte, consts.ShareSize-len(share))...)
+}
+
+// sparseShares splits a blob of the share version into shares.
+func sparseShares(namespace, data []byte, shareVersion uint32) [][]byte {
+	var shares [][]byte
+	n, err := types.SparseSharesNeeded(len(data), shareVersion)
+	if err != nil {
+		panic(err)
+	}
+	for i := uint64(0); i < n; i++ {
+		share := append([]byte{}, namespace...)
+		size := consts.ContinuationSparseShareContentSize
+		if i == 0 {
+			share = append(share, byte(shareVersion<<1|1))
+			share = binary.BigEndian.AppendUint32(share, uint32(len(data)))
+			size = consts.FirstSparseShareContentSize
+			if shareVersion == consts.ShareVersionOne {
+				share = append(share, make([]byte, consts.SignerSize)...)
+				size -= consts.SignerSize
+			}
+		} else {
+			share = append(share, byte(shareVersion<<1))
+		}
+		size = min(size, len(data))
+		share = append(share, data[:size]...)
+		data = data[size:]
+		shares = append(shares, append(share, make([]byte, consts.ShareSize-len(share))...))
+	}
+	return shares
+}
+
+func paddingShares(namespace []byte, n int) [][]byte {
+	shares := make([][]byte, n)
+	for i := range shares {
+		share := append([]byte{}, namespace...)
+		share = append(share, 1)
+		shares[i] = append(share, make([]byte, consts.ShareSize-len(share))...)
+	}
+	return shares
+}
@@ -208,11 +208,17 @@ func Commit(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCommit, erro
 
 // DataCommitment collects the data roots over a provided ordered range of blocks,
 // and then creates a new Merkle root of those data roots. The range is end exclusive.
+// The data commitment is read from the subtrees of data roots indexed by the
+// block store, and only computed from the blocks of the range if they were
+// saved before the index was added.
 func DataCommitment(ctx *rpctypes.Context, start, end uint64) (*ctypes.ResultDataCommitment, error) {
 	err := validateDataCommitmentRange(start, end)
 	if err != nil {
 		return nil, err
 	}
+	if root := GetEnvironment().BlockStore.LoadDataCommitment(int64(start), int64(end)); root != nil {
+		return &ctypes.ResultDataCommitment{DataCommitment: root}, nil
+	}
 	tuples, err := fetchDataRootTuples(start, end)
 	if err != nil {
 		return nil, err
@@ -227,7 +233,8 @@ func DataCommitment(ctx *rpctypes.Context, start, end uint64) (*ctypes.ResultDat
 
 // DataRootInclusionProof creates an inclusion proof for the data root of block
 // height `height` in the set of blocks defined by `start` and `end`. The range
-// is end exclusive.
+// is end exclusive. As DataCommitment, the proof is read from the block store
+// index when possible.
 func DataRootInclusionProof(
 	ctx *rpctypes.Context,
 	height int64,
@@ -238,6 +245,9 @@ func DataRootInclusionProof(
 	if err != nil {
 		return nil, err
 	}
+	if proof := GetEnvironment().BlockStore.LoadDataRootInclusionProof(height, int64(start), int64(end)); proof != nil {
+		return &ctypes.ResultDataRootInclusionProof{Proof: *proof}, nil
+	}
 	tuples, err := fetchDataRootTuples(start, end)
 	if err != nil {
 		return nil, err
@@ -298,38 +308,9 @@ type DataRootTuple struct {
 }
 
 // EncodeDataRootTuple takes a height and a data root, and returns the equivalent of
-// `abi.encode(...)` in Ethereum.
-// The encoded type is a DataRootTuple, which has the following ABI:
-//
-//	{
-//	  "components":[
-//	     {
-//	        "internalType":"uint256",
-//	        "name":"height",
-//	        "type":"uint256"
-//	     },
-//	     {
-//	        "internalType":"bytes32",
-//	        "name":"dataRoot",
-//	        "type":"bytes32"
-//	     },
-//	     {
-//	        "internalType":"structDataRootTuple",
-//	        "name":"_tuple",
-//	        "type":"tuple"
-//	     }
-//	  ]
-//	}
-//
-// padding the hex representation of the height padded to 32 bytes concatenated to the data root.
-// For more information, refer to:
-// https://github.com/celestiaorg/quantum-gravity-bridge/blob/master/src/DataRootTuple.sol
+// `abi.encode(...)` in Ethereum. See types.EncodeDataRootTuple.
 func EncodeDataRootTuple(height uint64, dataRoot [32]byte) ([]byte, error) {
-	paddedHeight, err := To32PaddedHexBytes(height)
-	if err != nil {
-		return nil, err
-	}
-	return append(paddedHeight, dataRoot[:]...), nil
+	return types.EncodeDataRootTuple(height, dataRoot), nil
 }
 
 // dataCommitmentBlocksLimit The maximum number of blocks to be used to create a data commitment.
@@ -456,9 +437,9 @@ func BlockSearchMatchEvents(
 	matchEvents bool,
 ) (*ctypes.ResultBlockSearch, error) {
 	if matchEvents {
-		query = "match.events = 1 AND " + query
+		query = "match.events = 1 AND (" + query + ")"
 	} else {
-		query = "match.events = 0 AND " + query
+		query = "match.events = 0 AND (" + query + ")"
 	}
 	return BlockSearch(ctx, query, pagePtr, perPagePtr, orderBy)
 }
@@ -538,18 +519,22 @@ func sortBlocks(results []int64, orderBy string) error {
 }
 
 // fetchDataRootTuples takes an end exclusive range of heights and fetches its
-// corresponding data root tuples.
+// corresponding data root tuples from the block metas.
 func fetchDataRootTuples(start, end uint64) ([]DataRootTuple, error) {
 	env := GetEnvironment()
 	tuples := make([]DataRootTuple, 0, end-start)
 	for height := start; height < end; height++ {
-		block := env.BlockStore.LoadBlock(int64(height))
-		if block == nil {
+		meta := env.BlockStore.LoadBlockMeta(int64(height))
+		if meta == nil {
 			return nil, fmt.Errorf("couldn't load block %d", height)
 		}
+		dataRoot := meta.Header.DataHash
+		if len(dataRoot) != 32 {
+			return nil, fmt.Errorf("invalid data root of block %d: expected 32 bytes, got %d", height, len(dataRoot))
+		}
 		tuples = append(tuples, DataRootTuple{
-			height:   uint64(block.Height),
-			dataRoot: *(*[32]byte)(block.DataHash),
+			height:   height,
+			dataRoot: *(*[32]byte)(dataRoot),
 		})
 	}
 	return tuples, nil
@@ -214,6 +214,46 @@ func TestDataCommitmentResults(t *testing.T) {
 	}
 }
 
+// indexedBlockStore is a mockBlockStore whose data commitments and data
+// root inclusion proofs are indexed.
+type indexedBlockStore struct {
+	mockBlockStore
+	commitment []byte
+	proof      *merkle.Proof
+}
+
+func (store indexedBlockStore) LoadDataCommitment(start, end int64) []byte {
+	return store.commitment
+}
+
+func (store indexedBlockStore) LoadDataRootInclusionProof(height, start, end int64) *merkle.Proof {
+	return store.proof
+}
+
+func TestDataCommitmentIndexed(t *testing.T) {
+	height := int64(100)
+	blockStore := indexedBlockStore{
+		mockBlockStore: mockBlockStore{height: height, blocks: randomBlocks(height)},
+		commitment:     []byte("indexed commitment"),
+		proof:          &merkle.Proof{Total: 10, Index: 2, LeafHash: []byte("indexed leaf")},
+	}
+	SetEnvironment(&Environment{BlockStore: blockStore})
+
+	// the indexed commitment and proof are returned instead of being computed.
+	res, err := DataCommitment(&rpctypes.Context{}, 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, blockStore.commitment, res.DataCommitment.Bytes())
+	proof, err := DataRootInclusionProof(&rpctypes.Context{}, 12, 10, 20)
+	require.NoError(t, err)
+	assert.Equal(t, *blockStore.proof, proof.Proof)
+
+	// the requests are still validated.
+	_, err = DataCommitment(&rpctypes.Context{}, 10, 102)
+	assert.Error(t, err)
+	_, err = DataRootInclusionProof(&rpctypes.Context{}, 20, 10, 20)
+	assert.Error(t, err)
+}
+
 func TestDataRootInclusionProofResults(t *testing.T) {
 	env := &Environment{}
 	env.StateStore = sm.NewStore(
@@ -305,6 +345,12 @@ func (mockBlockStore) SaveTxInfo(block *types.Block, txResponseCode []uint32) er
 	return nil
 }
 func (mockBlockStore) DeleteLatestBlock() error { return nil }
+func (mockBlockStore) LoadDataCommitment(start, end int64) []byte {
+	return nil
+}
+func (mockBlockStore) LoadDataRootInclusionProof(height, start, end int64) *merkle.Proof {
+	return nil
+}
 
 func (store mockBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 	if height > store.height {
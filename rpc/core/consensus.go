@@ -1,6 +1,10 @@
 package core
 
 import (
+	"errors"
+	"fmt"
+	"time"
+
 	cm "github.com/cometbft/cometbft/consensus"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
@@ -106,3 +110,26 @@ func ConsensusParams(ctx *rpctypes.Context, heightPtr *int64) (*ctypes.ResultCon
 		BlockHeight:     height,
 		ConsensusParams: consensusParams}, nil
 }
+
+// UnsafeSetHalt sets the height after which, and the time in seconds since the
+// Unix epoch from which, the node stops after committing a block, overriding
+// halt_height and halt_time of the config. Zero disables either condition.
+func UnsafeSetHalt(ctx *rpctypes.Context, height int64, haltTime int64) (*ctypes.ResultUnsafeSetHalt, error) {
+	cs, ok := GetEnvironment().ConsensusState.(interface {
+		SetHalt(height int64, haltTime time.Time) error
+	})
+	if !ok {
+		return nil, errors.New("consensus does not support halting")
+	}
+	if haltTime < 0 {
+		return nil, fmt.Errorf("negative halt time %d", haltTime)
+	}
+	var t time.Time
+	if haltTime > 0 {
+		t = time.Unix(haltTime, 0)
+	}
+	if err := cs.SetHalt(height, t); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultUnsafeSetHalt{HaltHeight: height, HaltTime: haltTime}, nil
+}
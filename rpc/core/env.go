@@ -13,6 +13,7 @@ import (
 	"github.com/cometbft/cometbft/libs/log"
 	mempl "github.com/cometbft/cometbft/mempool"
 	"github.com/cometbft/cometbft/p2p"
+	"github.com/cometbft/cometbft/pkg/trace"
 	"github.com/cometbft/cometbft/proxy"
 	sm "github.com/cometbft/cometbft/state"
 	"github.com/cometbft/cometbft/state/indexer"
@@ -104,6 +105,7 @@ type Environment struct {
 	ConsensusReactor *consensus.Reactor
 	EventBus         *types.EventBus // thread safe
 	Mempool          mempl.Mempool
+	Tracer           trace.Tracer
 
 	Logger log.Logger
 
@@ -212,8 +214,8 @@ func getHeight(latestHeight int64, heightPtr *int64) (int64, error) {
 
 func latestUncommittedHeight() int64 {
 	env := GetEnvironment()
-	nodeIsSyncing := env.ConsensusReactor.WaitSync()
-	if nodeIsSyncing {
+	// the consensus reactor is nil when inspecting a stopped node.
+	if env.ConsensusReactor != nil && env.ConsensusReactor.WaitSync() {
 		return env.BlockStore.Height()
 	}
 	return env.BlockStore.Height() + 1
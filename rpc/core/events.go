@@ -6,10 +6,13 @@ import (
 	"fmt"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
 )
 
 const (
@@ -21,14 +24,60 @@ const (
 // Subscribe for events via WebSocket.
 // More: https://docs.cometbft.com/v0.34/rpc/#/Websocket/subscribe
 func Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, error) {
+	return subscribe(ctx, query, nil, nil)
+}
+
+// SubscribeFrom subscribes for events via WebSocket, first replaying the
+// NewBlock and Tx events matching the query from either the given height or,
+// if the cursor of a previously received event is given, the event following
+// it. Once the replay catches up with the latest block, it continues with live
+// events. Replaying requires the node to keep the ABCI responses of the
+// replayed heights.
+func SubscribeFrom(ctx *rpctypes.Context, query string, height int64, cursor string) (*ctypes.ResultSubscribe, error) {
+	env := GetEnvironment()
+
+	var from eventCursor
+	switch {
+	case cursor != "" && height != 0:
+		return nil, errors.New("only one of height and cursor can be set")
+	case cursor != "":
+		last, err := parseEventCursor(cursor)
+		if err != nil {
+			return nil, err
+		}
+		from = eventCursor{height: last.height, index: last.index + 1}
+	case height > 0:
+		from = eventCursor{height: height}
+	default:
+		return nil, errors.New("either a positive height or a cursor must be set")
+	}
+
+	if base := env.BlockStore.Base(); from.height < base {
+		return nil, fmt.Errorf("cannot replay events from height %d, the lowest height available is %d",
+			from.height, base)
+	}
+	if latest := env.BlockStore.Height(); from.height > latest+1 {
+		return nil, fmt.Errorf("cannot replay events from height %d, the latest height is %d",
+			from.height, latest)
+	}
+
+	return subscribe(ctx, query, &from, nil)
+}
+
+// subscribe registers the subscription and forwards its events to the
+// WebSocket connection. If from is set, the events since from are replayed
+// first. If last is set, the subscription is removed once an event for which
+// it returns true has been forwarded.
+func subscribe(
+	ctx *rpctypes.Context,
+	query string,
+	from *eventCursor,
+	last func(types.TMEventData) bool,
+) (*ctypes.ResultSubscribe, error) {
 	addr := ctx.RemoteAddr()
 	env := GetEnvironment()
 
-	if env.EventBus.NumClients() >= env.Config.MaxSubscriptionClients {
-		return nil, fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
-	} else if env.EventBus.NumClientSubscriptions(addr) >= env.Config.MaxSubscriptionsPerClient {
-		return nil, fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
-	} else if len(query) > maxQueryLength {
+	if len(query) > maxQueryLength {
 		return nil, errors.New("maximum query length exceeded")
 	}
 
@@ -39,11 +88,18 @@ func Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, er
 		return nil, fmt.Errorf("failed to parse query: %w", err)
 	}
 
-	subCtx, cancel := context.WithTimeout(ctx.Context(), SubscribeTimeout)
-	defer cancel()
-
-	sub, err := env.EventBus.Subscribe(subCtx, addr, q, env.Config.SubscriptionBufferSize)
-	if err != nil {
+	// When replaying, the subscription is only registered once the replay has
+	// caught up, so that its buffer, sized for live events, doesn't overflow
+	// with the events of the blocks executed meanwhile. It is reserved until
+	// then, counting towards the limits.
+	var sub types.Subscription
+	if from == nil {
+		subCtx, cancel := context.WithTimeout(ctx.Context(), SubscribeTimeout)
+		defer cancel()
+		if sub, err = replaying.subscribe(subCtx, addr, q); err != nil {
+			return nil, err
+		}
+	} else if err := replaying.reserve(addr); err != nil {
 		return nil, err
 	}
 
@@ -51,17 +107,60 @@ func Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, er
 
 	// Capture the current ID, since it can change in the future.
 	subscriptionID := ctx.JSONReq.ID
+	writeEvent := func(resultEvent *ctypes.ResultEvent) error {
+		resp := rpctypes.NewRPCSuccessResponse(subscriptionID, resultEvent)
+		writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		return ctx.WSConn.WriteRPCResponse(writeCtx, resp)
+	}
 	go func() {
+		// next is the cursor of the first NewBlock or Tx event that hasn't been
+		// replayed, older live events were already sent.
+		var next eventCursor
+		if from != nil {
+			var err error
+			subscribed := false
+			next, err = replayEvents(q, query, *from, writeEvent)
+			if err == nil {
+				subCtx, cancel := context.WithTimeout(context.Background(), SubscribeTimeout)
+				sub, err = replaying.subscribeReserved(subCtx, addr, q)
+				cancel()
+				subscribed = err == nil
+			} else {
+				replaying.release(addr)
+			}
+			if err == nil {
+				// replay the blocks executed before the subscription was
+				// registered, their live events are skipped below.
+				next, err = replayEvents(q, query, next, writeEvent)
+			}
+			if err != nil {
+				env.Logger.Info("Can't replay events", "to", addr, "subscriptionID", subscriptionID, "err", err)
+				resp := rpctypes.RPCServerError(subscriptionID, fmt.Errorf("subscription was cancelled (reason: %w)", err))
+				if !ctx.WSConn.TryWriteRPCResponse(resp) {
+					env.Logger.Info("Can't write response (slow client)",
+						"to", addr, "subscriptionID", subscriptionID, "err", err)
+				}
+				if subscribed {
+					if err := env.EventBus.Unsubscribe(context.Background(), addr, q); err != nil {
+						env.Logger.Error("Can't unsubscribe", "to", addr, "subscriptionID", subscriptionID, "err", err)
+					}
+				}
+				return
+			}
+		}
+
 		for sub != nil {
 			select {
 			case msg := <-sub.Out():
-				var (
-					resultEvent = &ctypes.ResultEvent{Query: query, Data: msg.Data(), Events: msg.Events()}
-					resp        = rpctypes.NewRPCSuccessResponse(subscriptionID, resultEvent)
-				)
-				writeCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
-				defer cancel()
-				if err := ctx.WSConn.WriteRPCResponse(writeCtx, resp); err != nil {
+				resultEvent := &ctypes.ResultEvent{Query: query, Data: msg.Data(), Events: msg.Events()}
+				if cursor, ok := cursorForEvent(msg.Data()); ok {
+					if cursor.less(next) {
+						continue
+					}
+					resultEvent.Cursor = cursor.String()
+				}
+				if err := writeEvent(resultEvent); err != nil {
 					env.Logger.Info("Can't write response (slow client)",
 						"to", addr, "subscriptionID", subscriptionID, "err", err)
 
@@ -77,6 +176,12 @@ func Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, er
 						return
 					}
 				}
+				if last != nil && last(msg.Data()) {
+					if err := env.EventBus.Unsubscribe(context.Background(), addr, q); err != nil {
+						env.Logger.Error("Can't unsubscribe", "to", addr, "subscriptionID", subscriptionID, "err", err)
+					}
+					return
+				}
 			case <-sub.Cancelled():
 				if sub.Err() != cmtpubsub.ErrUnsubscribed {
 					var reason string
@@ -102,6 +207,194 @@ func Subscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultSubscribe, er
 	return &ctypes.ResultSubscribe{}, nil
 }
 
+// replaying holds the subscriptions reserved while replaying events.
+var replaying = &subscriptionReservations{reserved: make(map[string]int)}
+
+// subscriptionReservations registers the subscriptions to the event bus, and
+// reserves the ones replaying events, so that both are counted towards the
+// limits on the number of clients and subscriptions per client.
+type subscriptionReservations struct {
+	mtx      cmtsync.Mutex
+	reserved map[string]int // by client
+}
+
+// checkLimits returns an error if the client can't subscribe more. It must be
+// called with mtx held.
+func (r *subscriptionReservations) checkLimits(addr string) error {
+	env := GetEnvironment()
+	clients := env.EventBus.NumClients()
+	for client := range r.reserved {
+		if env.EventBus.NumClientSubscriptions(client) == 0 {
+			clients++
+		}
+	}
+	if clients >= env.Config.MaxSubscriptionClients {
+		return fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
+	} else if env.EventBus.NumClientSubscriptions(addr)+r.reserved[addr] >= env.Config.MaxSubscriptionsPerClient {
+		return fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
+	}
+	return nil
+}
+
+// subscribe registers a subscription of the client, within the limits.
+func (r *subscriptionReservations) subscribe(
+	ctx context.Context,
+	addr string,
+	q *cmtquery.Query,
+) (types.Subscription, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if err := r.checkLimits(addr); err != nil {
+		return nil, err
+	}
+	env := GetEnvironment()
+	return env.EventBus.Subscribe(ctx, addr, q, env.Config.SubscriptionBufferSize)
+}
+
+// reserve reserves a subscription of the client, within the limits.
+func (r *subscriptionReservations) reserve(addr string) error {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if err := r.checkLimits(addr); err != nil {
+		return err
+	}
+	r.reserved[addr]++
+	return nil
+}
+
+// subscribeReserved registers a subscription reserved by the client.
+func (r *subscriptionReservations) subscribeReserved(
+	ctx context.Context,
+	addr string,
+	q *cmtquery.Query,
+) (types.Subscription, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.releaseLocked(addr)
+	env := GetEnvironment()
+	return env.EventBus.Subscribe(ctx, addr, q, env.Config.SubscriptionBufferSize)
+}
+
+// release releases a subscription reserved by the client.
+func (r *subscriptionReservations) release(addr string) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	r.releaseLocked(addr)
+}
+
+func (r *subscriptionReservations) releaseLocked(addr string) {
+	if r.reserved[addr]--; r.reserved[addr] <= 0 {
+		delete(r.reserved, addr)
+	}
+}
+
+// replayEvents writes the NewBlock and Tx events matching the query, from the
+// given cursor up to the latest executed block, reconstructed from the block
+// store and the saved ABCI responses. It returns the cursor following the
+// last replayed event.
+func replayEvents(
+	q *cmtquery.Query,
+	query string,
+	from eventCursor,
+	writeEvent func(*ctypes.ResultEvent) error,
+) (eventCursor, error) {
+	env := GetEnvironment()
+	state, err := env.StateStore.Load()
+	if err != nil {
+		return from, err
+	}
+
+	for height := from.height; height <= state.LastBlockHeight; height++ {
+		block := env.BlockStore.LoadBlock(height)
+		if block == nil {
+			return from, fmt.Errorf("block at height %d not found", height)
+		}
+		abciResponses, err := env.StateStore.LoadABCIResponses(height)
+		if err != nil {
+			return from, fmt.Errorf("cannot load the ABCI responses of height %d: %w", height, err)
+		}
+
+		var events []ctypes.ResultEvent
+		if from.index == 0 {
+			data := types.EventDataNewBlock{
+				Block:            block,
+				ResultBeginBlock: *abciResponses.BeginBlock,
+				ResultEndBlock:   *abciResponses.EndBlock,
+			}
+			events = append(events, ctypes.ResultEvent{Data: data, Events: types.NewBlockEvents(data)})
+		}
+		for i := max(from.index-1, 0); i < int64(len(block.Txs)); i++ {
+			// blob txs are published without their blobs, see fireEvents.
+			tx := block.Txs[i]
+			if blobTx, isBlobTx := types.UnmarshalBlobTx(tx); isBlobTx {
+				tx = blobTx.Tx
+			}
+			data := types.EventDataTx{TxResult: abci.TxResult{
+				Height: height,
+				Index:  uint32(i),
+				Tx:     tx,
+				Result: *abciResponses.DeliverTxs[i],
+			}}
+			events = append(events, ctypes.ResultEvent{Data: data, Events: types.TxEvents(data)})
+		}
+
+		for i := range events {
+			event := &events[i]
+			matches, err := q.Matches(event.Events)
+			if err != nil {
+				return from, err
+			}
+			cursor, _ := cursorForEvent(event.Data)
+			if matches {
+				event.Query = query
+				event.Cursor = cursor.String()
+				if err := writeEvent(event); err != nil {
+					return from, err
+				}
+			}
+			from = eventCursor{height: cursor.height, index: cursor.index + 1}
+		}
+		from = eventCursor{height: height + 1}
+	}
+	return from, nil
+}
+
+// eventCursor is the position of a NewBlock or Tx event in the sequence of
+// events of the chain. The NewBlock event of a height has index 0 and is
+// followed by its Tx events.
+type eventCursor struct {
+	height int64
+	index  int64
+}
+
+// cursorForEvent returns the cursor of NewBlock and Tx events.
+func cursorForEvent(data types.TMEventData) (eventCursor, bool) {
+	switch data := data.(type) {
+	case types.EventDataNewBlock:
+		return eventCursor{height: data.Block.Height}, true
+	case types.EventDataTx:
+		return eventCursor{height: data.Height, index: int64(data.Index) + 1}, true
+	default:
+		return eventCursor{}, false
+	}
+}
+
+func parseEventCursor(s string) (eventCursor, error) {
+	var c eventCursor
+	if _, err := fmt.Sscanf(s, "%d/%d", &c.height, &c.index); err != nil || c.height < 1 || c.index < 0 {
+		return eventCursor{}, fmt.Errorf("invalid cursor %q", s)
+	}
+	return c, nil
+}
+
+func (c eventCursor) String() string {
+	return fmt.Sprintf("%d/%d", c.height, c.index)
+}
+
+func (c eventCursor) less(other eventCursor) bool {
+	return c.height < other.height || (c.height == other.height && c.index < other.index)
+}
+
 // Unsubscribe from events via WebSocket.
 // More: https://docs.cometbft.com/v0.34/rpc/#/Websocket/unsubscribe
 func Unsubscribe(ctx *rpctypes.Context, query string) (*ctypes.ResultUnsubscribe, error) {
@@ -0,0 +1,211 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/state/mocks"
+	"github.com/cometbft/cometbft/types"
+)
+
+// replayBlocks returns the blocks of the replay tests, and a state store
+// with the ABCI responses of the executed ones. The block at height 3 is
+// stored but not executed yet.
+func replayBlocks() ([]*types.Block, *mocks.Store) {
+	blocks := []*types.Block{
+		nil,
+		types.MakeBlock(1, []types.Tx{[]byte("tx-1")}, nil, nil),
+		types.MakeBlock(2, []types.Tx{[]byte("tx-2"), []byte("tx-3")}, nil, nil),
+		types.MakeBlock(3, nil, nil, nil),
+	}
+	stateStore := &mocks.Store{}
+	stateStore.On("Load").Return(sm.State{LastBlockHeight: 2}, nil)
+	for height := int64(1); height <= 2; height++ {
+		responses := &cmtstate.ABCIResponses{
+			BeginBlock: &abci.ResponseBeginBlock{},
+			EndBlock:   &abci.ResponseEndBlock{},
+		}
+		for range blocks[height].Txs {
+			responses.DeliverTxs = append(responses.DeliverTxs, &abci.ResponseDeliverTx{})
+		}
+		stateStore.On("LoadABCIResponses", height).Return(responses, nil)
+	}
+	return blocks, stateStore
+}
+
+func TestReplayEvents(t *testing.T) {
+	blocks, stateStore := replayBlocks()
+	SetEnvironment(&Environment{
+		StateStore: stateStore,
+		BlockStore: mockBlockStore{height: 3, blocks: blocks},
+	})
+
+	replay := func(q string, from eventCursor) ([]string, eventCursor) {
+		var cursors []string
+		next, err := replayEvents(query.MustParse(q), q, from, func(event *ctypes.ResultEvent) error {
+			assert.Equal(t, q, event.Query)
+			cursors = append(cursors, event.Cursor)
+			return nil
+		})
+		require.NoError(t, err)
+		return cursors, next
+	}
+
+	cursors, next := replay("tm.event EXISTS", eventCursor{height: 1})
+	assert.Equal(t, []string{"1/0", "1/1", "2/0", "2/1", "2/2"}, cursors)
+	assert.Equal(t, eventCursor{height: 3}, next)
+
+	cursors, next = replay("tm.event = 'Tx'", eventCursor{height: 2, index: 2})
+	assert.Equal(t, []string{"2/2"}, cursors)
+	assert.Equal(t, eventCursor{height: 3}, next)
+
+	cursors, _ = replay("tm.event = 'Tx' AND tx.height = 2", eventCursor{height: 1})
+	assert.Equal(t, []string{"2/1", "2/2"}, cursors)
+
+	cursors, next = replay("tm.event = 'NewBlock'", eventCursor{height: 3})
+	assert.Empty(t, cursors)
+	assert.Equal(t, eventCursor{height: 3}, next)
+}
+
+func TestSubscribeFromValidation(t *testing.T) {
+	SetEnvironment(&Environment{BlockStore: mockBlockStore{height: 10}})
+
+	for _, tc := range []struct {
+		height int64
+		cursor string
+	}{
+		{0, ""},
+		{-1, ""},
+		{1, "1/0"},
+		{0, "1"},
+		{0, "0/1"},
+		{0, "1/-1"},
+		{12, ""},
+		{0, "12/0"},
+	} {
+		_, err := SubscribeFrom(&rpctypes.Context{}, "tm.event = 'NewBlock'", tc.height, tc.cursor)
+		assert.Error(t, err, "height %d, cursor %q", tc.height, tc.cursor)
+	}
+}
+
+// wsConn is a WebSocket connection recording the cursors of the events
+// written to it.
+type wsConn struct {
+	rpctypes.WSRPCConnection
+	write   func()
+	cursors chan string
+}
+
+func (c *wsConn) GetRemoteAddr() string { return "ws" }
+
+func (c *wsConn) Context() context.Context { return context.Background() }
+
+func (c *wsConn) WriteRPCResponse(_ context.Context, resp rpctypes.RPCResponse) error {
+	c.write()
+	var event struct {
+		Cursor string `json:"cursor"`
+	}
+	if err := json.Unmarshal(resp.Result, &event); err != nil {
+		return err
+	}
+	c.cursors <- event.Cursor
+	return nil
+}
+
+func (c *wsConn) TryWriteRPCResponse(resp rpctypes.RPCResponse) bool {
+	c.cursors <- resp.Error.Error()
+	return true
+}
+
+func TestSubscribeFromReplaysBeforeSubscribing(t *testing.T) {
+	blocks, stateStore := replayBlocks()
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	config := cfg.DefaultRPCConfig()
+	// the replayed events would overflow the buffer of the subscription.
+	config.SubscriptionBufferSize = 1
+	SetEnvironment(&Environment{
+		StateStore: stateStore,
+		BlockStore: mockBlockStore{height: 3, blocks: blocks},
+		EventBus:   eventBus,
+		Logger:     log.NewNopLogger(),
+		Config:     *config,
+	})
+
+	replaying := true
+	conn := &wsConn{cursors: make(chan string, 10)}
+	conn.write = func() {
+		if replaying {
+			assert.Zero(t, eventBus.NumClientSubscriptions("ws"), "subscribed during the replay")
+		}
+	}
+	ctx := &rpctypes.Context{JSONReq: &rpctypes.RPCRequest{ID: rpctypes.JSONRPCIntID(1)}, WSConn: conn}
+	_, err := SubscribeFrom(ctx, "tm.event EXISTS", 1, "")
+	require.NoError(t, err)
+
+	for _, cursor := range []string{"1/0", "1/1", "2/0", "2/1", "2/2"} {
+		assert.Equal(t, cursor, <-conn.cursors)
+	}
+	require.Eventually(t, func() bool { return eventBus.NumClientSubscriptions("ws") == 1 },
+		time.Second, 10*time.Millisecond)
+	replaying = false
+
+	// the live events of the executed blocks are skipped.
+	require.NoError(t, eventBus.PublishEventNewBlock(types.EventDataNewBlock{Block: blocks[2]}))
+	require.NoError(t, eventBus.PublishEventNewBlock(types.EventDataNewBlock{Block: blocks[3]}))
+	assert.Equal(t, "3/0", <-conn.cursors)
+}
+
+func TestSubscribeFromReservesSubscription(t *testing.T) {
+	blocks, stateStore := replayBlocks()
+	eventBus := types.NewEventBus()
+	require.NoError(t, eventBus.Start())
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+	config := cfg.DefaultRPCConfig()
+	config.MaxSubscriptionsPerClient = 1
+	SetEnvironment(&Environment{
+		StateStore: stateStore,
+		BlockStore: mockBlockStore{height: 3, blocks: blocks},
+		EventBus:   eventBus,
+		Logger:     log.NewNopLogger(),
+		Config:     *config,
+	})
+
+	// block the replay until the second subscription was refused.
+	replayed := make(chan struct{})
+	conn := &wsConn{cursors: make(chan string, 10), write: func() { <-replayed }}
+	ctx := &rpctypes.Context{JSONReq: &rpctypes.RPCRequest{ID: rpctypes.JSONRPCIntID(1)}, WSConn: conn}
+	_, err := SubscribeFrom(ctx, "tm.event = 'NewBlock'", 1, "")
+	require.NoError(t, err)
+
+	_, err = SubscribeFrom(ctx, "tm.event = 'Tx'", 1, "")
+	assert.ErrorContains(t, err, "max_subscriptions_per_client 1 reached")
+	_, err = Subscribe(ctx, "tm.event = 'Tx'")
+	assert.ErrorContains(t, err, "max_subscriptions_per_client 1 reached")
+
+	close(replayed)
+	require.Eventually(t, func() bool { return eventBus.NumClientSubscriptions("ws") == 1 },
+		time.Second, 10*time.Millisecond)
+}
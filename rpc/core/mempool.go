@@ -7,6 +7,7 @@ import (
 	"time"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	mempl "github.com/cometbft/cometbft/mempool"
 	ctypes "github.com/cometbft/cometbft/rpc/core/types"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
@@ -59,6 +60,57 @@ func BroadcastTxSync(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcas
 	}
 }
 
+// BroadcastTxWatch returns with the response from CheckTx, like
+// BroadcastTxSync, and then streams the life cycle events of the tx over the
+// WebSocket connection: accepted, gossiped, rejected, evicted (with the reason)
+// and committed (with the height and index). The subscription ends after the
+// tx is committed, rejected or evicted, or can be cancelled by unsubscribing
+// from tm.event='TxStatus' AND tx.hash='<hash>'. Only the CAT mempool reports
+// these events.
+func BroadcastTxWatch(ctx *rpctypes.Context, tx types.Tx) (*ctypes.ResultBroadcastTx, error) {
+	env := GetEnvironment()
+
+	// Subscribe before broadcasting, so that no event is missed.
+	q := types.EventQueryTxStatusFor(tx.Hash())
+	if _, err := subscribe(ctx, q.String(), nil, isFinalTxStatus); err != nil {
+		return nil, fmt.Errorf("failed to subscribe to tx: %w", err)
+	}
+	if w, ok := env.Mempool.(txWatcher); ok {
+		w.WatchTx(tx.Key())
+	}
+
+	res, err := BroadcastTxSync(ctx, tx)
+	if err != nil {
+		if err := env.EventBus.Unsubscribe(context.Background(), ctx.RemoteAddr(), q); err != nil &&
+			!errors.Is(err, cmtpubsub.ErrSubscriptionNotFound) {
+			env.Logger.Error("Error unsubscribing from eventBus", "err", err)
+		}
+		return nil, err
+	}
+	return res, nil
+}
+
+// txWatcher is implemented by the mempools that report the commit of a tx only
+// if it is in the mempool or watched.
+type txWatcher interface {
+	WatchTx(key types.TxKey)
+}
+
+// isFinalTxStatus returns true for the events after which the status of a tx
+// no longer changes.
+func isFinalTxStatus(data types.TMEventData) bool {
+	status, ok := data.(types.EventDataTxStatus)
+	if !ok {
+		return false
+	}
+	switch status.Status {
+	case types.TxStatusCommitted, types.TxStatusRejected, types.TxStatusEvicted:
+		return true
+	default:
+		return false
+	}
+}
+
 // DEPRECATED: Use BroadcastTxSync or BroadcastTxAsync instead.
 // BroadcastTxCommit returns with the responses from CheckTx and DeliverTx.
 // More: https://docs.cometbft.com/v0.34/rpc/#/Tx/broadcast_tx_commit
@@ -117,6 +117,22 @@ func (mr *MockMempoolMockRecorder) WasRecentlyEvicted(key interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WasRecentlyEvicted", reflect.TypeOf((*MockMempool)(nil).WasRecentlyEvicted), key)
 }
 
+// WasRecentlyRejected mocks base method.
+func (m *MockMempool) WasRecentlyRejected(key types0.TxKey) (uint32, string, bool) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WasRecentlyRejected", key)
+	ret0, _ := ret[0].(uint32)
+	ret1, _ := ret[1].(string)
+	ret2, _ := ret[2].(bool)
+	return ret0, ret1, ret2
+}
+
+// WasRecentlyRejected indicates an expected call of WasRecentlyRejected.
+func (mr *MockMempoolMockRecorder) WasRecentlyRejected(key interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WasRecentlyRejected", reflect.TypeOf((*MockMempool)(nil).WasRecentlyRejected), key)
+}
+
 // Lock mocks base method.
 func (m *MockMempool) Lock() {
 	m.ctrl.T.Helper()
@@ -10,6 +10,7 @@ import (
 var Routes = map[string]*rpc.RPCFunc{
 	// subscribe/unsubscribe are reserved for websocket events.
 	"subscribe":       rpc.NewWSRPCFunc(Subscribe, "query"),
+	"subscribe_from":  rpc.NewWSRPCFunc(SubscribeFrom, "query,height,cursor"),
 	"unsubscribe":     rpc.NewWSRPCFunc(Unsubscribe, "query"),
 	"unsubscribe_all": rpc.NewWSRPCFunc(UnsubscribeAll, ""),
 
@@ -31,6 +32,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"check_tx":                  rpc.NewRPCFunc(CheckTx, "tx"),
 	"tx":                        rpc.NewRPCFunc(Tx, "hash,prove", rpc.Cacheable()),
 	"prove_shares":              rpc.NewRPCFunc(ProveShares, "height,startShare,endShare"),
+	"blobs_by_namespace":        rpc.NewRPCFunc(BlobsByNamespace, "height,namespace"),
 	"data_root_inclusion_proof": rpc.NewRPCFunc(DataRootInclusionProof, "height,start,end"),
 	"tx_search":                 rpc.NewRPCFunc(TxSearchMatchEvents, "query,prove,page,per_page,order_by,match_events"),
 	"block_search":              rpc.NewRPCFunc(BlockSearchMatchEvents, "query,page,per_page,order_by,match_events"),
@@ -46,6 +48,7 @@ var Routes = map[string]*rpc.RPCFunc{
 	"broadcast_tx_commit": rpc.NewRPCFunc(BroadcastTxCommit, "tx"),
 	"broadcast_tx_sync":   rpc.NewRPCFunc(BroadcastTxSync, "tx"),
 	"broadcast_tx_async":  rpc.NewRPCFunc(BroadcastTxAsync, "tx"),
+	"broadcast_tx_watch":  rpc.NewWSRPCFunc(BroadcastTxWatch, "tx"),
 
 	// abci API
 	"abci_query": rpc.NewRPCFunc(ABCIQuery, "path,data,height,prove"),
@@ -61,4 +64,9 @@ func AddUnsafeRoutes() {
 	Routes["dial_seeds"] = rpc.NewRPCFunc(UnsafeDialSeeds, "seeds")
 	Routes["dial_peers"] = rpc.NewRPCFunc(UnsafeDialPeers, "peers,persistent,unconditional,private")
 	Routes["unsafe_flush_mempool"] = rpc.NewRPCFunc(UnsafeFlushMempool, "")
+	Routes["unsafe_set_halt"] = rpc.NewRPCFunc(UnsafeSetHalt, "height,time")
+
+	// tracing API
+	Routes["unsafe_trace_tables"] = rpc.NewRPCFunc(UnsafeTraceTables, "enable,disable")
+	Routes["unsafe_flush_traces"] = rpc.NewRPCFunc(UnsafeFlushTraces, "")
 }
@@ -0,0 +1,69 @@
+package core
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/pkg/trace"
+	"github.com/cometbft/cometbft/pkg/trace/schema"
+	ctypes "github.com/cometbft/cometbft/rpc/core/types"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// UnsafeTraceTables enables and disables the collection of trace tables at
+// runtime and returns the tables being collected afterwards. Tables must be
+// one of the tables defined in the trace schema.
+func UnsafeTraceTables(ctx *rpctypes.Context, enable, disable []string) (*ctypes.ResultTraceTables, error) {
+	tc, err := tableController()
+	if err != nil {
+		return nil, err
+	}
+	if err := validateTraceTables(append(enable, disable...)); err != nil {
+		return nil, err
+	}
+
+	if len(enable) > 0 {
+		if err := tc.EnableTables(enable...); err != nil {
+			return nil, err
+		}
+	}
+	if len(disable) > 0 {
+		if err := tc.DisableTables(disable...); err != nil {
+			return nil, err
+		}
+	}
+	return &ctypes.ResultTraceTables{Tables: tc.CollectingTables()}, nil
+}
+
+// UnsafeFlushTraces writes or exports the buffered rows of every trace table.
+func UnsafeFlushTraces(ctx *rpctypes.Context) (*ctypes.ResultUnsafeFlushTraces, error) {
+	tc, err := tableController()
+	if err != nil {
+		return nil, err
+	}
+	if err := tc.Flush(); err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultUnsafeFlushTraces{}, nil
+}
+
+func tableController() (trace.TableController, error) {
+	tc, ok := GetEnvironment().Tracer.(trace.TableController)
+	if !ok {
+		return nil, errors.New("tracing is disabled or the tracer does not support changing tables")
+	}
+	return tc, nil
+}
+
+func validateTraceTables(tables []string) error {
+	known := make(map[string]struct{})
+	for _, table := range schema.AllTables() {
+		known[table] = struct{}{}
+	}
+	for _, table := range tables {
+		if _, ok := known[table]; !ok {
+			return fmt.Errorf("unknown trace table %q", table)
+		}
+	}
+	return nil
+}
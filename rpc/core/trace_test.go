@@ -0,0 +1,79 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/pkg/trace"
+	"github.com/cometbft/cometbft/pkg/trace/schema"
+	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+)
+
+// testTracer is a tracer that only records which tables are collected.
+type testTracer struct {
+	tables  map[string]struct{}
+	flushed bool
+}
+
+var _ trace.TableController = (*testTracer)(nil)
+
+func (tt *testTracer) Write(trace.Entry) {}
+
+func (tt *testTracer) Stop() {}
+
+func (tt *testTracer) IsCollecting(table string) bool {
+	_, ok := tt.tables[table]
+	return ok
+}
+
+func (tt *testTracer) Flush() error {
+	tt.flushed = true
+	return nil
+}
+
+func (tt *testTracer) DisableTables(ts ...string) error {
+	for _, table := range ts {
+		delete(tt.tables, table)
+	}
+	return nil
+}
+
+func (tt *testTracer) EnableTables(ts ...string) error {
+	for _, table := range ts {
+		tt.tables[table] = struct{}{}
+	}
+	return nil
+}
+
+func (tt *testTracer) CollectingTables() []string {
+	var tables []string
+	for _, table := range schema.AllTables() {
+		if tt.IsCollecting(table) {
+			tables = append(tables, table)
+		}
+	}
+	return tables
+}
+
+func TestUnsafeTraceTables(t *testing.T) {
+	tracer := &testTracer{tables: map[string]struct{}{schema.MempoolTxTable: {}}}
+	SetEnvironment(&Environment{Tracer: tracer})
+
+	res, err := UnsafeTraceTables(&rpctypes.Context{}, []string{schema.VoteTable}, []string{schema.MempoolTxTable})
+	require.NoError(t, err)
+	require.Equal(t, []string{schema.VoteTable}, res.Tables)
+
+	_, err = UnsafeTraceTables(&rpctypes.Context{}, []string{"unknown"}, nil)
+	require.Error(t, err)
+	require.Equal(t, []string{schema.VoteTable}, tracer.CollectingTables())
+
+	_, err = UnsafeFlushTraces(&rpctypes.Context{})
+	require.NoError(t, err)
+	require.True(t, tracer.flushed)
+
+	// tracers that can't change tables are rejected.
+	SetEnvironment(&Environment{Tracer: trace.NoOpTracer()})
+	_, err = UnsafeTraceTables(&rpctypes.Context{}, nil, nil)
+	require.Error(t, err)
+}
@@ -21,6 +21,7 @@ const (
 	txStatusUnknown   string = "UNKNOWN"
 	txStatusPending   string = "PENDING"
 	txStatusEvicted   string = "EVICTED"
+	txStatusRejected  string = "REJECTED"
 	txStatusCommitted string = "COMMITTED"
 )
 
@@ -189,41 +190,46 @@ func ProveShares(
 	startShare uint64,
 	endShare uint64,
 ) (*ctypes.ResultShareProof, error) {
-	var (
-		pShareProof cmtproto.ShareProof
-		shareProof  types.ShareProof
-	)
 	env := GetEnvironment()
 	rawBlock, err := loadRawBlock(env.BlockStore, height)
 	if err != nil {
 		return nil, err
 	}
-	res, err := env.ProxyAppQuery.QuerySync(abcitypes.RequestQuery{
+	shareProof, err := queryShareProof(rawBlock, fmt.Sprintf(consts.ShareInclusionProofQueryPath, startShare, endShare))
+	if err != nil {
+		return nil, err
+	}
+	return &ctypes.ResultShareProof{Proof: shareProof}, nil
+}
+
+// queryShareProof queries the application for the share proof at the given
+// custom query path, using the raw block to reconstruct the square.
+func queryShareProof(rawBlock []byte, path string) (types.ShareProof, error) {
+	var pShareProof cmtproto.ShareProof
+	res, err := GetEnvironment().ProxyAppQuery.QuerySync(abcitypes.RequestQuery{
 		Data: rawBlock,
-		Path: fmt.Sprintf(consts.ShareInclusionProofQueryPath, startShare, endShare),
+		Path: path,
 	})
 	if err != nil {
-		return nil, err
+		return types.ShareProof{}, err
 	}
 	if res.Value == nil && res.Log != "" {
 		// we can make the assumption that for custom queries, if the value is nil
 		// and some logs have been emitted, then an error happened.
-		return nil, errors.New(res.Log)
+		return types.ShareProof{}, errors.New(res.Log)
 	}
 	err = pShareProof.Unmarshal(res.Value)
 	if err != nil {
-		return nil, err
-	}
-	shareProof, err = types.ShareProofFromProto(pShareProof)
-	if err != nil {
-		return nil, err
+		return types.ShareProof{}, err
 	}
-	return &ctypes.ResultShareProof{Proof: shareProof}, nil
+	return types.ShareProofFromProto(pShareProof)
 }
 
 // TxStatus retrieves the status of a transaction given its hash. It returns a ResultTxStatus
 // containing the height and index of the transaction within the block(if committed)
-// or whether the transaction is pending, evicted from the mempool, or otherwise unknown.
+// or whether the transaction is pending, evicted from the mempool, rejected by
+// CheckTx, or otherwise unknown. Evicted and rejected transactions carry the
+// CheckTx code and log when the mempool still remembers them.
 func TxStatus(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxStatus, error) {
 	env := GetEnvironment()
 
@@ -245,13 +251,20 @@ func TxStatus(ctx *rpctypes.Context, hash []byte) (*ctypes.ResultTxStatus, error
 		return &ctypes.ResultTxStatus{Status: txStatusPending}, nil
 	}
 
-	// Check if the tx is evicted
+	// Check if the tx is evicted. Txs evicted after failing a recheck also
+	// have a CheckTx code and log.
+	code, log, rejected := env.Mempool.WasRecentlyRejected(txKey)
 	isEvicted := env.Mempool.WasRecentlyEvicted(txKey)
 	if isEvicted {
-		return &ctypes.ResultTxStatus{Status: txStatusEvicted}, nil
+		return &ctypes.ResultTxStatus{Status: txStatusEvicted, CheckTxCode: code, CheckTxLog: log}, nil
+	}
+
+	// Check if the tx was rejected by CheckTx
+	if rejected {
+		return &ctypes.ResultTxStatus{Status: txStatusRejected, CheckTxCode: code, CheckTxLog: log}, nil
 	}
 
-	// If the tx is not in the mempool, evicted, or committed, return unknown
+	// If the tx is not in the mempool, evicted, rejected, or committed, return unknown
 	return &ctypes.ResultTxStatus{Status: txStatusUnknown}, nil
 }
 
@@ -288,9 +301,9 @@ func TxSearchMatchEvents(
 ) (*ctypes.ResultTxSearch, error) {
 
 	if matchEvents {
-		query = "match.events = 1 AND " + query
+		query = "match.events = 1 AND (" + query + ")"
 	} else {
-		query = "match.events = 0 AND " + query
+		query = "match.events = 0 AND (" + query + ")"
 	}
 	return TxSearch(ctx, query, prove, pagePtr, perPagePtr, orderBy)
 
@@ -60,6 +60,8 @@ func TestTxStatus(t *testing.T) {
 					mempool.EXPECT().GetTxByKey(tx.Key()).Return(nil, false).AnyTimes()
 					// Set WasRecentlyEvicted to return false for all transactions
 					mempool.EXPECT().WasRecentlyEvicted(tx.Key()).Return(false).AnyTimes()
+					// Set WasRecentlyRejected to return false for all transactions
+					mempool.EXPECT().WasRecentlyRejected(tx.Key()).Return(uint32(0), "", false).AnyTimes()
 				}
 			},
 
@@ -99,10 +101,34 @@ func TestTxStatus(t *testing.T) {
 					mempool.EXPECT().GetTxByKey(tx.Key()).Return(nil, false).AnyTimes()
 					// Set WasRecentlyEvicted to return true for all transactions
 					mempool.EXPECT().WasRecentlyEvicted(tx.Key()).Return(true).AnyTimes()
+					// Set WasRecentlyRejected to return false for all transactions
+					mempool.EXPECT().WasRecentlyRejected(tx.Key()).Return(uint32(0), "", false).AnyTimes()
 				}
 			},
 			expectedStatus: "EVICTED",
 		},
+		{
+			name: "Rejected",
+			setup: func(env *Environment, txs []types.Tx) {
+				env.BlockStore = mockBlockStore{
+					height: 0,
+					blocks: nil,
+				}
+				// Reset the mempool
+				mempool = mock.NewMockMempool(ctrl)
+				env.Mempool = mempool
+
+				for _, tx := range txs {
+					// Set GetTxByKey to return nil and false for all transactions
+					mempool.EXPECT().GetTxByKey(tx.Key()).Return(nil, false).AnyTimes()
+					// Set WasRecentlyEvicted to return false for all transactions
+					mempool.EXPECT().WasRecentlyEvicted(tx.Key()).Return(false).AnyTimes()
+					// Set WasRecentlyRejected to return the CheckTx result for all transactions
+					mempool.EXPECT().WasRecentlyRejected(tx.Key()).Return(uint32(2), "insufficient fee", true).AnyTimes()
+				}
+			},
+			expectedStatus: "REJECTED",
+		},
 	}
 
 	for _, tt := range tests {
@@ -127,6 +153,12 @@ func TestTxStatus(t *testing.T) {
 					assert.Equal(t, uint32(i), txStatus.Index)
 					assert.Equal(t, uint32(0), txStatus.ExecutionCode)
 				}
+
+				// Check the CheckTx result of transactions that are rejected
+				if tt.expectedStatus == "REJECTED" {
+					assert.Equal(t, uint32(2), txStatus.CheckTxCode)
+					assert.Equal(t, "insufficient fee", txStatus.CheckTxLog)
+				}
 			}
 
 		})
@@ -62,11 +62,14 @@ type ResultCommit struct {
 
 // ResultTxStatus represents the status of a transaction during its life cycle.
 // It contains info to locate a tx in a committed block as well as its execution code and status.
+// Rejected and evicted txs also carry the code and log returned by CheckTx, if known.
 type ResultTxStatus struct {
 	Height        int64  `json:"height"`
 	Index         uint32 `json:"index"`
 	ExecutionCode uint32 `json:"execution_code"`
 	Status        string `json:"status"`
+	CheckTxCode   uint32 `json:"check_tx_code,omitempty"`
+	CheckTxLog    string `json:"check_tx_log,omitempty"`
 }
 
 // ABCI results from a block
@@ -266,9 +269,21 @@ type ResultBroadcastEvidence struct {
 	Hash []byte `json:"hash"`
 }
 
+// List of trace tables being collected
+type ResultTraceTables struct {
+	Tables []string `json:"tables"`
+}
+
+// The halt height and time set with unsafe_set_halt
+type ResultUnsafeSetHalt struct {
+	HaltHeight int64 `json:"halt_height"`
+	HaltTime   int64 `json:"halt_time"`
+}
+
 // empty results
 type (
 	ResultUnsafeFlushMempool struct{}
+	ResultUnsafeFlushTraces  struct{}
 	ResultUnsafeProfile      struct{}
 	ResultSubscribe          struct{}
 	ResultUnsubscribe        struct{}
@@ -280,6 +295,29 @@ type ResultEvent struct {
 	Query  string              `json:"query"`
 	Data   types.TMEventData   `json:"data"`
 	Events map[string][]string `json:"events"`
+	// Cursor identifies NewBlock and Tx events. Passing it to subscribe_from
+	// resumes the subscription with the events following this one.
+	Cursor string `json:"cursor,omitempty"`
+}
+
+// ResultBlobsByNamespace is the list of blobs of a namespace included in a
+// block.
+type ResultBlobsByNamespace struct {
+	Height    int64            `json:"height"`
+	Namespace []byte           `json:"namespace"`
+	Blobs     []NamespacedBlob `json:"blobs"`
+}
+
+// NamespacedBlob is a blob along with the shares it occupies in the square
+// and a proof of those shares to the data root of the block. EndShare is
+// exclusive.
+type NamespacedBlob struct {
+	TxIndex      uint32           `json:"tx_index"`
+	Data         []byte           `json:"data"`
+	ShareVersion uint32           `json:"share_version"`
+	StartShare   uint64           `json:"start_share"`
+	EndShare     uint64           `json:"end_share"`
+	Proof        types.ShareProof `json:"proof"`
 }
 
 // ResultShareProof API proof response of a set of shares
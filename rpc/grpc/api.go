@@ -2,10 +2,20 @@ package coregrpc
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc/peer"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cryptoenc "github.com/cometbft/cometbft/crypto/encoding"
+	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	core "github.com/cometbft/cometbft/rpc/core"
 	rpctypes "github.com/cometbft/cometbft/rpc/jsonrpc/types"
+	"github.com/cometbft/cometbft/types"
 )
 
 type broadcastAPI struct {
@@ -37,3 +47,288 @@ func (bapi *broadcastAPI) BroadcastTx(ctx context.Context, req *RequestBroadcast
 		},
 	}, nil
 }
+
+type blockAPI struct {
+}
+
+func (bapi *blockAPI) BlockByHeight(ctx context.Context, req *RequestBlockByHeight) (*ResponseBlock, error) {
+	res, err := core.Block(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return blockResponse(res.BlockID, res.Block)
+}
+
+func (bapi *blockAPI) BlockByHash(ctx context.Context, req *RequestBlockByHash) (*ResponseBlock, error) {
+	res, err := core.BlockByHash(&rpctypes.Context{}, req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return blockResponse(res.BlockID, res.Block)
+}
+
+func (bapi *blockAPI) HeaderByHeight(ctx context.Context, req *RequestHeaderByHeight) (*ResponseHeader, error) {
+	res, err := core.Header(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseHeader{Header: res.Header.ToProto()}, nil
+}
+
+func (bapi *blockAPI) HeaderByHash(ctx context.Context, req *RequestHeaderByHash) (*ResponseHeader, error) {
+	res, err := core.HeaderByHash(&rpctypes.Context{}, req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseHeader{Header: res.Header.ToProto()}, nil
+}
+
+func (bapi *blockAPI) Commit(ctx context.Context, req *RequestCommit) (*ResponseCommit, error) {
+	res, err := core.Commit(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseCommit{
+		SignedHeader: res.SignedHeader.ToProto(),
+		Canonical:    res.CanonicalCommit,
+	}, nil
+}
+
+func (bapi *blockAPI) BlockResults(ctx context.Context, req *RequestBlockResults) (*ResponseBlockResults, error) {
+	res, err := core.BlockResults(&rpctypes.Context{}, heightPtr(req.Height))
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseBlockResults{
+		Height:                res.Height,
+		TxsResults:            res.TxsResults,
+		BeginBlockEvents:      res.BeginBlockEvents,
+		EndBlockEvents:        res.EndBlockEvents,
+		ValidatorUpdates:      res.ValidatorUpdates,
+		ConsensusParamUpdates: res.ConsensusParamUpdates,
+	}, nil
+}
+
+func (bapi *blockAPI) Validators(ctx context.Context, req *RequestValidators) (*ResponseValidators, error) {
+	var page, perPage *int
+	if req.Page != 0 {
+		p := int(req.Page)
+		page = &p
+	}
+	if req.PerPage != 0 {
+		pp := int(req.PerPage)
+		perPage = &pp
+	}
+	res, err := core.Validators(&rpctypes.Context{}, heightPtr(req.Height), page, perPage)
+	if err != nil {
+		return nil, err
+	}
+	validators := make([]*cmtproto.Validator, len(res.Validators))
+	for i, val := range res.Validators {
+		if validators[i], err = val.ToProto(); err != nil {
+			return nil, err
+		}
+	}
+	return &ResponseValidators{
+		BlockHeight: res.BlockHeight,
+		Validators:  validators,
+		Count:       int64(res.Count),
+		Total:       int64(res.Total),
+	}, nil
+}
+
+func (bapi *blockAPI) Status(ctx context.Context, req *RequestStatus) (*ResponseStatus, error) {
+	res, err := core.Status(&rpctypes.Context{})
+	if err != nil {
+		return nil, err
+	}
+	validatorInfo := &ValidatorInfo{
+		Address:     res.ValidatorInfo.Address,
+		VotingPower: res.ValidatorInfo.VotingPower,
+	}
+	if res.ValidatorInfo.PubKey != nil {
+		pubKey, err := cryptoenc.PubKeyToProto(res.ValidatorInfo.PubKey)
+		if err != nil {
+			return nil, err
+		}
+		validatorInfo.PubKey = &pubKey
+	}
+	return &ResponseStatus{
+		NodeInfo: res.NodeInfo.ToProto(),
+		SyncInfo: &SyncInfo{
+			LatestBlockHash:     res.SyncInfo.LatestBlockHash,
+			LatestAppHash:       res.SyncInfo.LatestAppHash,
+			LatestBlockHeight:   res.SyncInfo.LatestBlockHeight,
+			LatestBlockTime:     res.SyncInfo.LatestBlockTime,
+			EarliestBlockHash:   res.SyncInfo.EarliestBlockHash,
+			EarliestAppHash:     res.SyncInfo.EarliestAppHash,
+			EarliestBlockHeight: res.SyncInfo.EarliestBlockHeight,
+			EarliestBlockTime:   res.SyncInfo.EarliestBlockTime,
+			CatchingUp:          res.SyncInfo.CatchingUp,
+		},
+		ValidatorInfo: validatorInfo,
+	}, nil
+}
+
+func (bapi *blockAPI) SubscribeNewBlocks(req *RequestSubscribeNewBlocks, stream BlockAPI_SubscribeNewBlocksServer) error {
+	env := core.GetEnvironment()
+	return subscribe(stream.Context(), types.EventQueryNewBlock, func(msg cmtpubsub.Message) error {
+		block := msg.Data().(types.EventDataNewBlock).Block
+		blockMeta := env.BlockStore.LoadBlockMeta(block.Height)
+		if blockMeta == nil {
+			return fmt.Errorf("no block meta found for height %d", block.Height)
+		}
+		res, err := blockResponse(blockMeta.BlockID, block)
+		if err != nil {
+			return err
+		}
+		return stream.Send(res)
+	})
+}
+
+func (bapi *blockAPI) SubscribeNewHeaders(req *RequestSubscribeNewHeaders, stream BlockAPI_SubscribeNewHeadersServer) error {
+	return subscribe(stream.Context(), types.EventQueryNewBlockHeader, func(msg cmtpubsub.Message) error {
+		header := msg.Data().(types.EventDataNewBlockHeader).Header
+		return stream.Send(&ResponseHeader{Header: header.ToProto()})
+	})
+}
+
+type txAPI struct {
+}
+
+func (tapi *txAPI) Tx(ctx context.Context, req *RequestTx) (*ResponseTx, error) {
+	res, err := core.Tx(&rpctypes.Context{}, req.Hash, req.Prove)
+	if err != nil {
+		return nil, err
+	}
+	resp := &ResponseTx{
+		Hash:     res.Hash,
+		Height:   res.Height,
+		Index:    res.Index,
+		TxResult: res.TxResult,
+		Tx:       res.Tx,
+	}
+	if req.Prove {
+		proof := res.Proof.ToProto()
+		resp.Proof = &proof
+	}
+	return resp, nil
+}
+
+func (tapi *txAPI) TxStatus(ctx context.Context, req *RequestTxStatus) (*ResponseTxStatus, error) {
+	res, err := core.TxStatus(&rpctypes.Context{}, req.Hash)
+	if err != nil {
+		return nil, err
+	}
+	return &ResponseTxStatus{
+		Height:        res.Height,
+		Index:         res.Index,
+		ExecutionCode: res.ExecutionCode,
+		Status:        res.Status,
+		CheckTxCode:   res.CheckTxCode,
+		CheckTxLog:    res.CheckTxLog,
+	}, nil
+}
+
+// subscriberID is used to give each gRPC subscription a unique subscriber on
+// the event bus.
+var subscriberID atomic.Uint64
+
+// grpcClients counts the subscriptions of the gRPC clients, by remote
+// address, for the limits of the RPC config. Each subscription being a
+// subscriber of its own on the event bus, the clients can't be counted there.
+var grpcClients = struct {
+	sync.Mutex
+	subscriptions map[string]int
+	total         int
+}{subscriptions: make(map[string]int)}
+
+// addSubscription counts a subscription of the client, unless it would
+// exceed the max_subscription_clients or max_subscriptions_per_client limit.
+func addSubscription(client string) error {
+	env := core.GetEnvironment()
+	grpcClients.Lock()
+	defer grpcClients.Unlock()
+
+	// the subscribers of the event bus other than the gRPC ones, and the gRPC
+	// clients.
+	numClients := env.EventBus.NumClients() - grpcClients.total + len(grpcClients.subscriptions)
+	numSubscriptions := grpcClients.subscriptions[client]
+	if numSubscriptions == 0 && numClients >= env.Config.MaxSubscriptionClients {
+		return fmt.Errorf("max_subscription_clients %d reached", env.Config.MaxSubscriptionClients)
+	} else if numSubscriptions >= env.Config.MaxSubscriptionsPerClient {
+		return fmt.Errorf("max_subscriptions_per_client %d reached", env.Config.MaxSubscriptionsPerClient)
+	}
+	grpcClients.subscriptions[client]++
+	grpcClients.total++
+	return nil
+}
+
+func removeSubscription(client string) {
+	grpcClients.Lock()
+	defer grpcClients.Unlock()
+	if grpcClients.subscriptions[client]--; grpcClients.subscriptions[client] == 0 {
+		delete(grpcClients.subscriptions, client)
+	}
+	grpcClients.total--
+}
+
+// subscribe passes every message matching the query to send, until the stream
+// is closed by the client, the subscription is cancelled or send fails.
+func subscribe(ctx context.Context, q cmtpubsub.Query, send func(cmtpubsub.Message) error) error {
+	env := core.GetEnvironment()
+	var client string
+	if p, ok := peer.FromContext(ctx); ok {
+		client = p.Addr.String()
+	}
+	if err := addSubscription(client); err != nil {
+		return err
+	}
+	defer removeSubscription(client)
+
+	subscriber := fmt.Sprintf("grpc-%d", subscriberID.Add(1))
+	subCtx, cancel := context.WithTimeout(ctx, core.SubscribeTimeout)
+	defer cancel()
+	sub, err := env.EventBus.Subscribe(subCtx, subscriber, q, env.Config.SubscriptionBufferSize)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		err := env.EventBus.Unsubscribe(context.Background(), subscriber, q)
+		if err != nil && !errors.Is(err, cmtpubsub.ErrSubscriptionNotFound) {
+			env.Logger.Error("Can't unsubscribe", "subscriber", subscriber, "err", err)
+		}
+	}()
+
+	for {
+		select {
+		case msg := <-sub.Out():
+			if err := send(msg); err != nil {
+				return err
+			}
+		case <-sub.Cancelled():
+			return fmt.Errorf("subscription was cancelled (reason: %w)", sub.Err())
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func blockResponse(blockID types.BlockID, block *types.Block) (*ResponseBlock, error) {
+	res := &ResponseBlock{BlockID: blockID.ToProto()}
+	if block != nil {
+		var err error
+		if res.Block, err = block.ToProto(); err != nil {
+			return nil, err
+		}
+	}
+	return res, nil
+}
+
+// heightPtr maps the zero height, which requests the latest height, to nil.
+func heightPtr(height int64) *int64 {
+	if height == 0 {
+		return nil
+	}
+	return &height
+}
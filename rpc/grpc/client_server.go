@@ -15,25 +15,33 @@ type Config struct {
 	MaxOpenConnections int
 }
 
-// StartGRPCServer starts a new gRPC BroadcastAPIServer using the given
-// net.Listener.
+// StartGRPCServer starts a new gRPC server serving the BroadcastAPI, BlockAPI
+// and TxAPI services using the given net.Listener.
 // NOTE: This function blocks - you may want to call it in a go-routine.
 func StartGRPCServer(ln net.Listener) error {
 	grpcServer := grpc.NewServer()
 	RegisterBroadcastAPIServer(grpcServer, &broadcastAPI{})
+	RegisterBlockAPIServer(grpcServer, &blockAPI{})
+	RegisterTxAPIServer(grpcServer, &txAPI{})
 	return grpcServer.Serve(ln)
 }
 
 // StartGRPCClient dials the gRPC server using protoAddr and returns a new
 // BroadcastAPIClient.
 func StartGRPCClient(protoAddr string) BroadcastAPIClient {
-	conn, err := grpc.Dial(protoAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithContextDialer(dialerFunc))
+	conn, err := Dial(protoAddr)
 	if err != nil {
 		panic(err)
 	}
 	return NewBroadcastAPIClient(conn)
 }
 
+// Dial returns a connection to the gRPC server at protoAddr, which can be
+// shared by the clients of all the services.
+func Dial(protoAddr string) (*grpc.ClientConn, error) {
+	return grpc.Dial(protoAddr, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithContextDialer(dialerFunc))
+}
+
 func dialerFunc(ctx context.Context, addr string) (net.Conn, error) {
 	return cmtnet.Connect(addr)
 }
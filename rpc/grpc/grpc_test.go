@@ -4,12 +4,14 @@ import (
 	"context"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/cometbft/cometbft/abci/example/kvstore"
 	core_grpc "github.com/cometbft/cometbft/rpc/grpc"
 	rpctest "github.com/cometbft/cometbft/rpc/test"
+	"github.com/cometbft/cometbft/types"
 )
 
 func TestMain(m *testing.M) {
@@ -33,3 +35,93 @@ func TestBroadcastTx(t *testing.T) {
 	require.EqualValues(t, 0, res.CheckTx.Code)
 	require.EqualValues(t, 0, res.DeliverTx.Code)
 }
+
+func TestBlockAPI(t *testing.T) {
+	conn, err := core_grpc.Dial(rpctest.GetConfig().RPC.GRPCListenAddress)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := core_grpc.NewBlockAPIClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	status, err := client.Status(ctx, &core_grpc.RequestStatus{})
+	require.NoError(t, err)
+	height := status.SyncInfo.LatestBlockHeight
+	require.Positive(t, height)
+
+	block, err := client.BlockByHeight(ctx, &core_grpc.RequestBlockByHeight{Height: height})
+	require.NoError(t, err)
+	require.Equal(t, height, block.Block.Header.Height)
+	require.Equal(t, status.SyncInfo.LatestBlockHash, block.BlockID.Hash)
+
+	header, err := client.HeaderByHash(ctx, &core_grpc.RequestHeaderByHash{Hash: block.BlockID.Hash})
+	require.NoError(t, err)
+	require.Equal(t, block.Block.Header, *header.Header)
+
+	commit, err := client.Commit(ctx, &core_grpc.RequestCommit{Height: height})
+	require.NoError(t, err)
+	require.Equal(t, block.BlockID, commit.SignedHeader.Commit.BlockID)
+
+	validators, err := client.Validators(ctx, &core_grpc.RequestValidators{Height: height})
+	require.NoError(t, err)
+	require.Len(t, validators.Validators, 1)
+	require.EqualValues(t, 1, validators.Total)
+
+	_, err = client.BlockByHeight(ctx, &core_grpc.RequestBlockByHeight{Height: height + 1000})
+	require.Error(t, err)
+
+	blocks, err := client.SubscribeNewBlocks(ctx, &core_grpc.RequestSubscribeNewBlocks{})
+	require.NoError(t, err)
+	headers, err := client.SubscribeNewHeaders(ctx, &core_grpc.RequestSubscribeNewHeaders{})
+	require.NoError(t, err)
+	newBlock, err := blocks.Recv()
+	require.NoError(t, err)
+	require.Greater(t, newBlock.Block.Header.Height, height)
+	newHeader, err := headers.Recv()
+	require.NoError(t, err)
+	require.Greater(t, newHeader.Header.Height, height)
+}
+
+func TestSubscriptionLimits(t *testing.T) {
+	conn, err := core_grpc.Dial(rpctest.GetConfig().RPC.GRPCListenAddress)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := core_grpc.NewBlockAPIClient(conn)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// the subscriptions are registered once they receive a block.
+	for i := 0; i < rpctest.GetConfig().RPC.MaxSubscriptionsPerClient; i++ {
+		headers, err := client.SubscribeNewHeaders(ctx, &core_grpc.RequestSubscribeNewHeaders{})
+		require.NoError(t, err)
+		_, err = headers.Recv()
+		require.NoError(t, err)
+	}
+	headers, err := client.SubscribeNewHeaders(ctx, &core_grpc.RequestSubscribeNewHeaders{})
+	require.NoError(t, err)
+	_, err = headers.Recv()
+	require.ErrorContains(t, err, "max_subscriptions_per_client")
+}
+
+func TestTxAPI(t *testing.T) {
+	tx := []byte("grpc-tx-api=value")
+	res, err := rpctest.GetGRPCClient().BroadcastTx(context.Background(), &core_grpc.RequestBroadcastTx{Tx: tx})
+	require.NoError(t, err)
+	require.EqualValues(t, 0, res.DeliverTx.Code)
+
+	conn, err := core_grpc.Dial(rpctest.GetConfig().RPC.GRPCListenAddress)
+	require.NoError(t, err)
+	defer conn.Close()
+	client := core_grpc.NewTxAPIClient(conn)
+
+	hash := types.Tx(tx).Hash()
+	txRes, err := client.Tx(context.Background(), &core_grpc.RequestTx{Hash: hash})
+	require.NoError(t, err)
+	require.Equal(t, tx, txRes.Tx)
+	require.Nil(t, txRes.Proof)
+
+	status, err := client.TxStatus(context.Background(), &core_grpc.RequestTxStatus{Hash: hash})
+	require.NoError(t, err)
+	require.Equal(t, txRes.Height, status.Height)
+	require.Equal(t, "COMMITTED", status.Status)
+}
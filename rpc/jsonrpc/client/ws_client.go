@@ -532,6 +532,26 @@ func (c *WSClient) Subscribe(ctx context.Context, query string) error {
 	return c.Call(ctx, "subscribe", params)
 }
 
+// SubscribeFrom subscribes to a query, first replaying the events since the
+// given height, or following the event with the given cursor if it is not
+// empty. Note the server must have a "subscribe_from" route defined.
+func (c *WSClient) SubscribeFrom(ctx context.Context, query string, height int64, cursor string) error {
+	params := map[string]interface{}{"query": query}
+	if cursor != "" {
+		params["cursor"] = cursor
+	} else {
+		params["height"] = height
+	}
+	return c.Call(ctx, "subscribe_from", params)
+}
+
+// BroadcastTxWatch broadcasts a tx and subscribes to its life cycle events.
+// Note the server must have a "broadcast_tx_watch" route defined.
+func (c *WSClient) BroadcastTxWatch(ctx context.Context, tx []byte) error {
+	params := map[string]interface{}{"tx": tx}
+	return c.Call(ctx, "broadcast_tx_watch", params)
+}
+
 // Unsubscribe from a query. Note the server must have a "unsubscribe" route
 // defined.
 func (c *WSClient) Unsubscribe(ctx context.Context, query string) error {
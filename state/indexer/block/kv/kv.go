@@ -87,6 +87,9 @@ func (idx *BlockerIndexer) Index(bh types.EventDataNewBlockHeader) error {
 // one or more block heights. In the case of height queries, i.e. block.height=H,
 // if the height is indexed, that height alone will be returned. An error and
 // nil slice is returned. Otherwise, a non-nil slice and nil error is returned.
+// The heights matching conditions joined with OR are united, and the ones
+// matching a NOT are removed from the heights matching the conditions it is
+// joined with using AND.
 func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64, error) {
 	results := make([]int64, 0)
 	select {
@@ -96,10 +99,47 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 	default:
 	}
 
-	conditions, err := q.Conditions()
+	// search the conditions joined with AND together, and combine the results
+	// of the ones joined with OR and NOT.
+	filteredHeights, err := indexer.SearchExpr(q.Expr(), func(conditions []query.Condition) (map[string][]byte, error) {
+		return idx.searchConditions(ctx, conditions)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("failed to parse query conditions: %w", err)
+		return nil, err
+	}
+
+	// fetch matching heights
+	results = make([]int64, 0, len(filteredHeights))
+	resultMap := make(map[int64]struct{})
+	for _, hBz := range filteredHeights {
+		h := int64FromBytes(hBz)
+
+		ok, err := idx.Has(h)
+		if err != nil {
+			return nil, err
+		}
+		_, okHeight := resultMap[h]
+		if ok && !okHeight {
+			resultMap[h] = struct{}{}
+			results = append(results, h)
+		}
+
+		select {
+		case <-ctx.Done():
+			break
+
+		default:
+		}
 	}
+
+	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
+
+	return results, nil
+}
+
+// searchConditions returns the heights of the blocks matching all the
+// conditions.
+func (idx *BlockerIndexer) searchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
 	// conditions to skip because they're handled before "everything else"
 	skipIndexes := make([]int, 0)
 
@@ -151,10 +191,11 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 
 		if ok {
-			return []int64{heightInfo.height}, nil
+			heightBz := int64ToBytes(heightInfo.height)
+			return map[string][]byte{string(heightBz): heightBz}, nil
 		}
 
-		return results, nil
+		return map[string][]byte{}, nil
 	}
 
 	var heightsInitialized bool
@@ -235,33 +276,7 @@ func (idx *BlockerIndexer) Search(ctx context.Context, q *query.Query) ([]int64,
 		}
 	}
 
-	// fetch matching heights
-	results = make([]int64, 0, len(filteredHeights))
-	resultMap := make(map[int64]struct{})
-	for _, hBz := range filteredHeights {
-		h := int64FromBytes(hBz)
-
-		ok, err := idx.Has(h)
-		if err != nil {
-			return nil, err
-		}
-		_, okHeight := resultMap[h]
-		if ok && !okHeight {
-			resultMap[h] = struct{}{}
-			results = append(results, h)
-		}
-
-		select {
-		case <-ctx.Done():
-			break
-
-		default:
-		}
-	}
-
-	sort.Slice(results, func(i, j int) bool { return results[i] < results[j] })
-
-	return results, nil
+	return filteredHeights, nil
 }
 
 // matchRange returns all matching block heights that match a given QueryRange
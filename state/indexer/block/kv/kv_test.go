@@ -141,6 +141,22 @@ func TestBlockIndexer(t *testing.T) {
 			q:       query.MustParse("end_event.foo CONTAINS '1'"),
 			results: []int64{1, 10},
 		},
+		"end_event.foo = 2 OR end_event.foo = 4": {
+			q:       query.MustParse("end_event.foo = 2 OR end_event.foo = 4"),
+			results: []int64{2, 4},
+		},
+		"end_event.foo IN (2, 6, 100)": {
+			q:       query.MustParse("end_event.foo IN (2, 6, 100)"),
+			results: []int64{1, 2, 6},
+		},
+		"begin_event.proposer = 'FCAA001' AND NOT end_event.foo <= 5": {
+			q:       query.MustParse("begin_event.proposer = 'FCAA001' AND NOT end_event.foo <= 5"),
+			results: []int64{1, 3, 5, 6, 7, 8, 9, 10, 11},
+		},
+		"block.height = 5 OR (block.height > 9 AND end_event.foo EXISTS)": {
+			q:       query.MustParse("block.height = 5 OR (block.height > 9 AND end_event.foo EXISTS)"),
+			results: []int64{5, 10},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -0,0 +1,151 @@
+package indexer
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/cometbft/cometbft/libs/pubsub/query"
+	"github.com/cometbft/cometbft/types"
+)
+
+// MaxConjunctions is the maximum number of conjunctions a query is rewritten
+// into to be searched, e.g. 6 for "a IN (1, 2) AND b IN (3, 4, 5)".
+const MaxConjunctions = 1000
+
+// ErrNegationOnly is returned when searching for a query with a NOT that
+// isn't joined with AND to a condition restricting the results, e.g.
+// "NOT tx.sender = 'a'", since answering it needs a scan of the whole index.
+var ErrNegationOnly = errors.New("NOT must be joined with AND to a condition that is not negated")
+
+// SearchConditions returns the values matching the conjunction of conditions,
+// like the hash of the transactions or the height of the blocks. The returned
+// map is keyed by anything identifying the values, which are used as such.
+type SearchConditions func(conditions []query.Condition) (map[string][]byte, error)
+
+// conjunction is a conjunction of conditions, some of them negated.
+type conjunction struct {
+	conditions []query.Condition
+	negated    []query.Condition
+}
+
+// SearchExpr searches for the values matching a query expression. The
+// expression is rewritten as a disjunction of conjunctions, each searched with
+// a single call to search, so that the conditions that apply to all the
+// others, like match.events, apply within each conjunction. The values of a
+// negated condition are the ones found by searching it with the conditions
+// of its conjunction, which are removed from the results of the conjunction.
+// The returned map is keyed by the values.
+func SearchExpr(expr query.Expr, search SearchConditions) (map[string][]byte, error) {
+	conjunctions, err := disjunctiveNormalForm(expr, false)
+	if err != nil {
+		return nil, err
+	}
+
+	union := make(map[string][]byte)
+	for _, c := range conjunctions {
+		if !restricts(c.conditions) {
+			return nil, ErrNegationOnly
+		}
+		matches, err := search(c.conditions)
+		if err != nil {
+			return nil, err
+		}
+		result := byValue(matches)
+		for _, negated := range c.negated {
+			if len(result) == 0 {
+				break
+			}
+			conditions := append(append([]query.Condition(nil), c.conditions...), negated)
+			matches, err := search(conditions)
+			if err != nil {
+				return nil, err
+			}
+			for _, v := range matches {
+				delete(result, string(v))
+			}
+		}
+		for k, v := range result {
+			union[k] = v
+		}
+	}
+	return union, nil
+}
+
+// disjunctiveNormalForm returns the conjunctions whose disjunction is the
+// expression, or its negation if negate is set. It fails if there are more
+// than MaxConjunctions of them.
+func disjunctiveNormalForm(expr query.Expr, negate bool) ([]conjunction, error) {
+	switch {
+	case expr.Kind == query.ExprCondition && negate:
+		return []conjunction{{negated: []query.Condition{expr.Condition}}}, nil
+
+	case expr.Kind == query.ExprCondition:
+		return []conjunction{{conditions: []query.Condition{expr.Condition}}}, nil
+
+	case expr.Kind == query.ExprNot:
+		return disjunctiveNormalForm(expr.Operands[0], !negate)
+
+	// NOT (a AND b) is NOT a OR NOT b
+	case (expr.Kind == query.ExprOr) != negate:
+		var conjunctions []conjunction
+		for _, operand := range expr.Operands {
+			c, err := disjunctiveNormalForm(operand, negate)
+			if err != nil {
+				return nil, err
+			}
+			conjunctions = append(conjunctions, c...)
+			if len(conjunctions) > MaxConjunctions {
+				return nil, errTooManyConjunctions
+			}
+		}
+		return conjunctions, nil
+
+	// NOT (a OR b) is NOT a AND NOT b, and the conjunction of disjunctions
+	// is the disjunction of the conjunctions of their operands.
+	default:
+		conjunctions := []conjunction{{}}
+		for _, operand := range expr.Operands {
+			operandConjunctions, err := disjunctiveNormalForm(operand, negate)
+			if err != nil {
+				return nil, err
+			}
+			if len(conjunctions)*len(operandConjunctions) > MaxConjunctions {
+				return nil, errTooManyConjunctions
+			}
+			product := make([]conjunction, 0, len(conjunctions)*len(operandConjunctions))
+			for _, c := range conjunctions {
+				for _, oc := range operandConjunctions {
+					product = append(product, conjunction{
+						conditions: append(append([]query.Condition(nil), c.conditions...), oc.conditions...),
+						negated:    append(append([]query.Condition(nil), c.negated...), oc.negated...),
+					})
+				}
+			}
+			conjunctions = product
+		}
+		return conjunctions, nil
+	}
+}
+
+// restricts returns whether the conditions restrict the results, match.events
+// only changing how the others are matched.
+func restricts(conditions []query.Condition) bool {
+	for _, c := range conditions {
+		if c.CompositeKey != types.MatchEventKey {
+			return true
+		}
+	}
+	return false
+}
+
+var errTooManyConjunctions = fmt.Errorf("query too complex: it is searched as more than %d conjunctions", MaxConjunctions)
+
+// byValue keys the matches by their value, since the indexers may key the
+// same value differently, e.g. per event when matching events.
+func byValue(matches map[string][]byte) map[string][]byte {
+	values := make(map[string][]byte, len(matches))
+	for _, v := range matches {
+		values[string(v)] = v
+	}
+	return values
+}
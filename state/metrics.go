@@ -19,6 +19,10 @@ type Metrics struct {
 	BlockProcessingTime metrics.Histogram
 	// Count of times a block was rejected via ProcessProposal
 	ProcessProposalRejected metrics.Counter
+	// Height the pruner pruned up to, by kind of data.
+	PrunerRetainHeight metrics.Gauge
+	// Number of heights pruned by the pruner, by kind of data.
+	PrunerPrunedHeights metrics.Counter
 }
 
 // PrometheusMetrics returns Metrics build using Prometheus client library.
@@ -43,6 +47,18 @@ func PrometheusMetrics(namespace string, labelsAndValues ...string) *Metrics {
 			Name:      "process_proposal_rejected",
 			Help:      "Count of times a block was rejected via ProcessProposal",
 		}, labels).With(labelsAndValues...),
+		PrunerRetainHeight: prometheus.NewGaugeFrom(stdprometheus.GaugeOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruner_retain_height",
+			Help:      "Height the pruner pruned up to, by kind of data.",
+		}, append(labels, "kind")).With(labelsAndValues...),
+		PrunerPrunedHeights: prometheus.NewCounterFrom(stdprometheus.CounterOpts{
+			Namespace: namespace,
+			Subsystem: MetricsSubsystem,
+			Name:      "pruner_pruned_heights",
+			Help:      "Number of heights pruned by the pruner, by kind of data.",
+		}, append(labels, "kind")).With(labelsAndValues...),
 	}
 }
 
@@ -51,5 +67,7 @@ func NopMetrics() *Metrics {
 	return &Metrics{
 		BlockProcessingTime:     discard.NewHistogram(),
 		ProcessProposalRejected: discard.NewCounter(),
+		PrunerRetainHeight:      discard.NewGauge(),
+		PrunerPrunedHeights:     discard.NewCounter(),
 	}
 }
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	merkle "github.com/cometbft/cometbft/crypto/merkle"
 	mock "github.com/stretchr/testify/mock"
 	types "github.com/cometbft/cometbft/types"
     "github.com/cometbft/cometbft/proto/tendermint/store"
@@ -103,6 +104,38 @@ func (_m *BlockStore) LoadTxInfo(hash []byte) *store.TxInfo {
 	return r0
 }
 
+// LoadDataCommitment provides a mock function with given fields: start, end
+func (_m *BlockStore) LoadDataCommitment(start int64, end int64) []byte {
+	ret := _m.Called(start, end)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(int64, int64) []byte); ok {
+		r0 = rf(start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	return r0
+}
+
+// LoadDataRootInclusionProof provides a mock function with given fields: height, start, end
+func (_m *BlockStore) LoadDataRootInclusionProof(height int64, start int64, end int64) *merkle.Proof {
+	ret := _m.Called(height, start, end)
+
+	var r0 *merkle.Proof
+	if rf, ok := ret.Get(0).(func(int64, int64, int64) *merkle.Proof); ok {
+		r0 = rf(height, start, end)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*merkle.Proof)
+		}
+	}
+
+	return r0
+}
+
 // LoadBlockByHash provides a mock function with given fields: hash
 func (_m *BlockStore) LoadBlockByHash(hash []byte) *types.Block {
 	ret := _m.Called(hash)
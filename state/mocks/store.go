@@ -174,6 +174,27 @@ func (_m *Store) LoadLastABCIResponse(_a0 int64) (*tendermintstate.ABCIResponses
 	return r0, r1
 }
 
+// LoadPrunedHeights provides a mock function with given fields:
+func (_m *Store) LoadPrunedHeights() (state.PrunedHeights, error) {
+	ret := _m.Called()
+
+	var r0 state.PrunedHeights
+	if rf, ok := ret.Get(0).(func() state.PrunedHeights); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(state.PrunedHeights)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LoadValidators provides a mock function with given fields: _a0
 func (_m *Store) LoadValidators(_a0 int64) (*types.ValidatorSet, error) {
 	ret := _m.Called(_a0)
@@ -197,6 +218,20 @@ func (_m *Store) LoadValidators(_a0 int64) (*types.ValidatorSet, error) {
 	return r0, r1
 }
 
+// PruneABCIResponses provides a mock function with given fields: _a0, _a1
+func (_m *Store) PruneABCIResponses(_a0 int64, _a1 int64) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // PruneStates provides a mock function with given fields: _a0, _a1
 func (_m *Store) PruneStates(_a0 int64, _a1 int64) error {
 	ret := _m.Called(_a0, _a1)
@@ -239,6 +274,34 @@ func (_m *Store) SaveABCIResponses(_a0 int64, _a1 *tendermintstate.ABCIResponses
 	return r0
 }
 
+// SaveConsensusParams provides a mock function with given fields: height, lastHeightChanged, params
+func (_m *Store) SaveConsensusParams(height int64, lastHeightChanged int64, params types.ConsensusParams) error {
+	ret := _m.Called(height, lastHeightChanged, params)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, types.ConsensusParams) error); ok {
+		r0 = rf(height, lastHeightChanged, params)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveValidatorSet provides a mock function with given fields: height, lastHeightChanged, valSet
+func (_m *Store) SaveValidatorSet(height int64, lastHeightChanged int64, valSet *types.ValidatorSet) error {
+	ret := _m.Called(height, lastHeightChanged, valSet)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, int64, *types.ValidatorSet) error); ok {
+		r0 = rf(height, lastHeightChanged, valSet)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 type mockConstructorTestingTNewStore interface {
 	mock.TestingT
 	Cleanup(func())
@@ -0,0 +1,239 @@
+package state
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+	"github.com/cometbft/cometbft/types"
+)
+
+// pruneBatchSize is the maximum number of heights of the states, ABCI
+// responses and blocks the Pruner prunes at once, so that pruning a long
+// history doesn't stall the node.
+const pruneBatchSize = 1000
+
+// PrunerBlockStore is the part of the block store pruned by the Pruner.
+type PrunerBlockStore interface {
+	Base() int64
+	Height() int64
+	LoadBlockMeta(height int64) *types.BlockMeta
+	PruneBlocks(height int64) (uint64, error)
+	PruneTxInfos(height int64) (uint64, error)
+}
+
+// Retention is the number of latest heights whose data is retained by the
+// Pruner, for each kind of data. 0 disables the pruning of the blocks and the
+// states, which are then only pruned up to the retain height of the
+// application. With 0, the ABCI responses are pruned with the states, and
+// the tx infos with the blocks.
+//
+// The blocks and the states needed to verify evidence, as long as it is
+// valid under the evidence params, are retained regardless.
+type Retention struct {
+	Blocks        int64
+	States        int64
+	ABCIResponses int64
+	TxInfo        int64
+}
+
+// Pruner prunes the blocks, the states, the ABCI responses and the tx infos
+// older than their retention in the background, incrementally.
+type Pruner struct {
+	service.BaseService
+
+	stateStore Store
+	blockStore PrunerBlockStore
+	retention  Retention
+	interval   time.Duration
+	metrics    *Metrics
+
+	// quit is closed by OnStop, before the Quit channel of the service.
+	quit chan struct{}
+	done chan struct{}
+}
+
+// PrunerOption sets an optional parameter on the Pruner.
+type PrunerOption func(*Pruner)
+
+// PrunerWithMetrics sets the metrics of the Pruner.
+func PrunerWithMetrics(metrics *Metrics) PrunerOption {
+	return func(p *Pruner) { p.metrics = metrics }
+}
+
+// NewPruner returns a Pruner of the stores, checking for data to prune at
+// the given interval.
+func NewPruner(
+	stateStore Store,
+	blockStore PrunerBlockStore,
+	retention Retention,
+	interval time.Duration,
+	options ...PrunerOption,
+) *Pruner {
+	p := &Pruner{
+		stateStore: stateStore,
+		blockStore: blockStore,
+		retention:  retention,
+		interval:   interval,
+		metrics:    NopMetrics(),
+		quit:       make(chan struct{}),
+		done:       make(chan struct{}),
+	}
+	p.BaseService = *service.NewBaseService(nil, "Pruner", p)
+	for _, option := range options {
+		option(p)
+	}
+	return p
+}
+
+// OnStart implements service.Service.
+func (p *Pruner) OnStart() error {
+	go p.routine()
+	return nil
+}
+
+// OnStop implements service.Service by waiting for the pruning in progress,
+// if any, so that the stores can be closed.
+func (p *Pruner) OnStop() {
+	close(p.quit)
+	<-p.done
+}
+
+func (p *Pruner) routine() {
+	defer close(p.done)
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		// keep pruning while there is a backlog, between the checks of Quit.
+		caughtUp, err := p.prune()
+		if err != nil {
+			p.Logger.Error("Failed to prune", "err", err)
+			caughtUp = true
+		}
+		if !caughtUp {
+			select {
+			case <-p.quit:
+				return
+			default:
+				continue
+			}
+		}
+		select {
+		case <-ticker.C:
+		case <-p.quit:
+			return
+		}
+	}
+}
+
+// prune prunes a batch of heights of each kind of data, and returns whether
+// nothing is left to prune.
+func (p *Pruner) prune() (bool, error) {
+	height := p.blockStore.Height()
+	if height == 0 {
+		return true, nil
+	}
+	base := p.blockStore.Base()
+	pruned, err := p.stateStore.LoadPrunedHeights()
+	if err != nil {
+		return false, err
+	}
+	caughtUp := true
+	var evidenceHeight int64
+	if p.retention.States > 0 || p.retention.Blocks > 0 {
+		evidenceHeight, err = p.evidenceRetainHeight(base)
+		if err != nil {
+			return false, err
+		}
+	}
+
+	// The states and the ABCI responses are pruned before the blocks, since
+	// they start from the base of the block store if they were never pruned.
+	if p.retention.States > 0 {
+		target := min(height-p.retention.States, evidenceHeight)
+		from, to := pruneRange(pruned.States, base, target)
+		if from < to {
+			if err := p.stateStore.PruneStates(from, to); err != nil {
+				return false, fmt.Errorf("failed to prune states from %v to %v: %w", from, to, err)
+			}
+			p.record("states", from, to)
+			caughtUp = caughtUp && to == target
+		}
+	}
+	if p.retention.ABCIResponses > 0 {
+		from, to := pruneRange(pruned.ABCIResponses, base, height-p.retention.ABCIResponses)
+		if from < to {
+			if err := p.stateStore.PruneABCIResponses(from, to); err != nil {
+				return false, fmt.Errorf("failed to prune ABCI responses from %v to %v: %w", from, to, err)
+			}
+			p.record("abci_responses", from, to)
+			caughtUp = caughtUp && to == height-p.retention.ABCIResponses
+		}
+	}
+	if p.retention.TxInfo > 0 {
+		to := height - p.retention.TxInfo
+		if to > 0 {
+			n, err := p.blockStore.PruneTxInfos(to)
+			if err != nil {
+				return false, fmt.Errorf("failed to prune tx infos up to %v: %w", to, err)
+			}
+			p.metrics.PrunerRetainHeight.With("kind", "tx_info").Set(float64(to))
+			p.metrics.PrunerPrunedHeights.With("kind", "tx_info").Add(float64(n))
+		}
+	}
+	if p.retention.Blocks > 0 {
+		target := min(height-p.retention.Blocks, evidenceHeight)
+		from, to := pruneRange(base, base, target)
+		if from < to {
+			if _, err := p.blockStore.PruneBlocks(to); err != nil {
+				return false, fmt.Errorf("failed to prune blocks from %v to %v: %w", from, to, err)
+			}
+			p.record("blocks", from, to)
+			caughtUp = caughtUp && to == target
+		}
+	}
+	return caughtUp, nil
+}
+
+// evidenceRetainHeight returns the lowest height, from base, whose block and
+// validator set are needed to verify evidence, which expires once both its
+// age in blocks and its age in time exceed the evidence params.
+func (p *Pruner) evidenceRetainHeight(base int64) (int64, error) {
+	state, err := p.stateStore.Load()
+	if err != nil {
+		return 0, err
+	}
+	params := state.ConsensusParams.Evidence
+	height := state.LastBlockHeight - params.MaxAgeNumBlocks
+	if height <= base {
+		return base, nil
+	}
+	// the times of the blocks increase with their heights.
+	minTime := state.LastBlockTime.Add(-params.MaxAgeDuration)
+	n := sort.Search(int(height-base), func(i int) bool {
+		meta := p.blockStore.LoadBlockMeta(base + int64(i))
+		return meta != nil && !meta.Header.Time.Before(minTime)
+	})
+	return base + int64(n), nil
+}
+
+// pruneRange returns the next batch of heights to prune, from the height
+// already pruned up to, or base if never pruned, up to target.
+func pruneRange(pruned, base, target int64) (int64, int64) {
+	from := pruned
+	if from == 0 {
+		from = base
+	}
+	to := target
+	if to > from+pruneBatchSize {
+		to = from + pruneBatchSize
+	}
+	return from, to
+}
+
+func (p *Pruner) record(kind string, from, to int64) {
+	p.Logger.Debug("Pruned", "kind", kind, "from", from, "to", to)
+	p.metrics.PrunerRetainHeight.With("kind", kind).Set(float64(to))
+	p.metrics.PrunerPrunedHeights.With("kind", kind).Add(float64(to - from))
+}
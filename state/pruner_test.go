@@ -0,0 +1,182 @@
+package state_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cometbft/cometbft-db"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/libs/log"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+)
+
+// pruningBlockStore is a block store recording the heights it was pruned up
+// to.
+type pruningBlockStore struct {
+	mtx      cmtsync.Mutex
+	base     int64
+	height   int64
+	txInfoTo int64
+}
+
+func (bs *pruningBlockStore) Base() int64 {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	return bs.base
+}
+
+func (bs *pruningBlockStore) Height() int64 {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	return bs.height
+}
+
+// LoadBlockMeta returns the meta of a block whose time is its height in
+// seconds.
+func (bs *pruningBlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	if height < bs.base || height > bs.height {
+		return nil
+	}
+	return &types.BlockMeta{Header: types.Header{Height: height, Time: time.Unix(height, 0)}}
+}
+
+func (bs *pruningBlockStore) PruneBlocks(height int64) (uint64, error) {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	pruned := uint64(height - bs.base)
+	bs.base = height
+	return pruned, nil
+}
+
+func (bs *pruningBlockStore) PruneTxInfos(height int64) (uint64, error) {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	bs.txInfoTo = height
+	return 0, nil
+}
+
+func (bs *pruningBlockStore) pruned() (int64, int64) {
+	bs.mtx.Lock()
+	defer bs.mtx.Unlock()
+	return bs.base, bs.txInfoTo
+}
+
+func TestPruner(t *testing.T) {
+	const height = 2500
+	stateStore := makePrunerStateStore(t, height, types.EvidenceParams{})
+
+	blockStore := &pruningBlockStore{base: 1, height: height}
+	pruner := sm.NewPruner(stateStore, blockStore, sm.Retention{
+		Blocks:        100,
+		States:        200,
+		ABCIResponses: 300,
+		TxInfo:        400,
+	}, 10*time.Millisecond)
+	pruner.SetLogger(log.TestingLogger())
+	require.NoError(t, pruner.Start())
+	t.Cleanup(func() { _ = pruner.Stop() })
+
+	// the heights are pruned in batches, until the retention is reached
+	require.Eventually(t, func() bool {
+		pruned, err := stateStore.LoadPrunedHeights()
+		require.NoError(t, err)
+		base, txInfoTo := blockStore.pruned()
+		return pruned.States == height-200 && pruned.ABCIResponses == height-300 &&
+			base == height-100 && txInfoTo == height-400
+	}, 5*time.Second, 10*time.Millisecond)
+
+	for h := int64(1); h <= height; h++ {
+		_, err := stateStore.LoadABCIResponses(h)
+		if h < height-300 {
+			require.Error(t, err, h)
+		} else {
+			require.NoError(t, err, h)
+		}
+	}
+	_, err := stateStore.LoadValidators(height - 201)
+	require.Error(t, err)
+	_, err = stateStore.LoadValidators(height - 200)
+	require.NoError(t, err)
+
+	// the pruning follows the new heights
+	blockStore.mtx.Lock()
+	blockStore.height = height + 50
+	blockStore.mtx.Unlock()
+	require.Eventually(t, func() bool {
+		pruned, err := stateStore.LoadPrunedHeights()
+		require.NoError(t, err)
+		base, _ := blockStore.pruned()
+		return pruned.States == height-150 && base == height-50
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestPrunerEvidenceAge(t *testing.T) {
+	const height = 2500
+	// the evidence of the last 150 blocks and of the last 200 seconds, i.e.
+	// since height 2299, is valid.
+	stateStore := makePrunerStateStore(t, height, types.EvidenceParams{
+		MaxAgeNumBlocks: 150,
+		MaxAgeDuration:  200 * time.Second,
+	})
+	blockStore := &pruningBlockStore{base: 1, height: height}
+	pruner := sm.NewPruner(stateStore, blockStore, sm.Retention{
+		Blocks: 100,
+		States: 300,
+	}, 10*time.Millisecond)
+	pruner.SetLogger(log.TestingLogger())
+	require.NoError(t, pruner.Start())
+	t.Cleanup(func() { _ = pruner.Stop() })
+
+	require.Eventually(t, func() bool {
+		pruned, err := stateStore.LoadPrunedHeights()
+		require.NoError(t, err)
+		base, _ := blockStore.pruned()
+		return pruned.States == height-300 && base == height-201
+	}, 5*time.Second, 10*time.Millisecond)
+	time.Sleep(50 * time.Millisecond)
+	base, _ := blockStore.pruned()
+	require.EqualValues(t, height-201, base)
+	_, err := stateStore.LoadValidators(height - 201)
+	require.NoError(t, err)
+}
+
+// makePrunerStateStore returns a state store with the states and the ABCI
+// responses up to height, whose blocks have their height in seconds as time.
+func makePrunerStateStore(t *testing.T, height int64, evidenceParams types.EvidenceParams) sm.Store {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		PruneABCIResponsesSeparately: true,
+	})
+	validator, _ := types.RandValidator(true, 10)
+	validatorSet := types.NewValidatorSet([]*types.Validator{validator})
+	for h := int64(1); h <= height; h++ {
+		state := sm.State{
+			InitialHeight:   1,
+			LastBlockHeight: h - 1,
+			LastBlockTime:   time.Unix(h-1, 0),
+			Validators:      validatorSet,
+			NextValidators:  validatorSet,
+			ConsensusParams: types.ConsensusParams{
+				Block:    types.BlockParams{MaxBytes: 10e6},
+				Evidence: evidenceParams,
+			},
+			LastHeightValidatorsChanged:      1,
+			LastHeightConsensusParamsChanged: 1,
+		}
+		if h > 1 {
+			state.LastValidators = validatorSet
+		}
+		require.NoError(t, stateStore.Save(state))
+		require.NoError(t, stateStore.SaveABCIResponses(h, &cmtstate.ABCIResponses{
+			DeliverTxs: []*abci.ResponseDeliverTx{{Data: []byte{1}}},
+		}))
+	}
+	return stateStore
+}
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"github.com/cometbft/cometbft/crypto/merkle"
 	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
 	"github.com/cometbft/cometbft/types"
 )
@@ -39,6 +40,9 @@ type BlockStore interface {
 
 	LoadTxInfo(hash []byte) *cmtstore.TxInfo
 
+	LoadDataCommitment(start, end int64) []byte
+	LoadDataRootInclusionProof(height, start, end int64) *merkle.Proof
+
 	DeleteLatestBlock() error
 }
 
@@ -3,6 +3,7 @@ package state
 import (
 	"errors"
 	"fmt"
+	"strconv"
 
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/gogo/protobuf/proto"
@@ -10,6 +11,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 	cmtmath "github.com/cometbft/cometbft/libs/math"
 	cmtos "github.com/cometbft/cometbft/libs/os"
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
 	"github.com/cometbft/cometbft/types"
@@ -40,7 +42,9 @@ func calcABCIResponsesKey(height int64) []byte {
 //----------------------
 
 var (
-	lastABCIResponseKey = []byte("lastABCIResponseKey")
+	lastABCIResponseKey    = []byte("lastABCIResponseKey")
+	prunedStatesKey        = []byte("prunedStatesKey")
+	prunedABCIResponsesKey = []byte("prunedABCIResponsesKey")
 )
 
 //go:generate ../scripts/mockery_generate.sh Store
@@ -70,10 +74,20 @@ type Store interface {
 	Save(State) error
 	// SaveABCIResponses saves ABCIResponses for a given height
 	SaveABCIResponses(int64, *cmtstate.ABCIResponses) error
+	// SaveValidatorSet saves the validator set of a height, which last changed
+	// at the given height, e.g. when importing blocks
+	SaveValidatorSet(height, lastHeightChanged int64, valSet *types.ValidatorSet) error
+	// SaveConsensusParams saves the consensus params of a height, which last
+	// changed at the given height, e.g. when importing blocks
+	SaveConsensusParams(height, lastHeightChanged int64, params types.ConsensusParams) error
 	// Bootstrap is used for bootstrapping state when not starting from a initial height.
 	Bootstrap(State) error
 	// PruneStates takes the height from which to start prning and which height stop at
 	PruneStates(int64, int64) error
+	// PruneABCIResponses takes the height from which to start pruning the ABCI responses and which height to stop at
+	PruneABCIResponses(int64, int64) error
+	// LoadPrunedHeights loads the heights the states and the ABCI responses were pruned up to
+	LoadPrunedHeights() (PrunedHeights, error)
 	// Close closes the connection with the database
 	Close() error
 }
@@ -81,6 +95,9 @@ type Store interface {
 // dbStore wraps a db (github.com/cometbft/cometbft-db)
 type dbStore struct {
 	db dbm.DB
+	// pruneMtx serializes the pruning of the store, e.g. by the Pruner and
+	// by the consensus.
+	pruneMtx *cmtsync.Mutex
 
 	StoreOptions
 }
@@ -92,13 +109,25 @@ type StoreOptions struct {
 	// the store will maintain only the response object from the latest
 	// height.
 	DiscardABCIResponses bool
+
+	// PruneABCIResponsesSeparately makes PruneStates keep the ABCI responses,
+	// which are then only pruned by PruneABCIResponses, e.g. to retain them
+	// for longer than the states.
+	PruneABCIResponsesSeparately bool
+}
+
+// PrunedHeights are the heights the states and the ABCI responses were pruned
+// up to, or 0 if they were never pruned.
+type PrunedHeights struct {
+	States        int64
+	ABCIResponses int64
 }
 
 var _ Store = (*dbStore)(nil)
 
 // NewStore creates the dbStore of the state pkg.
 func NewStore(db dbm.DB, options StoreOptions) Store {
-	return dbStore{db, options}
+	return dbStore{db, new(cmtsync.Mutex), options}
 }
 
 // LoadStateFromDBOrGenesisFile loads the most recent state from the database,
@@ -240,6 +269,8 @@ func (store dbStore) Bootstrap(state State) error {
 // encoding not preserving ordering: https://github.com/cometbft/cometbft/issues/4567
 // This will cause some old states to be left behind when doing incremental partial prunes,
 // specifically older checkpoints and LastHeightChanged targets.
+//
+// The heights the states were already pruned up to are skipped.
 func (store dbStore) PruneStates(from int64, to int64) error {
 	if from <= 0 || to <= 0 {
 		return fmt.Errorf("from height %v and to height %v must be greater than 0", from, to)
@@ -247,6 +278,18 @@ func (store dbStore) PruneStates(from int64, to int64) error {
 	if from >= to {
 		return fmt.Errorf("from height %v must be lower than to height %v", from, to)
 	}
+	store.pruneMtx.Lock()
+	defer store.pruneMtx.Unlock()
+	prunedTo, err := store.loadPrunedHeight(prunedStatesKey)
+	if err != nil {
+		return err
+	}
+	if prunedTo >= to {
+		return nil
+	}
+	if prunedTo > from {
+		from = prunedTo
+	}
 	valInfo, err := loadValidatorsInfo(store.db, to)
 	if err != nil {
 		return fmt.Errorf("validators at height %v not found: %w", to, err)
@@ -339,9 +382,11 @@ func (store dbStore) PruneStates(from int64, to int64) error {
 			}
 		}
 
-		err = batch.Delete(calcABCIResponsesKey(h))
-		if err != nil {
-			return err
+		if !store.PruneABCIResponsesSeparately {
+			err = batch.Delete(calcABCIResponsesKey(h))
+			if err != nil {
+				return err
+			}
 		}
 		pruned++
 
@@ -357,6 +402,15 @@ func (store dbStore) PruneStates(from int64, to int64) error {
 		}
 	}
 
+	if err := store.setPrunedHeight(batch, prunedStatesKey, to); err != nil {
+		return err
+	}
+	if !store.PruneABCIResponsesSeparately {
+		if err := store.setPrunedHeight(batch, prunedABCIResponsesKey, to); err != nil {
+			return err
+		}
+	}
+
 	err = batch.WriteSync()
 	if err != nil {
 		return err
@@ -365,6 +419,80 @@ func (store dbStore) PruneStates(from int64, to int64) error {
 	return nil
 }
 
+// PruneABCIResponses deletes the ABCI responses between the given heights
+// (including from, excluding to).
+func (store dbStore) PruneABCIResponses(from int64, to int64) error {
+	if from <= 0 || to <= 0 {
+		return fmt.Errorf("from height %v and to height %v must be greater than 0", from, to)
+	}
+	if from >= to {
+		return fmt.Errorf("from height %v must be lower than to height %v", from, to)
+	}
+	store.pruneMtx.Lock()
+	defer store.pruneMtx.Unlock()
+
+	batch := store.db.NewBatch()
+	defer batch.Close()
+	for h := from; h < to; h++ {
+		if err := batch.Delete(calcABCIResponsesKey(h)); err != nil {
+			return err
+		}
+
+		// avoid batches growing too large by flushing to database regularly
+		if (h-from+1)%1000 == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = store.db.NewBatch()
+			defer batch.Close()
+		}
+	}
+	if err := store.setPrunedHeight(batch, prunedABCIResponsesKey, to); err != nil {
+		return err
+	}
+	return batch.WriteSync()
+}
+
+// LoadPrunedHeights loads the heights the states and the ABCI responses were
+// pruned up to.
+func (store dbStore) LoadPrunedHeights() (PrunedHeights, error) {
+	states, err := store.loadPrunedHeight(prunedStatesKey)
+	if err != nil {
+		return PrunedHeights{}, err
+	}
+	abciResponses, err := store.loadPrunedHeight(prunedABCIResponsesKey)
+	if err != nil {
+		return PrunedHeights{}, err
+	}
+	return PrunedHeights{States: states, ABCIResponses: abciResponses}, nil
+}
+
+func (store dbStore) loadPrunedHeight(key []byte) (int64, error) {
+	bz, err := store.db.Get(key)
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	height, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse pruned height %q: %w", bz, err)
+	}
+	return height, nil
+}
+
+// setPrunedHeight records in batch that the data of key was pruned up to
+// height, unless it was already pruned further.
+func (store dbStore) setPrunedHeight(batch dbm.Batch, key []byte, height int64) error {
+	pruned, err := store.loadPrunedHeight(key)
+	if err != nil {
+		return err
+	}
+	if height <= pruned {
+		return nil
+	}
+	return batch.Set(key, []byte(strconv.FormatInt(height, 10)))
+}
+
 //------------------------------------------------------------------------
 
 // ABCIResponsesResultsHash returns the root hash of a Merkle tree of
@@ -554,6 +682,13 @@ func loadValidatorsInfo(db dbm.DB, height int64) (*cmtstate.ValidatorsInfo, erro
 // `height` is the effective height for which the validator is responsible for
 // signing. It should be called from s.Save(), right before the state itself is
 // persisted.
+// SaveValidatorSet saves the validator set of a height. If it didn't change at
+// that height, it must be the one of lastHeightChanged with its proposer
+// priorities incremented once per height since, which it is loaded from.
+func (store dbStore) SaveValidatorSet(height, lastHeightChanged int64, valSet *types.ValidatorSet) error {
+	return store.saveValidatorsInfo(height, lastHeightChanged, valSet)
+}
+
 func (store dbStore) saveValidatorsInfo(height, lastHeightChanged int64, valSet *types.ValidatorSet) error {
 	if lastHeightChanged > height {
 		return errors.New("lastHeightChanged cannot be greater than ValidatorsInfo height")
@@ -636,6 +771,14 @@ func (store dbStore) loadConsensusParamsInfo(height int64) (*cmtstate.ConsensusP
 	return paramsInfo, nil
 }
 
+// SaveConsensusParams persists the consensus params of the given height.
+func (store dbStore) SaveConsensusParams(height, lastHeightChanged int64, params types.ConsensusParams) error {
+	if lastHeightChanged > height {
+		return errors.New("lastHeightChanged cannot be greater than ConsensusParamsInfo height")
+	}
+	return store.saveConsensusParamsInfo(height, lastHeightChanged, params)
+}
+
 // saveConsensusParamsInfo persists the consensus params for the next block to disk.
 // It should be called from s.Save(), right before the state itself is persisted.
 // If the consensus params did not change after processing the latest block,
@@ -206,6 +206,69 @@ func TestPruneStates(t *testing.T) {
 	}
 }
 
+func TestPruneABCIResponses(t *testing.T) {
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		PruneABCIResponsesSeparately: true,
+	})
+	validator, _ := types.RandValidator(true, 10)
+	validatorSet := types.NewValidatorSet([]*types.Validator{validator})
+	for h := int64(1); h <= 20; h++ {
+		state := sm.State{
+			InitialHeight:   1,
+			LastBlockHeight: h - 1,
+			Validators:      validatorSet,
+			NextValidators:  validatorSet,
+			ConsensusParams: types.ConsensusParams{
+				Block: types.BlockParams{MaxBytes: 10e6},
+			},
+			LastHeightValidatorsChanged:      1,
+			LastHeightConsensusParamsChanged: 1,
+		}
+		require.NoError(t, stateStore.Save(state))
+		require.NoError(t, stateStore.SaveABCIResponses(h, &cmtstate.ABCIResponses{
+			DeliverTxs: []*abci.ResponseDeliverTx{{Data: []byte{1}}},
+		}))
+	}
+	pruned, err := stateStore.LoadPrunedHeights()
+	require.NoError(t, err)
+	require.Equal(t, sm.PrunedHeights{}, pruned)
+
+	// the ABCI responses are kept when pruning the states
+	require.NoError(t, stateStore.PruneStates(1, 15))
+	_, err = stateStore.LoadValidators(5)
+	require.Error(t, err)
+	_, err = stateStore.LoadABCIResponses(5)
+	require.NoError(t, err)
+	pruned, err = stateStore.LoadPrunedHeights()
+	require.NoError(t, err)
+	require.Equal(t, sm.PrunedHeights{States: 15}, pruned)
+
+	// the states already pruned are skipped, e.g. when the consensus prunes
+	// them from the base of the block store.
+	require.NoError(t, stateStore.PruneStates(1, 10))
+	require.NoError(t, stateStore.PruneStates(1, 17))
+	_, err = stateStore.LoadValidators(16)
+	require.Error(t, err)
+	pruned, err = stateStore.LoadPrunedHeights()
+	require.NoError(t, err)
+	require.Equal(t, sm.PrunedHeights{States: 17}, pruned)
+
+	require.Error(t, stateStore.PruneABCIResponses(0, 10))
+	require.Error(t, stateStore.PruneABCIResponses(10, 10))
+	require.NoError(t, stateStore.PruneABCIResponses(1, 10))
+	for h := int64(1); h <= 20; h++ {
+		_, err := stateStore.LoadABCIResponses(h)
+		if h < 10 {
+			require.Equal(t, sm.ErrNoABCIResponsesForHeight{Height: h}, err)
+		} else {
+			require.NoError(t, err)
+		}
+	}
+	pruned, err = stateStore.LoadPrunedHeights()
+	require.NoError(t, err)
+	require.Equal(t, sm.PrunedHeights{States: 17, ABCIResponses: 10}, pruned)
+}
+
 func TestABCIResponsesResultsHash(t *testing.T) {
 	responses := &cmtstate.ABCIResponses{
 		BeginBlock: &abci.ResponseBeginBlock{},
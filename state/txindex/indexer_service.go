@@ -2,6 +2,7 @@ package txindex
 
 import (
 	"context"
+	"sync/atomic"
 
 	"github.com/cometbft/cometbft/libs/service"
 	"github.com/cometbft/cometbft/state/indexer"
@@ -23,6 +24,9 @@ type IndexerService struct {
 	blockIdxr        indexer.BlockIndexer
 	eventBus         *types.EventBus
 	terminateOnError bool
+
+	// the height of the last block whose events were handled
+	indexedHeight atomic.Int64
 }
 
 // NewIndexerService returns a new service instance.
@@ -108,11 +112,18 @@ func (is *IndexerService) OnStart() error {
 			} else {
 				is.Logger.Debug("indexed transactions", "height", height, "num_txs", eventDataHeader.NumTxs)
 			}
+			is.indexedHeight.Store(height)
 		}
 	}()
 	return nil
 }
 
+// IndexedHeight returns the height of the last block the service indexed, or
+// failed to index, since it started.
+func (is *IndexerService) IndexedHeight() int64 {
+	return is.indexedHeight.Load()
+}
+
 // OnStop implements service.Service by unsubscribing from all transactions.
 func (is *IndexerService) OnStop() {
 	if is.eventBus.IsRunning() {
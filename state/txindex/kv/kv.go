@@ -210,10 +210,13 @@ func (txi *TxIndex) indexResult(batch dbm.Batch, result *abci.TxResult) error {
 //
 // It breaks the query into conditions (like "tx.height > 5"). For each
 // condition, it queries the DB index. One special use cases here: (1) if
-// "tx.hash" is found, it returns tx result for it (2) for range queries it is
+// "tx.hash" is found, it returns tx result for it, if it matches the other
+// conditions (2) for range queries it is
 // better for the client to provide both lower and upper bounds, so we are not
 // performing a full scan. Results from querying indexes are then intersected
-// and returned to the caller, in no particular order.
+// and returned to the caller, in no particular order. The results of
+// conditions joined with OR are united, and the ones of a NOT are removed from
+// the results of the conditions it is joined with using AND.
 //
 // Search will exit early and return any result fetched so far,
 // when a message is received on the context chan.
@@ -225,29 +228,71 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 	default:
 	}
 
-	var hashesInitialized bool
-	filteredHashes := make(map[string][]byte)
-
-	// get a list of conditions (like "tx.height > 5")
-	conditions, err := q.Conditions()
+	// search the conditions joined with AND (like "tx.height > 5") together,
+	// and combine the results of the ones joined with OR and NOT.
+	filteredHashes, err := indexer.SearchExpr(q.Expr(), func(conditions []query.Condition) (map[string][]byte, error) {
+		return txi.searchConditions(ctx, conditions)
+	})
 	if err != nil {
-		return nil, fmt.Errorf("error during parsing conditions from query: %w", err)
+		return nil, err
 	}
 
-	// if there is a hash condition, return the result immediately
-	hash, ok, err := lookForHash(conditions)
+	results := make([]*abci.TxResult, 0, len(filteredHashes))
+	resultMap := make(map[string]struct{})
+RESULTS_LOOP:
+	for _, h := range filteredHashes {
+		res, err := txi.Get(h)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
+		}
+		hashString := string(h)
+		if _, ok := resultMap[hashString]; !ok {
+			resultMap[hashString] = struct{}{}
+			results = append(results, res)
+		}
+		// Potentially exit early.
+		select {
+		case <-ctx.Done():
+			break RESULTS_LOOP
+		default:
+		}
+	}
+
+	return results, nil
+}
+
+// searchConditions returns the hashes of the transactions matching all the
+// conditions.
+func (txi *TxIndex) searchConditions(ctx context.Context, conditions []query.Condition) (map[string][]byte, error) {
+	var hashesInitialized bool
+	filteredHashes := make(map[string][]byte)
+
+	// if there is a hash condition, the result is the tx with this hash, if it
+	// matches the other conditions
+	hash, hashIdx, err := lookForHash(conditions)
 	if err != nil {
 		return nil, fmt.Errorf("error during searching for a hash in the query: %w", err)
-	} else if ok {
+	} else if hashIdx >= 0 {
 		res, err := txi.Get(hash)
 		switch {
 		case err != nil:
-			return []*abci.TxResult{}, fmt.Errorf("error while retrieving the result: %w", err)
+			return nil, fmt.Errorf("error while retrieving the result: %w", err)
 		case res == nil:
-			return []*abci.TxResult{}, nil
-		default:
-			return []*abci.TxResult{res}, nil
+			return filteredHashes, nil
+		}
+		others := make([]query.Condition, 0, len(conditions)-1)
+		others = append(append(others, conditions[:hashIdx]...), conditions[hashIdx+1:]...)
+		if restrictsTxs(others) {
+			matches, err := txi.searchConditions(ctx, others)
+			if err != nil {
+				return nil, err
+			}
+			if !containsHash(matches, hash) {
+				return filteredHashes, nil
+			}
 		}
+		filteredHashes[string(hash)] = hash
+		return filteredHashes, nil
 	}
 
 	var matchEvents bool
@@ -338,38 +383,39 @@ func (txi *TxIndex) Search(ctx context.Context, q *query.Query) ([]*abci.TxResul
 		}
 	}
 
-	results := make([]*abci.TxResult, 0, len(filteredHashes))
-	resultMap := make(map[string]struct{})
-RESULTS_LOOP:
-	for _, h := range filteredHashes {
-		res, err := txi.Get(h)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get Tx{%X}: %w", h, err)
-		}
-		hashString := string(h)
-		if _, ok := resultMap[hashString]; !ok {
-			resultMap[hashString] = struct{}{}
-			results = append(results, res)
-		}
-		// Potentially exit early.
-		select {
-		case <-ctx.Done():
-			break RESULTS_LOOP
-		default:
+	return filteredHashes, nil
+}
+
+func lookForHash(conditions []query.Condition) (hash []byte, hashIdx int, err error) {
+	for i, c := range conditions {
+		if c.CompositeKey == types.TxHashKey {
+			decoded, err := hex.DecodeString(c.Operand.(string))
+			return decoded, i, err
 		}
 	}
-
-	return results, nil
+	return nil, -1, nil
 }
 
-func lookForHash(conditions []query.Condition) (hash []byte, ok bool, err error) {
+// restrictsTxs returns whether the conditions restrict the transactions,
+// match.events only changing how the others are matched.
+func restrictsTxs(conditions []query.Condition) bool {
 	for _, c := range conditions {
-		if c.CompositeKey == types.TxHashKey {
-			decoded, err := hex.DecodeString(c.Operand.(string))
-			return decoded, true, err
+		if c.CompositeKey != types.MatchEventKey {
+			return true
+		}
+	}
+	return false
+}
+
+// containsHash returns whether the hash is one of the matched hashes, which
+// may be keyed per event when matching events.
+func containsHash(matches map[string][]byte, hash []byte) bool {
+	for _, h := range matches {
+		if bytes.Equal(h, hash) {
+			return true
 		}
 	}
-	return
+	return false
 }
 
 // lookForHeight returns a height if there is an "height=X" condition.
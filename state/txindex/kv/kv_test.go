@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"os"
+	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/gogo/protobuf/proto"
@@ -173,6 +175,26 @@ func TestTxSearch(t *testing.T) {
 		// search using EXISTS for non existing key
 		{"account.date EXISTS", 0},
 		{"not_allowed EXISTS", 0},
+		// search using OR
+		{"account.owner = 'Vlad' OR account.number = 1", 1},
+		{"account.owner = 'Vlad' OR account.owner = 'Igor'", 0},
+		// search using IN
+		{"account.owner IN ('Vlad', 'Ivan')", 1},
+		{"account.number IN (2, 3)", 0},
+		// search using NOT
+		{"account.number = 1 AND NOT account.owner = 'Ivan'", 0},
+		{"account.number = 1 AND NOT account.owner = 'Vlad'", 1},
+		// search by hash using NOT
+		{fmt.Sprintf("tx.hash = '%X' AND NOT account.owner = 'Vlad'", hash), 1},
+		{fmt.Sprintf("tx.hash = '%X' AND NOT account.owner = 'Ivan'", hash), 0},
+		// search by hash and another key
+		{fmt.Sprintf("tx.hash = '%X' AND account.owner = 'Vlad'", hash), 0},
+		// search using parentheses
+		{"(account.owner = 'Vlad' OR account.number = 10) AND account.owner CONTAINS 'an'", 1},
+		{"account.owner CONTAINS 'an' AND NOT (account.owner = 'Vlad' OR account.number = 10)", 0},
+		// search using OR and match events
+		{"match.events = 1 AND (account.owner = '/Ivan/' AND account.number = 10 OR account.owner = 'Vlad')", 1},
+		{"match.events = 1 AND (account.owner = '/Ivan/' AND account.number = 1 OR account.owner = 'Vlad')", 0},
 	}
 
 	ctx := context.Background()
@@ -191,6 +213,24 @@ func TestTxSearch(t *testing.T) {
 			}
 		})
 	}
+
+	// a NOT needs a condition restricting the results.
+	_, err = indexer.Search(ctx, query.MustParse("NOT account.owner = 'Vlad'"))
+	assert.Error(t, err)
+	_, err = indexer.Search(ctx, query.MustParse("match.events = 1 AND NOT account.owner = 'Vlad'"))
+	assert.Error(t, err)
+
+	// the queries are searched as a bounded number of conjunctions.
+	var numbers, owners []string
+	for i := 0; i < 100; i++ {
+		numbers = append(numbers, strconv.Itoa(i))
+	}
+	for i := 0; i < 11; i++ {
+		owners = append(owners, fmt.Sprintf("'%d'", i))
+	}
+	_, err = indexer.Search(ctx, query.MustParse(fmt.Sprintf("account.number IN (%s) AND account.owner IN (%s)",
+		strings.Join(numbers, ", "), strings.Join(owners, ", "))))
+	assert.Error(t, err)
 }
 
 func TestTxSearchEventMatch(t *testing.T) {
@@ -275,6 +315,22 @@ func TestTxSearchEventMatch(t *testing.T) {
 			q:             "match.events = 1 AND account.number <= 2 AND account.owner = 'Ivan' AND tx.height > 0",
 			resultsLength: 1,
 		},
+		"Match OR within events": {
+			q:             "match.events = 1 AND account.number = 1 AND (account.owner = 'Ana' OR account.owner = 'Bob')",
+			resultsLength: 1,
+		},
+		"Match OR within events - no match": {
+			q:             "match.events = 1 AND account.number = 1 AND (account.owner = 'Ivan' OR account.owner = 'Bob')",
+			resultsLength: 0,
+		},
+		"Match NOT within events": {
+			q:             "match.events = 1 AND account.number = 2 AND NOT account.owner = 'Ana'",
+			resultsLength: 1,
+		},
+		"Match NOT within events - no match": {
+			q:             "match.events = 1 AND account.number = 2 AND NOT account.owner = 'Ivan'",
+			resultsLength: 0,
+		},
 	}
 
 	ctx := context.Background()
@@ -8,6 +8,7 @@ import (
 	"github.com/gogo/protobuf/proto"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/behaviour"
 	"github.com/cometbft/cometbft/config"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	"github.com/cometbft/cometbft/p2p"
@@ -40,6 +41,8 @@ type Reactor struct {
 	// snapshots and chunks into the sync.
 	mtx    cmtsync.RWMutex
 	syncer *syncer
+
+	reporter behaviour.Reporter
 }
 
 // NewReactor creates a new state sync reactor.
@@ -82,6 +85,7 @@ func (r *Reactor) GetChannels() []*p2p.ChannelDescriptor {
 
 // OnStart implements p2p.Reactor.
 func (r *Reactor) OnStart() error {
+	r.reporter = behaviour.NewSwitchReporter(r.Switch)
 	return nil
 }
 
@@ -112,7 +116,7 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 	err := validateMsg(e.Message)
 	if err != nil {
 		r.Logger.Error("Invalid message", "peer", e.Src, "msg", e.Message, "err", err)
-		r.Switch.StopPeerForError(e.Src, err)
+		_ = r.reporter.Report(behaviour.BadMessage(e.Src.ID(), err.Error()))
 		return
 	}
 
@@ -203,7 +207,7 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 			}
 			r.Logger.Debug("Received chunk, adding to sync", "height", msg.Height, "format", msg.Format,
 				"chunk", msg.Index, "peer", e.Src.ID())
-			_, err := r.syncer.AddChunk(&chunk{
+			added, err := r.syncer.AddChunk(&chunk{
 				Height: msg.Height,
 				Format: msg.Format,
 				Index:  msg.Index,
@@ -215,6 +219,9 @@ func (r *Reactor) ReceiveEnvelope(e p2p.Envelope) {
 					"chunk", msg.Index, "err", err)
 				return
 			}
+			if added && !msg.Missing {
+				_ = r.reporter.Report(behaviour.GoodMessage(e.Src.ID(), "sent a snapshot chunk"))
+			}
 
 		default:
 			r.Logger.Error("Received unknown message %T", msg)
@@ -0,0 +1,688 @@
+// Package archive exports the blocks of a node to a portable archive, and
+// imports them into the stores of another node, e.g. to seed an archive node
+// without copying the databases.
+//
+// An archive starts with the magic bytes "CMTBLOCKS" and the big endian uint16
+// version of the format, followed by frames. A frame is a kind byte, the big
+// endian uint32 length of the payload, the payload, and the big endian CRC-32C
+// of the kind and the payload. The first frame holds the JSON encoded Header.
+// For each height follow the validator set, the consensus params, the block,
+// the commit and, unless the node discarded them, the ABCI responses, encoded
+// as protobuf. The validator set of the height after the last block follows,
+// and a last empty frame marks the end of the archive, so that truncated
+// archives are detected.
+package archive
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	"github.com/cometbft/cometbft/version"
+)
+
+// Version is the version of the archive format written by Export. Version 2
+// added the consensus params and next validators frames, without which the
+// state of the last block can't be restored.
+const Version uint16 = 2
+
+// maxFrameSize bounds the frames read, which are at most a block.
+const maxFrameSize = 2 * types.MaxBlockSizeBytes
+
+var (
+	magic       = []byte("CMTBLOCKS")
+	crc32cTable = crc32.MakeTable(crc32.Castagnoli)
+)
+
+type frameKind byte
+
+const (
+	kindHeader frameKind = iota + 1
+	kindValidators
+	kindBlock
+	kindCommit
+	kindABCIResponses
+	kindEnd
+	kindConsensusParams
+	kindNextValidators
+)
+
+// Header describes the blocks of an archive.
+type Header struct {
+	ChainID string `json:"chain_id"`
+	From    int64  `json:"from"`
+	To      int64  `json:"to"`
+}
+
+// Export writes the blocks from height from to height to of the stores to w,
+// with their validator sets, consensus params, commits and ABCI responses.
+func Export(w io.Writer, blockStore sm.BlockStore, stateStore sm.Store, chainID string, from, to int64) error {
+	if base, height := blockStore.Base(), blockStore.Height(); from < base || to > height || from > to {
+		return fmt.Errorf("cannot export heights %d to %d, the store has heights %d to %d", from, to, base, height)
+	}
+
+	bw := bufio.NewWriter(w)
+	if _, err := bw.Write(magic); err != nil {
+		return err
+	}
+	if err := binary.Write(bw, binary.BigEndian, Version); err != nil {
+		return err
+	}
+	header, err := json.Marshal(Header{ChainID: chainID, From: from, To: to})
+	if err != nil {
+		return err
+	}
+	if err := writeFrame(bw, kindHeader, header); err != nil {
+		return err
+	}
+
+	for height := from; height <= to; height++ {
+		if err := exportHeight(bw, blockStore, stateStore, height); err != nil {
+			return fmt.Errorf("exporting height %d: %w", height, err)
+		}
+	}
+
+	// the validator set of the next height is needed to bootstrap the state.
+	nextVals, err := stateStore.LoadValidators(to + 1)
+	if err != nil {
+		return fmt.Errorf("exporting the validator set of height %d: %w", to+1, err)
+	}
+	pbNextVals, err := nextVals.ToProto()
+	if err != nil {
+		return err
+	}
+	if err := writeProto(bw, kindNextValidators, pbNextVals); err != nil {
+		return err
+	}
+
+	if err := writeFrame(bw, kindEnd, nil); err != nil {
+		return err
+	}
+	return bw.Flush()
+}
+
+func exportHeight(w io.Writer, blockStore sm.BlockStore, stateStore sm.Store, height int64) error {
+	vals, err := stateStore.LoadValidators(height)
+	if err != nil {
+		return err
+	}
+	pbVals, err := vals.ToProto()
+	if err != nil {
+		return err
+	}
+	if err := writeProto(w, kindValidators, pbVals); err != nil {
+		return err
+	}
+
+	params, err := stateStore.LoadConsensusParams(height)
+	if err != nil {
+		return err
+	}
+	pbParams := params.ToProto()
+	if err := writeProto(w, kindConsensusParams, &pbParams); err != nil {
+		return err
+	}
+
+	block := blockStore.LoadBlock(height)
+	if block == nil {
+		return errors.New("block not found")
+	}
+	pbBlock, err := block.ToProto()
+	if err != nil {
+		return err
+	}
+	if err := writeProto(w, kindBlock, pbBlock); err != nil {
+		return err
+	}
+
+	// the canonical commit is only stored once the next block is committed.
+	commit := blockStore.LoadBlockCommit(height)
+	if commit == nil {
+		commit = blockStore.LoadSeenCommit(height)
+	}
+	if commit == nil {
+		return errors.New("commit not found")
+	}
+	if err := writeProto(w, kindCommit, commit.ToProto()); err != nil {
+		return err
+	}
+
+	abciResponses, err := stateStore.LoadABCIResponses(height)
+	switch {
+	case errors.Is(err, sm.ErrABCIResponsesNotPersisted):
+		return nil
+	case err != nil:
+		return err
+	}
+	return writeProto(w, kindABCIResponses, abciResponses)
+}
+
+// Import reads an archive written by Export from r and saves its blocks,
+// commits, validator sets, consensus params and ABCI responses in the stores.
+// The hashes linking the blocks, the commits and the ABCI responses are
+// verified against the validator sets of the archive before saving a block.
+//
+// The first block of the archive must be trusted: its hash must be
+// trustedHash if set, or else its validator set must be the one of its height
+// in the state store, or the one of the genesis if the archive starts at the
+// initial height.
+//
+// A block is saved last with the data of its height, so that an import that
+// failed can be resumed with the same archive: if the block store isn't
+// empty, the import resumes after its latest block, which the archive must
+// hold and which is trusted.
+//
+// Once the blocks are saved, the state is bootstrapped at the height before
+// the last block, whose app hash is only known from the last block, unless
+// the state store already holds that height. The node then replays the last
+// block on start, as if it stopped after saving it. An archive of a single
+// height above the initial height is refused, as it can't bootstrap the state.
+func Import(
+	r io.Reader,
+	blockStore sm.BlockStore,
+	stateStore sm.Store,
+	genDoc *types.GenesisDoc,
+	trustedHash []byte,
+) (Header, error) {
+	br := bufio.NewReader(r)
+	prefix := make([]byte, len(magic))
+	if _, err := io.ReadFull(br, prefix); err != nil || !bytes.Equal(prefix, magic) {
+		return Header{}, errors.New("not a block archive")
+	}
+	var version uint16
+	if err := binary.Read(br, binary.BigEndian, &version); err != nil {
+		return Header{}, err
+	}
+	if version != Version {
+		return Header{}, fmt.Errorf("unsupported archive version %d, expected %d", version, Version)
+	}
+
+	var header Header
+	kind, payload, err := readFrame(br)
+	if err != nil {
+		return Header{}, err
+	}
+	if kind != kindHeader {
+		return Header{}, fmt.Errorf("expected the archive header, got frame kind %d", kind)
+	}
+	if err := json.Unmarshal(payload, &header); err != nil {
+		return Header{}, fmt.Errorf("decoding the archive header: %w", err)
+	}
+	if header.ChainID != genDoc.ChainID {
+		return Header{}, fmt.Errorf("the archive is for chain %q, not %q", header.ChainID, genDoc.ChainID)
+	}
+	if header.From == header.To && header.To > genDoc.InitialHeight {
+		return header, fmt.Errorf("cannot bootstrap the state from the single height %d, "+
+			"the archive must also hold the height before", header.To)
+	}
+
+	im := &importer{
+		header:      header,
+		genDoc:      genDoc,
+		blockStore:  blockStore,
+		stateStore:  stateStore,
+		trustedHash: trustedHash,
+	}
+	if height := blockStore.Height(); height != 0 {
+		if height < header.From || height >= header.To {
+			return header, fmt.Errorf("cannot resume the import after height %d with an archive of heights %d to %d",
+				height, header.From, header.To)
+		}
+		im.resumeHeight = height
+	}
+	var (
+		cur      *entry
+		nextVals *types.ValidatorSet
+	)
+	for {
+		kind, payload, err := readFrame(br)
+		if err != nil {
+			return header, err
+		}
+		if kind == kindEnd {
+			break
+		}
+		if nextVals != nil {
+			return header, fmt.Errorf("unexpected frame kind %d after the next validator set", kind)
+		}
+		if kind == kindNextValidators {
+			if cur == nil {
+				return header, errors.New("unexpected next validator set before a block")
+			}
+			if nextVals, err = decodeValidatorSet(payload); err != nil {
+				return header, fmt.Errorf("decoding the next validator set: %w", err)
+			}
+			continue
+		}
+		if kind == kindValidators {
+			if cur != nil {
+				if err := im.add(cur); err != nil {
+					return header, err
+				}
+			}
+			cur = &entry{}
+		} else if cur == nil {
+			return header, fmt.Errorf("unexpected frame kind %d before a validator set", kind)
+		}
+		if err := cur.decode(kind, payload); err != nil {
+			return header, err
+		}
+	}
+	if cur == nil {
+		return header, errors.New("the archive holds no blocks")
+	}
+	if err := im.add(cur); err != nil {
+		return header, err
+	}
+	if im.prev.block.Height != header.To {
+		return header, fmt.Errorf("the archive ends at height %d, expected %d", im.prev.block.Height, header.To)
+	}
+	if nextVals == nil {
+		return header, errors.New("the archive lacks the validator set of the height after its last block")
+	}
+	if !bytes.Equal(nextVals.Hash(), im.prev.block.NextValidatorsHash) {
+		return header, fmt.Errorf("the next validator set hash %X doesn't match the next validators hash %X of the last block",
+			nextVals.Hash(), im.prev.block.NextValidatorsHash)
+	}
+	if err := im.save(im.prev); err != nil {
+		return header, err
+	}
+	return header, im.bootstrap(nextVals)
+}
+
+// entry holds the frames of a height.
+type entry struct {
+	vals          *types.ValidatorSet
+	params        *types.ConsensusParams
+	block         *types.Block
+	commit        *types.Commit
+	abciResponses *cmtstate.ABCIResponses
+
+	partSet *types.PartSet
+	blockID types.BlockID
+}
+
+func (e *entry) decode(kind frameKind, payload []byte) error {
+	var err error
+	switch kind {
+	case kindValidators:
+		e.vals, err = decodeValidatorSet(payload)
+	case kindConsensusParams:
+		e.params, err = decodeConsensusParams(payload)
+	case kindBlock:
+		pb := new(cmtproto.Block)
+		if err = proto.Unmarshal(payload, pb); err == nil {
+			e.block, err = types.BlockFromProto(pb)
+		}
+	case kindCommit:
+		pb := new(cmtproto.Commit)
+		if err = proto.Unmarshal(payload, pb); err == nil {
+			e.commit, err = types.CommitFromProto(pb)
+		}
+	case kindABCIResponses:
+		e.abciResponses = new(cmtstate.ABCIResponses)
+		err = proto.Unmarshal(payload, e.abciResponses)
+	default:
+		return fmt.Errorf("unknown frame kind %d", kind)
+	}
+	if err != nil {
+		return fmt.Errorf("decoding frame kind %d: %w", kind, err)
+	}
+	return nil
+}
+
+// importer verifies the entries of an archive and saves them once the
+// following entry is verified to link to them.
+type importer struct {
+	header      Header
+	genDoc      *types.GenesisDoc
+	blockStore  sm.BlockStore
+	stateStore  sm.Store
+	trustedHash []byte
+	// resumeHeight is the latest height of the block store when resuming an
+	// import, whose block is the first one verified.
+	resumeHeight int64
+
+	prev *entry
+	// saved is the last entry saved, whose validator set and consensus params
+	// were saved in full at lastHeightValsChanged and lastHeightParamsChanged.
+	saved                   *entry
+	lastHeightValsChanged   int64
+	lastHeightParamsChanged int64
+}
+
+func (im *importer) add(e *entry) error {
+	if e.block != nil && e.block.Height < im.resumeHeight {
+		// already imported
+		return nil
+	}
+	if err := im.verify(e); err != nil {
+		return err
+	}
+	if im.prev != nil {
+		if err := im.verifyLink(im.prev, e); err != nil {
+			return fmt.Errorf("height %d: %w", e.block.Height, err)
+		}
+		if im.prev.block.Height > im.resumeHeight {
+			if err := im.save(im.prev); err != nil {
+				return err
+			}
+		}
+	}
+	im.prev = e
+	return nil
+}
+
+// verify verifies the block and the commit of a height.
+func (im *importer) verify(e *entry) error {
+	if e.params == nil || e.block == nil || e.commit == nil {
+		return errors.New("incomplete height, the consensus params, the block or the commit is missing")
+	}
+	height := e.block.Height
+	expected := im.header.From
+	if im.prev != nil {
+		expected = im.prev.block.Height + 1
+	} else if im.resumeHeight != 0 {
+		expected = im.resumeHeight
+	}
+	if height != expected {
+		return fmt.Errorf("expected height %d, got %d", expected, height)
+	}
+	if err := e.block.ValidateBasic(); err != nil {
+		return fmt.Errorf("height %d: invalid block: %w", height, err)
+	}
+	if e.block.ChainID != im.genDoc.ChainID {
+		return fmt.Errorf("height %d: block of chain %q", height, e.block.ChainID)
+	}
+	if !bytes.Equal(e.block.ValidatorsHash, e.vals.Hash()) {
+		return fmt.Errorf("height %d: validators hash %X doesn't match the validator set hash %X",
+			height, e.block.ValidatorsHash, e.vals.Hash())
+	}
+	if !bytes.Equal(e.block.ConsensusHash, e.params.Hash()) {
+		return fmt.Errorf("height %d: consensus hash %X doesn't match the consensus params hash %X",
+			height, e.block.ConsensusHash, e.params.Hash())
+	}
+
+	e.partSet = e.block.MakePartSet(types.BlockPartSizeBytes)
+	e.blockID = types.BlockID{Hash: e.block.Hash(), PartSetHeader: e.partSet.Header()}
+	if im.prev == nil {
+		if err := im.verifyTrusted(e); err != nil {
+			return fmt.Errorf("height %d: %w", height, err)
+		}
+	}
+	if err := e.vals.VerifyCommitLight(im.genDoc.ChainID, e.blockID, height, e.commit); err != nil {
+		return fmt.Errorf("height %d: invalid commit: %w", height, err)
+	}
+
+	if e.abciResponses != nil && len(e.abciResponses.DeliverTxs) != len(e.block.Txs) {
+		return fmt.Errorf("height %d: %d DeliverTx responses for %d txs",
+			height, len(e.abciResponses.DeliverTxs), len(e.block.Txs))
+	}
+	return nil
+}
+
+// verifyTrusted verifies the first entry against the trusted hash or
+// validator set.
+func (im *importer) verifyTrusted(e *entry) error {
+	height := e.block.Height
+	if im.resumeHeight != 0 {
+		meta := im.blockStore.LoadBlockMeta(height)
+		if meta == nil || !bytes.Equal(meta.BlockID.Hash, e.blockID.Hash) {
+			return errors.New("the block doesn't match the one of the block store")
+		}
+		return nil
+	}
+	if len(im.trustedHash) > 0 {
+		if !bytes.Equal(e.blockID.Hash, im.trustedHash) {
+			return fmt.Errorf("the block hash %X doesn't match the trusted hash %X", e.blockID.Hash, im.trustedHash)
+		}
+		return nil
+	}
+	if vals, err := im.stateStore.LoadValidators(height); err == nil {
+		if !bytes.Equal(e.vals.Hash(), vals.Hash()) {
+			return errors.New("the validator set doesn't match the one of the state store")
+		}
+		return nil
+	}
+	if height == im.genDoc.InitialHeight && len(im.genDoc.Validators) > 0 {
+		genVals := make([]*types.Validator, len(im.genDoc.Validators))
+		for i, val := range im.genDoc.Validators {
+			genVals[i] = types.NewValidator(val.PubKey, val.Power)
+		}
+		if !bytes.Equal(e.vals.Hash(), types.NewValidatorSet(genVals).Hash()) {
+			return errors.New("the validator set doesn't match the genesis")
+		}
+		return nil
+	}
+	return errors.New("nothing to verify the first block against: " +
+		"neither the trusted hash, the validator set of its height nor the genesis validators are known")
+}
+
+// verifyLink verifies that the block of cur follows the one of prev.
+func (im *importer) verifyLink(prev, cur *entry) error {
+	if !cur.block.LastBlockID.Equals(prev.blockID) {
+		return fmt.Errorf("last block ID %v doesn't match the previous block %v", cur.block.LastBlockID, prev.blockID)
+	}
+	if !bytes.Equal(cur.block.ValidatorsHash, prev.block.NextValidatorsHash) {
+		return fmt.Errorf("validators hash %X doesn't match the next validators hash %X of the previous block",
+			cur.block.ValidatorsHash, prev.block.NextValidatorsHash)
+	}
+	if err := prev.vals.VerifyCommitLight(im.genDoc.ChainID, prev.blockID, prev.block.Height, cur.block.LastCommit); err != nil {
+		return fmt.Errorf("invalid last commit: %w", err)
+	}
+	if prev.abciResponses != nil {
+		if hash := sm.ABCIResponsesResultsHash(prev.abciResponses); !bytes.Equal(cur.block.LastResultsHash, hash) {
+			return fmt.Errorf("last results hash %X doesn't match the ABCI responses of the previous block %X",
+				cur.block.LastResultsHash, hash)
+		}
+	}
+	return nil
+}
+
+// save saves the data of the height of the entry, and then its block, so that
+// the heights of the block store are fully imported.
+func (im *importer) save(e *entry) error {
+	if err := im.saveValidators(e); err != nil {
+		return err
+	}
+	if err := im.saveConsensusParams(e); err != nil {
+		return err
+	}
+	im.saved = e
+	if e.abciResponses != nil {
+		if err := im.stateStore.SaveABCIResponses(e.block.Height, e.abciResponses); err != nil {
+			return err
+		}
+		codes := make([]uint32, len(e.abciResponses.DeliverTxs))
+		for i, res := range e.abciResponses.DeliverTxs {
+			codes[i] = res.Code
+		}
+		if err := im.blockStore.SaveTxInfo(e.block, codes); err != nil {
+			return err
+		}
+	}
+	im.blockStore.SaveBlock(e.block, e.partSet, e.commit)
+	return nil
+}
+
+// saveValidators saves the validator set of the entry. As the state does, it
+// is only saved in full when it differs from the one of the previous height
+// with its proposer priorities incremented, which it is loaded from otherwise.
+func (im *importer) saveValidators(e *entry) error {
+	height := e.block.Height
+	lastHeightChanged := height
+	if im.saved != nil && validatorSetsEqual(im.saved.vals.CopyIncrementProposerPriority(1), e.vals) {
+		lastHeightChanged = im.lastHeightValsChanged
+	}
+	if err := im.stateStore.SaveValidatorSet(height, lastHeightChanged, e.vals); err != nil {
+		return err
+	}
+	im.lastHeightValsChanged = lastHeightChanged
+	return nil
+}
+
+// saveConsensusParams saves the consensus params of the entry, in full only
+// when they differ from the ones of the previous height.
+func (im *importer) saveConsensusParams(e *entry) error {
+	height := e.block.Height
+	lastHeightChanged := height
+	if im.saved != nil && proto.Equal(paramsProto(im.saved.params), paramsProto(e.params)) {
+		lastHeightChanged = im.lastHeightParamsChanged
+	}
+	if err := im.stateStore.SaveConsensusParams(height, lastHeightChanged, *e.params); err != nil {
+		return err
+	}
+	im.lastHeightParamsChanged = lastHeightChanged
+	return nil
+}
+
+// bootstrap saves the state after the block before the last one, from the
+// last block and the validator set of the next height, so that the node
+// replays the last block on start.
+func (im *importer) bootstrap(nextVals *types.ValidatorSet) error {
+	last := im.prev
+	height := last.block.Height - 1
+	if height < im.genDoc.InitialHeight {
+		// the node starts from the genesis state
+		return nil
+	}
+	state, err := im.stateStore.Load()
+	if err != nil {
+		return err
+	}
+	if state.LastBlockHeight >= height {
+		return nil
+	}
+
+	lastVals, err := im.stateStore.LoadValidators(height)
+	if err != nil {
+		return err
+	}
+	meta := im.blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return fmt.Errorf("cannot bootstrap the state, the block of height %d is missing", height)
+	}
+	state = sm.State{
+		Version: cmtstate.Version{
+			Consensus: last.block.Version,
+			Software:  version.TMCoreSemVer,
+		},
+		ChainID:                          im.genDoc.ChainID,
+		InitialHeight:                    im.genDoc.InitialHeight,
+		LastBlockHeight:                  height,
+		LastBlockID:                      last.block.LastBlockID,
+		LastBlockTime:                    meta.Header.Time,
+		NextValidators:                   nextVals,
+		Validators:                       last.vals,
+		LastValidators:                   lastVals,
+		LastHeightValidatorsChanged:      height + 2,
+		ConsensusParams:                  *last.params,
+		LastHeightConsensusParamsChanged: height + 1,
+		LastResultsHash:                  last.block.LastResultsHash,
+		AppHash:                          last.block.AppHash,
+	}
+	return im.stateStore.Bootstrap(state)
+}
+
+func paramsProto(params *types.ConsensusParams) *cmtproto.ConsensusParams {
+	pb := params.ToProto()
+	return &pb
+}
+
+func decodeValidatorSet(payload []byte) (*types.ValidatorSet, error) {
+	pb := new(cmtproto.ValidatorSet)
+	if err := proto.Unmarshal(payload, pb); err != nil {
+		return nil, err
+	}
+	return types.ValidatorSetFromProto(pb)
+}
+
+func decodeConsensusParams(payload []byte) (*types.ConsensusParams, error) {
+	pb := new(cmtproto.ConsensusParams)
+	if err := proto.Unmarshal(payload, pb); err != nil {
+		return nil, err
+	}
+	if pb.Block == nil || pb.Evidence == nil || pb.Validator == nil || pb.Version == nil {
+		return nil, errors.New("incomplete consensus params")
+	}
+	params := types.ConsensusParamsFromProto(*pb)
+	if err := params.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return &params, nil
+}
+
+func validatorSetsEqual(a, b *types.ValidatorSet) bool {
+	pa, err := a.ToProto()
+	if err != nil {
+		return false
+	}
+	pb, err := b.ToProto()
+	if err != nil {
+		return false
+	}
+	return proto.Equal(pa, pb)
+}
+
+func writeProto(w io.Writer, kind frameKind, msg proto.Message) error {
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	return writeFrame(w, kind, payload)
+}
+
+func writeFrame(w io.Writer, kind frameKind, payload []byte) error {
+	buf := make([]byte, 5, 5+len(payload)+4)
+	buf[0] = byte(kind)
+	binary.BigEndian.PutUint32(buf[1:], uint32(len(payload)))
+	buf = append(buf, payload...)
+	buf = binary.BigEndian.AppendUint32(buf, checksum(kind, payload))
+	_, err := w.Write(buf)
+	return err
+}
+
+func readFrame(r io.Reader) (frameKind, []byte, error) {
+	var prefix [5]byte
+	if _, err := io.ReadFull(r, prefix[:]); err != nil {
+		return 0, nil, fmt.Errorf("reading frame: %w", unexpectedEOF(err))
+	}
+	kind := frameKind(prefix[0])
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if size > maxFrameSize {
+		return 0, nil, fmt.Errorf("frame of %d bytes exceeds the maximum of %d", size, maxFrameSize)
+	}
+	buf := make([]byte, size+4)
+	if _, err := io.ReadFull(r, buf); err != nil {
+		return 0, nil, fmt.Errorf("reading frame: %w", unexpectedEOF(err))
+	}
+	payload := buf[:size]
+	if sum := binary.BigEndian.Uint32(buf[size:]); sum != checksum(kind, payload) {
+		return 0, nil, errors.New("frame checksum mismatch, the archive is corrupted")
+	}
+	return kind, payload, nil
+}
+
+func checksum(kind frameKind, payload []byte) uint32 {
+	sum := crc32.Update(0, crc32cTable, []byte{byte(kind)})
+	return crc32.Update(sum, crc32cTable, payload)
+}
+
+// unexpectedEOF reports an archive ending before its end frame as truncated.
+func unexpectedEOF(err error) error {
+	if errors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+	return err
+}
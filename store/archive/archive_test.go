@@ -0,0 +1,350 @@
+package archive_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/abci/example/kvstore"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cometbft/cometbft/consensus"
+	"github.com/cometbft/cometbft/libs/log"
+	mpmock "github.com/cometbft/cometbft/mempool/mock"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/proxy"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/store/archive"
+	"github.com/cometbft/cometbft/types"
+)
+
+// makeChain commits blocks 1 to height, signed by a single validator, in new
+// stores.
+func makeChain(t *testing.T, height int64) (*types.GenesisDoc, *store.BlockStore, sm.Store) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	genDoc := &types.GenesisDoc{
+		ChainID:     "archive-test",
+		GenesisTime: time.Now(),
+		Validators:  []types.GenesisValidator{{PubKey: pubKey, Power: 10}},
+	}
+	require.NoError(t, genDoc.ValidateAndComplete())
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+
+	lastCommit := &types.Commit{}
+	var lastBlockID types.BlockID
+	var lastResultsHash []byte
+	for h := int64(1); h <= height; h++ {
+		state.LastBlockHeight = h - 1
+		require.NoError(t, stateStore.Save(state))
+
+		txs := types.Txs{types.Tx("tx"), types.Tx{byte(h)}}
+		block := types.MakeBlock(h, txs, lastCommit, nil)
+		block.Header.Populate(
+			state.Version.Consensus, genDoc.ChainID, genDoc.GenesisTime.Add(time.Duration(h)*time.Second),
+			lastBlockID, state.Validators.Hash(), state.NextValidators.Hash(),
+			state.ConsensusParams.Hash(), nil, lastResultsHash, pubKey.Address(),
+		)
+		partSet := block.MakePartSet(types.BlockPartSizeBytes)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		voteSet := types.NewVoteSet(genDoc.ChainID, h, 0, cmtproto.PrecommitType, state.Validators)
+		commit, err := types.MakeCommit(blockID, h, 0, voteSet, []types.PrivValidator{pv}, block.Time)
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, partSet, commit)
+
+		abciResponses := &cmtstate.ABCIResponses{
+			DeliverTxs: []*abci.ResponseDeliverTx{{Code: 0}, {Code: uint32(h)}},
+			BeginBlock: &abci.ResponseBeginBlock{},
+			EndBlock:   &abci.ResponseEndBlock{},
+		}
+		require.NoError(t, stateStore.SaveABCIResponses(h, abciResponses))
+
+		lastCommit, lastBlockID = commit, blockID
+		lastResultsHash = sm.ABCIResponsesResultsHash(abciResponses)
+	}
+	return genDoc, blockStore, stateStore
+}
+
+// makeExecutedChain commits blocks 1 to height, executed by a kvstore
+// application, in new stores, and returns the state at height.
+func makeExecutedChain(t *testing.T, height int64) (*types.GenesisDoc, *store.BlockStore, sm.Store, sm.State) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	genDoc := &types.GenesisDoc{
+		ChainID:     "archive-test",
+		GenesisTime: time.Now(),
+		Validators:  []types.GenesisValidator{{PubKey: pubKey, Power: 10}},
+	}
+	require.NoError(t, genDoc.ValidateAndComplete())
+
+	blockStore := store.NewBlockStore(dbm.NewMemDB())
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	proxyApp := startProxyApp(t)
+	state := handshake(t, blockStore, stateStore, genDoc, proxyApp)
+	blockExec := sm.NewBlockExecutor(stateStore, log.NewNopLogger(), proxyApp.Consensus(),
+		mpmock.Mempool{}, sm.EmptyEvidencePool{}, sm.WithBlockStore(blockStore))
+
+	lastCommit := &types.Commit{}
+	for h := int64(1); h <= height; h++ {
+		txs := types.Txs{types.Tx(fmt.Sprintf("key%d=value", h))}
+		block, partSet := state.MakeBlock(h, txs, lastCommit, nil, pubKey.Address())
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		voteSet := types.NewVoteSet(genDoc.ChainID, h, 0, cmtproto.PrecommitType, state.Validators)
+		commit, err := types.MakeCommit(blockID, h, 0, voteSet, []types.PrivValidator{pv}, block.Time.Add(time.Second))
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, partSet, commit)
+		state, _, err = blockExec.ApplyBlock(state, blockID, block, commit)
+		require.NoError(t, err)
+		lastCommit = commit
+	}
+	return genDoc, blockStore, stateStore, state
+}
+
+func startProxyApp(t *testing.T) proxy.AppConns {
+	proxyApp := proxy.NewAppConns(proxy.NewLocalClientCreator(kvstore.NewApplication()))
+	require.NoError(t, proxyApp.Start())
+	t.Cleanup(func() { _ = proxyApp.Stop() })
+	return proxyApp
+}
+
+// handshake runs the handshake of a starting node with the application, and
+// returns the resulting state.
+func handshake(
+	t *testing.T,
+	blockStore *store.BlockStore,
+	stateStore sm.Store,
+	genDoc *types.GenesisDoc,
+	proxyApp proxy.AppConns,
+) sm.State {
+	state, err := stateStore.LoadFromDBOrGenesisDoc(genDoc)
+	require.NoError(t, err)
+	handshaker := consensus.NewHandshaker(stateStore, state, blockStore, genDoc)
+	handshaker.SetLogger(log.NewNopLogger())
+	_, err = handshaker.Handshake(proxyApp)
+	require.NoError(t, err)
+	state, err = stateStore.Load()
+	require.NoError(t, err)
+	return state
+}
+
+func TestExportImport(t *testing.T) {
+	genDoc, blockStore, stateStore := makeChain(t, 5)
+
+	var buf bytes.Buffer
+	require.NoError(t, archive.Export(&buf, blockStore, stateStore, genDoc.ChainID, 1, 5))
+	exported := buf.Bytes()
+
+	importedBlocks := store.NewBlockStore(dbm.NewMemDB())
+	importedStates := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	header, err := archive.Import(bytes.NewReader(exported), importedBlocks, importedStates, genDoc, nil)
+	require.NoError(t, err)
+	require.Equal(t, archive.Header{ChainID: genDoc.ChainID, From: 1, To: 5}, header)
+
+	require.EqualValues(t, 1, importedBlocks.Base())
+	require.EqualValues(t, 5, importedBlocks.Height())
+	for h := int64(1); h <= 5; h++ {
+		require.Equal(t, blockStore.LoadBlock(h).Hash(), importedBlocks.LoadBlock(h).Hash())
+		require.Equal(t, blockStore.LoadBlockMeta(h), importedBlocks.LoadBlockMeta(h))
+		expected, err := stateStore.LoadABCIResponses(h)
+		require.NoError(t, err)
+		imported, err := importedStates.LoadABCIResponses(h)
+		require.NoError(t, err)
+		require.Equal(t, expected, imported)
+		expectedVals, err := stateStore.LoadValidators(h)
+		require.NoError(t, err)
+		importedVals, err := importedStates.LoadValidators(h)
+		require.NoError(t, err)
+		require.Equal(t, expectedVals, importedVals)
+	}
+	require.Equal(t, blockStore.LoadSeenCommit(5).Hash(), importedBlocks.LoadSeenCommit(5).Hash())
+	txInfo := importedBlocks.LoadTxInfo(types.Tx{3}.Hash())
+	require.NotNil(t, txInfo)
+	require.EqualValues(t, 3, txInfo.Height)
+	require.EqualValues(t, 3, txInfo.Code)
+
+	// a range in the middle of the chain needs the hash of its first block.
+	buf.Reset()
+	require.NoError(t, archive.Export(&buf, blockStore, stateStore, genDoc.ChainID, 2, 4))
+	middle := buf.Bytes()
+	importedBlocks = store.NewBlockStore(dbm.NewMemDB())
+	_, err = archive.Import(bytes.NewReader(middle), importedBlocks,
+		sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{}), genDoc, nil)
+	require.Error(t, err)
+	_, err = archive.Import(bytes.NewReader(middle), importedBlocks,
+		sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{}), genDoc, blockStore.LoadBlockMeta(3).BlockID.Hash)
+	require.Error(t, err)
+	require.EqualValues(t, 0, importedBlocks.Height())
+	_, err = archive.Import(bytes.NewReader(middle), importedBlocks,
+		sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{}), genDoc, blockStore.LoadBlockMeta(2).BlockID.Hash)
+	require.NoError(t, err)
+	require.EqualValues(t, 2, importedBlocks.Base())
+	require.EqualValues(t, 4, importedBlocks.Height())
+
+	// the archive must hold the latest block of a store that isn't empty.
+	_, err = archive.Import(bytes.NewReader(middle), importedBlocks, importedStates, genDoc, nil)
+	require.Error(t, err)
+
+	// a single height can't bootstrap the state, even if trusted.
+	buf.Reset()
+	require.NoError(t, archive.Export(&buf, blockStore, stateStore, genDoc.ChainID, 3, 3))
+	_, err = archive.Import(bytes.NewReader(buf.Bytes()), store.NewBlockStore(dbm.NewMemDB()),
+		sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{}), genDoc, blockStore.LoadBlockMeta(3).BlockID.Hash)
+	require.ErrorContains(t, err, "single height")
+
+	require.Error(t, archive.Export(&buf, blockStore, stateStore, genDoc.ChainID, 1, 6))
+}
+
+func TestImportResume(t *testing.T) {
+	genDoc, blockStore, stateStore := makeChain(t, 5)
+	var buf bytes.Buffer
+	require.NoError(t, archive.Export(&buf, blockStore, stateStore, genDoc.ChainID, 1, 5))
+	exported := buf.Bytes()
+
+	// the truncated archive fails once the blocks up to height 3 are saved.
+	importedBlocks := store.NewBlockStore(dbm.NewMemDB())
+	importedStates := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	truncated := exported[:len(exported)/5*4]
+	_, err := archive.Import(bytes.NewReader(truncated), importedBlocks, importedStates, genDoc, nil)
+	require.Error(t, err)
+	height := importedBlocks.Height()
+	require.Positive(t, height)
+	require.Less(t, height, int64(5))
+
+	_, err = archive.Import(bytes.NewReader(exported), importedBlocks, importedStates, genDoc, nil)
+	require.NoError(t, err)
+	require.EqualValues(t, 1, importedBlocks.Base())
+	require.EqualValues(t, 5, importedBlocks.Height())
+	for h := int64(1); h <= 5; h++ {
+		require.Equal(t, blockStore.LoadBlockMeta(h), importedBlocks.LoadBlockMeta(h))
+		_, err := importedStates.LoadABCIResponses(h)
+		require.NoError(t, err)
+		_, err = importedStates.LoadValidators(h)
+		require.NoError(t, err)
+	}
+
+}
+
+func TestImportWithoutGenesisValidators(t *testing.T) {
+	genDoc, blockStore, stateStore := makeChain(t, 3)
+	var buf bytes.Buffer
+	require.NoError(t, archive.Export(&buf, blockStore, stateStore, genDoc.ChainID, 1, 3))
+	exported := buf.Bytes()
+
+	// the validators of the first block are set by the application.
+	genDoc.Validators = nil
+	_, err := archive.Import(bytes.NewReader(exported), store.NewBlockStore(dbm.NewMemDB()),
+		sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{}), genDoc, nil)
+	require.Error(t, err)
+
+	// they are trusted once in the state store.
+	importedStates := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	vals, err := stateStore.LoadValidators(1)
+	require.NoError(t, err)
+	require.NoError(t, importedStates.SaveValidatorSet(1, 1, vals))
+	_, err = archive.Import(bytes.NewReader(exported), store.NewBlockStore(dbm.NewMemDB()),
+		importedStates, genDoc, nil)
+	require.NoError(t, err)
+}
+
+func TestImportInvalid(t *testing.T) {
+	genDoc, blockStore, stateStore := makeChain(t, 3)
+	var buf bytes.Buffer
+	require.NoError(t, archive.Export(&buf, blockStore, stateStore, genDoc.ChainID, 1, 3))
+	exported := buf.Bytes()
+
+	otherGenDoc, otherBlockStore, otherStateStore := makeChain(t, 3)
+	otherGenDoc.ChainID = genDoc.ChainID
+	buf.Reset()
+	require.NoError(t, archive.Export(&buf, otherBlockStore, otherStateStore, genDoc.ChainID, 1, 3))
+	otherExported := buf.Bytes()
+
+	testCases := map[string]struct {
+		archive []byte
+		genDoc  *types.GenesisDoc
+	}{
+		"truncated": {
+			archive: exported[:len(exported)-20],
+			genDoc:  genDoc,
+		},
+		"corrupted": {
+			archive: func() []byte {
+				corrupted := bytes.Clone(exported)
+				corrupted[len(corrupted)/2] ^= 0xff
+				return corrupted
+			}(),
+			genDoc: genDoc,
+		},
+		"other genesis": {
+			archive: otherExported,
+			genDoc:  genDoc,
+		},
+		"other chain": {
+			archive: exported,
+			genDoc:  &types.GenesisDoc{ChainID: "other-chain"},
+		},
+		"previous version": {
+			archive: func() []byte {
+				previous := bytes.Clone(exported)
+				binary.BigEndian.PutUint16(previous[len("CMTBLOCKS"):], archive.Version-1)
+				return previous
+			}(),
+			genDoc: genDoc,
+		},
+		"not an archive": {
+			archive: []byte("not an archive"),
+			genDoc:  genDoc,
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			_, err := archive.Import(bytes.NewReader(tc.archive), store.NewBlockStore(dbm.NewMemDB()),
+				sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{}), tc.genDoc, nil)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestImportHandshake(t *testing.T) {
+	genDoc, blockStore, stateStore, state := makeExecutedChain(t, 5)
+	var buf bytes.Buffer
+	require.NoError(t, archive.Export(&buf, blockStore, stateStore, genDoc.ChainID, 1, 5))
+
+	importedBlocks := store.NewBlockStore(dbm.NewMemDB())
+	importedStates := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	_, err := archive.Import(&buf, importedBlocks, importedStates, genDoc, nil)
+	require.NoError(t, err)
+	for h := int64(1); h <= 5; h++ {
+		expected, err := stateStore.LoadConsensusParams(h)
+		require.NoError(t, err)
+		imported, err := importedStates.LoadConsensusParams(h)
+		require.NoError(t, err)
+		require.Equal(t, expected, imported)
+	}
+
+	// the state is bootstrapped before the last block, which a new
+	// application replays from the first block on start.
+	bootstrapped, err := importedStates.Load()
+	require.NoError(t, err)
+	require.EqualValues(t, 4, bootstrapped.LastBlockHeight)
+	imported := handshake(t, importedBlocks, importedStates, genDoc, startProxyApp(t))
+	require.EqualValues(t, 5, imported.LastBlockHeight)
+	require.Equal(t, state.LastBlockID, imported.LastBlockID)
+	require.Equal(t, state.AppHash, imported.AppHash)
+	require.Equal(t, state.LastResultsHash, imported.LastResultsHash)
+	require.Equal(t, state.Validators.Hash(), imported.Validators.Hash())
+	require.Equal(t, state.NextValidators.Hash(), imported.NextValidators.Hash())
+	require.Equal(t, state.ConsensusParams, imported.ConsensusParams)
+}
@@ -0,0 +1,422 @@
+package store
+
+import (
+	"bytes"
+	"compress/flate"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	cmtsync "github.com/cometbft/cometbft/libs/sync"
+)
+
+// ColdTier stores the block metas and block parts moved out of the database of
+// a BlockStore, e.g. to keep the database of archive nodes small. The blocks
+// are written once, in segments of consecutive heights, and are immutable
+// afterwards. The metas and parts are stored encoded, as in the database.
+type ColdTier interface {
+	// Height returns the last height of the tier, or 0 if it is empty.
+	Height() int64
+	// WriteSegment writes the blocks of heights start to end, loaded with
+	// load, as a segment. start must be above Height.
+	WriteSegment(start, end int64, load func(height int64) (ColdBlock, error)) error
+	// LoadBlockMeta returns the encoded block meta at height, or nil if the
+	// tier doesn't hold the height.
+	LoadBlockMeta(height int64) ([]byte, error)
+	// LoadBlockPart returns the encoded block part at height and index, or nil
+	// if the tier doesn't hold it.
+	LoadBlockPart(height int64, index int) ([]byte, error)
+	// Prune removes the blocks below height. The segments holding only pruned
+	// blocks are deleted.
+	Prune(height int64) error
+	Close() error
+}
+
+// ColdBlock is an encoded block meta and the encoded parts of its block.
+type ColdBlock struct {
+	Meta  []byte
+	Parts [][]byte
+}
+
+// SegmentStore is a ColdTier storing every segment in a file of a directory.
+// The metas and parts are compressed with DEFLATE and checksummed.
+//
+// A segment file starts with the magic bytes "CMTSEG" and the big endian uint16
+// version of the format, followed by the compressed entries. For every height
+// follows the list of its entries, the meta first, each as the uint64 offset,
+// the uint32 length and the CRC-32C of the compressed entry. A table gives
+// for every height the uint64 offset and the uint32 number of parts of its
+// list. The file ends with the first and last heights, the offset of the
+// table and the magic bytes.
+//
+// The heights pruned from the first segment are not removed from its file,
+// but recorded as a big endian uint64 in the floor file of the directory.
+type SegmentStore struct {
+	dir string
+
+	mtx      cmtsync.RWMutex
+	segments []*segment // sorted by height
+	floor    int64      // the heights below are pruned
+}
+
+var _ ColdTier = (*SegmentStore)(nil)
+
+const (
+	segmentVersion    uint16 = 1
+	segmentExt               = ".seg"
+	segmentEntrySize         = 8 + 4 + 4
+	segmentHeightSize        = 8 + 4
+	segmentFooterSize        = 8 + 8 + 8 + len(segmentMagic)
+	segmentMagic             = "CMTSEG"
+	segmentFloorFile         = "floor"
+)
+
+var segmentCRCTable = crc32.MakeTable(crc32.Castagnoli)
+
+type segment struct {
+	file        *os.File
+	start, end  int64
+	tableOffset int64
+}
+
+type segmentEntry struct {
+	offset uint64
+	length uint32
+	crc    uint32
+}
+
+// NewSegmentStore opens the segments in dir. The directory is created when
+// the first segment is written.
+func NewSegmentStore(dir string) (*SegmentStore, error) {
+	ss := &SegmentStore{dir: dir}
+	files, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return ss, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if ss.floor, err = readSegmentFloor(dir); err != nil {
+		return nil, err
+	}
+	for _, f := range files {
+		if f.IsDir() || !strings.HasSuffix(f.Name(), segmentExt) {
+			continue
+		}
+		seg, err := openSegment(filepath.Join(dir, f.Name()))
+		if err != nil {
+			ss.Close()
+			return nil, err
+		}
+		ss.segments = append(ss.segments, seg)
+	}
+	sort.Slice(ss.segments, func(i, j int) bool { return ss.segments[i].start < ss.segments[j].start })
+	for i := 1; i < len(ss.segments); i++ {
+		if ss.segments[i].start <= ss.segments[i-1].end {
+			ss.Close()
+			return nil, fmt.Errorf("segments %s and %s overlap",
+				ss.segments[i-1].file.Name(), ss.segments[i].file.Name())
+		}
+	}
+	return ss, nil
+}
+
+// readSegmentFloor returns the floor recorded in dir, or 0 if none is.
+func readSegmentFloor(dir string) (int64, error) {
+	bz, err := os.ReadFile(filepath.Join(dir, segmentFloorFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	if len(bz) != 8 {
+		return 0, fmt.Errorf("floor file of %s is corrupted", dir)
+	}
+	return int64(binary.BigEndian.Uint64(bz)), nil
+}
+
+// writeSegmentFloor records the floor in dir. It is written to a temporary
+// file, which is renamed once synced, as the segments.
+func writeSegmentFloor(dir string, floor int64) error {
+	if err := os.MkdirAll(dir, 0o700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(dir, "floor-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if _, err := tmp.Write(binary.BigEndian.AppendUint64(nil, uint64(floor))); err != nil {
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), filepath.Join(dir, segmentFloorFile))
+}
+
+func openSegment(path string) (*segment, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return nil, err
+	}
+	footer := make([]byte, segmentFooterSize)
+	if info.Size() < int64(len(segmentMagic)+2+segmentFooterSize) {
+		file.Close()
+		return nil, fmt.Errorf("segment %s is truncated", path)
+	}
+	if _, err := file.ReadAt(footer, info.Size()-int64(segmentFooterSize)); err != nil {
+		file.Close()
+		return nil, err
+	}
+	if string(footer[24:]) != segmentMagic {
+		file.Close()
+		return nil, fmt.Errorf("segment %s is truncated or corrupted", path)
+	}
+	seg := &segment{
+		file:        file,
+		start:       int64(binary.BigEndian.Uint64(footer[0:])),
+		end:         int64(binary.BigEndian.Uint64(footer[8:])),
+		tableOffset: int64(binary.BigEndian.Uint64(footer[16:])),
+	}
+	tableEnd := seg.tableOffset + (seg.end-seg.start+1)*segmentHeightSize
+	if seg.start <= 0 || seg.end < seg.start || tableEnd != info.Size()-int64(segmentFooterSize) {
+		file.Close()
+		return nil, fmt.Errorf("segment %s has an invalid footer", path)
+	}
+	return seg, nil
+}
+
+// Height implements ColdTier.
+func (ss *SegmentStore) Height() int64 {
+	ss.mtx.RLock()
+	defer ss.mtx.RUnlock()
+	if len(ss.segments) == 0 {
+		return 0
+	}
+	return ss.segments[len(ss.segments)-1].end
+}
+
+// WriteSegment implements ColdTier. The segment is written to a temporary
+// file, which is renamed once synced, so that crashes leave no partial
+// segment behind.
+func (ss *SegmentStore) WriteSegment(start, end int64, load func(height int64) (ColdBlock, error)) error {
+	if start <= ss.Height() || end < start {
+		return fmt.Errorf("invalid segment %d-%d above height %d", start, end, ss.Height())
+	}
+	if err := os.MkdirAll(ss.dir, 0o700); err != nil {
+		return err
+	}
+	path := filepath.Join(ss.dir, fmt.Sprintf("%020d-%020d%s", start, end, segmentExt))
+	tmp, err := os.CreateTemp(ss.dir, "segment-*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	if err := writeSegment(tmp, start, end, load); err != nil {
+		return fmt.Errorf("failed to write segment %d-%d: %w", start, end, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return err
+	}
+
+	seg, err := openSegment(path)
+	if err != nil {
+		return err
+	}
+	ss.mtx.Lock()
+	ss.segments = append(ss.segments, seg)
+	ss.mtx.Unlock()
+	return nil
+}
+
+func writeSegment(w io.Writer, start, end int64, load func(height int64) (ColdBlock, error)) error {
+	var (
+		offset uint64
+		lists  [][]segmentEntry
+		buf    bytes.Buffer
+	)
+	write := func(bz []byte) error {
+		n, err := w.Write(bz)
+		offset += uint64(n)
+		return err
+	}
+	header := binary.BigEndian.AppendUint16([]byte(segmentMagic), segmentVersion)
+	if err := write(header); err != nil {
+		return err
+	}
+
+	compressor, err := flate.NewWriter(&buf, flate.DefaultCompression)
+	if err != nil {
+		return err
+	}
+	for h := start; h <= end; h++ {
+		block, err := load(h)
+		if err != nil {
+			return err
+		}
+		list := make([]segmentEntry, 0, len(block.Parts)+1)
+		for _, data := range append([][]byte{block.Meta}, block.Parts...) {
+			buf.Reset()
+			compressor.Reset(&buf)
+			if _, err := compressor.Write(data); err != nil {
+				return err
+			}
+			if err := compressor.Close(); err != nil {
+				return err
+			}
+			list = append(list, segmentEntry{
+				offset: offset,
+				length: uint32(buf.Len()),
+				crc:    crc32.Checksum(buf.Bytes(), segmentCRCTable),
+			})
+			if err := write(buf.Bytes()); err != nil {
+				return err
+			}
+		}
+		lists = append(lists, list)
+	}
+
+	// the entry lists, then the table pointing at them
+	table := make([]byte, 0, len(lists)*segmentHeightSize)
+	for _, list := range lists {
+		table = binary.BigEndian.AppendUint64(table, offset)
+		table = binary.BigEndian.AppendUint32(table, uint32(len(list)-1))
+		bz := make([]byte, 0, len(list)*segmentEntrySize)
+		for _, e := range list {
+			bz = binary.BigEndian.AppendUint64(bz, e.offset)
+			bz = binary.BigEndian.AppendUint32(bz, e.length)
+			bz = binary.BigEndian.AppendUint32(bz, e.crc)
+		}
+		if err := write(bz); err != nil {
+			return err
+		}
+	}
+	tableOffset := offset
+	if err := write(table); err != nil {
+		return err
+	}
+
+	footer := binary.BigEndian.AppendUint64(nil, uint64(start))
+	footer = binary.BigEndian.AppendUint64(footer, uint64(end))
+	footer = binary.BigEndian.AppendUint64(footer, tableOffset)
+	return write(append(footer, segmentMagic...))
+}
+
+// LoadBlockMeta implements ColdTier.
+func (ss *SegmentStore) LoadBlockMeta(height int64) ([]byte, error) {
+	return ss.load(height, 0)
+}
+
+// LoadBlockPart implements ColdTier.
+func (ss *SegmentStore) LoadBlockPart(height int64, index int) ([]byte, error) {
+	if index < 0 {
+		return nil, nil
+	}
+	return ss.load(height, index+1)
+}
+
+// load reads the entry at index in the list of height, the meta being first.
+func (ss *SegmentStore) load(height int64, index int) ([]byte, error) {
+	ss.mtx.RLock()
+	defer ss.mtx.RUnlock()
+	if height < ss.floor {
+		return nil, nil
+	}
+	i := sort.Search(len(ss.segments), func(i int) bool { return ss.segments[i].end >= height })
+	if i == len(ss.segments) || ss.segments[i].start > height {
+		return nil, nil
+	}
+	seg := ss.segments[i]
+
+	bz := make([]byte, segmentHeightSize)
+	if _, err := seg.file.ReadAt(bz, seg.tableOffset+(height-seg.start)*segmentHeightSize); err != nil {
+		return nil, fmt.Errorf("failed to read segment %s: %w", seg.file.Name(), err)
+	}
+	listOffset := int64(binary.BigEndian.Uint64(bz))
+	if parts := int(binary.BigEndian.Uint32(bz[8:])); index > parts {
+		return nil, nil
+	}
+
+	bz = make([]byte, segmentEntrySize)
+	if _, err := seg.file.ReadAt(bz, listOffset+int64(index)*segmentEntrySize); err != nil {
+		return nil, fmt.Errorf("failed to read segment %s: %w", seg.file.Name(), err)
+	}
+	entry := segmentEntry{
+		offset: binary.BigEndian.Uint64(bz),
+		length: binary.BigEndian.Uint32(bz[8:]),
+		crc:    binary.BigEndian.Uint32(bz[12:]),
+	}
+	compressed := make([]byte, entry.length)
+	if _, err := seg.file.ReadAt(compressed, int64(entry.offset)); err != nil {
+		return nil, fmt.Errorf("failed to read segment %s: %w", seg.file.Name(), err)
+	}
+	if crc32.Checksum(compressed, segmentCRCTable) != entry.crc {
+		return nil, fmt.Errorf("segment %s is corrupted at height %d", seg.file.Name(), height)
+	}
+	data, err := io.ReadAll(flate.NewReader(bytes.NewReader(compressed)))
+	if err != nil {
+		return nil, fmt.Errorf("segment %s is corrupted at height %d: %w", seg.file.Name(), height, err)
+	}
+	return data, nil
+}
+
+// Prune implements ColdTier.
+func (ss *SegmentStore) Prune(height int64) error {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+	if height > ss.floor {
+		if err := writeSegmentFloor(ss.dir, height); err != nil {
+			return err
+		}
+		ss.floor = height
+	}
+	for len(ss.segments) > 0 && ss.segments[0].end < height {
+		seg := ss.segments[0]
+		seg.file.Close()
+		if err := os.Remove(seg.file.Name()); err != nil {
+			return err
+		}
+		ss.segments = ss.segments[1:]
+	}
+	return nil
+}
+
+// Close implements ColdTier.
+func (ss *SegmentStore) Close() error {
+	ss.mtx.Lock()
+	defer ss.mtx.Unlock()
+	var err error
+	for _, seg := range ss.segments {
+		if cerr := seg.file.Close(); cerr != nil && err == nil {
+			err = cerr
+		}
+	}
+	ss.segments = nil
+	return err
+}
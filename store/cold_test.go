@@ -0,0 +1,194 @@
+package store
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	cfg "github.com/cometbft/cometbft/config"
+	"github.com/cometbft/cometbft/libs/log"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/types"
+	cmttime "github.com/cometbft/cometbft/types/time"
+)
+
+func coldBlock(height int64) ColdBlock {
+	block := ColdBlock{Meta: []byte(fmt.Sprintf("meta %d", height))}
+	for i := int64(0); i < height%3+1; i++ {
+		block.Parts = append(block.Parts, []byte(fmt.Sprintf("part %d of %d", i, height)))
+	}
+	return block
+}
+
+func TestSegmentStore(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "cold")
+	ss, err := NewSegmentStore(dir)
+	require.NoError(t, err)
+	require.EqualValues(t, 0, ss.Height())
+
+	load := func(h int64) (ColdBlock, error) { return coldBlock(h), nil }
+	require.NoError(t, ss.WriteSegment(3, 12, load))
+	require.NoError(t, ss.WriteSegment(13, 22, load))
+	require.EqualValues(t, 22, ss.Height())
+
+	// segments must follow each other
+	require.Error(t, ss.WriteSegment(20, 30, load))
+	// failed segments are not kept
+	require.Error(t, ss.WriteSegment(23, 32, func(h int64) (ColdBlock, error) {
+		return ColdBlock{}, errors.New("failed")
+	}))
+	require.EqualValues(t, 22, ss.Height())
+
+	check := func(ss *SegmentStore, from int64) {
+		for h := int64(1); h <= 25; h++ {
+			meta, err := ss.LoadBlockMeta(h)
+			require.NoError(t, err)
+			part, err := ss.LoadBlockPart(h, 0)
+			require.NoError(t, err)
+			if h < from || h > 22 {
+				require.Nil(t, meta, h)
+				require.Nil(t, part, h)
+				continue
+			}
+			expected := coldBlock(h)
+			require.Equal(t, expected.Meta, meta)
+			for i, p := range expected.Parts {
+				part, err := ss.LoadBlockPart(h, i)
+				require.NoError(t, err)
+				require.Equal(t, p, part)
+			}
+			part, err = ss.LoadBlockPart(h, len(expected.Parts))
+			require.NoError(t, err)
+			require.Nil(t, part)
+		}
+	}
+	check(ss, 3)
+
+	// the segments are found when reopened
+	require.NoError(t, ss.Close())
+	ss, err = NewSegmentStore(dir)
+	require.NoError(t, err)
+	require.EqualValues(t, 22, ss.Height())
+	check(ss, 3)
+
+	// pruning removes the segments holding only pruned heights
+	require.NoError(t, ss.Prune(14))
+	check(ss, 14)
+	segments, err := filepath.Glob(filepath.Join(dir, "*"+segmentExt))
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+	require.NoError(t, ss.Close())
+
+	// the pruned heights of the remaining segment are not found when reopened
+	ss, err = NewSegmentStore(dir)
+	require.NoError(t, err)
+	check(ss, 14)
+	require.NoError(t, ss.Close())
+
+	// corrupted segments are detected, at heights not pruned
+	require.NoError(t, os.Remove(filepath.Join(dir, segmentFloorFile)))
+	path := segments[0]
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	bz[20] ^= 0xff
+	require.NoError(t, os.WriteFile(path, bz, 0o600))
+	ss, err = NewSegmentStore(dir)
+	require.NoError(t, err)
+	_, err = ss.LoadBlockMeta(13)
+	require.Error(t, err)
+
+	require.NoError(t, os.WriteFile(path, bz[:len(bz)-1], 0o600))
+	_, err = NewSegmentStore(dir)
+	require.Error(t, err)
+}
+
+func TestMoveToColdTier(t *testing.T) {
+	config := cfg.ResetTestRoot("blockstore_cold_test")
+	defer os.RemoveAll(config.RootDir)
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+
+	coldTier, err := NewSegmentStore(config.ColdStorageDir())
+	require.NoError(t, err)
+	db := dbm.NewMemDB()
+	bs := NewBlockStore(db, WithColdTier(coldTier))
+
+	blocks := make([]*types.Block, 0, 50)
+	for h := int64(1); h <= 50; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(h, cmttime.Now()))
+		blocks = append(blocks, block)
+	}
+
+	cs := NewColdTierService(bs, 15, 10)
+	cs.SetLogger(log.TestingLogger())
+	require.NoError(t, cs.moveSegments())
+	require.EqualValues(t, 30, coldTier.Height())
+
+	// the moved blocks are no longer in the database
+	for h := int64(1); h <= 30; h++ {
+		bz, err := db.Get(calcBlockMetaKey(h))
+		require.NoError(t, err)
+		require.Nil(t, bz)
+	}
+	check := func(bs *BlockStore, from int64) {
+		for h := int64(1); h <= 50; h++ {
+			block := blocks[h-1]
+			if h < from {
+				require.Nil(t, bs.LoadBlock(h))
+				require.Nil(t, bs.LoadBlockMeta(h))
+				continue
+			}
+			require.Equal(t, block.Hash(), bs.LoadBlock(h).Hash())
+			require.Equal(t, block.Hash(), bs.LoadBlockByHash(block.Hash()).Hash())
+			require.Equal(t, block.Hash(), bs.LoadBlockMeta(h).BlockID.Hash)
+			require.Equal(t, block.Hash(), bs.LoadBlockMetaByHash(block.Hash()).BlockID.Hash)
+			total := int(bs.LoadBlockMeta(h).BlockID.PartSetHeader.Total)
+			require.NotNil(t, bs.LoadBlockPart(h, total-1))
+			require.Nil(t, bs.LoadBlockPart(h, total))
+		}
+	}
+	check(bs, 1)
+
+	// the latest blocks and the segments already moved can't be moved
+	require.Error(t, bs.MoveToColdTier(21, 30))
+	require.Error(t, bs.MoveToColdTier(31, 50))
+	require.Error(t, NewBlockStore(dbm.NewMemDB()).MoveToColdTier(1, 10))
+
+	// pruning prunes both tiers
+	pruned, err := bs.PruneBlocks(25)
+	require.NoError(t, err)
+	assert.EqualValues(t, 24, pruned)
+	check(bs, 25)
+	segments, err := filepath.Glob(filepath.Join(config.ColdStorageDir(), "*"+segmentExt))
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+
+	// the blocks are read from the cold tier after a restart
+	require.NoError(t, coldTier.Close())
+	coldTier, err = NewSegmentStore(config.ColdStorageDir())
+	require.NoError(t, err)
+	bs = NewBlockStore(db, WithColdTier(coldTier))
+	for h := int64(51); h <= 60; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(h, cmttime.Now()))
+		blocks = append(blocks, block)
+	}
+	cs = NewColdTierService(bs, 15, 10)
+	cs.SetLogger(log.TestingLogger())
+	require.NoError(t, cs.moveSegments())
+	require.EqualValues(t, 40, coldTier.Height())
+	require.NotNil(t, bs.LoadBlock(30))
+	require.NotNil(t, bs.LoadBlock(40))
+
+	// the service stops once started
+	require.NoError(t, cs.Start())
+	require.NoError(t, cs.Stop())
+}
@@ -0,0 +1,94 @@
+package store
+
+import (
+	"time"
+
+	"github.com/cometbft/cometbft/libs/service"
+)
+
+// coldTierInterval is the interval at which the ColdTierService checks for
+// blocks to move.
+const coldTierInterval = 10 * time.Second
+
+// ColdTierService moves the blocks of a BlockStore older than a depth to its
+// cold tier in the background, in segments of a fixed number of heights.
+type ColdTierService struct {
+	service.BaseService
+
+	blockStore  *BlockStore
+	depth       int64
+	segmentSize int64
+
+	// quit is closed by OnStop, before the Quit channel of the service.
+	quit chan struct{}
+	done chan struct{}
+}
+
+// NewColdTierService returns a service moving the blocks more than depth below
+// the height of blockStore to its cold tier, in segments of segmentSize
+// heights.
+func NewColdTierService(blockStore *BlockStore, depth, segmentSize int64) *ColdTierService {
+	cs := &ColdTierService{
+		blockStore:  blockStore,
+		depth:       depth,
+		segmentSize: segmentSize,
+		quit:        make(chan struct{}),
+		done:        make(chan struct{}),
+	}
+	cs.BaseService = *service.NewBaseService(nil, "ColdTierService", cs)
+	return cs
+}
+
+// OnStart implements service.Service.
+func (cs *ColdTierService) OnStart() error {
+	go cs.routine()
+	return nil
+}
+
+// OnStop implements service.Service by waiting for the segment being written,
+// if any, so that the block store can be closed.
+func (cs *ColdTierService) OnStop() {
+	close(cs.quit)
+	<-cs.done
+}
+
+func (cs *ColdTierService) routine() {
+	defer close(cs.done)
+	ticker := time.NewTicker(coldTierInterval)
+	defer ticker.Stop()
+	for {
+		if err := cs.moveSegments(); err != nil {
+			cs.Logger.Error("Failed to move blocks to the cold tier", "err", err)
+		}
+		select {
+		case <-ticker.C:
+		case <-cs.quit:
+			return
+		}
+	}
+}
+
+// moveSegments moves the segments whose blocks are all more than depth below
+// the height of the block store.
+func (cs *ColdTierService) moveSegments() error {
+	for {
+		select {
+		case <-cs.quit:
+			return nil
+		default:
+		}
+
+		start := cs.blockStore.coldTier.Height() + 1
+		if base := cs.blockStore.Base(); start < base {
+			start = base
+		}
+		end := start + cs.segmentSize - 1
+		if end > cs.blockStore.Height()-cs.depth {
+			return nil
+		}
+		if err := cs.blockStore.MoveToColdTier(start, end); err != nil {
+			return err
+		}
+		cs.Logger.Info("Moved blocks to the cold tier", "from", start, "to", end)
+	}
+}
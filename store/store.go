@@ -1,12 +1,17 @@
 package store
 
 import (
+	"errors"
 	"fmt"
+	"math/bits"
 	"strconv"
+	"strings"
 
 	dbm "github.com/cometbft/cometbft-db"
 	"github.com/gogo/protobuf/proto"
 
+	"github.com/cometbft/cometbft/crypto/merkle"
+	"github.com/cometbft/cometbft/crypto/tmhash"
 	cmtsync "github.com/cometbft/cometbft/libs/sync"
 	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
 	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
@@ -21,6 +26,14 @@ There are three types of information stored:
   - Block part:  Parts of each block, aggregated w/ PartSet
   - Commit:      The commit part of each block, for gossiping precommit votes
 
+The roots of the subtrees of data root tuples ending at each block are also
+indexed as blocks are saved, so that the data commitment of a range of blocks,
+and the inclusion proofs of their data roots, are read in a logarithmic number
+of lookups.
+
+With a ColdTier, the block metas and block parts of old blocks can be moved out
+of the database with MoveToColdTier, and are then read from the tier.
+
 Currently the precommit signatures are duplicated in the Block parts as
 well as the Commit.  In the future this may change, perhaps by moving
 the Commit data outside the Block. (TODO)
@@ -41,17 +54,44 @@ type BlockStore struct {
 	mtx    cmtsync.RWMutex
 	base   int64
 	height int64
+
+	coldTier ColdTier
+
+	// txInfoPrunedSeparately makes PruneBlocks keep the tx infos.
+	txInfoPrunedSeparately bool
+	// pruneMtx serializes the pruning of the store.
+	pruneMtx cmtsync.Mutex
+}
+
+// BlockStoreOption sets an optional parameter on the BlockStore.
+type BlockStoreOption func(*BlockStore)
+
+// WithColdTier sets the tier the block metas and block parts of old blocks are
+// moved to, and read from once moved.
+func WithColdTier(coldTier ColdTier) BlockStoreOption {
+	return func(bs *BlockStore) { bs.coldTier = coldTier }
+}
+
+// WithTxInfoPrunedSeparately makes PruneBlocks keep the tx infos, which are
+// then only pruned by PruneTxInfos, e.g. to retain them for longer than the
+// blocks.
+func WithTxInfoPrunedSeparately() BlockStoreOption {
+	return func(bs *BlockStore) { bs.txInfoPrunedSeparately = true }
 }
 
 // NewBlockStore returns a new BlockStore with the given DB,
 // initialized to the last height that was committed to the DB.
-func NewBlockStore(db dbm.DB) *BlockStore {
-	bs := LoadBlockStoreState(db)
-	return &BlockStore{
-		base:   bs.Base,
-		height: bs.Height,
+func NewBlockStore(db dbm.DB, options ...BlockStoreOption) *BlockStore {
+	bss := LoadBlockStoreState(db)
+	bs := &BlockStore{
+		base:   bss.Base,
+		height: bss.Height,
 		db:     db,
 	}
+	for _, option := range options {
+		option(bs)
+	}
+	return bs
 }
 
 // Base returns the first known contiguous block height, or 0 for empty block stores.
@@ -153,6 +193,12 @@ func (bs *BlockStore) LoadBlockPart(height int64, index int) *types.Part {
 	if err != nil {
 		panic(err)
 	}
+	if len(bz) == 0 && bs.coldTier != nil {
+		bz, err = bs.coldTier.LoadBlockPart(height, index)
+		if err != nil {
+			panic(err)
+		}
+	}
 	if len(bz) == 0 {
 		return nil
 	}
@@ -179,6 +225,13 @@ func (bs *BlockStore) LoadBlockMeta(height int64) *types.BlockMeta {
 		panic(err)
 	}
 
+	if len(bz) == 0 && bs.coldTier != nil {
+		bz, err = bs.coldTier.LoadBlockMeta(height)
+		if err != nil {
+			panic(err)
+		}
+	}
+
 	if len(bz) == 0 {
 		return nil
 	}
@@ -265,10 +318,13 @@ func (bs *BlockStore) LoadSeenCommit(height int64) *types.Commit {
 }
 
 // PruneBlocks removes block up to (but not including) a height. It returns number of blocks pruned.
+// The tx infos of the blocks are removed too, unless they are pruned separately.
 func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	if height <= 0 {
 		return 0, fmt.Errorf("height must be greater than 0")
 	}
+	bs.pruneMtx.Lock()
+	defer bs.pruneMtx.Unlock()
 	bs.mtx.RLock()
 	if height > bs.height {
 		bs.mtx.RUnlock()
@@ -305,8 +361,19 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 			continue
 		}
 		block := bs.LoadBlock(h)
-		for _, tx := range block.Txs {
-			if err := batch.Delete(calcTxHashKey(tx.Hash())); err != nil {
+		if bs.txInfoPrunedSeparately {
+			// keep the hashes of the txs of blocks saved before they were
+			// recorded by SaveTxInfo, for PruneTxInfos.
+			if err := bs.saveMissingTxHashes(batch, block); err != nil {
+				return 0, err
+			}
+		} else {
+			for _, tx := range block.Txs {
+				if err := batch.Delete(calcTxHashKey(tx.Hash())); err != nil {
+					return 0, err
+				}
+			}
+			if err := batch.Delete(calcTxHashesKey(h)); err != nil {
 				return 0, err
 			}
 		}
@@ -322,6 +389,9 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 		if err := batch.Delete(calcSeenCommitKey(h)); err != nil {
 			return 0, err
 		}
+		if err := batch.Delete(calcDataRootSubtreesKey(h)); err != nil {
+			return 0, err
+		}
 		for p := 0; p < int(meta.BlockID.PartSetHeader.Total); p++ {
 			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
 				return 0, err
@@ -344,9 +414,78 @@ func (bs *BlockStore) PruneBlocks(height int64) (uint64, error) {
 	if err != nil {
 		return 0, err
 	}
+	if bs.coldTier != nil {
+		if err := bs.coldTier.Prune(height); err != nil {
+			return 0, fmt.Errorf("failed to prune the cold tier up to height %v: %w", height, err)
+		}
+	}
 	return pruned, nil
 }
 
+// MoveToColdTier moves the block metas and block parts of the blocks start to
+// end from the database to a segment of the cold tier. The segment must
+// follow the blocks already moved, and the latest block can't be moved.
+func (bs *BlockStore) MoveToColdTier(start, end int64) error {
+	if bs.coldTier == nil {
+		return errors.New("the block store has no cold tier")
+	}
+	bs.mtx.RLock()
+	base, height := bs.base, bs.height
+	bs.mtx.RUnlock()
+	if start < base || start <= bs.coldTier.Height() || end < start || end >= height {
+		return fmt.Errorf("cannot move blocks %v to %v to the cold tier, which holds blocks up to %v, "+
+			"with blocks %v to %v in the store", start, end, bs.coldTier.Height(), base, height)
+	}
+
+	parts := make([]int, 0, end-start+1)
+	err := bs.coldTier.WriteSegment(start, end, func(h int64) (ColdBlock, error) {
+		meta, err := bs.db.Get(calcBlockMetaKey(h))
+		if err != nil {
+			return ColdBlock{}, err
+		}
+		if len(meta) == 0 {
+			return ColdBlock{}, fmt.Errorf("missing block meta at height %v", h)
+		}
+		pbbm := new(cmtproto.BlockMeta)
+		if err := proto.Unmarshal(meta, pbbm); err != nil {
+			return ColdBlock{}, fmt.Errorf("unmarshal to cmtproto.BlockMeta: %w", err)
+		}
+		block := ColdBlock{Meta: meta, Parts: make([][]byte, pbbm.BlockID.PartSetHeader.Total)}
+		for i := range block.Parts {
+			part, err := bs.db.Get(calcBlockPartKey(h, i))
+			if err != nil {
+				return ColdBlock{}, err
+			}
+			if len(part) == 0 {
+				return ColdBlock{}, fmt.Errorf("missing block part %v at height %v", i, h)
+			}
+			block.Parts[i] = part
+		}
+		parts = append(parts, len(block.Parts))
+		return block, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	// the blocks are read from the cold tier from now on, so they can be
+	// deleted from the database.
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+	for i, total := range parts {
+		h := start + int64(i)
+		for p := 0; p < total; p++ {
+			if err := batch.Delete(calcBlockPartKey(h, p)); err != nil {
+				return err
+			}
+		}
+		if err := batch.Delete(calcBlockMetaKey(h)); err != nil {
+			return err
+		}
+	}
+	return batch.WriteSync()
+}
+
 // SaveBlock persists the given block, blockParts, and seenCommit to the underlying db.
 // blockParts: Must be parts of the block
 // seenCommit: The +2/3 precommits that were seen which committed at height.
@@ -369,13 +508,15 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 		panic("BlockStore can only save complete block part sets")
 	}
 
-	// Save block parts. This must be done before the block meta, since callers
-	// typically load the block meta first as an indication that the block exists
-	// and then go on to load block parts - we must make sure the block is
-	// complete as soon as the block meta is written.
+	// The block is written in a single batch, with the new BlockStoreState
+	// descriptor, so that it is either saved completely or not at all.
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+
+	// Save block parts
 	for i := 0; i < int(blockParts.Total()); i++ {
 		part := blockParts.GetPart(i)
-		bs.saveBlockPart(height, i, part)
+		bs.saveBlockPart(batch, height, i, part)
 	}
 
 	// Save block meta
@@ -385,17 +526,17 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 		panic("nil blockmeta")
 	}
 	metaBytes := mustEncode(pbm)
-	if err := bs.db.Set(calcBlockMetaKey(height), metaBytes); err != nil {
+	if err := batch.Set(calcBlockMetaKey(height), metaBytes); err != nil {
 		panic(err)
 	}
-	if err := bs.db.Set(calcBlockHashKey(hash), []byte(fmt.Sprintf("%d", height))); err != nil {
+	if err := batch.Set(calcBlockHashKey(hash), []byte(fmt.Sprintf("%d", height))); err != nil {
 		panic(err)
 	}
 
 	// Save block commit (duplicate and separate from the Block)
 	pbc := block.LastCommit.ToProto()
 	blockCommitBytes := mustEncode(pbc)
-	if err := bs.db.Set(calcBlockCommitKey(height-1), blockCommitBytes); err != nil {
+	if err := batch.Set(calcBlockCommitKey(height-1), blockCommitBytes); err != nil {
 		panic(err)
 	}
 
@@ -403,7 +544,26 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 	// NOTE: we can delete this at a later height
 	pbsc := seenCommit.ToProto()
 	seenCommitBytes := mustEncode(pbsc)
-	if err := bs.db.Set(calcSeenCommitKey(height), seenCommitBytes); err != nil {
+	if err := batch.Set(calcSeenCommitKey(height), seenCommitBytes); err != nil {
+		panic(err)
+	}
+
+	// Index the subtrees of data root tuples ending at the block
+	if subtrees := bs.dataRootSubtrees(height, block.DataHash); subtrees != nil {
+		if err := batch.Set(calcDataRootSubtreesKey(height), subtrees); err != nil {
+			panic(err)
+		}
+	}
+
+	// Save new BlockStoreState descriptor, and flush the database.
+	bss := cmtstore.BlockStoreState{Base: bs.Base(), Height: height}
+	if bss.Base == 0 {
+		bss.Base = height
+	}
+	if err := batch.Set(blockStoreKey, mustEncode(&bss)); err != nil {
+		panic(err)
+	}
+	if err := batch.WriteSync(); err != nil {
 		panic(err)
 	}
 
@@ -414,18 +574,15 @@ func (bs *BlockStore) SaveBlock(block *types.Block, blockParts *types.PartSet, s
 		bs.base = height
 	}
 	bs.mtx.Unlock()
-
-	// Save new BlockStoreState descriptor. This also flushes the database.
-	bs.saveState()
 }
 
-func (bs *BlockStore) saveBlockPart(height int64, index int, part *types.Part) {
+func (bs *BlockStore) saveBlockPart(batch dbm.Batch, height int64, index int, part *types.Part) {
 	pbp, err := part.ToProto()
 	if err != nil {
 		panic(fmt.Errorf("unable to make part into proto: %w", err))
 	}
 	partBytes := mustEncode(pbp)
-	if err := bs.db.Set(calcBlockPartKey(height, index), partBytes); err != nil {
+	if err := batch.Set(calcBlockPartKey(height, index), partBytes); err != nil {
 		panic(err)
 	}
 }
@@ -459,7 +616,9 @@ func (bs *BlockStore) SaveTxInfo(block *types.Block, txResponseCodes []uint32) e
 	// Create a new batch
 	batch := bs.db.NewBatch()
 
-	// Batch and save txs from the block
+	// Batch and save txs from the block, and their hashes by height for
+	// PruneTxInfos if the tx infos are pruned separately.
+	hashes := make([]byte, 0, len(block.Txs)*tmhash.Size)
 	for i, tx := range block.Txs {
 		txInfo := cmtstore.TxInfo{
 			Height: block.Height,
@@ -470,7 +629,14 @@ func (bs *BlockStore) SaveTxInfo(block *types.Block, txResponseCodes []uint32) e
 		if err != nil {
 			return fmt.Errorf("unable to marshal tx: %w", err)
 		}
-		if err := batch.Set(calcTxHashKey(tx.Hash()), txInfoBytes); err != nil {
+		hash := tx.Hash()
+		if err := batch.Set(calcTxHashKey(hash), txInfoBytes); err != nil {
+			return err
+		}
+		hashes = append(hashes, hash...)
+	}
+	if bs.txInfoPrunedSeparately {
+		if err := batch.Set(calcTxHashesKey(block.Height), hashes); err != nil {
 			return err
 		}
 	}
@@ -479,7 +645,165 @@ func (bs *BlockStore) SaveTxInfo(block *types.Block, txResponseCodes []uint32) e
 	return batch.WriteSync()
 }
 
+// saveMissingTxHashes saves the hashes of the txs of block, unless they were
+// saved by SaveTxInfo.
+func (bs *BlockStore) saveMissingTxHashes(batch dbm.Batch, block *types.Block) error {
+	if has, err := bs.db.Has(calcTxHashesKey(block.Height)); err != nil || has {
+		return err
+	}
+	hashes := make([]byte, 0, len(block.Txs)*tmhash.Size)
+	for _, tx := range block.Txs {
+		hashes = append(hashes, tx.Hash()...)
+	}
+	return batch.Set(calcTxHashesKey(block.Height), hashes)
+}
+
+// PruneTxInfos removes the tx infos of the txs of the blocks up to (but not
+// including) a height, whether the blocks were pruned or not. The tx infos
+// of txs included again in a later block are kept. It returns the number of
+// blocks whose tx infos were pruned.
+func (bs *BlockStore) PruneTxInfos(height int64) (uint64, error) {
+	if height <= 0 {
+		return 0, fmt.Errorf("height must be greater than 0")
+	}
+	bs.pruneMtx.Lock()
+	defer bs.pruneMtx.Unlock()
+
+	prunedTo, err := bs.LoadTxInfoPrunedHeight()
+	if err != nil {
+		return 0, err
+	}
+	if err := bs.saveMissingTxHashesUpTo(prunedTo, height); err != nil {
+		return 0, err
+	}
+
+	pruned := uint64(0)
+	for {
+		// prune 1000 blocks at a time to avoid batches becoming too large,
+		// writing them once the iterator is closed.
+		heights, hashes, err := bs.loadTxHashes(height, 1000)
+		if err != nil {
+			return 0, err
+		}
+		if len(heights) == 0 {
+			if height > prunedTo {
+				err := bs.db.SetSync(txInfoPrunedKey, []byte(strconv.FormatInt(height, 10)))
+				if err != nil {
+					return 0, err
+				}
+			}
+			return pruned, nil
+		}
+
+		batch := bs.db.NewBatch()
+		for i, h := range heights {
+			for j := 0; j < len(hashes[i]); j += tmhash.Size {
+				hash := hashes[i][j : j+tmhash.Size]
+				if txInfo := bs.LoadTxInfo(hash); txInfo != nil && txInfo.Height == h {
+					if err := batch.Delete(calcTxHashKey(hash)); err != nil {
+						batch.Close()
+						return 0, err
+					}
+				}
+			}
+			if err := batch.Delete(calcTxHashesKey(h)); err != nil {
+				batch.Close()
+				return 0, err
+			}
+		}
+		err = batch.WriteSync()
+		batch.Close()
+		if err != nil {
+			return 0, fmt.Errorf("failed to prune tx infos up to height %v: %w", height, err)
+		}
+		pruned += uint64(len(heights))
+	}
+}
+
+// saveMissingTxHashesUpTo saves the hashes of the txs of the blocks from the
+// height the tx infos were pruned up to, or the base, up to (but not
+// including) height, unless they were saved by SaveTxInfo, so that the tx
+// infos of the blocks saved before are pruned too.
+func (bs *BlockStore) saveMissingTxHashesUpTo(prunedTo, height int64) error {
+	bs.mtx.RLock()
+	from, to := bs.base, bs.height+1
+	bs.mtx.RUnlock()
+	if prunedTo > from {
+		from = prunedTo
+	}
+	if height < to {
+		to = height
+	}
+
+	batch := bs.db.NewBatch()
+	defer batch.Close()
+	for h := from; h < to; h++ {
+		block := bs.LoadBlock(h)
+		if block == nil { // assume pruned since
+			continue
+		}
+		if err := bs.saveMissingTxHashes(batch, block); err != nil {
+			return err
+		}
+		// flush every 1000 blocks to avoid batches becoming too large
+		if (h-from+1)%1000 == 0 {
+			if err := batch.Write(); err != nil {
+				return err
+			}
+			batch.Close()
+			batch = bs.db.NewBatch()
+			defer batch.Close()
+		}
+	}
+	return batch.WriteSync()
+}
+
+// LoadTxInfoPrunedHeight loads the height the tx infos were pruned up to, or
+// 0 if they were never pruned.
+func (bs *BlockStore) LoadTxInfoPrunedHeight() (int64, error) {
+	bz, err := bs.db.Get(txInfoPrunedKey)
+	if err != nil || len(bz) == 0 {
+		return 0, err
+	}
+	height, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse the tx infos pruned height %q: %w", bz, err)
+	}
+	return height, nil
+}
+
+// loadTxHashes loads the tx hashes of at most limit heights below height.
+func (bs *BlockStore) loadTxHashes(height int64, limit int) ([]int64, [][]byte, error) {
+	it, err := bs.db.Iterator(calcTxHashesKey(0), calcTxHashesKey(height))
+	if err != nil {
+		return nil, nil, err
+	}
+	defer it.Close()
+
+	var (
+		heights []int64
+		hashes  [][]byte
+	)
+	for ; it.Valid() && len(heights) < limit; it.Next() {
+		h, err := strconv.ParseInt(strings.TrimPrefix(string(it.Key()), txHashesPrefix), 10, 64)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid tx hashes key %q: %w", it.Key(), err)
+		}
+		if len(it.Value())%tmhash.Size != 0 {
+			return nil, nil, fmt.Errorf("invalid tx hashes at height %v", h)
+		}
+		heights = append(heights, h)
+		hashes = append(hashes, append([]byte{}, it.Value()...))
+	}
+	return heights, hashes, it.Error()
+}
+
 func (bs *BlockStore) Close() error {
+	if bs.coldTier != nil {
+		if err := bs.coldTier.Close(); err != nil {
+			return err
+		}
+	}
 	return bs.db.Close()
 }
 
@@ -509,10 +833,25 @@ func calcTxHashKey(hash []byte) []byte {
 	return []byte(fmt.Sprintf("TH:%x", hash))
 }
 
+const txHashesPrefix = "TXS:"
+
+// The heights of the tx hashes keys are zero padded so that the keys are
+// ordered by height, allowing to prune the tx infos by height.
+func calcTxHashesKey(height int64) []byte {
+	return []byte(fmt.Sprintf("%s%020d", txHashesPrefix, height))
+}
+
+func calcDataRootSubtreesKey(height int64) []byte {
+	return []byte(fmt.Sprintf("DRS:%v", height))
+}
+
 //-----------------------------------------------------------------------------
 
 var blockStoreKey = []byte("blockStore")
 
+// txInfoPrunedKey is the key of the height the tx infos were pruned up to.
+var txInfoPrunedKey = []byte("txInfoPruned")
+
 // SaveBlockStoreState persists the blockStore state to the database.
 func SaveBlockStoreState(bsj *cmtstore.BlockStoreState, db dbm.DB) {
 	bytes, err := proto.Marshal(bsj)
@@ -568,6 +907,149 @@ func (bs *BlockStore) LoadTxInfo(txHash []byte) *cmtstore.TxInfo {
 	return &txi
 }
 
+// dataRootSubtreeLevels is the number of subtrees of data root tuples indexed
+// per block: the subtrees of 1, 2, 4, ..., 8192 tuples ending at the block.
+// Data commitments are thus served for ranges of up to 16383 blocks.
+const dataRootSubtreeLevels = 14
+
+// dataRootSubtrees returns the concatenated roots of the subtrees of the data
+// root tuples ending at the height, as hashed by merkle.HashFromByteSlices,
+// from the smallest to the largest. The subtree of 2^l tuples is the parent
+// of the subtrees of 2^(l-1) tuples ending at the height and 2^(l-1) heights
+// below, so the larger subtrees are only indexed if the blocks below were
+// indexed too. It returns nil if the data root isn't 32 bytes.
+func (bs *BlockStore) dataRootSubtrees(height int64, dataRoot []byte) []byte {
+	if len(dataRoot) != 32 {
+		return nil
+	}
+	root := merkle.LeafHash(types.EncodeDataRootTuple(uint64(height), *(*[32]byte)(dataRoot)))
+	subtrees := append([]byte{}, root...)
+	for level := 1; level < dataRootSubtreeLevels; level++ {
+		half := int64(1) << (level - 1)
+		if height-2*half < 0 {
+			break
+		}
+		left := bs.loadDataRootSubtree(height-half, level-1)
+		if left == nil {
+			break
+		}
+		root = merkle.InnerHash(left, root)
+		subtrees = append(subtrees, root...)
+	}
+	return subtrees
+}
+
+// loadDataRootSubtree returns the root of the subtree of 2^level data root
+// tuples ending at the height, or nil if it isn't indexed.
+func (bs *BlockStore) loadDataRootSubtree(height int64, level int) []byte {
+	bz, err := bs.db.Get(calcDataRootSubtreesKey(height))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) < (level+1)*tmhash.Size {
+		return nil
+	}
+	// capped, as merkle.InnerHash appends to its left child.
+	return bz[level*tmhash.Size : (level+1)*tmhash.Size : (level+1)*tmhash.Size]
+}
+
+// LoadDataCommitment returns the data commitment over the end exclusive range
+// of heights, i.e. the merkle root of their data root tuples, or nil if the
+// range isn't stored or indexed, such as blocks saved before the index was
+// added.
+func (bs *BlockStore) LoadDataCommitment(start, end int64) []byte {
+	if !bs.storesRange(start, end) {
+		return nil
+	}
+	return bs.dataRootRangeRoot(start, end)
+}
+
+// LoadDataRootInclusionProof returns the inclusion proof of the data root
+// tuple of the height in the data commitment over the end exclusive range of
+// heights, or nil if the range isn't stored or indexed.
+func (bs *BlockStore) LoadDataRootInclusionProof(height, start, end int64) *merkle.Proof {
+	if !bs.storesRange(start, end) || height < start || height >= end {
+		return nil
+	}
+	leaf := bs.loadDataRootSubtree(height, 0)
+	if leaf == nil {
+		return nil
+	}
+	aunts, ok := bs.dataRootRangeAunts(height, start, end)
+	if !ok {
+		return nil
+	}
+	return &merkle.Proof{
+		Total:    end - start,
+		Index:    height - start,
+		LeafHash: leaf,
+		Aunts:    aunts,
+	}
+}
+
+// storesRange returns whether the blocks of the non empty end exclusive range
+// of heights are all stored.
+func (bs *BlockStore) storesRange(start, end int64) bool {
+	bs.mtx.RLock()
+	defer bs.mtx.RUnlock()
+	return start >= bs.base && start < end && end <= bs.height+1
+}
+
+// dataRootRangeRoot returns the root of the tree of the data root tuples of
+// the non empty end exclusive range of heights, split as
+// merkle.HashFromByteSlices does: the left subtree is the largest power of two
+// smaller than the range, thus indexed.
+func (bs *BlockStore) dataRootRangeRoot(start, end int64) []byte {
+	size := end - start
+	if size&(size-1) == 0 {
+		return bs.loadDataRootSubtree(end-1, bits.TrailingZeros64(uint64(size)))
+	}
+	split := splitPoint(size)
+	left := bs.loadDataRootSubtree(start+split-1, bits.TrailingZeros64(uint64(split)))
+	if left == nil {
+		return nil
+	}
+	right := bs.dataRootRangeRoot(start+split, end)
+	if right == nil {
+		return nil
+	}
+	return merkle.InnerHash(left, right)
+}
+
+// dataRootRangeAunts returns the aunts of the data root tuple of the height in
+// the tree of the range, from the leaf up. Only the first right sibling on
+// the way down isn't indexed, after which the siblings are all subtrees of a
+// power of two, so the aunts take a logarithmic number of lookups.
+func (bs *BlockStore) dataRootRangeAunts(height, start, end int64) ([][]byte, bool) {
+	size := end - start
+	if size == 1 {
+		return [][]byte{}, true
+	}
+	split := splitPoint(size)
+	var (
+		sibling []byte
+		aunts   [][]byte
+		ok      bool
+	)
+	if height < start+split {
+		sibling = bs.dataRootRangeRoot(start+split, end)
+		aunts, ok = bs.dataRootRangeAunts(height, start, start+split)
+	} else {
+		sibling = bs.dataRootRangeRoot(start, start+split)
+		aunts, ok = bs.dataRootRangeAunts(height, start+split, end)
+	}
+	if !ok || sibling == nil {
+		return nil, false
+	}
+	return append(aunts, sibling), true
+}
+
+// splitPoint returns the largest power of two smaller than the size, which
+// must be greater than one.
+func splitPoint(size int64) int64 {
+	return int64(1) << (bits.Len64(uint64(size-1)) - 1)
+}
+
 // mustEncode proto encodes a proto.message and panics if fails
 func mustEncode(pb proto.Message) []byte {
 	bz, err := proto.Marshal(pb)
@@ -607,6 +1089,9 @@ func (bs *BlockStore) DeleteLatestBlock() error {
 	if err := batch.Delete(calcSeenCommitKey(targetHeight)); err != nil {
 		return err
 	}
+	if err := batch.Delete(calcDataRootSubtreesKey(targetHeight)); err != nil {
+		return err
+	}
 	// delete last, so as to not leave keys built on meta.BlockID dangling
 	if err := batch.Delete(calcBlockMetaKey(targetHeight)); err != nil {
 		return err
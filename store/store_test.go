@@ -17,6 +17,7 @@ import (
 
 	cfg "github.com/cometbft/cometbft/config"
 	"github.com/cometbft/cometbft/crypto"
+	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/libs/log"
 	cmtrand "github.com/cometbft/cometbft/libs/rand"
 	cmtstore "github.com/cometbft/cometbft/proto/tendermint/store"
@@ -403,6 +404,11 @@ func TestSaveTxInfo(t *testing.T) {
 		// Save the tx info
 		err := blockStore.SaveTxInfo(block, txResponseCodes)
 		require.NoError(t, err)
+
+		// The tx hashes by height are only saved for PruneTxInfos
+		has, err := blockStore.db.Has(calcTxHashesKey(h))
+		require.NoError(t, err)
+		require.False(t, has)
 	}
 
 	// Get the blocks from blockstore up to the height
@@ -642,6 +648,138 @@ func TestPruneBlocksPrunesTxs(t *testing.T) {
 	}
 }
 
+func TestPruneTxInfos(t *testing.T) {
+	config := cfg.ResetTestRoot("blockchain_reactor_test")
+	defer os.RemoveAll(config.RootDir)
+
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{
+		DiscardABCIResponses: false,
+	})
+	state, err := stateStore.LoadFromDBOrGenesisFile(config.GenesisFile())
+	require.NoError(t, err)
+	db := dbm.NewMemDB()
+	blockStore := NewBlockStore(db, WithTxInfoPrunedSeparately())
+
+	blocks := make([]*types.Block, 0, 1500)
+	for h := int64(1); h <= 1500; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		partSet := block.MakePartSet(2)
+		seenCommit := makeTestCommit(h, cmttime.Now())
+		blockStore.SaveBlock(block, partSet, seenCommit)
+		err := blockStore.SaveTxInfo(block, make([]uint32, len(block.Txs)))
+		require.NoError(t, err)
+		// the blocks below 100 and from 500 to 600 have no tx hashes
+		// recorded by height, as if they were saved before
+		if h < 100 || (h >= 500 && h < 600) {
+			require.NoError(t, db.Delete(calcTxHashesKey(h)))
+		}
+		blocks = append(blocks, block)
+	}
+	// a tx of the block 1200 is included again at height 1400
+	retx := blocks[1199].Txs[0]
+	block := blocks[1399]
+	block.Txs = append(block.Txs, retx)
+	require.NoError(t, blockStore.SaveTxInfo(block, make([]uint32, len(block.Txs))))
+
+	// the tx infos are kept when pruning the blocks
+	pruned, err := blockStore.PruneBlocks(200)
+	require.NoError(t, err)
+	assert.EqualValues(t, 199, pruned)
+	for _, block := range blocks[:200] {
+		for _, tx := range block.Txs {
+			require.NotNil(t, blockStore.LoadTxInfo(tx.Hash()))
+		}
+	}
+
+	pruned, err = blockStore.PruneTxInfos(1300)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1299, pruned)
+	for _, block := range blocks {
+		for _, tx := range block.Txs {
+			txInfo := blockStore.LoadTxInfo(tx.Hash())
+			switch {
+			case tx.Key() == retx.Key():
+				require.NotNil(t, txInfo)
+				require.EqualValues(t, 1400, txInfo.Height)
+			case block.Height < 1300:
+				require.Nil(t, txInfo, block.Height)
+			default:
+				require.NotNil(t, txInfo, block.Height)
+				require.Equal(t, block.Height, txInfo.Height)
+			}
+		}
+	}
+
+	// pruning again only prunes the new heights
+	pruned, err = blockStore.PruneTxInfos(1300)
+	require.NoError(t, err)
+	assert.EqualValues(t, 0, pruned)
+	pruned, err = blockStore.PruneTxInfos(1401)
+	require.NoError(t, err)
+	assert.EqualValues(t, 101, pruned)
+	require.Nil(t, blockStore.LoadTxInfo(retx.Hash()))
+
+	_, err = blockStore.PruneTxInfos(0)
+	require.Error(t, err)
+}
+
+func TestDataCommitmentsAndProofs(t *testing.T) {
+	state, bs, cleanup := makeStateAndBlockStore(log.NewNopLogger())
+	defer cleanup()
+
+	tuples := [][]byte{nil} // indexed by height
+	for h := int64(1); h <= 40; h++ {
+		block := makeBlock(h, state, new(types.Commit))
+		bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(h, cmttime.Now()))
+		tuples = append(tuples, types.EncodeDataRootTuple(uint64(h), *(*[32]byte)(block.DataHash)))
+		if h == 10 {
+			// the first blocks are saved as before the data roots were
+			// indexed.
+			for i := int64(1); i <= 10; i++ {
+				require.NoError(t, bs.db.Delete(calcDataRootSubtreesKey(i)))
+			}
+		}
+	}
+
+	// the commitments and proofs of the ranges after the first blocks are
+	// the ones of the full trees.
+	for start := int64(11); start <= 40; start++ {
+		for end := start + 1; end <= 41; end++ {
+			root, proofs := merkle.ProofsFromByteSlices(tuples[start:end])
+			require.Equal(t, root, bs.LoadDataCommitment(start, end), "[%d, %d)", start, end)
+			for h := start; h < end; h++ {
+				proof := bs.LoadDataRootInclusionProof(h, start, end)
+				require.Equal(t, proofs[h-start], proof, "%d in [%d, %d)", h, start, end)
+			}
+		}
+	}
+	// the ones of the first blocks, or of blocks not stored, aren't indexed.
+	assert.Nil(t, bs.LoadDataCommitment(10, 20))
+	assert.Nil(t, bs.LoadDataRootInclusionProof(15, 10, 20))
+	assert.Nil(t, bs.LoadDataCommitment(30, 42))
+	assert.Nil(t, bs.LoadDataRootInclusionProof(35, 30, 42))
+	assert.Nil(t, bs.LoadDataCommitment(20, 20))
+	assert.Nil(t, bs.LoadDataRootInclusionProof(20, 10, 20))
+
+	// pruning deletes the subtrees of the pruned blocks.
+	_, err := bs.PruneBlocks(20)
+	require.NoError(t, err)
+	assert.Nil(t, bs.LoadDataCommitment(15, 25))
+	assert.Nil(t, bs.loadDataRootSubtree(19, 0))
+	root, _ := merkle.ProofsFromByteSlices(tuples[20:41])
+	assert.Equal(t, root, bs.LoadDataCommitment(20, 41))
+
+	// as does deleting the latest block.
+	require.NoError(t, bs.DeleteLatestBlock())
+	assert.Nil(t, bs.LoadDataCommitment(30, 41))
+	assert.Nil(t, bs.loadDataRootSubtree(40, 0))
+	block := makeBlock(40, state, new(types.Commit))
+	bs.SaveBlock(block, block.MakePartSet(2), makeTestCommit(40, cmttime.Now()))
+	root, _ = merkle.ProofsFromByteSlices(append(tuples[20:40:40],
+		types.EncodeDataRootTuple(40, *(*[32]byte)(block.DataHash))))
+	assert.Equal(t, root, bs.LoadDataCommitment(20, 41))
+}
+
 func TestLoadBlockMeta(t *testing.T) {
 	bs, db := freshBlockStore()
 	height := int64(10)
@@ -0,0 +1,238 @@
+// Package verify checks the consistency of the blocks of a node with their
+// commits, validator sets, tx index entries and ABCI responses, e.g. after a
+// crash or a disk failure.
+package verify
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/gogo/protobuf/proto"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/types"
+)
+
+// Error is returned by Verify for the first height failing a check.
+type Error struct {
+	Height int64
+	Err    error
+}
+
+func (e Error) Error() string {
+	return fmt.Sprintf("height %d: %v", e.Height, e.Err)
+}
+
+func (e Error) Unwrap() error {
+	return e.Err
+}
+
+// Verify walks the blocks of the store from its base to its height, and checks
+// that:
+//   - the parts of each block reassemble to the block ID of its meta,
+//   - each block links to the block ID of the previous one,
+//   - the commit of each block is signed by its validator set in stateStore,
+//   - the tx index entries of each tx point at the tx,
+//   - the ABCI responses of each block hash to the LastResultsHash of the next
+//     one, unless the node discards them.
+//
+// The tx index entries of a block saved but not executed yet, after a crash,
+// are not checked, nor are the validator sets, the tx index entries and the
+// ABCI responses of the heights they were pruned up to. Verify returns the
+// first height failing a check as an Error. A mismatch of the ABCI responses
+// is reported at their height.
+func Verify(blockStore *store.BlockStore, stateStore sm.Store) error {
+	state, err := stateStore.Load()
+	if err != nil {
+		return fmt.Errorf("failed to load state: %w", err)
+	}
+	pruned, err := stateStore.LoadPrunedHeights()
+	if err != nil {
+		return fmt.Errorf("failed to load the pruned heights: %w", err)
+	}
+	txInfoPruned, err := blockStore.LoadTxInfoPrunedHeight()
+	if err != nil {
+		return fmt.Errorf("failed to load the pruned heights: %w", err)
+	}
+	v := &verifier{
+		blockStore:      blockStore,
+		stateStore:      stateStore,
+		lastBlockHeight: state.LastBlockHeight,
+		pruned:          pruned,
+		txInfoPruned:    txInfoPruned,
+	}
+	for h := blockStore.Base(); h > 0 && h <= blockStore.Height(); h++ {
+		if err := v.verifyHeight(h); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+type verifier struct {
+	blockStore      *store.BlockStore
+	stateStore      sm.Store
+	lastBlockHeight int64
+	// pruned and txInfoPruned are the heights the data of the stores was
+	// pruned up to, below which it isn't checked.
+	pruned       sm.PrunedHeights
+	txInfoPruned int64
+
+	prev   *types.Block
+	prevID types.BlockID
+}
+
+// verifyHeight checks the block at height, and the ABCI responses of the
+// previous block against it, returning an Error for the failing height. A
+// corrupted store makes the block store panic, which is reported as an error.
+func (v *verifier) verifyHeight(height int64) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = Error{Height: height, Err: fmt.Errorf("failed to load: %v", r)}
+		}
+	}()
+
+	meta := v.blockStore.LoadBlockMeta(height)
+	if meta == nil {
+		return Error{Height: height, Err: errors.New("missing block meta")}
+	}
+	block, err := loadBlock(v.blockStore, meta)
+	if err != nil {
+		return Error{Height: height, Err: err}
+	}
+
+	if v.prev != nil {
+		if !block.LastBlockID.Equals(v.prevID) {
+			return Error{Height: height, Err: fmt.Errorf(
+				"last block ID %v does not match the block ID %v of the previous block", block.LastBlockID, v.prevID)}
+		}
+		if v.prev.Height >= v.pruned.ABCIResponses {
+			if err := verifyResults(v.stateStore, v.prev.Height, block.LastResultsHash); err != nil {
+				return Error{Height: v.prev.Height, Err: err}
+			}
+		}
+	}
+
+	if height >= v.pruned.States {
+		if err := verifyCommit(v.blockStore, v.stateStore, block, meta.BlockID); err != nil {
+			return Error{Height: height, Err: err}
+		}
+	}
+	if height >= v.txInfoPruned && height <= v.lastBlockHeight {
+		if err := verifyTxInfos(v.blockStore, block); err != nil {
+			return Error{Height: height, Err: err}
+		}
+	}
+
+	v.prev, v.prevID = block, meta.BlockID
+	return nil
+}
+
+// loadBlock reassembles the parts of the block of meta, checking their proofs
+// and the hash of the block.
+func loadBlock(blockStore *store.BlockStore, meta *types.BlockMeta) (*types.Block, error) {
+	partSet := types.NewPartSetFromHeader(meta.BlockID.PartSetHeader)
+	for i := 0; i < int(partSet.Total()); i++ {
+		part := blockStore.LoadBlockPart(meta.Header.Height, i)
+		if part == nil {
+			return nil, fmt.Errorf("missing block part %d", i)
+		}
+		if _, err := partSet.AddPart(part); err != nil {
+			return nil, fmt.Errorf("invalid block part %d: %w", i, err)
+		}
+	}
+
+	bz, err := io.ReadAll(partSet.GetReader())
+	if err != nil {
+		return nil, err
+	}
+	pbb := new(cmtproto.Block)
+	if err := proto.Unmarshal(bz, pbb); err != nil {
+		return nil, fmt.Errorf("failed to decode block: %w", err)
+	}
+	block, err := types.BlockFromProto(pbb)
+	if err != nil {
+		return nil, fmt.Errorf("invalid block: %w", err)
+	}
+	if block.Height != meta.Header.Height {
+		return nil, fmt.Errorf("block has height %d", block.Height)
+	}
+	if hash := block.Hash(); !bytes.Equal(hash, meta.BlockID.Hash) {
+		return nil, fmt.Errorf("block hash %X does not match the hash %X of its meta", hash, meta.BlockID.Hash)
+	}
+	return block, nil
+}
+
+// verifyCommit checks the commit of block against its validator set. The
+// commit of the latest block is the one seen by the node.
+func verifyCommit(blockStore *store.BlockStore, stateStore sm.Store, block *types.Block, blockID types.BlockID) error {
+	commit := blockStore.LoadBlockCommit(block.Height)
+	if commit == nil {
+		commit = blockStore.LoadSeenCommit(block.Height)
+	}
+	if commit == nil {
+		return errors.New("missing commit")
+	}
+	vals, err := stateStore.LoadValidators(block.Height)
+	if err != nil {
+		return fmt.Errorf("failed to load validators: %w", err)
+	}
+	if !bytes.Equal(vals.Hash(), block.ValidatorsHash) {
+		return fmt.Errorf("validators hash %X does not match the validators hash %X of the block",
+			vals.Hash(), block.ValidatorsHash)
+	}
+	if err := vals.VerifyCommitLight(block.ChainID, blockID, block.Height, commit); err != nil {
+		return fmt.Errorf("invalid commit: %w", err)
+	}
+	return nil
+}
+
+// verifyTxInfos checks that the tx index entry of each tx of block points at
+// a tx with the same hash. A tx included again in a later block points at the
+// later one.
+func verifyTxInfos(blockStore *store.BlockStore, block *types.Block) error {
+	for i, tx := range block.Txs {
+		hash := tx.Hash()
+		txInfo := blockStore.LoadTxInfo(hash)
+		if txInfo == nil {
+			return fmt.Errorf("missing tx index entry for tx %d (%X)", i, hash)
+		}
+		txs := block.Txs
+		if txInfo.Height != block.Height {
+			if txInfo.Height < block.Height {
+				return fmt.Errorf("tx %d (%X) is indexed at the earlier height %d", i, hash, txInfo.Height)
+			}
+			other := blockStore.LoadBlock(txInfo.Height)
+			if other == nil {
+				return fmt.Errorf("tx %d (%X) is indexed at missing height %d", i, hash, txInfo.Height)
+			}
+			txs = other.Txs
+		}
+		if int(txInfo.Index) >= len(txs) || !bytes.Equal(txs[txInfo.Index].Hash(), hash) {
+			return fmt.Errorf("tx %d (%X) is indexed at height %d index %d, which is another tx",
+				i, hash, txInfo.Height, txInfo.Index)
+		}
+	}
+	return nil
+}
+
+// verifyResults checks that the ABCI responses of the block at height hash to
+// lastResultsHash, if the node kept them.
+func verifyResults(stateStore sm.Store, height int64, lastResultsHash []byte) error {
+	abciResponses, err := stateStore.LoadABCIResponses(height)
+	if errors.Is(err, sm.ErrABCIResponsesNotPersisted) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to load ABCI responses: %w", err)
+	}
+	if hash := sm.ABCIResponsesResultsHash(abciResponses); !bytes.Equal(hash, lastResultsHash) {
+		return fmt.Errorf("ABCI responses hash to %X, not to the last results hash %X of the next block",
+			hash, lastResultsHash)
+	}
+	return nil
+}
@@ -0,0 +1,157 @@
+package verify_test
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	dbm "github.com/cometbft/cometbft-db"
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtstate "github.com/cometbft/cometbft/proto/tendermint/state"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	sm "github.com/cometbft/cometbft/state"
+	"github.com/cometbft/cometbft/store"
+	"github.com/cometbft/cometbft/store/verify"
+	"github.com/cometbft/cometbft/types"
+)
+
+// makeChain commits and executes blocks 1 to height, signed by a single
+// validator, in new stores. Every block includes the tx "tx".
+func makeChain(t *testing.T, height int64) (dbm.DB, *store.BlockStore, sm.Store) {
+	pv := types.NewMockPV()
+	pubKey, err := pv.GetPubKey()
+	require.NoError(t, err)
+	genDoc := &types.GenesisDoc{
+		ChainID:     "verify-test",
+		GenesisTime: time.Now(),
+		Validators:  []types.GenesisValidator{{PubKey: pubKey, Power: 10}},
+	}
+	require.NoError(t, genDoc.ValidateAndComplete())
+	state, err := sm.MakeGenesisState(genDoc)
+	require.NoError(t, err)
+
+	blockStoreDB := dbm.NewMemDB()
+	blockStore := store.NewBlockStore(blockStoreDB)
+	stateStore := sm.NewStore(dbm.NewMemDB(), sm.StoreOptions{})
+
+	lastCommit := &types.Commit{}
+	var lastBlockID types.BlockID
+	var lastResultsHash []byte
+	for h := int64(1); h <= height; h++ {
+		state.LastBlockHeight = h - 1
+		require.NoError(t, stateStore.Save(state))
+
+		txs := types.Txs{types.Tx("tx"), types.Tx{byte(h)}}
+		block := types.MakeBlock(h, txs, lastCommit, nil)
+		block.Header.Populate(
+			state.Version.Consensus, genDoc.ChainID, genDoc.GenesisTime.Add(time.Duration(h)*time.Second),
+			lastBlockID, state.Validators.Hash(), state.NextValidators.Hash(),
+			state.ConsensusParams.Hash(), nil, lastResultsHash, pubKey.Address(),
+		)
+		partSet := block.MakePartSet(types.BlockPartSizeBytes)
+		blockID := types.BlockID{Hash: block.Hash(), PartSetHeader: partSet.Header()}
+		voteSet := types.NewVoteSet(genDoc.ChainID, h, 0, cmtproto.PrecommitType, state.Validators)
+		commit, err := types.MakeCommit(blockID, h, 0, voteSet, []types.PrivValidator{pv}, block.Time)
+		require.NoError(t, err)
+		blockStore.SaveBlock(block, partSet, commit)
+
+		abciResponses := &cmtstate.ABCIResponses{
+			DeliverTxs: []*abci.ResponseDeliverTx{{Code: 0}, {Code: uint32(h)}},
+			BeginBlock: &abci.ResponseBeginBlock{},
+			EndBlock:   &abci.ResponseEndBlock{},
+		}
+		require.NoError(t, blockStore.SaveTxInfo(block, []uint32{0, uint32(h)}))
+		require.NoError(t, stateStore.SaveABCIResponses(h, abciResponses))
+
+		lastCommit, lastBlockID = commit, blockID
+		lastResultsHash = sm.ABCIResponsesResultsHash(abciResponses)
+	}
+	state.LastBlockHeight = height
+	state.LastValidators = state.Validators.Copy()
+	require.NoError(t, stateStore.Save(state))
+	return blockStoreDB, blockStore, stateStore
+}
+
+func TestVerify(t *testing.T) {
+	_, blockStore, stateStore := makeChain(t, 5)
+	require.NoError(t, verify.Verify(blockStore, stateStore))
+
+	// an empty store
+	require.NoError(t, verify.Verify(store.NewBlockStore(dbm.NewMemDB()), stateStore))
+}
+
+func TestVerifyPruned(t *testing.T) {
+	_, blockStore, stateStore := makeChain(t, 10)
+	require.NoError(t, stateStore.PruneStates(1, 4))
+	_, err := blockStore.PruneTxInfos(6)
+	require.NoError(t, err)
+	require.NoError(t, verify.Verify(blockStore, stateStore))
+}
+
+func TestVerifyInvalid(t *testing.T) {
+	testCases := map[string]struct {
+		corrupt func(t *testing.T, db dbm.DB, blockStore *store.BlockStore, stateStore sm.Store)
+		height  int64
+	}{
+		"corrupted block part": {
+			corrupt: func(t *testing.T, db dbm.DB, _ *store.BlockStore, _ sm.Store) {
+				require.NoError(t, db.Set([]byte("P:2:0"), []byte("corrupted")))
+			},
+			height: 2,
+		},
+		"missing block part": {
+			corrupt: func(t *testing.T, db dbm.DB, _ *store.BlockStore, _ sm.Store) {
+				require.NoError(t, db.Delete([]byte("P:4:0")))
+			},
+			height: 4,
+		},
+		"invalid commit": {
+			corrupt: func(t *testing.T, _ dbm.DB, blockStore *store.BlockStore, _ sm.Store) {
+				require.NoError(t, blockStore.SaveSeenCommit(5, blockStore.LoadBlockCommit(4)))
+			},
+			height: 5,
+		},
+		"tx index entry of another tx": {
+			corrupt: func(t *testing.T, _ dbm.DB, blockStore *store.BlockStore, _ sm.Store) {
+				block := &types.Block{
+					Header: types.Header{Height: 3},
+					Data:   types.Data{Txs: types.Txs{types.Tx{3}}},
+				}
+				require.NoError(t, blockStore.SaveTxInfo(block, []uint32{0}))
+			},
+			height: 3,
+		},
+		"missing tx index entry": {
+			corrupt: func(t *testing.T, db dbm.DB, _ *store.BlockStore, _ sm.Store) {
+				require.NoError(t, db.Delete([]byte(fmt.Sprintf("TH:%x", types.Tx{2}.Hash()))))
+			},
+			height: 2,
+		},
+		"ABCI responses": {
+			corrupt: func(t *testing.T, _ dbm.DB, _ *store.BlockStore, stateStore sm.Store) {
+				require.NoError(t, stateStore.SaveABCIResponses(3, &cmtstate.ABCIResponses{
+					DeliverTxs: []*abci.ResponseDeliverTx{{Code: 1}, {Code: 3}},
+					BeginBlock: &abci.ResponseBeginBlock{},
+					EndBlock:   &abci.ResponseEndBlock{},
+				}))
+			},
+			height: 3,
+		},
+	}
+	for name, tc := range testCases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			db, blockStore, stateStore := makeChain(t, 5)
+			tc.corrupt(t, db, blockStore, stateStore)
+
+			err := verify.Verify(blockStore, stateStore)
+			require.Error(t, err)
+			var verifyErr verify.Error
+			require.True(t, errors.As(err, &verifyErr), err)
+			require.Equal(t, tc.height, verifyErr.Height, err)
+		})
+	}
+}
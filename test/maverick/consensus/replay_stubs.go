@@ -42,6 +42,9 @@ func (emptyMempool) TxsBytes() int64               { return 0 }
 
 func (emptyMempool) GetTxByKey(txKey types.TxKey) (types.Tx, bool) { return nil, false }
 func (emptyMempool) WasRecentlyEvicted(txKey types.TxKey) bool     { return false }
+func (emptyMempool) WasRecentlyRejected(txKey types.TxKey) (uint32, string, bool) {
+	return 0, "", false
+}
 
 func (emptyMempool) TxsFront() *clist.CElement    { return nil }
 func (emptyMempool) TxsWaitChan() <-chan struct{} { return nil }
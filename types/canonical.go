@@ -48,6 +48,8 @@ func CanonicalizeProposal(chainID string, proposal *cmtproto.Proposal) cmtproto.
 		BlockID:   CanonicalizeBlockID(proposal.BlockID),
 		Timestamp: proposal.Timestamp,
 		ChainID:   chainID,
+
+		ExtendedPartSetHeader: proposal.ExtendedPartSetHeader,
 	}
 }
 
@@ -0,0 +1,86 @@
+package types
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+)
+
+// EncodeDataRootTuple takes a height and a data root, and returns the equivalent of
+// `abi.encode(...)` in Ethereum.
+// The encoded type is a DataRootTuple, which has the following ABI:
+//
+//	{
+//	  "components":[
+//	     {
+//	        "internalType":"uint256",
+//	        "name":"height",
+//	        "type":"uint256"
+//	     },
+//	     {
+//	        "internalType":"bytes32",
+//	        "name":"dataRoot",
+//	        "type":"bytes32"
+//	     },
+//	     {
+//	        "internalType":"structDataRootTuple",
+//	        "name":"_tuple",
+//	        "type":"tuple"
+//	     }
+//	  ]
+//	}
+//
+// i.e. the height padded to 32 bytes concatenated to the data root.
+// For more information, refer to:
+// https://github.com/celestiaorg/quantum-gravity-bridge/blob/master/src/DataRootTuple.sol
+func EncodeDataRootTuple(height uint64, dataRoot [32]byte) []byte {
+	encoded := make([]byte, 64)
+	binary.BigEndian.PutUint64(encoded[24:32], height)
+	copy(encoded[32:], dataRoot[:])
+	return encoded
+}
+
+// DataCommitment returns the merkle root of the data root tuples of the
+// headers, which must be of consecutive heights. This is the data commitment
+// over the end exclusive range of heights from the first header's height to
+// the height following the last header.
+func DataCommitment(headers []*Header) ([]byte, error) {
+	leaves, err := dataRootTuples(headers)
+	if err != nil {
+		return nil, err
+	}
+	return merkle.HashFromByteSlices(leaves), nil
+}
+
+// VerifyDataRootInclusion verifies that the data root tuple of the header is
+// included in the data commitment over the headers of the end exclusive
+// range of heights [start, end) using the proof.
+func VerifyDataRootInclusion(proof *merkle.Proof, header *Header, start, end uint64, dataCommitment []byte) error {
+	height := uint64(header.Height)
+	if height < start || height >= end {
+		return fmt.Errorf("height %d is not in the range [%d, %d)", height, start, end)
+	}
+	if proof.Total != int64(end-start) || proof.Index != int64(height-start) {
+		return fmt.Errorf("proof index %d of %d does not match height %d in the range [%d, %d)",
+			proof.Index, proof.Total, height, start, end)
+	}
+	if len(header.DataHash) != 32 {
+		return fmt.Errorf("expected a 32 byte data root, got %d bytes", len(header.DataHash))
+	}
+	return proof.Verify(dataCommitment, EncodeDataRootTuple(height, *(*[32]byte)(header.DataHash)))
+}
+
+func dataRootTuples(headers []*Header) ([][]byte, error) {
+	leaves := make([][]byte, 0, len(headers))
+	for i, header := range headers {
+		if i > 0 && header.Height != headers[i-1].Height+1 {
+			return nil, fmt.Errorf("headers are not consecutive: %d follows %d", header.Height, headers[i-1].Height)
+		}
+		if len(header.DataHash) != 32 {
+			return nil, fmt.Errorf("expected a 32 byte data root at height %d, got %d bytes", header.Height, len(header.DataHash))
+		}
+		leaves = append(leaves, EncodeDataRootTuple(uint64(header.Height), *(*[32]byte)(header.DataHash)))
+	}
+	return leaves, nil
+}
@@ -0,0 +1,45 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtrand "github.com/cometbft/cometbft/libs/rand"
+)
+
+func TestDataCommitment(t *testing.T) {
+	const start, end = 10, 15
+	headers := make([]*Header, 0, end-start)
+	leaves := make([][]byte, 0, end-start)
+	for height := int64(start); height < end; height++ {
+		header := &Header{Height: height, DataHash: cmtrand.Bytes(32)}
+		headers = append(headers, header)
+		leaves = append(leaves, EncodeDataRootTuple(uint64(height), *(*[32]byte)(header.DataHash)))
+	}
+
+	dataCommitment, err := DataCommitment(headers)
+	require.NoError(t, err)
+	root, proofs := merkle.ProofsFromByteSlices(leaves)
+	assert.Equal(t, root, dataCommitment)
+
+	for i, header := range headers {
+		assert.NoError(t, VerifyDataRootInclusion(proofs[i], header, start, end, dataCommitment))
+	}
+
+	// a proof for another height, or of another range, is rejected.
+	assert.Error(t, VerifyDataRootInclusion(proofs[0], headers[1], start, end, dataCommitment))
+	assert.Error(t, VerifyDataRootInclusion(proofs[0], headers[0], start, end+1, dataCommitment))
+	assert.Error(t, VerifyDataRootInclusion(proofs[0], headers[0], start+1, end, dataCommitment))
+
+	// a tampered data root is rejected.
+	tampered := *headers[2]
+	tampered.DataHash = cmtrand.Bytes(32)
+	assert.Error(t, VerifyDataRootInclusion(proofs[2], &tampered, start, end, dataCommitment))
+
+	// headers must be consecutive.
+	_, err = DataCommitment([]*Header{headers[0], headers[2]})
+	assert.Error(t, err)
+}
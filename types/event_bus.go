@@ -109,7 +109,7 @@ func (b *EventBus) Publish(eventType string, eventData TMEventData) error {
 // map of stringified events where each key is composed of the event
 // type and each of the event's attributes keys in the form of
 // "{event.Type}.{attribute.Key}" and the value is each attribute's value.
-func (b *EventBus) validateAndStringifyEvents(events []types.Event, logger log.Logger) map[string][]string {
+func validateAndStringifyEvents(events []types.Event, logger log.Logger) map[string][]string {
 	result := make(map[string][]string)
 	for _, event := range events {
 		if len(event.Type) == 0 {
@@ -135,13 +135,24 @@ func (b *EventBus) PublishEventNewBlock(data EventDataNewBlock) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
 
+	events := newBlockEvents(data, b.Logger.With("block", data.Block.StringShort()))
+
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
+// NewBlockEvents returns the events a new block event is published with, and
+// which subscription queries are matched against.
+func NewBlockEvents(data EventDataNewBlock) map[string][]string {
+	return newBlockEvents(data, log.NewNopLogger())
+}
+
+func newBlockEvents(data EventDataNewBlock, logger log.Logger) map[string][]string {
 	resultEvents := append(data.ResultBeginBlock.Events, data.ResultEndBlock.Events...)
-	events := b.validateAndStringifyEvents(resultEvents, b.Logger.With("block", data.Block.StringShort()))
+	events := validateAndStringifyEvents(resultEvents, logger)
 
 	// add predefined new block event
 	events[EventTypeKey] = append(events[EventTypeKey], EventNewBlock)
-
-	return b.pubsub.PublishWithEvents(ctx, data, events)
+	return events
 }
 
 func (b *EventBus) PublishEventNewSignedBlock(data EventDataSignedBlock) error {
@@ -154,7 +165,7 @@ func (b *EventBus) PublishEventNewBlockHeader(data EventDataNewBlockHeader) erro
 
 	resultTags := append(data.ResultBeginBlock.Events, data.ResultEndBlock.Events...)
 	// TODO: Create StringShort method for Header and use it in logger.
-	events := b.validateAndStringifyEvents(resultTags, b.Logger.With("header", data.Header))
+	events := validateAndStringifyEvents(resultTags, b.Logger.With("header", data.Header))
 
 	// add predefined new block header event
 	events[EventTypeKey] = append(events[EventTypeKey], EventNewBlockHeader)
@@ -181,13 +192,39 @@ func (b *EventBus) PublishEventTx(data EventDataTx) error {
 	// no explicit deadline for publishing events
 	ctx := context.Background()
 
-	events := b.validateAndStringifyEvents(data.Result.Events, b.Logger.With("tx", data.Tx))
+	events := txEvents(data, b.Logger.With("tx", data.Tx))
+
+	return b.pubsub.PublishWithEvents(ctx, data, events)
+}
+
+// TxEvents returns the events a tx event is published with, and which
+// subscription queries are matched against.
+func TxEvents(data EventDataTx) map[string][]string {
+	return txEvents(data, log.NewNopLogger())
+}
+
+func txEvents(data EventDataTx, logger log.Logger) map[string][]string {
+	events := validateAndStringifyEvents(data.Result.Events, logger)
 
 	// add predefined compositeKeys
 	events[EventTypeKey] = append(events[EventTypeKey], EventTx)
 	events[TxHashKey] = append(events[TxHashKey], fmt.Sprintf("%X", Tx(data.Tx).Hash()))
 	events[TxHeightKey] = append(events[TxHeightKey], fmt.Sprintf("%d", data.Height))
+	return events
+}
 
+// PublishEventTxStatus publishes a transition in the life cycle of a
+// transaction, with the predefined keys EventTypeKey and TxStatusHashKey.
+// It doesn't use TxHashKey, so that subscriptions to the Tx events of a
+// transaction don't receive its TxStatus events.
+func (b *EventBus) PublishEventTxStatus(data EventDataTxStatus) error {
+	// no explicit deadline for publishing events
+	ctx := context.Background()
+
+	events := map[string][]string{
+		EventTypeKey:    {EventTxStatus},
+		TxStatusHashKey: {fmt.Sprintf("%X", data.Hash)},
+	}
 	return b.pubsub.PublishWithEvents(ctx, data, events)
 }
 
@@ -275,6 +312,10 @@ func (NopEventBus) PublishEventTx(data EventDataTx) error {
 	return nil
 }
 
+func (NopEventBus) PublishEventTxStatus(data EventDataTxStatus) error {
+	return nil
+}
+
 func (NopEventBus) PublishEventNewRoundStep(data EventDataRoundState) error {
 	return nil
 }
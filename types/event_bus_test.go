@@ -357,6 +357,50 @@ func TestEventBusPublishEventNewEvidence(t *testing.T) {
 	}
 }
 
+func TestEventBusPublishEventTxStatus(t *testing.T) {
+	eventBus := NewEventBus()
+	err := eventBus.Start()
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		if err := eventBus.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	tx := Tx("foo")
+	sub, err := eventBus.Subscribe(context.Background(), "test", EventQueryTxStatusFor(tx.Hash()))
+	require.NoError(t, err)
+	// nor are subscriptions to the Tx events of the transaction.
+	txSub, err := eventBus.Subscribe(context.Background(), "test",
+		cmtquery.MustParse(fmt.Sprintf("%s='%X'", TxHashKey, tx.Hash())))
+	require.NoError(t, err)
+
+	// the event of another transaction is not matched.
+	err = eventBus.PublishEventTxStatus(EventDataTxStatus{Hash: Tx("bar").Hash(), Status: TxStatusAccepted})
+	require.NoError(t, err)
+	data := EventDataTxStatus{
+		Hash:   tx.Hash(),
+		Status: TxStatusEvicted,
+		Reason: TxEvictedRecheck,
+		Code:   2,
+		Log:    "invalid nonce",
+	}
+	err = eventBus.PublishEventTxStatus(data)
+	require.NoError(t, err)
+
+	select {
+	case msg := <-sub.Out():
+		assert.Equal(t, data, msg.Data())
+	case <-time.After(1 * time.Second):
+		t.Fatal("did not receive a tx status after 1 sec.")
+	}
+	select {
+	case msg := <-txSub.Out():
+		t.Fatalf("received a tx status on the tx hash: %v", msg.Data())
+	default:
+	}
+}
+
 func TestEventBusPublish(t *testing.T) {
 	eventBus := NewEventBus()
 	err := eventBus.Start()
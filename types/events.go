@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	abci "github.com/cometbft/cometbft/abci/types"
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
 	cmtjson "github.com/cometbft/cometbft/libs/json"
 	cmtpubsub "github.com/cometbft/cometbft/libs/pubsub"
 	cmtquery "github.com/cometbft/cometbft/libs/pubsub/query"
@@ -23,6 +24,10 @@ const (
 	EventTx                  = "Tx"
 	EventValidatorSetUpdates = "ValidatorSetUpdates"
 
+	// Transaction life cycle events, triggered from the mempool as
+	// transactions are accepted, gossiped, rejected, evicted and committed.
+	EventTxStatus = "TxStatus"
+
 	// Internal consensus events.
 	// These are used for testing the consensus state machine.
 	// They can also be used to build real-time consensus visualizers.
@@ -52,6 +57,7 @@ func init() {
 	cmtjson.RegisterType(EventDataNewBlockHeader{}, "tendermint/event/NewBlockHeader")
 	cmtjson.RegisterType(EventDataNewEvidence{}, "tendermint/event/NewEvidence")
 	cmtjson.RegisterType(EventDataTx{}, "tendermint/event/Tx")
+	cmtjson.RegisterType(EventDataTxStatus{}, "tendermint/event/TxStatus")
 	cmtjson.RegisterType(EventDataRoundState{}, "tendermint/event/RoundState")
 	cmtjson.RegisterType(EventDataNewRound{}, "tendermint/event/NewRound")
 	cmtjson.RegisterType(EventDataCompleteProposal{}, "tendermint/event/CompleteProposal")
@@ -118,6 +124,38 @@ type EventDataNewRound struct {
 	Proposer ValidatorInfo `json:"proposer"`
 }
 
+// Statuses of the transitions reported by EventDataTxStatus.
+const (
+	TxStatusAccepted  = "ACCEPTED"
+	TxStatusGossiped  = "GOSSIPED"
+	TxStatusRejected  = "REJECTED"
+	TxStatusEvicted   = "EVICTED"
+	TxStatusCommitted = "COMMITTED"
+)
+
+// Reasons for evicting a transaction from the mempool.
+const (
+	TxEvictedFull     = "full"
+	TxEvictedTTL      = "ttl"
+	TxEvictedRecheck  = "recheck"
+	TxEvictedReplaced = "replaced"
+)
+
+// EventDataTxStatus reports a transition in the life cycle of a transaction.
+// Code and Log are those of the CheckTx response for rejected transactions and
+// transactions evicted by a recheck, and of the DeliverTx response for
+// committed transactions. Height and Index are only set for committed
+// transactions.
+type EventDataTxStatus struct {
+	Hash   cmtbytes.HexBytes `json:"hash"`
+	Status string            `json:"status"`
+	Reason string            `json:"reason,omitempty"`
+	Code   uint32            `json:"code"`
+	Log    string            `json:"log,omitempty"`
+	Height int64             `json:"height"`
+	Index  uint32            `json:"index"`
+}
+
 type EventDataCompleteProposal struct {
 	Height int64  `json:"height"`
 	Round  int32  `json:"round"`
@@ -147,6 +185,10 @@ const (
 	// TxHeightKey is a reserved key, used to specify transaction block's height.
 	// see EventBus#PublishEventTx
 	TxHeightKey = "tx.height"
+	// TxStatusHashKey is a reserved key, used to specify the hash of the
+	// transaction a TxStatus event is about.
+	// see EventBus#PublishEventTxStatus
+	TxStatusHashKey = "tx_status.hash"
 
 	// BlockHeightKey is a reserved key used for indexing BeginBlock and Endblock
 	// events.
@@ -172,6 +214,7 @@ var (
 	EventQueryTimeoutPropose      = QueryForEvent(EventTimeoutPropose)
 	EventQueryTimeoutWait         = QueryForEvent(EventTimeoutWait)
 	EventQueryTx                  = QueryForEvent(EventTx)
+	EventQueryTxStatus            = QueryForEvent(EventTxStatus)
 	EventQueryUnlock              = QueryForEvent(EventUnlock)
 	EventQueryValidatorSetUpdates = QueryForEvent(EventValidatorSetUpdates)
 	EventQueryValidBlock          = QueryForEvent(EventValidBlock)
@@ -182,6 +225,12 @@ func EventQueryTxFor(tx Tx) cmtpubsub.Query {
 	return cmtquery.MustParse(fmt.Sprintf("%s='%s' AND %s='%X'", EventTypeKey, EventTx, TxHashKey, tx.Hash()))
 }
 
+// EventQueryTxStatusFor returns the query matching the life cycle events of
+// the transaction with the given hash.
+func EventQueryTxStatusFor(hash []byte) cmtpubsub.Query {
+	return cmtquery.MustParse(fmt.Sprintf("%s='%s' AND %s='%X'", EventTypeKey, EventTxStatus, TxStatusHashKey, hash))
+}
+
 func QueryForEvent(eventType string) cmtpubsub.Query {
 	return cmtquery.MustParse(fmt.Sprintf("%s='%s'", EventTypeKey, eventType))
 }
@@ -196,6 +245,11 @@ type BlockEventPublisher interface {
 	PublishEventValidatorSetUpdates(EventDataValidatorSetUpdates) error
 }
 
+// TxStatusEventPublisher publishes the life cycle events of transactions.
+type TxStatusEventPublisher interface {
+	PublishEventTxStatus(EventDataTxStatus) error
+}
+
 type TxEventPublisher interface {
 	PublishEventTx(EventDataTx) error
 }
@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/klauspost/reedsolomon"
+
 	"github.com/cometbft/cometbft/crypto/merkle"
 	"github.com/cometbft/cometbft/libs/bits"
 	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
@@ -18,8 +20,13 @@ import (
 var (
 	ErrPartSetUnexpectedIndex = errors.New("error part set unexpected index")
 	ErrPartSetInvalidProof    = errors.New("error part set invalid proof")
+	ErrPartSetInvalidParity   = errors.New("error part set invalid parity")
 )
 
+// MaxPartSetShards is the maximum number of parts, including the parity parts,
+// of an extended PartSet.
+const MaxPartSetShards = 256
+
 type Part struct {
 	Index uint32            `json:"index"`
 	Bytes cmtbytes.HexBytes `json:"bytes"`
@@ -147,6 +154,106 @@ func PartSetHeaderFromProto(ppsh *cmtproto.PartSetHeader) (*PartSetHeader, error
 
 //-------------------------------------
 
+// ExtendedPartSetHeaderAppVersion is the app version from which the proposals
+// can extend their block parts with parity parts, signing their
+// ExtendedPartSetHeader. Before, the proposals with an ExtendedPartSetHeader
+// are rejected, so that the nodes which don't know of it keep verifying the
+// signatures of the proposals.
+const ExtendedPartSetHeaderAppVersion uint64 = 4
+
+// ExtendedPartSetHeader is the header of a PartSet extended with Reed-Solomon
+// parity parts. The parts can be recovered from any Total of the parts and
+// the parity parts, whose indexes follow the ones of the parts.
+type ExtendedPartSetHeader struct {
+	PartSetHeader PartSetHeader     `json:"part_set_header"`
+	ParityTotal   uint32            `json:"parity_total"`
+	ParityHash    cmtbytes.HexBytes `json:"parity_hash"`
+	// The parity parts are computed over the parts padded to the same size.
+	LastPartSize uint32 `json:"last_part_size"`
+}
+
+// String returns a string representation of ExtendedPartSetHeader.
+//
+// 1. PartSetHeader
+// 2. total number of parity parts
+// 3. first 6 bytes of the parity hash
+func (epsh ExtendedPartSetHeader) String() string {
+	return fmt.Sprintf("%v+%v:%X", epsh.PartSetHeader, epsh.ParityTotal, cmtbytes.Fingerprint(epsh.ParityHash))
+}
+
+// ValidateBasic performs basic validation.
+func (epsh ExtendedPartSetHeader) ValidateBasic() error {
+	if err := epsh.PartSetHeader.ValidateBasic(); err != nil {
+		return fmt.Errorf("wrong PartSetHeader: %w", err)
+	}
+	if epsh.PartSetHeader.Total == 0 || epsh.ParityTotal == 0 {
+		return errors.New("no parts or parity parts")
+	}
+	if epsh.PartSetHeader.Total+epsh.ParityTotal > MaxPartSetShards ||
+		epsh.PartSetHeader.Total+epsh.ParityTotal < epsh.ParityTotal {
+		return fmt.Errorf("too many parts: %d+%d, max: %d",
+			epsh.PartSetHeader.Total, epsh.ParityTotal, MaxPartSetShards)
+	}
+	if err := ValidateHash(epsh.ParityHash); err != nil {
+		return fmt.Errorf("wrong ParityHash: %w", err)
+	}
+	if len(epsh.ParityHash) == 0 {
+		return errors.New("missing ParityHash")
+	}
+	if epsh.LastPartSize == 0 || epsh.LastPartSize > BlockPartSizeBytes {
+		return fmt.Errorf("wrong LastPartSize: %d, max: %d", epsh.LastPartSize, BlockPartSizeBytes)
+	}
+	return nil
+}
+
+// ToProto converts ExtendedPartSetHeader to protobuf
+func (epsh *ExtendedPartSetHeader) ToProto() *cmtproto.ExtendedPartSetHeader {
+	if epsh == nil {
+		return nil
+	}
+
+	return &cmtproto.ExtendedPartSetHeader{
+		PartSetHeader: epsh.PartSetHeader.ToProto(),
+		ParityTotal:   epsh.ParityTotal,
+		ParityHash:    epsh.ParityHash,
+		LastPartSize:  epsh.LastPartSize,
+	}
+}
+
+// ExtendedPartSetHeaderFromProto converts a protobuf ExtendedPartSetHeader to
+// an ExtendedPartSetHeader. It returns an error if the header is invalid.
+func ExtendedPartSetHeaderFromProto(pepsh *cmtproto.ExtendedPartSetHeader) (*ExtendedPartSetHeader, error) {
+	if pepsh == nil {
+		return nil, errors.New("nil ExtendedPartSetHeader")
+	}
+	psh, err := PartSetHeaderFromProto(&pepsh.PartSetHeader)
+	if err != nil {
+		return nil, err
+	}
+	epsh := &ExtendedPartSetHeader{
+		PartSetHeader: *psh,
+		ParityTotal:   pepsh.ParityTotal,
+		ParityHash:    pepsh.ParityHash,
+		LastPartSize:  pepsh.LastPartSize,
+	}
+
+	return epsh, epsh.ValidateBasic()
+}
+
+// ParityTotal returns the number of parity parts extending a PartSet of total
+// parts: as many as the parts, up to MaxPartSetShards parts in all.
+func ParityTotal(total uint32) uint32 {
+	if total >= MaxPartSetShards {
+		return 0
+	}
+	if total > MaxPartSetShards-total {
+		return MaxPartSetShards - total
+	}
+	return total
+}
+
+//-------------------------------------
+
 type PartSet struct {
 	total uint32
 	hash  []byte
@@ -158,6 +265,16 @@ type PartSet struct {
 	// a count of the total size (in bytes). Used to ensure that the
 	// part set doesn't exceed the maximum block bytes
 	byteSize int64
+
+	// The Reed-Solomon parity parts of an extended PartSet. They are dropped,
+	// and no longer accepted, if they don't match the parts.
+	parityTotal     uint32
+	parityHash      []byte
+	lastPartSize    uint32
+	parityParts     []*Part
+	parityBitArray  *bits.BitArray
+	parityCount     uint32
+	parityDiscarded bool
 }
 
 // Returns an immutable, full PartSet from the data bytes.
@@ -205,6 +322,18 @@ func NewPartSetFromHeader(header PartSetHeader) *PartSet {
 	}
 }
 
+// Returns an empty extended PartSet ready to be populated with parts and
+// parity parts.
+func NewPartSetFromExtendedHeader(header ExtendedPartSetHeader) *PartSet {
+	ps := NewPartSetFromHeader(header.PartSetHeader)
+	ps.parityTotal = header.ParityTotal
+	ps.parityHash = header.ParityHash
+	ps.lastPartSize = header.LastPartSize
+	ps.parityParts = make([]*Part, header.ParityTotal)
+	ps.parityBitArray = bits.NewBitArray(int(header.ParityTotal))
+	return ps
+}
+
 func (ps *PartSet) Header() PartSetHeader {
 	if ps == nil {
 		return PartSetHeader{}
@@ -215,6 +344,22 @@ func (ps *PartSet) Header() PartSetHeader {
 	}
 }
 
+// ExtendedHeader returns the header of an extended PartSet, with the header of
+// its parity parts. It has no parity parts if the PartSet isn't extended.
+func (ps *PartSet) ExtendedHeader() ExtendedPartSetHeader {
+	if ps == nil {
+		return ExtendedPartSetHeader{}
+	}
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	return ExtendedPartSetHeader{
+		PartSetHeader: ps.Header(),
+		ParityTotal:   ps.parityTotal,
+		ParityHash:    ps.parityHash,
+		LastPartSize:  ps.lastPartSize,
+	}
+}
+
 func (ps *PartSet) HasHeader(header PartSetHeader) bool {
 	if ps == nil {
 		return false
@@ -228,6 +373,14 @@ func (ps *PartSet) BitArray() *bits.BitArray {
 	return ps.partsBitArray.Copy()
 }
 
+// ParityBitArray returns the parity parts of the PartSet, nil if it isn't
+// extended.
+func (ps *PartSet) ParityBitArray() *bits.BitArray {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	return ps.parityBitArray.Copy()
+}
+
 func (ps *PartSet) Hash() []byte {
 	if ps == nil {
 		return merkle.HashFromByteSlices(nil)
@@ -263,6 +416,17 @@ func (ps *PartSet) Total() uint32 {
 	return ps.total
 }
 
+// ParityTotal returns the number of parity parts of an extended PartSet, 0 if
+// it isn't extended.
+func (ps *PartSet) ParityTotal() uint32 {
+	if ps == nil {
+		return 0
+	}
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	return ps.parityTotal
+}
+
 func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	if ps == nil {
 		return false, nil
@@ -270,9 +434,8 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
 
-	// Invalid part index
 	if part.Index >= ps.total {
-		return false, ErrPartSetUnexpectedIndex
+		return ps.addParityPart(part)
 	}
 
 	// If part already exists, return false.
@@ -290,12 +453,227 @@ func (ps *PartSet) AddPart(part *Part) (bool, error) {
 	ps.partsBitArray.SetIndex(int(part.Index), true)
 	ps.count++
 	ps.byteSize += int64(len(part.Bytes))
+
+	if ps.parityTotal > 0 {
+		if ps.count == ps.total {
+			// Compute the parity parts, so that they can be sent to peers.
+			ps.encodeParity()
+		} else if ps.count+ps.parityCount >= ps.total {
+			if err := ps.recover(); err != nil {
+				return true, err
+			}
+		}
+	}
+	return true, nil
+}
+
+// addParityPart adds a parity part, recovering the parts once there are
+// enough parts and parity parts.
+// CONTRACT: ps.mtx is held.
+func (ps *PartSet) addParityPart(part *Part) (bool, error) {
+	// Invalid part index
+	if part.Index >= ps.total+ps.parityTotal {
+		return false, ErrPartSetUnexpectedIndex
+	}
+
+	// If part already exists, or the parity is no longer used, return false.
+	index := part.Index - ps.total
+	if ps.parityParts[index] != nil || ps.parityDiscarded || ps.count == ps.total {
+		return false, nil
+	}
+
+	// Check hash proof
+	if part.Proof.Index != int64(index) || part.Proof.Verify(ps.parityHash, part.Bytes) != nil {
+		return false, ErrPartSetInvalidProof
+	}
+
+	ps.parityParts[index] = part
+	ps.parityBitArray.SetIndex(int(index), true)
+	ps.parityCount++
+
+	if ps.count+ps.parityCount >= ps.total {
+		if err := ps.recover(); err != nil {
+			return true, err
+		}
+	}
 	return true, nil
 }
 
+// encodeParity computes the parity parts of a complete extended PartSet. The
+// parity is discarded if it doesn't match the parity hash.
+// CONTRACT: ps.mtx is held.
+func (ps *PartSet) encodeParity() {
+	if uint32(len(ps.parts[ps.total-1].Bytes)) != ps.lastPartSize {
+		ps.discardParity()
+		return
+	}
+	parity, err := ps.computeParity(ps.parityTotal)
+	if err != nil || !ps.setParityParts(parity) {
+		ps.discardParity()
+	}
+}
+
+// computeParity returns parityTotal Reed-Solomon parity parts of the parts of
+// a complete PartSet, padded to the size of the first part.
+// CONTRACT: ps.mtx is held.
+func (ps *PartSet) computeParity(parityTotal uint32) ([][]byte, error) {
+	enc, err := reedsolomon.New(int(ps.total), int(parityTotal))
+	if err != nil {
+		return nil, err
+	}
+	shardSize := len(ps.parts[0].Bytes)
+	shards := make([][]byte, ps.total+parityTotal)
+	for i := range shards {
+		if i < int(ps.total) {
+			shards[i] = padShard(ps.parts[i].Bytes, shardSize)
+		} else {
+			shards[i] = make([]byte, shardSize)
+		}
+	}
+	if err := enc.Encode(shards); err != nil {
+		return nil, err
+	}
+	return shards[ps.total:], nil
+}
+
+// recover recovers the missing parts of an extended PartSet from its parts
+// and parity parts, and computes the missing parity parts.
+// CONTRACT: ps.mtx is held, and there are at least ps.total parts and parity
+// parts.
+func (ps *PartSet) recover() error {
+	var shardSize int
+	for _, part := range ps.parityParts {
+		if part != nil {
+			shardSize = len(part.Bytes)
+			break
+		}
+	}
+	shards := make([][]byte, ps.total+ps.parityTotal)
+	for i, part := range ps.parts {
+		if part != nil {
+			shards[i] = padShard(part.Bytes, shardSize)
+		}
+	}
+	for i, part := range ps.parityParts {
+		if part != nil {
+			shards[int(ps.total)+i] = part.Bytes
+		}
+	}
+
+	enc, err := reedsolomon.New(int(ps.total), int(ps.parityTotal))
+	if err != nil {
+		ps.discardParity()
+		return ErrPartSetInvalidParity
+	}
+	if err := enc.Reconstruct(shards); err != nil || int(ps.lastPartSize) > shardSize {
+		ps.discardParity()
+		return ErrPartSetInvalidParity
+	}
+	data := shards[:ps.total]
+	data[ps.total-1] = data[ps.total-1][:ps.lastPartSize]
+	root, proofs := merkle.ProofsFromByteSlices(data)
+	if !bytes.Equal(root, ps.hash) || !ps.setParityParts(shards[ps.total:]) {
+		ps.discardParity()
+		return ErrPartSetInvalidParity
+	}
+
+	for i, part := range ps.parts {
+		if part != nil {
+			continue
+		}
+		ps.parts[i] = &Part{Index: uint32(i), Bytes: data[i], Proof: *proofs[i]}
+		ps.partsBitArray.SetIndex(i, true)
+		ps.count++
+		ps.byteSize += int64(len(data[i]))
+	}
+	return nil
+}
+
+// setParityParts sets all the parity parts of the PartSet, if they match the
+// parity hash.
+// CONTRACT: ps.mtx is held.
+func (ps *PartSet) setParityParts(parity [][]byte) bool {
+	root, proofs := merkle.ProofsFromByteSlices(parity)
+	if !bytes.Equal(root, ps.parityHash) {
+		return false
+	}
+	for i, part := range ps.parityParts {
+		if part != nil {
+			continue
+		}
+		ps.parityParts[i] = &Part{Index: ps.total + uint32(i), Bytes: parity[i], Proof: *proofs[i]}
+		ps.parityBitArray.SetIndex(i, true)
+		ps.parityCount++
+	}
+	return true
+}
+
+// ParityDiscarded returns whether the parity parts of an extended PartSet were
+// found not to match the parts, and dropped.
+func (ps *PartSet) ParityDiscarded() bool {
+	if ps == nil {
+		return false
+	}
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+	return ps.parityDiscarded
+}
+
+// discardParity drops the parity parts, which don't match the parts: they are
+// no longer accepted nor sent to peers.
+// CONTRACT: ps.mtx is held.
+func (ps *PartSet) discardParity() {
+	ps.parityDiscarded = true
+	ps.parityParts = make([]*Part, ps.parityTotal)
+	ps.parityBitArray = bits.NewBitArray(int(ps.parityTotal))
+	ps.parityCount = 0
+}
+
+// Extend extends a complete PartSet with Reed-Solomon parity parts. It does
+// nothing if the PartSet is already extended, or has too many parts to be
+// extended.
+func (ps *PartSet) Extend() error {
+	ps.mtx.Lock()
+	defer ps.mtx.Unlock()
+
+	if ps.count != ps.total {
+		return errors.New("cannot extend an incomplete PartSet")
+	}
+	if ps.parityTotal > 0 || ParityTotal(ps.total) == 0 {
+		return nil
+	}
+
+	parity, err := ps.computeParity(ParityTotal(ps.total))
+	if err != nil {
+		return err
+	}
+	ps.parityTotal = uint32(len(parity))
+	ps.parityHash = merkle.HashFromByteSlices(parity)
+	ps.lastPartSize = uint32(len(ps.parts[ps.total-1].Bytes))
+	ps.parityParts = make([]*Part, ps.parityTotal)
+	ps.parityBitArray = bits.NewBitArray(int(ps.parityTotal))
+	ps.setParityParts(parity)
+	return nil
+}
+
+// padShard returns the bytes of a part padded with zeros to size.
+func padShard(bz []byte, size int) []byte {
+	if len(bz) >= size {
+		return bz
+	}
+	padded := make([]byte, size)
+	copy(padded, bz)
+	return padded
+}
+
+// GetPart returns the part, or the parity part, at index, nil if it hasn't
+// been added.
 func (ps *PartSet) GetPart(index int) *Part {
 	ps.mtx.Lock()
 	defer ps.mtx.Unlock()
+	if index >= int(ps.total) {
+		return ps.parityParts[index-int(ps.total)]
+	}
 	return ps.parts[index]
 }
 
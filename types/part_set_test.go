@@ -88,6 +88,112 @@ func TestWrongProof(t *testing.T) {
 	}
 }
 
+func TestExtendedPartSet(t *testing.T) {
+	nParts := 10
+	data := cmtrand.Bytes(testPartSize*nParts - 100)
+	partSet := NewPartSetFromData(data, testPartSize)
+	require.NoError(t, partSet.Extend())
+	header := partSet.ExtendedHeader()
+	require.NoError(t, header.ValidateBasic())
+	assert.EqualValues(t, nParts, header.ParityTotal)
+	assert.EqualValues(t, testPartSize-100, header.LastPartSize)
+	assert.True(t, partSet.ParityBitArray().IsFull())
+
+	// the parts are recovered from any nParts of the parts and parity parts
+	for _, indexes := range [][]int{
+		{10, 11, 12, 13, 14, 15, 16, 17, 18, 19},
+		{0, 2, 4, 6, 8, 11, 13, 15, 17, 19},
+		{9, 10, 11, 12, 13, 14, 15, 16, 17, 18},
+		{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	} {
+		partSet2 := NewPartSetFromExtendedHeader(header)
+		for i, index := range indexes {
+			assert.False(t, partSet2.IsComplete())
+			added, err := partSet2.AddPart(partSet.GetPart(index))
+			require.NoError(t, err)
+			require.True(t, added)
+			assert.Equal(t, i+1 == nParts, partSet2.IsComplete())
+		}
+		assert.EqualValues(t, len(data), partSet2.ByteSize())
+		assert.True(t, partSet2.ParityBitArray().IsFull())
+		data2, err := io.ReadAll(partSet2.GetReader())
+		require.NoError(t, err)
+		assert.Equal(t, data, data2)
+
+		// the recovered parts and parity parts are valid
+		partSet3 := NewPartSetFromExtendedHeader(header)
+		for i := 0; i < nParts; i++ {
+			added, err := partSet3.AddPart(partSet2.GetPart(nParts + i))
+			require.NoError(t, err)
+			require.True(t, added)
+		}
+		for i := 0; i < nParts; i++ {
+			assert.Equal(t, partSet.GetPart(i), partSet3.GetPart(i))
+		}
+
+		// parts past the parity parts are rejected
+		added, err := partSet2.AddPart(&Part{Index: uint32(2 * nParts)})
+		assert.False(t, added)
+		assert.ErrorIs(t, err, ErrPartSetUnexpectedIndex)
+	}
+
+	// a parity part with the proof of another one is rejected
+	partSet2 := NewPartSetFromExtendedHeader(header)
+	part := *partSet.GetPart(nParts + 1)
+	part.Index = uint32(nParts)
+	added, err := partSet2.AddPart(&part)
+	assert.False(t, added)
+	assert.ErrorIs(t, err, ErrPartSetInvalidProof)
+
+	// the parts of a PartSet of MaxPartSetShards parts aren't extended
+	partSet = NewPartSetFromData(cmtrand.Bytes(MaxPartSetShards), 1)
+	require.NoError(t, partSet.Extend())
+	assert.Zero(t, partSet.ParityTotal())
+	require.Error(t, NewPartSetFromHeader(partSet.Header()).Extend())
+}
+
+func TestExtendedPartSetInvalidParity(t *testing.T) {
+	nParts := 4
+	data := cmtrand.Bytes(testPartSize * nParts)
+	partSet := NewPartSetFromData(data, testPartSize)
+
+	// parity parts which don't match the parts
+	parity := make([][]byte, nParts)
+	for i := range parity {
+		parity[i] = cmtrand.Bytes(testPartSize)
+	}
+	root, proofs := merkle.ProofsFromByteSlices(parity)
+	header := ExtendedPartSetHeader{
+		PartSetHeader: partSet.Header(),
+		ParityTotal:   uint32(nParts),
+		ParityHash:    root,
+		LastPartSize:  testPartSize,
+	}
+	require.NoError(t, header.ValidateBasic())
+
+	partSet2 := NewPartSetFromExtendedHeader(header)
+	for i := 0; i < nParts-1; i++ {
+		added, err := partSet2.AddPart(&Part{Index: uint32(nParts + i), Bytes: parity[i], Proof: *proofs[i]})
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	_, err := partSet2.AddPart(partSet.GetPart(0))
+	require.ErrorIs(t, err, ErrPartSetInvalidParity)
+	assert.True(t, partSet2.ParityBitArray().IsEmpty())
+
+	// the parity parts are no longer accepted, the parts are
+	added, err := partSet2.AddPart(&Part{Index: uint32(2*nParts - 1), Bytes: parity[nParts-1], Proof: *proofs[nParts-1]})
+	require.NoError(t, err)
+	require.False(t, added)
+	for i := 1; i < nParts; i++ {
+		added, err := partSet2.AddPart(partSet.GetPart(i))
+		require.NoError(t, err)
+		require.True(t, added)
+	}
+	assert.True(t, partSet2.IsComplete())
+	assert.True(t, partSet2.ParityBitArray().IsEmpty())
+}
+
 func TestPartSetHeaderValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName              string
@@ -109,6 +215,34 @@ func TestPartSetHeaderValidateBasic(t *testing.T) {
 	}
 }
 
+func TestExtendedPartSetHeaderValidateBasic(t *testing.T) {
+	testCases := []struct {
+		testName       string
+		malleateHeader func(*ExtendedPartSetHeader)
+		expectErr      bool
+	}{
+		{"Good header", func(header *ExtendedPartSetHeader) {}, false},
+		{"Invalid Hash", func(header *ExtendedPartSetHeader) { header.PartSetHeader.Hash = make([]byte, 1) }, true},
+		{"No parity parts", func(header *ExtendedPartSetHeader) { header.ParityTotal = 0 }, true},
+		{"Too many parts", func(header *ExtendedPartSetHeader) { header.ParityTotal = MaxPartSetShards }, true},
+		{"Invalid ParityHash", func(header *ExtendedPartSetHeader) { header.ParityHash = make([]byte, 1) }, true},
+		{"No ParityHash", func(header *ExtendedPartSetHeader) { header.ParityHash = nil }, true},
+		{"No LastPartSize", func(header *ExtendedPartSetHeader) { header.LastPartSize = 0 }, true},
+		{"Too big LastPartSize", func(header *ExtendedPartSetHeader) { header.LastPartSize = BlockPartSizeBytes + 1 }, true},
+	}
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.testName, func(t *testing.T) {
+			data := cmtrand.Bytes(testPartSize * 100)
+			ps := NewPartSetFromData(data, testPartSize)
+			require.NoError(t, ps.Extend())
+			header := ps.ExtendedHeader()
+			tc.malleateHeader(&header)
+			assert.Equal(t, tc.expectErr, header.ValidateBasic() != nil, "Validate Basic had an unexpected result")
+		})
+	}
+}
+
 func TestPartValidateBasic(t *testing.T) {
 	testCases := []struct {
 		testName     string
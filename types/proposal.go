@@ -22,6 +22,8 @@ var (
 // to be considered valid. It may depend on votes from a previous round,
 // a so-called Proof-of-Lock (POL) round, as noted in the POLRound.
 // If POLRound >= 0, then BlockID corresponds to the block that is locked in POLRound.
+// If the block parts are extended with parity parts, ExtendedPartSetHeader
+// describes them.
 type Proposal struct {
 	Type      cmtproto.SignedMsgType
 	Height    int64     `json:"height"`
@@ -30,6 +32,8 @@ type Proposal struct {
 	BlockID   BlockID   `json:"block_id"`
 	Timestamp time.Time `json:"timestamp"`
 	Signature []byte    `json:"signature"`
+
+	ExtendedPartSetHeader *ExtendedPartSetHeader `json:"extended_part_set_header,omitempty"`
 }
 
 // NewProposal returns a new Proposal.
@@ -66,6 +70,15 @@ func (p *Proposal) ValidateBasic() error {
 	if !p.BlockID.IsComplete() {
 		return fmt.Errorf("expected a complete, non-empty BlockID, got: %v", p.BlockID)
 	}
+	if p.ExtendedPartSetHeader != nil {
+		if err := p.ExtendedPartSetHeader.ValidateBasic(); err != nil {
+			return fmt.Errorf("wrong ExtendedPartSetHeader: %v", err)
+		}
+		if !p.ExtendedPartSetHeader.PartSetHeader.Equals(p.BlockID.PartSetHeader) {
+			return fmt.Errorf("expected the ExtendedPartSetHeader of %v, got: %v",
+				p.BlockID.PartSetHeader, p.ExtendedPartSetHeader.PartSetHeader)
+		}
+	}
 
 	// NOTE: Timestamp validation is subtle and handled elsewhere.
 
@@ -131,6 +144,7 @@ func (p *Proposal) ToProto() *cmtproto.Proposal {
 	pb.PolRound = p.POLRound
 	pb.Timestamp = p.Timestamp
 	pb.Signature = p.Signature
+	pb.ExtendedPartSetHeader = p.ExtendedPartSetHeader.ToProto()
 
 	return pb
 }
@@ -156,6 +170,12 @@ func ProposalFromProto(pp *cmtproto.Proposal) (*Proposal, error) {
 	p.POLRound = pp.PolRound
 	p.Timestamp = pp.Timestamp
 	p.Signature = pp.Signature
+	if pp.ExtendedPartSetHeader != nil {
+		p.ExtendedPartSetHeader, err = ExtendedPartSetHeaderFromProto(pp.ExtendedPartSetHeader)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	return p, p.ValidateBasic()
 }
@@ -44,6 +44,11 @@ func TestProposalSignable(t *testing.T) {
 	expected, err := protoio.MarshalDelimited(&pb)
 	require.NoError(t, err)
 	require.Equal(t, expected, signBytes, "Got unexpected sign bytes for Proposal")
+
+	// the ExtendedPartSetHeader is signed
+	extended := *pbp
+	extended.ExtendedPartSetHeader = &cmtproto.ExtendedPartSetHeader{ParityTotal: 1}
+	require.NotEqual(t, signBytes, ProposalSignBytes(chainID, &extended))
 }
 
 func TestProposalString(t *testing.T) {
@@ -144,6 +149,23 @@ func TestProposalValidateBasic(t *testing.T) {
 		{"Too big Signature", func(p *Proposal) {
 			p.Signature = make([]byte, MaxSignatureSize+1)
 		}, true},
+		{"Good ExtendedPartSetHeader", func(p *Proposal) {
+			p.ExtendedPartSetHeader = &ExtendedPartSetHeader{
+				PartSetHeader: p.BlockID.PartSetHeader, ParityTotal: 1, ParityHash: tmhash.Sum([]byte("parityhash")), LastPartSize: 1,
+			}
+			p.BlockID.PartSetHeader.Total = 1
+			p.ExtendedPartSetHeader.PartSetHeader.Total = 1
+		}, false},
+		{"Invalid ExtendedPartSetHeader", func(p *Proposal) {
+			p.ExtendedPartSetHeader = &ExtendedPartSetHeader{PartSetHeader: p.BlockID.PartSetHeader}
+		}, true},
+		{"Wrong ExtendedPartSetHeader", func(p *Proposal) {
+			p.BlockID.PartSetHeader.Total = 1
+			p.ExtendedPartSetHeader = &ExtendedPartSetHeader{
+				PartSetHeader: PartSetHeader{Total: 2, Hash: p.BlockID.PartSetHeader.Hash},
+				ParityTotal:   1, ParityHash: tmhash.Sum([]byte("parityhash")), LastPartSize: 1,
+			}
+		}, true},
 	}
 	blockID := makeBlockID(tmhash.Sum([]byte("blockhash")), math.MaxInt32, tmhash.Sum([]byte("partshash")))
 
@@ -167,6 +189,14 @@ func TestProposalProtoBuf(t *testing.T) {
 	proposal := NewProposal(1, 2, 3, makeBlockID([]byte("hash"), 2, []byte("part_set_hash")))
 	proposal.Signature = []byte("sig")
 	proposal2 := NewProposal(1, 2, 3, BlockID{})
+	proposal3 := NewProposal(1, 2, 3, makeBlockID([]byte("hash"), 2, []byte("part_set_hash")))
+	proposal3.Signature = []byte("sig")
+	proposal3.ExtendedPartSetHeader = &ExtendedPartSetHeader{
+		PartSetHeader: proposal3.BlockID.PartSetHeader,
+		ParityTotal:   2,
+		ParityHash:    tmhash.Sum([]byte("parity_hash")),
+		LastPartSize:  10,
+	}
 
 	testCases := []struct {
 		msg     string
@@ -175,6 +205,7 @@ func TestProposalProtoBuf(t *testing.T) {
 	}{
 		{"success", proposal, true},
 		{"success", proposal2, false}, // blcokID cannot be empty
+		{"success extended", proposal3, true},
 		{"empty proposal failure validatebasic", &Proposal{}, false},
 		{"nil proposal", nil, false},
 	}
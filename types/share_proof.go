@@ -112,12 +112,12 @@ func (sp ShareProof) VerifyProof(root []byte) bool {
 			true,
 		)
 		sharesUsed := proof.End - proof.Start
-		if sp.NamespaceVersion > math.MaxUint8 {
-			return false
-		}
 		// Consider extracting celestia-app's namespace package. We can't use it
 		// here because that would introduce a circulcar import.
-		namespace := append([]byte{uint8(sp.NamespaceVersion)}, sp.NamespaceID...)
+		namespace, err := sp.Namespace()
+		if err != nil {
+			return false
+		}
 		valid := nmtProof.VerifyInclusion(
 			consts.NewBaseHashFunc(),
 			namespace,
@@ -131,3 +131,54 @@ func (sp ShareProof) VerifyProof(root []byte) bool {
 	}
 	return sp.RowProof.VerifyProof(root)
 }
+
+// ShareRange returns the end exclusive range of the proven shares in the
+// original data square, in row-major order. The data root commits to the
+// row roots and the column roots of the extended square, so the width of the
+// original square is a quarter of the number of leaves of the row proofs, and
+// the rows of the shares are the indexes of their row proofs, which are
+// authenticated along with the row roots by VerifyProof. The shares must be
+// contiguous.
+func (sp ShareProof) ShareRange() (start, end uint64, err error) {
+	if err := sp.Validate(); err != nil {
+		return 0, 0, err
+	}
+	total := sp.RowProof.Proofs[0].Total
+	if total <= 0 || total%4 != 0 {
+		return 0, 0, fmt.Errorf("the data root can't commit to %d roots", total)
+	}
+	width := total / 4
+
+	for i, proof := range sp.ShareProofs {
+		rowProof := sp.RowProof.Proofs[i]
+		if rowProof == nil || rowProof.Total != total {
+			return 0, 0, fmt.Errorf("row proof %d is not of the same data root as the first one", i)
+		}
+		if rowProof.Index != int64(sp.RowProof.StartRow)+int64(i) || rowProof.Index >= width {
+			return 0, 0, fmt.Errorf("row proof %d is of row %d, expected row %d of the original square",
+				i, rowProof.Index, int64(sp.RowProof.StartRow)+int64(i))
+		}
+		if int64(proof.End) > width {
+			return 0, 0, fmt.Errorf("share proof %d ends at %d, past the width %d of the square", i, proof.End, width)
+		}
+		// only the first row may start, and only the last row may end, within
+		// the row.
+		if (i > 0 && proof.Start != 0) || (i < len(sp.ShareProofs)-1 && int64(proof.End) != width) {
+			return 0, 0, errors.New("the proven shares are not contiguous")
+		}
+	}
+
+	first, last := sp.ShareProofs[0], sp.ShareProofs[len(sp.ShareProofs)-1]
+	start = uint64(sp.RowProof.StartRow)*uint64(width) + uint64(first.Start)
+	end = uint64(sp.RowProof.EndRow)*uint64(width) + uint64(last.End)
+	return start, end, nil
+}
+
+// Namespace returns the namespace of the proven shares: the namespace version
+// followed by the namespace ID.
+func (sp ShareProof) Namespace() ([]byte, error) {
+	if sp.NamespaceVersion > math.MaxUint8 {
+		return nil, fmt.Errorf("namespace version %d doesn't fit in a byte", sp.NamespaceVersion)
+	}
+	return append([]byte{uint8(sp.NamespaceVersion)}, sp.NamespaceID...), nil
+}
@@ -98,6 +98,34 @@ func TestShareProofVerify(t *testing.T) {
 	}
 }
 
+func TestShareProofShareRange(t *testing.T) {
+	// the row proof of the valid share proof is of the first row of a square
+	// of width 32.
+	start, end, err := validShareProof().ShareRange()
+	assert.NoError(t, err)
+	assert.EqualValues(t, 0, start)
+	assert.EqualValues(t, 1, end)
+
+	otherRow := validShareProof()
+	otherRow.RowProof.Proofs[0].Index = 1
+	_, _, err = otherRow.ShareRange()
+	assert.Error(t, err)
+
+	parityRow := validShareProof()
+	parityRow.RowProof.StartRow, parityRow.RowProof.EndRow = 32, 32
+	parityRow.RowProof.Proofs[0].Index = 32
+	_, _, err = parityRow.ShareRange()
+	assert.Error(t, err)
+
+	pastWidth := validShareProof()
+	pastWidth.ShareProofs[0].Start, pastWidth.ShareProofs[0].End = 32, 33
+	_, _, err = pastWidth.ShareRange()
+	assert.Error(t, err)
+
+	_, _, err = ShareProof{}.ShareRange()
+	assert.Error(t, err)
+}
+
 func mismatchedShareProofs() ShareProof {
 	sp := validShareProof()
 	sp.ShareProofs = []*types.NMTProof{}
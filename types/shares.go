@@ -0,0 +1,142 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/cometbft/cometbft/pkg/consts"
+)
+
+// SparseSharesNeeded returns the number of shares needed to store a blob of
+// the given size and share version.
+func SparseSharesNeeded(blobSize int, shareVersion uint32) (uint64, error) {
+	firstShareSize, err := firstSparseShareContentSize(shareVersion)
+	if err != nil {
+		return 0, err
+	}
+	if blobSize <= firstShareSize {
+		return 1, nil
+	}
+	rest := blobSize - firstShareSize
+	return 1 + uint64((rest+consts.ContinuationSparseShareContentSize-1)/consts.ContinuationSparseShareContentSize), nil
+}
+
+// firstSparseShareContentSize returns the number of bytes of blob data that
+// fit in the first share of a blob of the share version.
+func firstSparseShareContentSize(shareVersion uint32) (int, error) {
+	switch shareVersion {
+	case consts.ShareVersionZero:
+		return consts.FirstSparseShareContentSize, nil
+	case consts.ShareVersionOne:
+		return consts.FirstSparseShareContentSize - consts.SignerSize, nil
+	default:
+		return 0, fmt.Errorf("unsupported share version %d", shareVersion)
+	}
+}
+
+// ParseCompactShares returns the length delimited units, such as transactions
+// or IndexWrappers, that are fully contained in a contiguous range of compact
+// shares. Units that started before the first share, or that continue after
+// the last share, are skipped.
+func ParseCompactShares(shares [][]byte) ([]Tx, error) {
+	var (
+		data    []byte
+		started bool
+	)
+	for i, share := range shares {
+		if len(share) != consts.ShareSize {
+			return nil, fmt.Errorf("share %d has size %d, expected %d", i, len(share), consts.ShareSize)
+		}
+		offset := consts.NamespaceSize + consts.ShareInfoBytes
+		if isSequenceStart(share) {
+			offset += consts.SequenceLenBytes
+		}
+		reserved := int(binary.BigEndian.Uint32(share[offset:]))
+		offset += consts.CompactShareReservedBytes
+
+		if !started {
+			// skip the end of a unit that started before the first share.
+			if reserved == 0 {
+				continue
+			}
+			if reserved < offset || reserved >= consts.ShareSize {
+				return nil, fmt.Errorf("share %d has invalid reserved bytes %d", i, reserved)
+			}
+			offset = reserved
+			started = true
+		}
+		data = append(data, share[offset:]...)
+	}
+
+	var units []Tx
+	for len(data) > 0 {
+		size, n := binary.Uvarint(data)
+		// a unit of size zero is padding at the end of the last share.
+		if n <= 0 || size == 0 || size > uint64(len(data)-n) {
+			break
+		}
+		units = append(units, Tx(data[n:n+int(size)]))
+		data = data[n+int(size):]
+	}
+	return units, nil
+}
+
+// ParseSparseShares returns the namespace, the share version and the data of
+// the blob stored in the shares, which must be all the shares of a single
+// blob. The signer of a blob of share version one is skipped.
+func ParseSparseShares(shares [][]byte) (namespace []byte, shareVersion uint32, data []byte, err error) {
+	if len(shares) == 0 {
+		return nil, 0, nil, fmt.Errorf("no shares provided")
+	}
+	var size int
+	for i, share := range shares {
+		if len(share) != consts.ShareSize {
+			return nil, 0, nil, fmt.Errorf("share %d has size %d, expected %d", i, len(share), consts.ShareSize)
+		}
+		if i == 0 {
+			namespace = share[:consts.NamespaceSize]
+			shareVersion = shareVersionOf(share)
+		} else if !bytes.Equal(namespace, share[:consts.NamespaceSize]) {
+			return nil, 0, nil, fmt.Errorf("share %d is not in the namespace of the first share", i)
+		} else if shareVersionOf(share) != shareVersion {
+			return nil, 0, nil, fmt.Errorf("share %d has share version %d, expected %d", i, shareVersionOf(share), shareVersion)
+		}
+		if isSequenceStart(share) != (i == 0) {
+			return nil, 0, nil, fmt.Errorf("share %d has an unexpected sequence start indicator", i)
+		}
+
+		offset := consts.NamespaceSize + consts.ShareInfoBytes
+		if i == 0 {
+			size = int(binary.BigEndian.Uint32(share[offset:]))
+			offset += consts.SequenceLenBytes
+			if shareVersion == consts.ShareVersionOne {
+				offset += consts.SignerSize
+			}
+		}
+		data = append(data, share[offset:]...)
+	}
+	if size > len(data) {
+		return nil, 0, nil, fmt.Errorf("sequence length %d exceeds the %d bytes stored in the shares", size, len(data))
+	}
+	needed, err := SparseSharesNeeded(size, shareVersion)
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	if uint64(len(shares)) != needed {
+		return nil, 0, nil, fmt.Errorf("a blob of %d bytes is stored in %d shares, got %d", size, needed, len(shares))
+	}
+	return namespace, shareVersion, data[:size], nil
+}
+
+// shareVersionOf returns the share version of a share, stored in the seven
+// most significant bits of the info byte.
+func shareVersionOf(share []byte) uint32 {
+	return uint32(share[consts.NamespaceSize] >> 1)
+}
+
+// isSequenceStart returns true if the share is the first share of a sequence.
+// The sequence start indicator is the least significant bit of the info byte.
+func isSequenceStart(share []byte) bool {
+	return share[consts.NamespaceSize]&1 == 1
+}
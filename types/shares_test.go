@@ -0,0 +1,180 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cometbft/cometbft/pkg/consts"
+)
+
+func TestSparseSharesNeeded(t *testing.T) {
+	const firstV1 = consts.FirstSparseShareContentSize - consts.SignerSize
+	testCases := []struct {
+		blobSize     int
+		shareVersion uint32
+		want         uint64
+	}{
+		{0, 0, 1},
+		{1, 0, 1},
+		{consts.FirstSparseShareContentSize, 0, 1},
+		{consts.FirstSparseShareContentSize + 1, 0, 2},
+		{consts.FirstSparseShareContentSize + consts.ContinuationSparseShareContentSize, 0, 2},
+		{consts.FirstSparseShareContentSize + consts.ContinuationSparseShareContentSize + 1, 0, 3},
+		{0, 1, 1},
+		{firstV1, 1, 1},
+		{firstV1 + 1, 1, 2},
+		{consts.FirstSparseShareContentSize, 1, 2},
+		{firstV1 + consts.ContinuationSparseShareContentSize, 1, 2},
+		{firstV1 + consts.ContinuationSparseShareContentSize + 1, 1, 3},
+	}
+	for _, tc := range testCases {
+		got, err := SparseSharesNeeded(tc.blobSize, tc.shareVersion)
+		require.NoError(t, err)
+		assert.Equal(t, tc.want, got, "blob size %d, share version %d", tc.blobSize, tc.shareVersion)
+	}
+
+	_, err := SparseSharesNeeded(1, 2)
+	assert.Error(t, err)
+}
+
+func TestParseCompactShares(t *testing.T) {
+	units := []Tx{
+		bytes.Repeat([]byte{1}, 100),
+		bytes.Repeat([]byte{2}, 600), // spans the first two shares
+		bytes.Repeat([]byte{3}, 200),
+		bytes.Repeat([]byte{4}, 300), // spans the last two shares
+	}
+	shares := writeCompactShares(units)
+	require.Len(t, shares, 3)
+
+	parsed, err := ParseCompactShares(shares)
+	require.NoError(t, err)
+	assert.Equal(t, units, parsed)
+
+	// units that started before the first share are skipped, as are units
+	// that continue after the last share.
+	parsed, err = ParseCompactShares(shares[1:2])
+	require.NoError(t, err)
+	assert.Equal(t, units[2:3], parsed)
+
+	_, err = ParseCompactShares([][]byte{make([]byte, 10)})
+	assert.Error(t, err)
+}
+
+func TestParseSparseShares(t *testing.T) {
+	namespace := append([]byte{0}, bytes.Repeat([]byte{7}, consts.NamespaceIDSize)...)
+	for _, shareVersion := range []uint32{consts.ShareVersionZero, consts.ShareVersionOne} {
+		for _, size := range []int{0, 10, consts.FirstSparseShareContentSize, 1000} {
+			data := bytes.Repeat([]byte{9}, size)
+			shares := writeSparseShares(namespace, data, shareVersion)
+
+			parsedNamespace, parsedVersion, parsedData, err := ParseSparseShares(shares)
+			require.NoError(t, err)
+			assert.Equal(t, namespace, parsedNamespace)
+			assert.Equal(t, shareVersion, parsedVersion)
+			assert.Equal(t, data, parsedData)
+		}
+	}
+
+	shares := writeSparseShares(namespace, bytes.Repeat([]byte{9}, 1000), 0)
+	// missing shares
+	_, _, _, err := ParseSparseShares(shares[:2])
+	assert.Error(t, err)
+	// shares that don't start the sequence
+	_, _, _, err = ParseSparseShares(shares[1:])
+	assert.Error(t, err)
+	// shares of another namespace
+	other := append([]byte{}, shares[1]...)
+	other[consts.NamespaceSize-1] = 8
+	_, _, _, err = ParseSparseShares([][]byte{shares[0], other, shares[2]})
+	assert.Error(t, err)
+	// shares of another share version
+	other = append([]byte{}, shares[1]...)
+	other[consts.NamespaceSize] = consts.ShareVersionOne << 1
+	_, _, _, err = ParseSparseShares([][]byte{shares[0], other, shares[2]})
+	assert.Error(t, err)
+	// shares of an unsupported share version
+	unsupported := writeSparseShares(namespace, []byte{9}, 0)
+	unsupported[0][consts.NamespaceSize] = 2<<1 | 1
+	_, _, _, err = ParseSparseShares(unsupported)
+	assert.Error(t, err)
+}
+
+// writeSparseShares splits the data of a blob of the share version into
+// shares.
+func writeSparseShares(namespace, data []byte, shareVersion uint32) [][]byte {
+	var shares [][]byte
+	n, err := SparseSharesNeeded(len(data), shareVersion)
+	if err != nil {
+		panic(err)
+	}
+	for i := uint64(0); i < n; i++ {
+		share := append([]byte{}, namespace...)
+		size := consts.ContinuationSparseShareContentSize
+		if i == 0 {
+			share = append(share, byte(shareVersion<<1|1))
+			share = binary.BigEndian.AppendUint32(share, uint32(len(data)))
+			size = consts.FirstSparseShareContentSize
+			if shareVersion == consts.ShareVersionOne {
+				share = append(share, bytes.Repeat([]byte{0xAB}, consts.SignerSize)...)
+				size -= consts.SignerSize
+			}
+		} else {
+			share = append(share, byte(shareVersion<<1))
+		}
+		size = min(size, len(data))
+		share = append(share, data[:size]...)
+		data = data[size:]
+		shares = append(shares, append(share, make([]byte, consts.ShareSize-len(share))...))
+	}
+	return shares
+}
+
+// writeCompactShares splits length delimited units into compact shares of the
+// transaction namespace.
+func writeCompactShares(units []Tx) [][]byte {
+	var (
+		data   []byte
+		starts []int
+	)
+	for _, unit := range units {
+		starts = append(starts, len(data))
+		data = binary.AppendUvarint(data, uint64(len(unit)))
+		data = append(data, unit...)
+	}
+	namespace := append([]byte{0}, consts.TxNamespaceID[len(consts.TxNamespaceID)-consts.NamespaceIDSize:]...)
+
+	var shares [][]byte
+	for pos := 0; pos < len(data); {
+		share := append([]byte{}, namespace...)
+		if pos == 0 {
+			share = append(share, 1)
+			share = binary.BigEndian.AppendUint32(share, uint32(len(data)))
+		} else {
+			share = append(share, 0)
+		}
+		header := len(share) + consts.CompactShareReservedBytes
+		end := pos + consts.ShareSize - header
+		if end > len(data) {
+			end = len(data)
+		}
+
+		reserved := 0
+		for _, start := range starts {
+			if start >= pos && start < end {
+				reserved = header + start - pos
+				break
+			}
+		}
+		share = binary.BigEndian.AppendUint32(share, uint32(reserved))
+		share = append(share, data[pos:end]...)
+		share = append(share, make([]byte, consts.ShareSize-len(share))...)
+		shares = append(shares, share)
+		pos = end
+	}
+	return shares
+}